@@ -1,6 +1,7 @@
 package app
 
 import (
+	"fmt"
 	"io"
 	stdlog "log"
 	"net/http"
@@ -15,6 +16,7 @@ import (
 	tmjson "github.com/tendermint/tendermint/libs/json"
 	"github.com/tendermint/tendermint/libs/log"
 	tmos "github.com/tendermint/tendermint/libs/os"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 	dbm "github.com/tendermint/tm-db"
 
 	transfer "github.com/cosmos/ibc-go/modules/apps/transfer"
@@ -98,10 +100,14 @@ import (
 	customauth "github.com/terra-money/core/custom/auth"
 	customante "github.com/terra-money/core/custom/auth/ante"
 	customauthrest "github.com/terra-money/core/custom/auth/client/rest"
+	customauthconfig "github.com/terra-money/core/custom/auth/config"
 	customauthsim "github.com/terra-money/core/custom/auth/simulation"
 	customauthtx "github.com/terra-money/core/custom/auth/tx"
+	customauthtypes "github.com/terra-money/core/custom/auth/types"
 	customauthz "github.com/terra-money/core/custom/authz"
+	customauthztypes "github.com/terra-money/core/custom/authz/types"
 	custombank "github.com/terra-money/core/custom/bank"
+	custombanktypes "github.com/terra-money/core/custom/bank/types"
 	customcrisis "github.com/terra-money/core/custom/crisis"
 	customdistr "github.com/terra-money/core/custom/distribution"
 	customevidence "github.com/terra-money/core/custom/evidence"
@@ -120,10 +126,12 @@ import (
 	oraclekeeper "github.com/terra-money/core/x/oracle/keeper"
 	oracletypes "github.com/terra-money/core/x/oracle/types"
 	"github.com/terra-money/core/x/treasury"
+	treasuryclient "github.com/terra-money/core/x/treasury/client"
 	treasurykeeper "github.com/terra-money/core/x/treasury/keeper"
 	treasurytypes "github.com/terra-money/core/x/treasury/types"
 	"github.com/terra-money/core/x/vesting"
 	"github.com/terra-money/core/x/wasm"
+	wasmclient "github.com/terra-money/core/x/wasm/client"
 	wasmconfig "github.com/terra-money/core/x/wasm/config"
 	wasmkeeper "github.com/terra-money/core/x/wasm/keeper"
 	wasmtypes "github.com/terra-money/core/x/wasm/types"
@@ -165,6 +173,14 @@ var (
 			upgradeclient.CancelProposalHandler,
 			ibcclientclient.UpdateClientProposalHandler,
 			ibcclientclient.UpgradeProposalHandler,
+			treasuryclient.AddTaxExemptionProposalHandler,
+			treasuryclient.RemoveTaxExemptionProposalHandler,
+			wasmclient.PinCodesProposalHandler,
+			wasmclient.UnpinCodesProposalHandler,
+			wasmclient.StoreCodeProposalHandler,
+			wasmclient.InstantiateContractProposalHandler,
+			wasmclient.MigrateContractProposalHandler,
+			wasmclient.SudoContractProposalHandler,
 		),
 		customparams.AppModuleBasic{},
 		customcrisis.AppModuleBasic{},
@@ -217,6 +233,13 @@ type TerraApp struct { // nolint: golint
 	legacyAmino       *codec.LegacyAmino
 	appCodec          codec.Codec
 	interfaceRegistry codectypes.InterfaceRegistry
+	txConfig          client.TxConfig
+
+	// taxableMsgRegistry reports the taxable principals of the msgs
+	taxableMsgRegistry customauthtypes.TaxableMsgRegistry
+
+	// baseGasPrice is the base of the dynamic local min gas prices; nil if disabled
+	baseGasPrice *sdk.DecCoin
 
 	invCheckPeriod uint
 
@@ -250,6 +273,7 @@ type TerraApp struct { // nolint: golint
 	// make scoped keepers public for test purposes
 	ScopedIBCKeeper      capabilitykeeper.ScopedKeeper
 	ScopedTransferKeeper capabilitykeeper.ScopedKeeper
+	ScopedWasmKeeper     capabilitykeeper.ScopedKeeper
 
 	// the module manager
 	mm *module.Manager
@@ -301,12 +325,21 @@ func NewTerraApp(
 		legacyAmino:       legacyAmino,
 		appCodec:          appCodec,
 		interfaceRegistry: interfaceRegistry,
+		txConfig:          encodingConfig.TxConfig,
 		invCheckPeriod:    invCheckPeriod,
 		keys:              keys,
 		tkeys:             tkeys,
 		memKeys:           memKeys,
 	}
 
+	// register the msgs subject to the stability tax
+	app.taxableMsgRegistry = customauthtypes.NewTaxableMsgRegistry()
+	customauthtypes.RegisterTaxableMsgs(app.taxableMsgRegistry)
+	customauthztypes.RegisterTaxableMsgs(app.taxableMsgRegistry)
+	custombanktypes.RegisterTaxableMsgs(app.taxableMsgRegistry)
+	markettypes.RegisterTaxableMsgs(app.taxableMsgRegistry)
+	wasmtypes.RegisterTaxableMsgs(app.taxableMsgRegistry)
+
 	// init params keeper and subspaces
 	app.ParamsKeeper = initParamsKeeper(appCodec, legacyAmino, keys[paramstypes.StoreKey], tkeys[paramstypes.TStoreKey])
 	// set the BaseApp's parameter store
@@ -316,6 +349,7 @@ func NewTerraApp(
 	app.CapabilityKeeper = capabilitykeeper.NewKeeper(appCodec, keys[capabilitytypes.StoreKey], memKeys[capabilitytypes.MemStoreKey])
 	scopedIBCKeeper := app.CapabilityKeeper.ScopeToModule(ibchost.ModuleName)
 	scopedTransferKeeper := app.CapabilityKeeper.ScopeToModule(ibctransfertypes.ModuleName)
+	scopedWasmKeeper := app.CapabilityKeeper.ScopeToModule(wasmtypes.ModuleName)
 
 	// Applications that wish to enforce statically created ScopedKeepers should call `Seal` after creating
 	// their scoped modules in `NewApp` with `ScopeToModule`
@@ -370,11 +404,6 @@ func NewTerraApp(
 	)
 	transferModule := transfer.NewAppModule(app.TransferKeeper)
 
-	// Create static IBC router, add transfer route, then set and seal it
-	ibcRouter := porttypes.NewRouter()
-	ibcRouter.AddRoute(ibctransfertypes.ModuleName, transferModule)
-	app.IBCKeeper.SetRouter(ibcRouter)
-
 	// create evidence keeper with router
 	evidenceKeeper := evidencekeeper.NewKeeper(
 		appCodec, keys[evidencetypes.StoreKey], &app.StakingKeeper, app.SlashingKeeper,
@@ -391,8 +420,9 @@ func NewTerraApp(
 		appCodec, keys[markettypes.StoreKey],
 		app.GetSubspace(markettypes.ModuleName),
 		app.AccountKeeper, app.BankKeeper, app.OracleKeeper,
+		app.DistrKeeper, distrtypes.ModuleName,
 	)
-	app.TreasuryKeeper = treasurykeeper.NewKeeper(
+	treasuryKeeper := treasurykeeper.NewKeeper(
 		appCodec, keys[treasurytypes.StoreKey],
 		app.GetSubspace(treasurytypes.ModuleName),
 		app.AccountKeeper, app.BankKeeper,
@@ -400,12 +430,20 @@ func NewTerraApp(
 		app.StakingKeeper, app.DistrKeeper,
 		distrtypes.ModuleName)
 
+	// register the treasury hooks; modules reacting to the epoch policy updates add their hooks here
+	// NOTE: AfterEpochEnd is only called past the probation period, when the policy is updated
+	// NOTE: the hooks must be set before the treasury keeper is passed to other keepers by value
+	app.TreasuryKeeper = *treasuryKeeper.SetHooks(
+		treasurytypes.NewMultiTreasuryHooks(),
+	)
+
 	app.WasmKeeper = wasmkeeper.NewKeeper(
 		appCodec, keys[wasmtypes.StoreKey],
 		app.GetSubspace(wasmtypes.ModuleName),
 		app.AccountKeeper, app.BankKeeper,
-		app.TreasuryKeeper, bApp.MsgServiceRouter(),
-		app.GRPCQueryRouter(), wasmtypes.DefaultFeatures,
+		app.TreasuryKeeper, app.taxableMsgRegistry, app.IBCKeeper.ChannelKeeper,
+		&app.IBCKeeper.PortKeeper, scopedWasmKeeper,
+		bApp.MsgServiceRouter(), app.GRPCQueryRouter(), wasmtypes.DefaultFeatures,
 		homePath, wasmConfig,
 	)
 
@@ -417,7 +455,7 @@ func NewTerraApp(
 		wasmtypes.WasmMsgParserRouteWasm:         wasmkeeper.NewWasmMsgParser(),
 		wasmtypes.WasmMsgParserRouteDistribution: distrwasm.NewWasmMsgParser(),
 		wasmtypes.WasmMsgParserRouteGov:          govwasm.NewWasmMsgParser(),
-	}, wasmkeeper.NewStargateWasmMsgParser(appCodec))
+	}, wasmkeeper.NewStargateWasmMsgParser(appCodec), wasmkeeper.NewIBCWasmMsgParser(app.TransferKeeper))
 	app.WasmKeeper.RegisterQueriers(map[string]wasmtypes.WasmQuerierInterface{
 		wasmtypes.WasmQueryRouteBank:     bankwasm.NewWasmQuerier(app.BankKeeper),
 		wasmtypes.WasmQueryRouteStaking:  stakingwasm.NewWasmQuerier(app.StakingKeeper, app.DistrKeeper),
@@ -427,13 +465,22 @@ func NewTerraApp(
 		wasmtypes.WasmQueryRouteWasm:     wasmkeeper.NewWasmQuerier(app.WasmKeeper),
 	}, wasmkeeper.NewStargateWasmQuerier(app.WasmKeeper))
 
+	// Create static IBC router, add transfer and wasm routes, then set and seal it
+	// NOTE: the wasm ibc handler must be created after the wasm msg parsers are registered
+	ibcRouter := porttypes.NewRouter()
+	ibcRouter.AddRoute(ibctransfertypes.ModuleName, transferModule)
+	ibcRouter.AddRoute(wasmtypes.ModuleName, wasm.NewIBCHandler(app.WasmKeeper, app.IBCKeeper.ChannelKeeper))
+	app.IBCKeeper.SetRouter(ibcRouter)
+
 	// register the proposal types
 	govRouter := govtypes.NewRouter()
 	govRouter.AddRoute(govtypes.RouterKey, govtypes.ProposalHandler).
 		AddRoute(paramproposal.RouterKey, params.NewParamChangeProposalHandler(app.ParamsKeeper)).
 		AddRoute(distrtypes.RouterKey, distr.NewCommunityPoolSpendProposalHandler(app.DistrKeeper)).
 		AddRoute(upgradetypes.RouterKey, upgrade.NewSoftwareUpgradeProposalHandler(app.UpgradeKeeper)).
-		AddRoute(ibcclienttypes.RouterKey, ibcclient.NewClientProposalHandler(app.IBCKeeper.ClientKeeper))
+		AddRoute(ibcclienttypes.RouterKey, ibcclient.NewClientProposalHandler(app.IBCKeeper.ClientKeeper)).
+		AddRoute(treasurytypes.RouterKey, treasury.NewProposalHandler(app.TreasuryKeeper)).
+		AddRoute(wasmtypes.RouterKey, wasm.NewProposalHandler(app.WasmKeeper))
 	app.GovKeeper = govkeeper.NewKeeper(
 		appCodec, keys[govtypes.StoreKey], app.GetSubspace(govtypes.ModuleName), app.AccountKeeper, app.BankKeeper,
 		&stakingKeeper, govRouter,
@@ -477,9 +524,10 @@ func NewTerraApp(
 	// NOTE: staking module is required if HistoricalEntries param > 0
 	app.mm.SetOrderBeginBlockers(
 		upgradetypes.ModuleName, capabilitytypes.ModuleName,
-		minttypes.ModuleName, distrtypes.ModuleName,
-		slashingtypes.ModuleName, evidencetypes.ModuleName,
-		stakingtypes.ModuleName, ibchost.ModuleName,
+		minttypes.ModuleName, treasurytypes.ModuleName,
+		distrtypes.ModuleName, slashingtypes.ModuleName,
+		evidencetypes.ModuleName, stakingtypes.ModuleName,
+		ibchost.ModuleName,
 	)
 	app.mm.SetOrderEndBlockers(
 		crisistypes.ModuleName, govtypes.ModuleName,
@@ -549,16 +597,26 @@ func NewTerraApp(
 	app.SetInitChainer(app.InitChainer)
 	app.SetBeginBlocker(app.BeginBlocker)
 
+	baseGasPrice, err := customauthconfig.GetConfig(appOpts).GetBaseGasPrice()
+	if err != nil {
+		panic(fmt.Sprintf("invalid base gas price: %s", err))
+	}
+	app.baseGasPrice = baseGasPrice
+
 	anteHandler, err := customante.NewAnteHandler(
 		customante.HandlerOptions{
 			AccountKeeper:    app.AccountKeeper,
 			BankKeeper:       app.BankKeeper,
 			FeegrantKeeper:   app.FeeGrantKeeper,
 			OracleKeeper:     app.OracleKeeper,
+			MarketKeeper:     app.MarketKeeper,
 			TreasuryKeeper:   app.TreasuryKeeper,
+			BaseGasPrice:     baseGasPrice,
 			SigGasConsumer:   ante.DefaultSigVerificationGasConsumer,
 			SignModeHandler:  encodingConfig.TxConfig.SignModeHandler(),
 			IBCChannelKeeper: app.IBCKeeper.ChannelKeeper,
+
+			TaxableMsgRegistry: app.taxableMsgRegistry,
 		},
 	)
 	if err != nil {
@@ -572,9 +630,16 @@ func NewTerraApp(
 		if err := app.LoadLatestVersion(); err != nil {
 			tmos.Exit(err.Error())
 		}
+
+		// the wasm vm cache is not persisted, so the pinned codes are pinned again on every startup
+		ctx := app.BaseApp.NewUncachedContext(true, tmproto.Header{})
+		if err := app.WasmKeeper.InitializePinnedCodes(ctx); err != nil {
+			tmos.Exit(fmt.Sprintf("failed to initialize pinned codes: %s", err))
+		}
 	}
 	app.ScopedIBCKeeper = scopedIBCKeeper
 	app.ScopedTransferKeeper = scopedTransferKeeper
+	app.ScopedWasmKeeper = scopedWasmKeeper
 
 	return app
 }
@@ -648,6 +713,11 @@ func (app *TerraApp) InterfaceRegistry() codectypes.InterfaceRegistry {
 	return app.interfaceRegistry
 }
 
+// TaxableMsgRegistry returns TerraApp's TaxableMsgRegistry
+func (app *TerraApp) TaxableMsgRegistry() customauthtypes.TaxableMsgRegistry {
+	return app.taxableMsgRegistry
+}
+
 // GetKey returns the KVStoreKey for the provided store key.
 //
 // NOTE: This is solely to be used for testing purposes.
@@ -682,6 +752,42 @@ func (app *TerraApp) SimulationManager() *module.SimulationManager {
 	return app.sm
 }
 
+// GetBaseApp returns the BaseApp of the TerraApp.
+//
+// NOTE: This is solely to be used for testing purposes; together with the other
+// getters below it implements the ibc-go TestingApp interface.
+func (app *TerraApp) GetBaseApp() *baseapp.BaseApp {
+	return app.BaseApp
+}
+
+// GetStakingKeeper returns the staking keeper of the TerraApp.
+//
+// NOTE: This is solely to be used for testing purposes.
+func (app *TerraApp) GetStakingKeeper() stakingkeeper.Keeper {
+	return app.StakingKeeper
+}
+
+// GetIBCKeeper returns the ibc keeper of the TerraApp.
+//
+// NOTE: This is solely to be used for testing purposes.
+func (app *TerraApp) GetIBCKeeper() *ibckeeper.Keeper {
+	return app.IBCKeeper
+}
+
+// GetScopedIBCKeeper returns the ibc scoped capability keeper of the TerraApp.
+//
+// NOTE: This is solely to be used for testing purposes.
+func (app *TerraApp) GetScopedIBCKeeper() capabilitykeeper.ScopedKeeper {
+	return app.ScopedIBCKeeper
+}
+
+// GetTxConfig returns the TxConfig of the TerraApp.
+//
+// NOTE: This is solely to be used for testing purposes.
+func (app *TerraApp) GetTxConfig() client.TxConfig {
+	return app.txConfig
+}
+
 // RegisterAPIRoutes registers all application module routes with the provided
 // API server.
 func (app *TerraApp) RegisterAPIRoutes(apiSvr *api.Server, apiConfig config.APIConfig) {
@@ -711,7 +817,10 @@ func (app *TerraApp) RegisterAPIRoutes(apiSvr *api.Server, apiConfig config.APIC
 // RegisterTxService implements the Application.RegisterTxService method.
 func (app *TerraApp) RegisterTxService(clientCtx client.Context) {
 	authtx.RegisterTxService(app.BaseApp.GRPCQueryRouter(), clientCtx, app.BaseApp.Simulate, app.interfaceRegistry)
-	customauthtx.RegisterTxService(app.BaseApp.GRPCQueryRouter(), clientCtx, app.TreasuryKeeper)
+	// the fee estimation applies the same local min gas prices as the ante handler
+	minGasPriceDecorator := customante.NewDynamicMinGasPriceDecorator(app.baseGasPrice, app.OracleKeeper, app.MarketKeeper)
+	customauthtx.RegisterTxService(app.BaseApp.GRPCQueryRouter(), clientCtx, app.BaseApp.Simulate,
+		minGasPriceDecorator.LocalMinGasPrices, app.TreasuryKeeper, app.taxableMsgRegistry)
 }
 
 // RegisterTendermintService implements the Application.RegisterTendermintService method.
@@ -1,6 +1,7 @@
 package main
 
 import (
+	customauthconfig "github.com/terra-money/core/custom/auth/config"
 	wasmconfig "github.com/terra-money/core/x/wasm/config"
 
 	serverconfig "github.com/cosmos/cosmos-sdk/server/config"
@@ -10,7 +11,8 @@ import (
 type TerraAppConfig struct {
 	serverconfig.Config
 
-	WASMConfig wasmconfig.Config `mapstructure:"wasm"`
+	WASMConfig wasmconfig.Config       `mapstructure:"wasm"`
+	FeeConfig  customauthconfig.Config `mapstructure:"fee"`
 }
 
 // initAppConfig helps to override default appConfig template and configs.
@@ -37,9 +39,10 @@ func initAppConfig() (string, interface{}) {
 	terraAppConfig := TerraAppConfig{
 		Config:     *srvCfg,
 		WASMConfig: *wasmconfig.DefaultConfig(),
+		FeeConfig:  *customauthconfig.DefaultConfig(),
 	}
 
-	terraAppTemplate := serverconfig.DefaultConfigTemplate + wasmconfig.DefaultConfigTemplate
+	terraAppTemplate := serverconfig.DefaultConfigTemplate + wasmconfig.DefaultConfigTemplate + customauthconfig.DefaultConfigTemplate
 
 	return terraAppTemplate, terraAppConfig
 }
@@ -9,6 +9,8 @@ import (
 	cosmosante "github.com/cosmos/cosmos-sdk/x/auth/ante"
 	"github.com/cosmos/cosmos-sdk/x/auth/signing"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	customauthtypes "github.com/terra-money/core/custom/auth/types"
 )
 
 // HandlerOptions are the options required for constructing a default SDK AnteHandler.
@@ -17,10 +19,17 @@ type HandlerOptions struct {
 	BankKeeper       types.BankKeeper
 	FeegrantKeeper   cosmosante.FeegrantKeeper
 	OracleKeeper     OracleKeeper
+	MarketKeeper     MarketKeeper
 	TreasuryKeeper   TreasuryKeeper
 	SignModeHandler  signing.SignModeHandler
 	SigGasConsumer   cosmosante.SignatureVerificationGasConsumer
 	IBCChannelKeeper channelkeeper.Keeper
+
+	// TaxableMsgRegistry reports the taxable principals of the msgs
+	TaxableMsgRegistry customauthtypes.TaxableMsgRegistry
+
+	// BaseGasPrice is the base of the dynamic minimum gas prices; disabled if nil
+	BaseGasPrice *sdk.DecCoin
 }
 
 // NewAnteHandler returns an AnteHandler that checks and increments sequence
@@ -43,6 +52,14 @@ func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "treasury keeper is required for ante builder")
 	}
 
+	if options.TaxableMsgRegistry == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "taxable msg registry is required for ante builder")
+	}
+
+	if options.BaseGasPrice != nil && options.MarketKeeper == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "market keeper is required for dynamic min gas prices")
+	}
+
 	if options.SignModeHandler == nil {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "sign mode handler is required for ante builder")
 	}
@@ -55,8 +72,10 @@ func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
 	return sdk.ChainAnteDecorators(
 		cosmosante.NewSetUpContextDecorator(), // outermost AnteDecorator. SetUpContext must be called first
 		cosmosante.NewRejectExtensionOptionsDecorator(),
-		NewSpammingPreventionDecorator(options.OracleKeeper), // spamming prevention
-		NewTaxFeeDecorator(options.TreasuryKeeper),           // mempool gas fee validation & record tax proceeds
+		NewSpammingPreventionDecorator(options.OracleKeeper),                                             // spamming prevention
+		NewDynamicMinGasPriceDecorator(options.BaseGasPrice, options.OracleKeeper, options.MarketKeeper), // derive local min gas prices
+		NewTaxFeeDecorator(options.TreasuryKeeper, options.TaxableMsgRegistry),                           // mempool gas fee validation & record tax proceeds
+		NewMsgFeeDecorator(options.TreasuryKeeper, options.TaxableMsgRegistry),                           // msg type fee requirements validation
 		cosmosante.NewValidateBasicDecorator(),
 		cosmosante.NewTxTimeoutHeightDecorator(),
 		cosmosante.NewValidateMemoDecorator(options.AccountKeeper),
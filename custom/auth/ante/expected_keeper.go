@@ -2,16 +2,34 @@ package ante
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	oracletypes "github.com/terra-money/core/x/oracle/types"
+	treasurytypes "github.com/terra-money/core/x/treasury/types"
 )
 
-// TreasuryKeeper for tax charging & recording
-type TreasuryKeeper interface {
-	RecordEpochTaxProceeds(ctx sdk.Context, delta sdk.Coins)
+// TaxKeeper for tax computation
+type TaxKeeper interface {
 	GetTaxRate(ctx sdk.Context) (taxRate sdk.Dec)
+	GetDenomTaxRateOverride(ctx sdk.Context, denom string) (taxRate sdk.Dec, found bool)
 	GetTaxCap(ctx sdk.Context, denom string) (taxCap sdk.Int)
+	IsTaxExempt(ctx sdk.Context, addresses ...string) bool
 }
 
-// OracleKeeper for feeder validation
+// TreasuryKeeper for tax charging & recording and fee requirements loading
+type TreasuryKeeper interface {
+	TaxKeeper
+	RecordEpochTaxProceeds(ctx sdk.Context, delta sdk.Coins)
+	MinGasPrices(ctx sdk.Context) (res sdk.DecCoins)
+	MsgFeeRequirements(ctx sdk.Context) (res []treasurytypes.MsgFeeRequirement)
+}
+
+// OracleKeeper for feeder validation & whitelist loading
 type OracleKeeper interface {
 	ValidateFeeder(ctx sdk.Context, feederAddr sdk.AccAddress, validatorAddr sdk.ValAddress) error
+	Whitelist(ctx sdk.Context) (res oracletypes.DenomList)
+}
+
+// MarketKeeper for the min gas price conversion
+type MarketKeeper interface {
+	ComputeInternalSwap(ctx sdk.Context, offerCoin sdk.DecCoin, askDenom string) (sdk.DecCoin, error)
 }
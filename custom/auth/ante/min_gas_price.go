@@ -0,0 +1,85 @@
+package ante
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	core "github.com/terra-money/core/types"
+)
+
+// DynamicMinGasPriceDecorator derives the local minimum gas prices of every
+// whitelisted denom from a single base gas price, at the current oracle
+// exchange rates, so the node operator does not need to maintain the price
+// of each denom as the exchange rates drift.
+// The derived prices override the node's static minimum gas prices of the
+// same denoms; the static prices of the other denoms are kept.
+// Note this only applies when ctx.CheckTx = true
+type DynamicMinGasPriceDecorator struct {
+	baseGasPrice *sdk.DecCoin
+	oracleKeeper OracleKeeper
+	marketKeeper MarketKeeper
+}
+
+// NewDynamicMinGasPriceDecorator returns new dynamic min gas price decorator instance;
+// the decorator is a no-op when baseGasPrice is nil
+func NewDynamicMinGasPriceDecorator(baseGasPrice *sdk.DecCoin, oracleKeeper OracleKeeper, marketKeeper MarketKeeper) DynamicMinGasPriceDecorator {
+	return DynamicMinGasPriceDecorator{
+		baseGasPrice: baseGasPrice,
+		oracleKeeper: oracleKeeper,
+		marketKeeper: marketKeeper,
+	}
+}
+
+// AnteHandle replaces the local minimum gas prices with the derived ones
+func (dmd DynamicMinGasPriceDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	if dmd.baseGasPrice != nil && ctx.IsCheckTx() && !simulate {
+		ctx = ctx.WithMinGasPrices(dmd.ComputeMinGasPrices(ctx))
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// LocalMinGasPrices returns the local minimum gas prices the decorator applies
+// in CheckTx; the node's static ones without base gas price
+func (dmd DynamicMinGasPriceDecorator) LocalMinGasPrices(ctx sdk.Context) sdk.DecCoins {
+	if dmd.baseGasPrice == nil {
+		return ctx.MinGasPrices()
+	}
+
+	return dmd.ComputeMinGasPrices(ctx)
+}
+
+// ComputeMinGasPrices returns the node's minimum gas prices with the prices of Luna
+// and the whitelisted denoms derived from the base gas price.
+// Denoms without an effective exchange rate keep their static price.
+func (dmd DynamicMinGasPriceDecorator) ComputeMinGasPrices(ctx sdk.Context) sdk.DecCoins {
+	// the price derivation is local to this node, so it must not consume tx gas
+	ctx = ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+
+	denoms := []string{dmd.baseGasPrice.Denom, core.MicroLunaDenom}
+	for _, denom := range dmd.oracleKeeper.Whitelist(ctx) {
+		denoms = append(denoms, denom.Name)
+	}
+
+	derived := map[string]sdk.DecCoin{}
+	for _, denom := range denoms {
+		gasPrice, err := dmd.marketKeeper.ComputeInternalSwap(ctx, *dmd.baseGasPrice, denom)
+		if err != nil {
+			continue
+		}
+
+		derived[gasPrice.Denom] = gasPrice
+	}
+
+	minGasPrices := sdk.DecCoins{}
+	for _, gasPrice := range ctx.MinGasPrices() {
+		if _, ok := derived[gasPrice.Denom]; !ok {
+			minGasPrices = append(minGasPrices, gasPrice)
+		}
+	}
+
+	for _, gasPrice := range derived {
+		minGasPrices = append(minGasPrices, gasPrice)
+	}
+
+	return minGasPrices.Sort()
+}
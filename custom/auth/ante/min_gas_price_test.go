@@ -0,0 +1,79 @@
+package ante_test
+
+import (
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/terra-money/core/custom/auth/ante"
+	core "github.com/terra-money/core/types"
+	oracletypes "github.com/terra-money/core/x/oracle/types"
+)
+
+func (suite *AnteTestSuite) TestDynamicMinGasPrices() {
+	suite.SetupTest(true) // setup
+	suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+
+	suite.app.OracleKeeper.SetParams(suite.ctx, oracletypes.DefaultParams())
+	suite.app.OracleKeeper.SetLunaExchangeRate(suite.ctx, core.MicroUSDDenom, sdk.NewDec(100))
+	suite.app.OracleKeeper.SetLunaExchangeRate(suite.ctx, core.MicroKRWDenom, sdk.NewDec(120000))
+
+	baseGasPrice := sdk.NewDecCoinFromDec(core.MicroUSDDenom, sdk.NewDecWithPrec(15, 2))
+	dmd := ante.NewDynamicMinGasPriceDecorator(&baseGasPrice, suite.app.OracleKeeper, suite.app.MarketKeeper)
+
+	// static prices of the other denoms are kept, the derived ones override
+	suite.ctx = suite.ctx.WithMinGasPrices(sdk.NewDecCoins(
+		sdk.NewDecCoinFromDec("atom", sdk.NewDec(1)),
+		sdk.NewDecCoinFromDec(core.MicroUSDDenom, sdk.NewDec(1)),
+	))
+	suite.Require().Equal(sdk.NewDecCoins(
+		sdk.NewDecCoinFromDec("atom", sdk.NewDec(1)),
+		sdk.NewDecCoinFromDec(core.MicroKRWDenom, sdk.NewDec(180)),
+		sdk.NewDecCoinFromDec(core.MicroLunaDenom, sdk.NewDecWithPrec(15, 4)),
+		baseGasPrice,
+	), dmd.ComputeMinGasPrices(suite.ctx))
+	suite.ctx = suite.ctx.WithMinGasPrices(sdk.DecCoins{})
+
+	mfd := ante.NewTaxFeeDecorator(suite.app.TreasuryKeeper, suite.app.TaxableMsgRegistry())
+	antehandler := sdk.ChainAnteDecorators(dmd, mfd)
+
+	// keys and addresses
+	priv1, _, addr1 := testdata.KeyTestPubAddr()
+
+	// msg and signatures
+	msg := testdata.NewTestMsg(addr1)
+	gasLimit := testdata.NewTestGasLimit()
+	suite.Require().NoError(suite.txBuilder.SetMsgs(msg))
+	suite.txBuilder.SetGasLimit(gasLimit)
+
+	privs, accNums, accSeqs := []cryptotypes.PrivKey{priv1}, []uint64{0}, []uint64{0}
+
+	// 100000 gas * 0.0015uluna = 150uluna required
+	suite.txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin(core.MicroLunaDenom, 149)))
+	tx, err := suite.CreateTestTx(privs, accNums, accSeqs, suite.ctx.ChainID())
+	suite.Require().NoError(err)
+
+	_, err = antehandler(suite.ctx, tx, false)
+	suite.Require().Error(err, "Decorator should have errored on too low fee for derived gas price")
+
+	// no min gas price check in DeliverTx
+	_, err = antehandler(suite.ctx.WithIsCheckTx(false), tx, false)
+	suite.Require().NoError(err)
+
+	// 100000 gas * 180ukrw = 18000000ukrw required
+	suite.txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin(core.MicroKRWDenom, 18000000)))
+	tx, err = suite.CreateTestTx(privs, accNums, accSeqs, suite.ctx.ChainID())
+	suite.Require().NoError(err)
+
+	_, err = antehandler(suite.ctx, tx, false)
+	suite.Require().NoError(err, "Decorator should not have errored on fee enough for derived gas price")
+
+	// the decorator is a no-op without base gas price
+	antehandler = sdk.ChainAnteDecorators(ante.NewDynamicMinGasPriceDecorator(nil, suite.app.OracleKeeper, suite.app.MarketKeeper), mfd)
+	suite.txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin(core.MicroLunaDenom, 149)))
+	tx, err = suite.CreateTestTx(privs, accNums, accSeqs, suite.ctx.ChainID())
+	suite.Require().NoError(err)
+
+	_, err = antehandler(suite.ctx, tx, false)
+	suite.Require().NoError(err)
+}
@@ -0,0 +1,146 @@
+package ante
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+
+	customauthtypes "github.com/terra-money/core/custom/auth/types"
+	treasurytypes "github.com/terra-money/core/x/treasury/types"
+)
+
+// MaxFeeWaivedMsgGasUsage is the gas per msg up to which a zero gas price
+// multiplier waives the gas fee of a tx
+const MaxFeeWaivedMsgGasUsage = uint64(100_000)
+
+// TxFeeRequirement is the fee requirement of a tx, derived from
+// the fee requirements of its msg types
+type TxFeeRequirement struct {
+	// GasPriceMultiplier scales the global min gas prices
+	GasPriceMultiplier sdk.Dec
+	// FlatFee is charged on top of the gas fee
+	FlatFee sdk.Coins
+}
+
+// WaivesGasFee returns true if the requirement waives the gas fee of a tx with the given
+// gas limit and number of msgs; a zero gas price multiplier does so within
+// MaxFeeWaivedMsgGasUsage gas per msg, so that free txs cannot claim the block gas
+func (r TxFeeRequirement) WaivesGasFee(gas uint64, numMsgs int) bool {
+	return r.GasPriceMultiplier.IsZero() && gas <= uint64(numMsgs)*MaxFeeWaivedMsgGasUsage
+}
+
+// GetTxFeeRequirement returns the fee requirement of the msgs; false if none of the
+// msg types has a fee requirement. The gas price multiplier is the highest among
+// the msgs, counting 1 for the msgs without requirement, and the flat fees add up.
+// The msgs executed through authz count as if they were sent directly.
+func GetTxFeeRequirement(ctx sdk.Context, tk TreasuryKeeper, msgs []sdk.Msg) (TxFeeRequirement, bool) {
+	requirements := tk.MsgFeeRequirements(ctx)
+	if len(requirements) == 0 {
+		return TxFeeRequirement{}, false
+	}
+
+	requirement := TxFeeRequirement{GasPriceMultiplier: sdk.ZeroDec(), FlatFee: sdk.Coins{}}
+	found := addMsgFeeRequirements(&requirement, requirements, msgs)
+
+	return requirement, found
+}
+
+// addMsgFeeRequirements adds the fee requirements of the msgs, and of the msgs
+// they execute, to the tx fee requirement; false if none of them has one
+func addMsgFeeRequirements(txRequirement *TxFeeRequirement, requirements []treasurytypes.MsgFeeRequirement, msgs []sdk.Msg) bool {
+	found := false
+	for _, msg := range msgs {
+		msgMultiplier := sdk.OneDec()
+		for _, requirement := range requirements {
+			if requirement.MsgTypeUrl == sdk.MsgTypeURL(msg) {
+				found = true
+				msgMultiplier = requirement.GasPriceMultiplier
+				txRequirement.FlatFee = txRequirement.FlatFee.Add(requirement.FlatFee...)
+				break
+			}
+		}
+
+		if msgMultiplier.GT(txRequirement.GasPriceMultiplier) {
+			txRequirement.GasPriceMultiplier = msgMultiplier
+		}
+
+		// the msgs of an invalid MsgExec fail its ValidateBasic
+		if execMsg, ok := msg.(*authz.MsgExec); ok {
+			if execMsgs, err := execMsg.GetMessages(); err == nil {
+				found = addMsgFeeRequirements(txRequirement, requirements, execMsgs) || found
+			}
+		}
+	}
+
+	return found
+}
+
+// MsgFeeDecorator will check if the transaction's fee covers the fee requirements
+// of its msg types, set in the treasury params: the flat fees of the msgs, plus
+// the global min gas prices scaled by the gas price multiplier of the msgs.
+// A zero multiplier beyond the gas limit of the waiver counts as 1.
+// As the requirements are part of consensus, they apply in DeliverTx too.
+// The txs without msg fee requirements are checked by the TaxFeeDecorator.
+// CONTRACT: Tx must implement FeeTx to use MsgFeeDecorator
+type MsgFeeDecorator struct {
+	treasuryKeeper     TreasuryKeeper
+	taxableMsgRegistry customauthtypes.TaxableMsgRegistry
+}
+
+// NewMsgFeeDecorator returns new msg fee decorator instance
+func NewMsgFeeDecorator(treasuryKeeper TreasuryKeeper, taxableMsgRegistry customauthtypes.TaxableMsgRegistry) MsgFeeDecorator {
+	return MsgFeeDecorator{
+		treasuryKeeper:     treasuryKeeper,
+		taxableMsgRegistry: taxableMsgRegistry,
+	}
+}
+
+// AnteHandle handles msg fee requirement checking
+func (mfd MsgFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+
+	if !simulate {
+		msgs := feeTx.GetMsgs()
+		requirement, found := GetTxFeeRequirement(ctx, mfd.treasuryKeeper, msgs)
+		if found {
+			taxes, err := FilterMsgAndComputeTax(ctx, mfd.treasuryKeeper, mfd.taxableMsgRegistry, msgs...)
+			if err != nil {
+				return ctx, err
+			}
+
+			multiplier := requirement.GasPriceMultiplier
+			if multiplier.IsZero() && !requirement.WaivesGasFee(feeTx.GetGas(), len(msgs)) {
+				multiplier = sdk.OneDec()
+			}
+
+			minGasPrices := mfd.treasuryKeeper.MinGasPrices(ctx).MulDec(multiplier)
+			if err := EnsureSufficientMsgFees(feeTx.GetGas(), feeTx.GetFee(), taxes, minGasPrices, requirement.FlatFee); err != nil {
+				return ctx, sdkerrors.Wrapf(sdkerrors.ErrInsufficientFee, err.Error())
+			}
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// EnsureSufficientMsgFees verifies that the given transaction has supplied
+// enough fees to cover the stability tax and the flat fee of its msgs in all
+// their denoms, and the gas at the given min gas prices.
+func EnsureSufficientMsgFees(gas uint64, feeCoins sdk.Coins, taxes sdk.Coins, minGasPrices sdk.DecCoins, flatFee sdk.Coins) error {
+	charges := taxes.Add(flatFee...)
+
+	if _, hasNeg := feeCoins.SafeSub(charges); hasNeg {
+		return fmt.Errorf("insufficient fees; got: %q, required: %q(flat) +%q(stability) + gas", feeCoins, flatFee, taxes)
+	}
+
+	if err := ensureSufficientFees(gas, feeCoins, charges, minGasPrices); err != nil {
+		return fmt.Errorf("msg fee requirements not met: %w", err)
+	}
+
+	return nil
+}
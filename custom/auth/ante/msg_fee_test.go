@@ -0,0 +1,120 @@
+package ante_test
+
+import (
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/terra-money/core/custom/auth/ante"
+	core "github.com/terra-money/core/types"
+	oracletypes "github.com/terra-money/core/x/oracle/types"
+	treasurytypes "github.com/terra-money/core/x/treasury/types"
+)
+
+func (suite *AnteTestSuite) TestMsgFeeRequirements() {
+	suite.SetupTest(true) // setup
+	suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+
+	antehandler := sdk.ChainAnteDecorators(
+		ante.NewTaxFeeDecorator(suite.app.TreasuryKeeper, suite.app.TaxableMsgRegistry()),
+		ante.NewMsgFeeDecorator(suite.app.TreasuryKeeper, suite.app.TaxableMsgRegistry()),
+	)
+
+	// keys and addresses
+	priv1, _, addr1 := testdata.KeyTestPubAddr()
+	privs, accNums, accSeqs := []cryptotypes.PrivKey{priv1}, []uint64{0}, []uint64{0}
+
+	sendMsg := banktypes.NewMsgSend(addr1, addr1, sdk.NewCoins(sdk.NewInt64Coin(core.MicroLunaDenom, 1)))
+	consentMsg := oracletypes.NewMsgDelegateFeedConsent(sdk.ValAddress(addr1), addr1)
+	execMsg := authz.NewMsgExec(addr1, []sdk.Msg{sendMsg})
+
+	params := suite.app.TreasuryKeeper.GetParams(suite.ctx)
+	params.MinGasPrices = sdk.NewDecCoins(sdk.NewDecCoinFromDec(core.MicroLunaDenom, sdk.NewDecWithPrec(1, 2)))
+	params.MsgFeeRequirements = []treasurytypes.MsgFeeRequirement{
+		{
+			MsgTypeUrl:         sdk.MsgTypeURL(sendMsg),
+			GasPriceMultiplier: sdk.NewDec(2),
+			FlatFee:            sdk.NewCoins(sdk.NewInt64Coin(core.MicroSDRDenom, 100)),
+		},
+		{
+			MsgTypeUrl:         sdk.MsgTypeURL(consentMsg),
+			GasPriceMultiplier: sdk.ZeroDec(),
+		},
+	}
+	suite.app.TreasuryKeeper.SetParams(suite.ctx, params)
+
+	gasLimit := testdata.NewTestGasLimit()
+	suite.txBuilder.SetGasLimit(gasLimit)
+
+	// 100000 gas * 0.01uluna * 2 = 2000uluna and 100usdr required
+	testCases := []struct {
+		msgs  []sdk.Msg
+		fee   sdk.Coins
+		valid bool
+	}{
+		{[]sdk.Msg{sendMsg}, sdk.NewCoins(sdk.NewInt64Coin(core.MicroLunaDenom, 2000), sdk.NewInt64Coin(core.MicroSDRDenom, 100)), true},
+		{[]sdk.Msg{sendMsg}, sdk.NewCoins(sdk.NewInt64Coin(core.MicroLunaDenom, 1999), sdk.NewInt64Coin(core.MicroSDRDenom, 100)), false},
+		{[]sdk.Msg{sendMsg}, sdk.NewCoins(sdk.NewInt64Coin(core.MicroLunaDenom, 2000), sdk.NewInt64Coin(core.MicroSDRDenom, 99)), false},
+		// the flat fees add up
+		{[]sdk.Msg{sendMsg, sendMsg}, sdk.NewCoins(sdk.NewInt64Coin(core.MicroLunaDenom, 2000), sdk.NewInt64Coin(core.MicroSDRDenom, 100)), false},
+		{[]sdk.Msg{sendMsg, sendMsg}, sdk.NewCoins(sdk.NewInt64Coin(core.MicroLunaDenom, 2000), sdk.NewInt64Coin(core.MicroSDRDenom, 200)), true},
+		// the executed msgs count as if they were sent directly
+		{[]sdk.Msg{&execMsg}, sdk.NewCoins(sdk.NewInt64Coin(core.MicroLunaDenom, 2000), sdk.NewInt64Coin(core.MicroSDRDenom, 100)), true},
+		{[]sdk.Msg{&execMsg}, sdk.NewCoins(sdk.NewInt64Coin(core.MicroLunaDenom, 1999), sdk.NewInt64Coin(core.MicroSDRDenom, 100)), false},
+		{[]sdk.Msg{&execMsg}, sdk.NewCoins(sdk.NewInt64Coin(core.MicroLunaDenom, 2000)), false},
+		// zero multiplier waives the global and the local min gas prices
+		{[]sdk.Msg{consentMsg}, sdk.NewCoins(), true},
+		// the highest multiplier applies; 1 for the msgs without requirement
+		{[]sdk.Msg{consentMsg, testdata.NewTestMsg(addr1)}, sdk.NewCoins(sdk.NewInt64Coin(core.MicroLunaDenom, 999)), false},
+		{[]sdk.Msg{consentMsg, testdata.NewTestMsg(addr1)}, sdk.NewCoins(sdk.NewInt64Coin(core.MicroLunaDenom, 1000)), true},
+		// the msgs without requirement are checked against the global min gas prices
+		{[]sdk.Msg{testdata.NewTestMsg(addr1)}, sdk.NewCoins(sdk.NewInt64Coin(core.MicroLunaDenom, 999)), false},
+		{[]sdk.Msg{testdata.NewTestMsg(addr1)}, sdk.NewCoins(sdk.NewInt64Coin(core.MicroLunaDenom, 1000)), true},
+	}
+
+	for i, tc := range testCases {
+		suite.Require().NoError(suite.txBuilder.SetMsgs(tc.msgs...))
+		suite.txBuilder.SetFeeAmount(tc.fee)
+		tx, err := suite.CreateTestTx(privs, accNums, accSeqs, suite.ctx.ChainID())
+		suite.Require().NoError(err)
+
+		// enforced in both CheckTx and DeliverTx, on top of the local min gas prices
+		localMinGasPrices := sdk.NewDecCoins(sdk.NewDecCoinFromDec(core.MicroLunaDenom, sdk.NewDecWithPrec(1, 2)))
+		for _, isCheckTx := range []bool{true, false} {
+			_, err = antehandler(suite.ctx.WithIsCheckTx(isCheckTx).WithMinGasPrices(localMinGasPrices), tx, false)
+			if tc.valid {
+				suite.Require().NoError(err, "test case #%d", i)
+			} else {
+				suite.Require().Error(err, "test case #%d", i)
+			}
+		}
+	}
+	// the gas fee waiver is limited to a gas per msg
+	suite.txBuilder.SetGasLimit(ante.MaxFeeWaivedMsgGasUsage + 1)
+	suite.Require().NoError(suite.txBuilder.SetMsgs(consentMsg))
+	for _, tc := range []struct {
+		fee   sdk.Coins
+		valid bool
+	}{
+		{sdk.NewCoins(), false},
+		// 100001 gas * 0.01uluna = 1000.01uluna
+		{sdk.NewCoins(sdk.NewInt64Coin(core.MicroLunaDenom, 1000)), false},
+		{sdk.NewCoins(sdk.NewInt64Coin(core.MicroLunaDenom, 1001)), true},
+	} {
+		suite.txBuilder.SetFeeAmount(tc.fee)
+		tx, err := suite.CreateTestTx(privs, accNums, accSeqs, suite.ctx.ChainID())
+		suite.Require().NoError(err)
+
+		for _, isCheckTx := range []bool{true, false} {
+			localMinGasPrices := sdk.NewDecCoins(sdk.NewDecCoinFromDec(core.MicroLunaDenom, sdk.NewDecWithPrec(1, 2)))
+			_, err = antehandler(suite.ctx.WithIsCheckTx(isCheckTx).WithMinGasPrices(localMinGasPrices), tx, false)
+			if tc.valid {
+				suite.Require().NoError(err, "fee %s", tc.fee)
+			} else {
+				suite.Require().Error(err, "fee %s", tc.fee)
+			}
+		}
+	}
+}
@@ -86,3 +86,7 @@ func (ok dummyOracleKeeper) ValidateFeeder(ctx sdk.Context, feederAddr sdk.AccAd
 
 	return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "cannot ensure feeder right")
 }
+
+func (ok dummyOracleKeeper) Whitelist(ctx sdk.Context) oracletypes.DenomList {
+	return oracletypes.DenomList{}
+}
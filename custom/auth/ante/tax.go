@@ -5,13 +5,10 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
-	authz "github.com/cosmos/cosmos-sdk/x/authz"
-	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
+	customauthtypes "github.com/terra-money/core/custom/auth/types"
 	core "github.com/terra-money/core/types"
-	marketexported "github.com/terra-money/core/x/market/exported"
 	oracleexported "github.com/terra-money/core/x/oracle/exported"
-	wasmexported "github.com/terra-money/core/x/wasm/exported"
 )
 
 // MaxOracleMsgGasUsage is constant expected oracle msg gas cost
@@ -21,17 +18,22 @@ const MaxOracleMsgGasUsage = uint64(100_000)
 // as tax + the local validator's minimum gasFee (defined in validator config)
 // and record tax proceeds to treasury module to track tax proceeds.
 // If fee is too low, decorator returns error and tx is rejected from mempool.
-// Note this only applies when ctx.CheckTx = true
+// Note the local minimum gasFee only applies when ctx.CheckTx = true, and not to
+// the txs whose msg fee requirements waive the gas fee with a zero multiplier
+// within MaxFeeWaivedMsgGasUsage gas per msg;
+// the global minimum gas prices of the treasury params apply in DeliverTx too
 // If fee is high enough or not CheckTx, then call next AnteHandler
 // CONTRACT: Tx must implement FeeTx to use MempoolFeeDecorator
 type TaxFeeDecorator struct {
-	treasuryKeeper TreasuryKeeper
+	treasuryKeeper     TreasuryKeeper
+	taxableMsgRegistry customauthtypes.TaxableMsgRegistry
 }
 
 // NewTaxFeeDecorator returns new tax fee decorator instance
-func NewTaxFeeDecorator(treasuryKeeper TreasuryKeeper) TaxFeeDecorator {
+func NewTaxFeeDecorator(treasuryKeeper TreasuryKeeper, taxableMsgRegistry customauthtypes.TaxableMsgRegistry) TaxFeeDecorator {
 	return TaxFeeDecorator{
-		treasuryKeeper: treasuryKeeper,
+		treasuryKeeper:     treasuryKeeper,
+		taxableMsgRegistry: taxableMsgRegistry,
 	}
 }
 
@@ -48,14 +50,29 @@ func (tfd TaxFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool,
 
 	if !simulate {
 		// Compute taxes
-		taxes := FilterMsgAndComputeTax(ctx, tfd.treasuryKeeper, msgs...)
+		taxes, err := FilterMsgAndComputeTax(ctx, tfd.treasuryKeeper, tfd.taxableMsgRegistry, msgs...)
+		if err != nil {
+			return ctx, err
+		}
 
-		// Mempool fee validation
 		// No fee validation for oracle txs
-		if ctx.IsCheckTx() &&
-			!(isOracleTx(ctx, msgs) && gas <= uint64(len(msgs))*MaxOracleMsgGasUsage) {
-			if err := EnsureSufficientMempoolFees(ctx, gas, feeCoins, taxes); err != nil {
-				return ctx, sdkerrors.Wrapf(sdkerrors.ErrInsufficientFee, err.Error())
+		if !(isOracleTx(ctx, msgs) && gas <= uint64(len(msgs))*MaxOracleMsgGasUsage) {
+			requirement, found := GetTxFeeRequirement(ctx, tfd.treasuryKeeper, msgs)
+
+			// Mempool fee validation
+			// The txs exempted from gas fees by their msg fee requirements skip it too
+			if ctx.IsCheckTx() && !(found && requirement.WaivesGasFee(gas, len(msgs))) {
+				if err := EnsureSufficientMempoolFees(ctx, gas, feeCoins, taxes); err != nil {
+					return ctx, sdkerrors.Wrapf(sdkerrors.ErrInsufficientFee, err.Error())
+				}
+			}
+
+			// Global fee validation, part of consensus
+			// The txs with msg fee requirements are checked by the MsgFeeDecorator
+			if !found {
+				if err := EnsureSufficientGlobalFees(gas, feeCoins, taxes, tfd.treasuryKeeper.MinGasPrices(ctx)); err != nil {
+					return ctx, sdkerrors.Wrapf(sdkerrors.ErrInsufficientFee, err.Error())
+				}
 			}
 		}
 
@@ -80,8 +97,22 @@ func (tfd TaxFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool,
 // Contract: This should only be called during CheckTx as it cannot be part of
 // consensus.
 func EnsureSufficientMempoolFees(ctx sdk.Context, gas uint64, feeCoins sdk.Coins, taxes sdk.Coins) error {
+	return ensureSufficientFees(gas, feeCoins, taxes, ctx.MinGasPrices())
+}
+
+// EnsureSufficientGlobalFees verifies that the given transaction has supplied
+// enough fees(gas + stability) to cover the global minimum gas prices set by
+// governance. Unlike the mempool fees, it is checked in DeliverTx too.
+func EnsureSufficientGlobalFees(gas uint64, feeCoins sdk.Coins, taxes sdk.Coins, minGasPrices sdk.DecCoins) error {
+	if err := ensureSufficientFees(gas, feeCoins, taxes, minGasPrices); err != nil {
+		return fmt.Errorf("global min gas prices not met: %w", err)
+	}
+
+	return nil
+}
+
+func ensureSufficientFees(gas uint64, feeCoins sdk.Coins, taxes sdk.Coins, minGasPrices sdk.DecCoins) error {
 	requiredFees := sdk.Coins{}
-	minGasPrices := ctx.MinGasPrices()
 	if !minGasPrices.IsZero() {
 		requiredFees = make(sdk.Coins, len(minGasPrices))
 
@@ -107,54 +138,56 @@ func EnsureSufficientMempoolFees(ctx sdk.Context, gas uint64, feeCoins sdk.Coins
 	return nil
 }
 
-// FilterMsgAndComputeTax computes the stability tax on MsgSend and MsgMultiSend.
-func FilterMsgAndComputeTax(ctx sdk.Context, tk TreasuryKeeper, msgs ...sdk.Msg) sdk.Coins {
+// FilterMsgAndComputeTax computes the stability tax on the taxable principals of the msgs,
+// which are reported by the extractors registered in the taxable msg registry.
+// Transfers whose parties all belong to the same tax exemption zone are not taxed.
+func FilterMsgAndComputeTax(ctx sdk.Context, tk TaxKeeper, registry customauthtypes.TaxableMsgRegistry, msgs ...sdk.Msg) (sdk.Coins, error) {
 	taxes := sdk.Coins{}
 	for _, msg := range msgs {
-		switch msg := msg.(type) {
-		case *banktypes.MsgSend:
-			taxes = taxes.Add(computeTax(ctx, tk, msg.Amount)...)
-
-		case *banktypes.MsgMultiSend:
-			for _, input := range msg.Inputs {
-				taxes = taxes.Add(computeTax(ctx, tk, input.Coins)...)
-			}
-
-		case *marketexported.MsgSwapSend:
-			taxes = taxes.Add(computeTax(ctx, tk, sdk.NewCoins(msg.OfferCoin))...)
-
-		case *wasmexported.MsgInstantiateContract:
-			taxes = taxes.Add(computeTax(ctx, tk, msg.InitCoins)...)
-
-		case *wasmexported.MsgExecuteContract:
-			taxes = taxes.Add(computeTax(ctx, tk, msg.Coins)...)
-
-		case *authz.MsgExec:
-			messages, err := msg.GetMessages()
-			if err != nil {
-				panic(err)
-			}
+		principals, err := registry.GetTaxablePrincipals(msg)
+		if err != nil {
+			return nil, err
+		}
 
-			taxes = taxes.Add(FilterMsgAndComputeTax(ctx, tk, messages...)...)
+		for _, principal := range principals {
+			taxes = taxes.Add(computeTaxUnlessExempt(ctx, tk, principal.Amount, principal.Parties...)...)
 		}
 	}
 
-	return taxes
+	return taxes, nil
 }
 
-// computes the stability tax according to tax-rate and tax-cap
-func computeTax(ctx sdk.Context, tk TreasuryKeeper, principal sdk.Coins) sdk.Coins {
-	taxRate := tk.GetTaxRate(ctx)
-	if taxRate.Equal(sdk.ZeroDec()) {
+// computeTaxUnlessExempt computes the stability tax on the principal unless
+// all the given addresses belong to the same tax exemption zone. The exemption
+// list is only consulted when tax is due.
+func computeTaxUnlessExempt(ctx sdk.Context, tk TaxKeeper, principal sdk.Coins, addresses ...string) sdk.Coins {
+	taxes := computeTax(ctx, tk, principal)
+	if taxes.IsZero() || tk.IsTaxExempt(ctx, addresses...) {
 		return sdk.Coins{}
 	}
 
+	return taxes
+}
+
+// computes the stability tax according to the denom tax-rate and tax-cap
+func computeTax(ctx sdk.Context, tk TaxKeeper, principal sdk.Coins) sdk.Coins {
+	globalTaxRate := tk.GetTaxRate(ctx)
+
 	taxes := sdk.Coins{}
 	for _, coin := range principal {
 		if coin.Denom == core.MicroLunaDenom || coin.Denom == sdk.DefaultBondDenom {
 			continue
 		}
 
+		taxRate, found := tk.GetDenomTaxRateOverride(ctx, coin.Denom)
+		if !found {
+			taxRate = globalTaxRate
+		}
+
+		if taxRate.Equal(sdk.ZeroDec()) {
+			continue
+		}
+
 		taxDue := sdk.NewDecFromInt(coin.Amount).Mul(taxRate).TruncateInt()
 
 		// If tax due is greater than the tax cap, cap!
@@ -6,10 +6,13 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authz "github.com/cosmos/cosmos-sdk/x/authz"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/modules/core/02-client/types"
 
 	"github.com/terra-money/core/custom/auth/ante"
 	core "github.com/terra-money/core/types"
 	markettypes "github.com/terra-money/core/x/market/types"
+	oracletypes "github.com/terra-money/core/x/oracle/types"
 	wasmtypes "github.com/terra-money/core/x/wasm/types"
 )
 
@@ -17,7 +20,7 @@ func (suite *AnteTestSuite) TestEnsureMempoolFeesGas() {
 	suite.SetupTest(true) // setup
 	suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
 
-	mfd := ante.NewTaxFeeDecorator(suite.app.TreasuryKeeper)
+	mfd := ante.NewTaxFeeDecorator(suite.app.TreasuryKeeper, suite.app.TaxableMsgRegistry())
 	antehandler := sdk.ChainAnteDecorators(mfd)
 
 	// keys and addresses
@@ -69,7 +72,7 @@ func (suite *AnteTestSuite) TestEnsureMempoolFeesSend() {
 	suite.SetupTest(true) // setup
 	suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
 
-	mfd := ante.NewTaxFeeDecorator(suite.app.TreasuryKeeper)
+	mfd := ante.NewTaxFeeDecorator(suite.app.TreasuryKeeper, suite.app.TaxableMsgRegistry())
 	antehandler := sdk.ChainAnteDecorators(mfd)
 
 	// keys and addresses
@@ -120,7 +123,7 @@ func (suite *AnteTestSuite) TestEnsureMempoolFeesSwapSend() {
 	suite.SetupTest(true) // setup
 	suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
 
-	mfd := ante.NewTaxFeeDecorator(suite.app.TreasuryKeeper)
+	mfd := ante.NewTaxFeeDecorator(suite.app.TreasuryKeeper, suite.app.TaxableMsgRegistry())
 	antehandler := sdk.ChainAnteDecorators(mfd)
 
 	// keys and addresses
@@ -171,7 +174,7 @@ func (suite *AnteTestSuite) TestEnsureMempoolFeesMultiSend() {
 	suite.SetupTest(true) // setup
 	suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
 
-	mfd := ante.NewTaxFeeDecorator(suite.app.TreasuryKeeper)
+	mfd := ante.NewTaxFeeDecorator(suite.app.TreasuryKeeper, suite.app.TaxableMsgRegistry())
 	antehandler := sdk.ChainAnteDecorators(mfd)
 
 	// keys and addresses
@@ -234,7 +237,7 @@ func (suite *AnteTestSuite) TestEnsureMempoolFeesInstantiateContract() {
 	suite.SetupTest(true) // setup
 	suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
 
-	mfd := ante.NewTaxFeeDecorator(suite.app.TreasuryKeeper)
+	mfd := ante.NewTaxFeeDecorator(suite.app.TreasuryKeeper, suite.app.TaxableMsgRegistry())
 	antehandler := sdk.ChainAnteDecorators(mfd)
 
 	// keys and addresses
@@ -286,7 +289,7 @@ func (suite *AnteTestSuite) TestEnsureMempoolFeesExecuteContract() {
 	suite.SetupTest(true) // setup
 	suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
 
-	mfd := ante.NewTaxFeeDecorator(suite.app.TreasuryKeeper)
+	mfd := ante.NewTaxFeeDecorator(suite.app.TreasuryKeeper, suite.app.TaxableMsgRegistry())
 	antehandler := sdk.ChainAnteDecorators(mfd)
 
 	// keys and addresses
@@ -333,12 +336,69 @@ func (suite *AnteTestSuite) TestEnsureMempoolFeesExecuteContract() {
 	suite.Require().NoError(err, "Decorator should not have errored on fee higher than local gasPrice")
 }
 
+func (suite *AnteTestSuite) TestEnsureMempoolFeesIBCTransfer() {
+	suite.SetupTest(true) // setup
+	suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+
+	mfd := ante.NewTaxFeeDecorator(suite.app.TreasuryKeeper, suite.app.TaxableMsgRegistry())
+	antehandler := sdk.ChainAnteDecorators(mfd)
+
+	// keys and addresses
+	priv1, _, addr1 := testdata.KeyTestPubAddr()
+
+	// msg and signatures
+	sendAmount := int64(1000000)
+	sendCoin := sdk.NewInt64Coin(core.MicroSDRDenom, sendAmount)
+	msg := ibctransfertypes.NewMsgTransfer("transfer", "channel-0", sendCoin, addr1.String(), "cosmos1receiver", clienttypes.NewHeight(0, 100), 0)
+
+	feeAmount := testdata.NewTestFeeAmount()
+	gasLimit := testdata.NewTestGasLimit()
+	suite.Require().NoError(suite.txBuilder.SetMsgs(msg))
+	suite.txBuilder.SetFeeAmount(feeAmount)
+	suite.txBuilder.SetGasLimit(gasLimit)
+
+	privs, accNums, accSeqs := []cryptotypes.PrivKey{priv1}, []uint64{0}, []uint64{0}
+	tx, err := suite.CreateTestTx(privs, accNums, accSeqs, suite.ctx.ChainID())
+	suite.Require().NoError(err)
+
+	// set zero gas prices
+	suite.ctx = suite.ctx.WithMinGasPrices(sdk.NewDecCoins())
+
+	// Set IsCheckTx to true
+	suite.ctx = suite.ctx.WithIsCheckTx(true)
+
+	// antehandler errors with insufficient fees due to tax
+	_, err = antehandler(suite.ctx, tx, false)
+	suite.Require().Error(err, "Decorator should errored on low fee for local gasPrice + tax")
+
+	tk := suite.app.TreasuryKeeper
+	expectedTax := tk.GetTaxRate(suite.ctx).MulInt64(sendAmount).TruncateInt()
+	if taxCap := tk.GetTaxCap(suite.ctx, core.MicroSDRDenom); expectedTax.GT(taxCap) {
+		expectedTax = taxCap
+	}
+
+	// set tax amount
+	suite.txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewCoin(core.MicroSDRDenom, expectedTax)))
+	tx, err = suite.CreateTestTx(privs, accNums, accSeqs, suite.ctx.ChainID())
+	suite.Require().NoError(err)
+
+	// must pass with tax
+	_, err = antehandler(suite.ctx, tx, false)
+	suite.Require().NoError(err, "Decorator should not have errored on fee higher than local gasPrice")
+
+	// sender in a tax exemption zone is still taxed
+	tk.SetTaxExemptionZone(suite.ctx, addr1, "exchange")
+	taxes, err := ante.FilterMsgAndComputeTax(suite.ctx, tk, suite.app.TaxableMsgRegistry(), msg)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewCoin(core.MicroSDRDenom, expectedTax)), taxes)
+}
+
 func (suite *AnteTestSuite) TestEnsureMempoolFeesExec() {
 
 	suite.SetupTest(true) // setup
 	suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
 
-	mfd := ante.NewTaxFeeDecorator(suite.app.TreasuryKeeper)
+	mfd := ante.NewTaxFeeDecorator(suite.app.TreasuryKeeper, suite.app.TaxableMsgRegistry())
 	antehandler := sdk.ChainAnteDecorators(mfd)
 
 	// keys and addresses
@@ -384,3 +444,178 @@ func (suite *AnteTestSuite) TestEnsureMempoolFeesExec() {
 	_, err = antehandler(suite.ctx, tx, false)
 	suite.Require().NoError(err, "Decorator should not have errored on fee higher than local gasPrice")
 }
+
+func (suite *AnteTestSuite) TestEnsureMempoolFeesTaxExemption() {
+	suite.SetupTest(true) // setup
+	suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+
+	mfd := ante.NewTaxFeeDecorator(suite.app.TreasuryKeeper, suite.app.TaxableMsgRegistry())
+	antehandler := sdk.ChainAnteDecorators(mfd)
+
+	// keys and addresses
+	priv1, _, addr1 := testdata.KeyTestPubAddr()
+	_, _, addr2 := testdata.KeyTestPubAddr()
+
+	// msg and signatures
+	sendAmount := int64(1000000)
+	sendCoins := sdk.NewCoins(sdk.NewInt64Coin(core.MicroSDRDenom, sendAmount))
+	msg := banktypes.NewMsgSend(addr1, addr2, sendCoins)
+
+	feeAmount := testdata.NewTestFeeAmount()
+	gasLimit := testdata.NewTestGasLimit()
+	suite.Require().NoError(suite.txBuilder.SetMsgs(msg))
+	suite.txBuilder.SetFeeAmount(feeAmount)
+	suite.txBuilder.SetGasLimit(gasLimit)
+
+	privs, accNums, accSeqs := []cryptotypes.PrivKey{priv1}, []uint64{0}, []uint64{0}
+	tx, err := suite.CreateTestTx(privs, accNums, accSeqs, suite.ctx.ChainID())
+	suite.Require().NoError(err)
+
+	// set zero gas prices
+	suite.ctx = suite.ctx.WithMinGasPrices(sdk.NewDecCoins())
+
+	// Set IsCheckTx to true
+	suite.ctx = suite.ctx.WithIsCheckTx(true)
+
+	// antehandler errors with insufficient fees due to tax
+	_, err = antehandler(suite.ctx, tx, false)
+	suite.Require().Error(err, "Decorator should errored on low fee for local gasPrice + tax")
+
+	// only the sender is exempt
+	tk := suite.app.TreasuryKeeper
+	tk.SetTaxExemptionZone(suite.ctx, addr1, "exchange")
+	_, err = antehandler(suite.ctx, tx, false)
+	suite.Require().Error(err, "Decorator should errored on transfer to an address outside of the zone")
+
+	// recipient in another zone
+	tk.SetTaxExemptionZone(suite.ctx, addr2, "bridge")
+	_, err = antehandler(suite.ctx, tx, false)
+	suite.Require().Error(err, "Decorator should errored on transfer between different zones")
+
+	// both parties in the same zone
+	tk.SetTaxExemptionZone(suite.ctx, addr2, "exchange")
+	_, err = antehandler(suite.ctx, tx, false)
+	suite.Require().NoError(err, "Decorator should not have errored on transfer within a tax exemption zone")
+}
+
+func (suite *AnteTestSuite) TestEnsureMempoolFeesDenomTaxRate() {
+	suite.SetupTest(true) // setup
+	suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+
+	mfd := ante.NewTaxFeeDecorator(suite.app.TreasuryKeeper, suite.app.TaxableMsgRegistry())
+	antehandler := sdk.ChainAnteDecorators(mfd)
+
+	// keys and addresses
+	priv1, _, addr1 := testdata.KeyTestPubAddr()
+	_, _, addr2 := testdata.KeyTestPubAddr()
+
+	// msg and signatures
+	sendAmount := int64(1000000)
+	sendCoins := sdk.NewCoins(sdk.NewInt64Coin(core.MicroSDRDenom, sendAmount))
+	msg := banktypes.NewMsgSend(addr1, addr2, sendCoins)
+
+	feeAmount := testdata.NewTestFeeAmount()
+	gasLimit := testdata.NewTestGasLimit()
+	suite.Require().NoError(suite.txBuilder.SetMsgs(msg))
+	suite.txBuilder.SetFeeAmount(feeAmount)
+	suite.txBuilder.SetGasLimit(gasLimit)
+
+	privs, accNums, accSeqs := []cryptotypes.PrivKey{priv1}, []uint64{0}, []uint64{0}
+	tx, err := suite.CreateTestTx(privs, accNums, accSeqs, suite.ctx.ChainID())
+	suite.Require().NoError(err)
+
+	// set zero gas prices
+	suite.ctx = suite.ctx.WithMinGasPrices(sdk.NewDecCoins())
+
+	// Set IsCheckTx to true
+	suite.ctx = suite.ctx.WithIsCheckTx(true)
+
+	// zero global tax rate with a denom override
+	tk := suite.app.TreasuryKeeper
+	tk.SetTaxRate(suite.ctx, sdk.ZeroDec())
+	tk.SetDenomTaxRate(suite.ctx, core.MicroSDRDenom, sdk.NewDecWithPrec(1, 2))
+	_, err = antehandler(suite.ctx, tx, false)
+	suite.Require().Error(err, "Decorator should errored on low fee for the denom tax rate")
+
+	// zero denom override with a global tax rate
+	tk.SetTaxRate(suite.ctx, sdk.NewDecWithPrec(1, 2))
+	tk.SetDenomTaxRate(suite.ctx, core.MicroSDRDenom, sdk.ZeroDec())
+	_, err = antehandler(suite.ctx, tx, false)
+	suite.Require().NoError(err, "Decorator should not have errored on zero denom tax rate")
+
+	// removing the override falls back to the global tax rate
+	tk.DeleteDenomTaxRate(suite.ctx, core.MicroSDRDenom)
+	_, err = antehandler(suite.ctx, tx, false)
+	suite.Require().Error(err, "Decorator should errored on low fee for the global tax rate")
+}
+
+func (suite *AnteTestSuite) TestEnsureGlobalMinGasPrices() {
+	suite.SetupTest(true) // setup
+	suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+
+	mfd := ante.NewTaxFeeDecorator(suite.app.TreasuryKeeper, suite.app.TaxableMsgRegistry())
+	antehandler := sdk.ChainAnteDecorators(mfd)
+
+	// keys and addresses
+	priv1, _, addr1 := testdata.KeyTestPubAddr()
+
+	// msg and signatures
+	msg := testdata.NewTestMsg(addr1)
+	feeAmount := testdata.NewTestFeeAmount()
+	gasLimit := testdata.NewTestGasLimit()
+	suite.Require().NoError(suite.txBuilder.SetMsgs(msg))
+	suite.txBuilder.SetFeeAmount(feeAmount)
+	suite.txBuilder.SetGasLimit(gasLimit)
+
+	privs, accNums, accSeqs := []cryptotypes.PrivKey{priv1}, []uint64{0}, []uint64{0}
+	tx, err := suite.CreateTestTx(privs, accNums, accSeqs, suite.ctx.ChainID())
+	suite.Require().NoError(err)
+
+	// no local min gas prices
+	suite.ctx = suite.ctx.WithMinGasPrices(sdk.NewDecCoins())
+
+	// Set global gas price higher than the standard test fee
+	params := suite.app.TreasuryKeeper.GetParams(suite.ctx)
+	params.MinGasPrices = sdk.NewDecCoins(sdk.NewDecCoinFromDec("atom", sdk.NewDec(200).Quo(sdk.NewDec(100000))))
+	suite.app.TreasuryKeeper.SetParams(suite.ctx, params)
+
+	_, err = antehandler(suite.ctx, tx, false)
+	suite.Require().Error(err, "Decorator should have errored on too low fee for global gasPrice in CheckTx")
+
+	_, err = antehandler(suite.ctx.WithIsCheckTx(false), tx, false)
+	suite.Require().Error(err, "Decorator should have errored on too low fee for global gasPrice in DeliverTx")
+
+	// no fee validation in simulation
+	_, err = antehandler(suite.ctx.WithIsCheckTx(false), tx, true)
+	suite.Require().NoError(err)
+
+	// oracle txs within the gas limit are exempt
+	suite.Require().NoError(suite.txBuilder.SetMsgs(
+		oracletypes.NewMsgAggregateExchangeRateVote("", "", addr1, sdk.ValAddress(addr1)),
+	))
+	suite.txBuilder.SetFeeAmount(sdk.NewCoins())
+	suite.txBuilder.SetGasLimit(ante.MaxOracleMsgGasUsage)
+	tx, err = suite.CreateTestTx(privs, accNums, accSeqs, suite.ctx.ChainID())
+	suite.Require().NoError(err)
+
+	_, err = antehandler(suite.ctx.WithIsCheckTx(false), tx, false)
+	suite.Require().NoError(err, "Decorator should not have errored on oracle tx")
+
+	// but not when exceeding the oracle gas limit
+	suite.txBuilder.SetGasLimit(ante.MaxOracleMsgGasUsage + 1)
+	tx, err = suite.CreateTestTx(privs, accNums, accSeqs, suite.ctx.ChainID())
+	suite.Require().NoError(err)
+
+	_, err = antehandler(suite.ctx.WithIsCheckTx(false), tx, false)
+	suite.Require().Error(err, "Decorator should have errored on oracle tx exceeding the gas limit")
+
+	// sufficient fee for global gasPrice
+	suite.Require().NoError(suite.txBuilder.SetMsgs(msg))
+	suite.txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin("atom", 200)))
+	suite.txBuilder.SetGasLimit(gasLimit)
+	tx, err = suite.CreateTestTx(privs, accNums, accSeqs, suite.ctx.ChainID())
+	suite.Require().NoError(err)
+
+	_, err = antehandler(suite.ctx.WithIsCheckTx(false), tx, false)
+	suite.Require().NoError(err, "Decorator should not have errored on fee enough for global gasPrice")
+}
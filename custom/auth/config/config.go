@@ -0,0 +1,59 @@
+package config
+
+import (
+	"github.com/spf13/cast"
+
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// config default values
+const (
+	DefaultBaseGasPrice = ""
+)
+
+// Config is the extra config required for the fee checks of the ante handler
+type Config struct {
+	// The base gas price (e.g. "0.15uusd") the minimum gas prices of every
+	// whitelisted denom are derived from, at the current oracle exchange rates.
+	// The static minimum gas prices are used as they are if empty.
+	BaseGasPrice string `mapstructure:"base-gas-price"`
+}
+
+// DefaultConfig returns the default settings for the fee config
+func DefaultConfig() *Config {
+	return &Config{
+		BaseGasPrice: DefaultBaseGasPrice,
+	}
+}
+
+// GetConfig load config values from the app options
+func GetConfig(appOpts servertypes.AppOptions) *Config {
+	return &Config{
+		BaseGasPrice: cast.ToString(appOpts.Get("fee.base-gas-price")),
+	}
+}
+
+// GetBaseGasPrice parses the base gas price; returns nil if it is not set
+func (c Config) GetBaseGasPrice() (*sdk.DecCoin, error) {
+	if c.BaseGasPrice == "" {
+		return nil, nil
+	}
+
+	baseGasPrice, err := sdk.ParseDecCoin(c.BaseGasPrice)
+	if err != nil {
+		return nil, err
+	}
+
+	return &baseGasPrice, nil
+}
+
+// DefaultConfigTemplate default config template for the fee config
+const DefaultConfigTemplate = `
+[fee]
+# The base gas price (e.g. "0.15uusd") the minimum gas prices of every
+# whitelisted denom are derived from, at the current oracle exchange rates.
+# The derived prices override the minimum-gas-prices of the same denoms.
+# Leave empty to only use the static minimum-gas-prices.
+base-gas-price = "{{ .FeeConfig.BaseGasPrice }}"
+`
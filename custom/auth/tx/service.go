@@ -9,25 +9,46 @@ import (
 	"google.golang.org/grpc/status"
 
 	customante "github.com/terra-money/core/custom/auth/ante"
+	customauthtypes "github.com/terra-money/core/custom/auth/types"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
 )
 
 var _ ServiceServer = txServer{}
 
+// BaseAppSimulateFn is the signature of the Baseapp#Simulate function.
+type BaseAppSimulateFn func(txBytes []byte) (sdk.GasInfo, *sdk.Result, error)
+
+// LocalMinGasPricesFn is the signature of the function returning the local min gas
+// prices of the node, e.g. DynamicMinGasPriceDecorator#LocalMinGasPrices.
+type LocalMinGasPricesFn func(ctx sdk.Context) sdk.DecCoins
+
 // txServer is the server for the protobuf Tx service.
 type txServer struct {
-	clientCtx      client.Context
-	treasuryKeeper customante.TreasuryKeeper
+	clientCtx          client.Context
+	simulate           BaseAppSimulateFn
+	localMinGasPrices  LocalMinGasPricesFn
+	treasuryKeeper     customante.TreasuryKeeper
+	taxableMsgRegistry customauthtypes.TaxableMsgRegistry
 }
 
 // NewTxServer creates a new Tx service server.
-func NewTxServer(clientCtx client.Context, treasuryKeeper customante.TreasuryKeeper) ServiceServer {
+func NewTxServer(
+	clientCtx client.Context,
+	simulate BaseAppSimulateFn,
+	localMinGasPrices LocalMinGasPricesFn,
+	treasuryKeeper customante.TreasuryKeeper,
+	taxableMsgRegistry customauthtypes.TaxableMsgRegistry,
+) ServiceServer {
 	return txServer{
-		clientCtx:      clientCtx,
-		treasuryKeeper: treasuryKeeper,
+		clientCtx:          clientCtx,
+		simulate:           simulate,
+		localMinGasPrices:  localMinGasPrices,
+		treasuryKeeper:     treasuryKeeper,
+		taxableMsgRegistry: taxableMsgRegistry,
 	}
 }
 
@@ -52,21 +73,114 @@ func (ts txServer) ComputeTax(c context.Context, req *ComputeTaxRequest) (*Compu
 		return nil, status.Errorf(codes.InvalidArgument, "empty txBytes is not allowed")
 	}
 
-	taxAmount := customante.FilterMsgAndComputeTax(ctx, ts.treasuryKeeper, msgs...)
+	taxAmount, err := customante.FilterMsgAndComputeTax(ctx, ts.treasuryKeeper, ts.taxableMsgRegistry, msgs...)
+	if err != nil {
+		return nil, err
+	}
+
 	return &ComputeTaxResponse{
 		TaxAmount: taxAmount,
 	}, nil
 }
 
+// EstimateFee implements the ServiceServer.EstimateFee RPC method.
+// The gas limit is the simulated gas usage multiplied by the gas adjustment, rounded up,
+// and the fee amount is the gas limit at the first of the gas prices plus the stability
+// tax and the flat fee of the msg fee requirements.
+func (ts txServer) EstimateFee(c context.Context, req *EstimateFeeRequest) (*EstimateFeeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request cannot be nil")
+	}
+
+	if len(req.TxBytes) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "empty txBytes is not allowed")
+	}
+
+	tx, err := ts.clientCtx.TxConfig.TxDecoder()(req.TxBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	gasAdjustment := req.GasAdjustment
+	if gasAdjustment.IsNil() || gasAdjustment.IsZero() {
+		gasAdjustment = sdk.OneDec()
+	} else if gasAdjustment.LT(sdk.OneDec()) {
+		return nil, status.Errorf(codes.InvalidArgument, "gas adjustment must not be less than 1: %s", gasAdjustment)
+	}
+
+	requirement, found := customante.GetTxFeeRequirement(ctx, ts.treasuryKeeper, tx.GetMsgs())
+	if !found {
+		requirement = customante.TxFeeRequirement{GasPriceMultiplier: sdk.OneDec(), FlatFee: sdk.Coins{}}
+	}
+
+	gasPrices := req.GasPrices
+	if !gasPrices.Empty() {
+		if err := gasPrices.Validate(); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid gas prices: %s", err)
+		}
+	}
+
+	gasInfo, _, err := ts.simulate(req.TxBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	taxAmount, err := customante.FilterMsgAndComputeTax(ctx, ts.treasuryKeeper, ts.taxableMsgRegistry, tx.GetMsgs()...)
+	if err != nil {
+		return nil, err
+	}
+
+	gas := gasAdjustment.MulInt64(int64(gasInfo.GasUsed)).Ceil().TruncateInt().Uint64()
+
+	if gasPrices.Empty() {
+		// a zero multiplier only waives the gas fee within the gas limit of the waiver
+		multiplier := requirement.GasPriceMultiplier
+		waived := requirement.WaivesGasFee(gas, len(tx.GetMsgs()))
+		if multiplier.IsZero() && !waived {
+			multiplier = sdk.OneDec()
+		}
+
+		gasPrices = ts.treasuryKeeper.MinGasPrices(ctx).MulDec(multiplier)
+
+		// without global min gas prices, the local min gas prices of the node apply,
+		// as checked by the ante handler, unless the msg fee requirements waive the gas fee
+		if gasPrices.Empty() && !waived {
+			gasPrices = ts.localMinGasPrices(ctx)
+		}
+	}
+
+	// The min gas prices are met by the gas fee in any one of their denoms, so the
+	// gas fee is paid in the first denom only, where fee = ceil(gasPrice * gasLimit).
+	fees := taxAmount.Add(requirement.FlatFee...)
+	if !gasPrices.Empty() {
+		gp := gasPrices[0]
+		fees = fees.Add(sdk.NewCoin(gp.Denom, gp.Amount.MulInt64(int64(gas)).Ceil().RoundInt()))
+	}
+
+	return &EstimateFeeResponse{
+		Fee: &txtypes.Fee{
+			Amount:   fees,
+			GasLimit: gas,
+		},
+		GasUsed:   gasInfo.GasUsed,
+		TaxAmount: taxAmount,
+		FlatFee:   requirement.FlatFee,
+	}, nil
+}
+
 // RegisterTxService registers the tx service on the gRPC router.
 func RegisterTxService(
 	qrt gogogrpc.Server,
 	clientCtx client.Context,
+	simulate BaseAppSimulateFn,
+	localMinGasPrices LocalMinGasPricesFn,
 	treasuryKeeper customante.TreasuryKeeper,
+	taxableMsgRegistry customauthtypes.TaxableMsgRegistry,
 ) {
 	RegisterServiceServer(
 		qrt,
-		NewTxServer(clientCtx, treasuryKeeper),
+		NewTxServer(clientCtx, simulate, localMinGasPrices, treasuryKeeper, taxableMsgRegistry),
 	)
 }
 
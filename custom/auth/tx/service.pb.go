@@ -139,11 +139,151 @@ func (m *ComputeTaxResponse) GetTaxAmount() github_com_cosmos_cosmos_sdk_types.C
 	return nil
 }
 
+// EstimateFeeRequest is the request type for the Service.EstimateFee
+// RPC method.
+type EstimateFeeRequest struct {
+	// tx_bytes is the raw transaction to simulate.
+	TxBytes []byte `protobuf:"bytes,1,opt,name=tx_bytes,json=txBytes,proto3" json:"tx_bytes,omitempty"`
+	// gas_adjustment is the factor the simulated gas usage is multiplied by;
+	// defaults to 1 if not given, and must not be less than 1 otherwise.
+	GasAdjustment github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=gas_adjustment,json=gasAdjustment,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"gas_adjustment"`
+	// gas_prices are the prices the gas fee is computed with, paid in the first
+	// denom only; defaults to the global minimum gas prices, scaled by the msg fee
+	// requirements, or to the local minimum gas prices of the node without global
+	// ones, if not given.
+	GasPrices github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,3,rep,name=gas_prices,json=gasPrices,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"gas_prices"`
+}
+
+func (m *EstimateFeeRequest) Reset()         { *m = EstimateFeeRequest{} }
+func (m *EstimateFeeRequest) String() string { return proto.CompactTextString(m) }
+func (*EstimateFeeRequest) ProtoMessage()    {}
+func (*EstimateFeeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0b3c73e5d85273f4, []int{2}
+}
+func (m *EstimateFeeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EstimateFeeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EstimateFeeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EstimateFeeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EstimateFeeRequest.Merge(m, src)
+}
+func (m *EstimateFeeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *EstimateFeeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_EstimateFeeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EstimateFeeRequest proto.InternalMessageInfo
+
+func (m *EstimateFeeRequest) GetTxBytes() []byte {
+	if m != nil {
+		return m.TxBytes
+	}
+	return nil
+}
+
+func (m *EstimateFeeRequest) GetGasPrices() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.GasPrices
+	}
+	return nil
+}
+
+// EstimateFeeResponse is the response type for the Service.EstimateFee
+// RPC method.
+type EstimateFeeResponse struct {
+	// fee is the fee to be set in the transaction, covering the gas and the tax
+	Fee *tx.Fee `protobuf:"bytes,1,opt,name=fee,proto3" json:"fee,omitempty"`
+	// gas_used is the simulated gas usage, before the gas adjustment
+	GasUsed uint64 `protobuf:"varint,2,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+	// tax_amount is the stability tax included in the fee
+	TaxAmount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=tax_amount,json=taxAmount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"tax_amount"`
+	// flat_fee is the flat fee of the msg fee requirements included in the fee
+	FlatFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=flat_fee,json=flatFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"flat_fee"`
+}
+
+func (m *EstimateFeeResponse) Reset()         { *m = EstimateFeeResponse{} }
+func (m *EstimateFeeResponse) String() string { return proto.CompactTextString(m) }
+func (*EstimateFeeResponse) ProtoMessage()    {}
+func (*EstimateFeeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0b3c73e5d85273f4, []int{3}
+}
+func (m *EstimateFeeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EstimateFeeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EstimateFeeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EstimateFeeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EstimateFeeResponse.Merge(m, src)
+}
+func (m *EstimateFeeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *EstimateFeeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_EstimateFeeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EstimateFeeResponse proto.InternalMessageInfo
+
+func (m *EstimateFeeResponse) GetFee() *tx.Fee {
+	if m != nil {
+		return m.Fee
+	}
+	return nil
+}
+
+func (m *EstimateFeeResponse) GetGasUsed() uint64 {
+	if m != nil {
+		return m.GasUsed
+	}
+	return 0
+}
+
+func (m *EstimateFeeResponse) GetTaxAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TaxAmount
+	}
+	return nil
+}
+
+func (m *EstimateFeeResponse) GetFlatFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.FlatFee
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*ComputeTaxRequest)(nil), "terra.tx.v1beta1.ComputeTaxRequest")
 	golang_proto.RegisterType((*ComputeTaxRequest)(nil), "terra.tx.v1beta1.ComputeTaxRequest")
 	proto.RegisterType((*ComputeTaxResponse)(nil), "terra.tx.v1beta1.ComputeTaxResponse")
 	golang_proto.RegisterType((*ComputeTaxResponse)(nil), "terra.tx.v1beta1.ComputeTaxResponse")
+	proto.RegisterType((*EstimateFeeRequest)(nil), "terra.tx.v1beta1.EstimateFeeRequest")
+	golang_proto.RegisterType((*EstimateFeeRequest)(nil), "terra.tx.v1beta1.EstimateFeeRequest")
+	proto.RegisterType((*EstimateFeeResponse)(nil), "terra.tx.v1beta1.EstimateFeeResponse")
+	golang_proto.RegisterType((*EstimateFeeResponse)(nil), "terra.tx.v1beta1.EstimateFeeResponse")
 }
 
 func init() { proto.RegisterFile("terra/tx/v1beta1/service.proto", fileDescriptor_0b3c73e5d85273f4) }
@@ -152,33 +292,45 @@ func init() {
 }
 
 var fileDescriptor_0b3c73e5d85273f4 = []byte{
-	// 405 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x91, 0x3f, 0x8f, 0x94, 0x40,
-	0x18, 0xc6, 0x19, 0x4c, 0x3c, 0x9d, 0xb3, 0x50, 0xa2, 0x09, 0x47, 0x74, 0x6e, 0x83, 0x16, 0x78,
-	0xc9, 0xcd, 0x78, 0x6b, 0x67, 0x27, 0xd7, 0x58, 0xe3, 0x35, 0xda, 0x6c, 0x06, 0x9c, 0xb0, 0xa8,
-	0xcc, 0x8b, 0xcc, 0xcb, 0x66, 0xb6, 0x53, 0x7b, 0x13, 0x13, 0xbf, 0x85, 0x9f, 0xc2, 0x72, 0xcb,
-	0x4d, 0x6c, 0xac, 0xd4, 0x2c, 0x7e, 0x10, 0xb3, 0x80, 0x2b, 0x71, 0x13, 0x2b, 0x98, 0xfc, 0xde,
-	0x3f, 0xcf, 0xf3, 0xbc, 0x94, 0xa1, 0xaa, 0x6b, 0x29, 0xd0, 0x8a, 0xc5, 0x59, 0xaa, 0x50, 0x9e,
-	0x09, 0xa3, 0xea, 0x45, 0x91, 0x29, 0x5e, 0xd5, 0x80, 0xe0, 0x5d, 0xef, 0x38, 0x47, 0xcb, 0x07,
-	0x1e, 0xdc, 0xcc, 0x21, 0x87, 0x0e, 0x8a, 0xed, 0x5f, 0x5f, 0x17, 0xdc, 0xce, 0x01, 0xf2, 0xd7,
-	0x4a, 0xc8, 0xaa, 0x10, 0x52, 0x6b, 0x40, 0x89, 0x05, 0x68, 0x33, 0x50, 0x96, 0x81, 0x29, 0xc1,
-	0x88, 0x54, 0x1a, 0xb5, 0x5b, 0x94, 0x41, 0xa1, 0x07, 0x1e, 0x0c, 0x7c, 0x24, 0x03, 0x6d, 0xcf,
-	0xc2, 0x67, 0xf4, 0xc6, 0x39, 0x94, 0x55, 0x83, 0xea, 0x42, 0xda, 0x44, 0xbd, 0x69, 0x94, 0x41,
-	0xef, 0x3e, 0x75, 0xd1, 0xfa, 0x64, 0x42, 0xa2, 0xc3, 0xe9, 0x2d, 0xde, 0x77, 0x8f, 0x44, 0xf2,
-	0x0b, 0x1b, 0xbb, 0x3e, 0x49, 0x5c, 0xb4, 0xde, 0x11, 0xbd, 0x82, 0x76, 0x96, 0x2e, 0x51, 0x19,
-	0xdf, 0x9d, 0x90, 0xe8, 0x5a, 0x72, 0x80, 0x36, 0xde, 0x3e, 0xc3, 0xb7, 0x84, 0x7a, 0xe3, 0xd9,
-	0xa6, 0x02, 0x6d, 0x94, 0xf7, 0x92, 0x52, 0x94, 0x76, 0x26, 0x4b, 0x68, 0x34, 0xfa, 0x64, 0x72,
-	0x29, 0x3a, 0x9c, 0x1e, 0xfd, 0x59, 0xb2, 0xb5, 0xb0, 0x5b, 0x73, 0x0e, 0x85, 0x8e, 0x1f, 0xac,
-	0xbe, 0x1f, 0x3b, 0x9f, 0x7f, 0x1c, 0x47, 0x79, 0x81, 0xf3, 0x26, 0xe5, 0x19, 0x94, 0x62, 0xf0,
-	0xd3, 0x7f, 0x4e, 0xcd, 0x8b, 0x57, 0x02, 0x97, 0x95, 0x32, 0x5d, 0x83, 0x49, 0xae, 0xa2, 0xb4,
-	0x8f, 0xbb, 0xe9, 0xd3, 0x0f, 0x84, 0x1e, 0x3c, 0xed, 0x13, 0xf7, 0xde, 0x11, 0x4a, 0xff, 0xca,
-	0xf1, 0xee, 0xf2, 0x7f, 0xb3, 0xe7, 0x7b, 0x41, 0x04, 0xf7, 0xfe, 0x5f, 0xd4, 0x3b, 0x0a, 0xa3,
-	0xf7, 0x5f, 0x7f, 0x7d, 0x72, 0xc3, 0xf0, 0x8e, 0xd8, 0x3b, 0x77, 0xd6, 0x57, 0xcf, 0x50, 0xda,
-	0x47, 0xe4, 0x24, 0x7e, 0xb2, 0xda, 0x30, 0xb2, 0xde, 0x30, 0xf2, 0x73, 0xc3, 0xc8, 0xc7, 0x96,
-	0x39, 0x5f, 0x5a, 0x46, 0xd6, 0x2d, 0x73, 0xbe, 0xb5, 0xcc, 0x79, 0x7e, 0x32, 0xb2, 0xd8, 0x4d,
-	0x3a, 0x2d, 0x41, 0xab, 0xa5, 0xc8, 0xa0, 0x56, 0x22, 0x6b, 0x0c, 0x42, 0x29, 0x64, 0x83, 0x73,
-	0x81, 0x36, 0xbd, 0xdc, 0x9d, 0xef, 0xe1, 0xef, 0x00, 0x00, 0x00, 0xff, 0xff, 0xcc, 0xeb, 0xe4,
-	0x8f, 0x62, 0x02, 0x00, 0x00,
+	// 603 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0x4f, 0x4f, 0x13, 0x4f,
+	0x18, 0xee, 0x6c, 0xc9, 0xaf, 0x30, 0xfc, 0x34, 0x3a, 0xfe, 0x49, 0x69, 0x70, 0x21, 0x8b, 0x9a,
+	0x82, 0x61, 0x47, 0xe0, 0xc6, 0x8d, 0x82, 0xc4, 0xa3, 0xa9, 0x70, 0xd0, 0x4b, 0x33, 0xdd, 0xbe,
+	0x2c, 0x8b, 0xec, 0xce, 0xda, 0x79, 0x97, 0x0c, 0x37, 0x35, 0xf1, 0x6e, 0x62, 0xfc, 0x12, 0x1e,
+	0xfc, 0x0c, 0x1e, 0x39, 0x92, 0x78, 0x31, 0x1e, 0xd0, 0x50, 0x3f, 0x84, 0x47, 0x33, 0xb3, 0x0b,
+	0x16, 0xda, 0x28, 0x07, 0x3d, 0xb5, 0x9b, 0xe7, 0x99, 0xe7, 0x7d, 0x9e, 0xf7, 0x9d, 0x77, 0xa8,
+	0x8b, 0xd0, 0xed, 0x0a, 0x8e, 0x9a, 0xef, 0x2d, 0xb4, 0x01, 0xc5, 0x02, 0x57, 0xd0, 0xdd, 0x8b,
+	0x02, 0xf0, 0xd3, 0xae, 0x44, 0xc9, 0xae, 0x58, 0xdc, 0x47, 0xed, 0x17, 0x78, 0xed, 0x7a, 0x28,
+	0x43, 0x69, 0x41, 0x6e, 0xfe, 0xe5, 0xbc, 0xda, 0x64, 0x28, 0x65, 0xb8, 0x0b, 0x5c, 0xa4, 0x11,
+	0x17, 0x49, 0x22, 0x51, 0x60, 0x24, 0x13, 0x55, 0xa0, 0x6e, 0x20, 0x55, 0x2c, 0x15, 0x6f, 0x0b,
+	0x05, 0xa7, 0x85, 0x02, 0x19, 0x25, 0x05, 0x5e, 0x2b, 0xf0, 0x3e, 0x1b, 0xa8, 0x73, 0xcc, 0x7b,
+	0x42, 0xaf, 0xae, 0xca, 0x38, 0xcd, 0x10, 0x36, 0x84, 0x6e, 0xc2, 0xf3, 0x0c, 0x14, 0xb2, 0x59,
+	0xea, 0xa0, 0xae, 0x92, 0x69, 0x52, 0x1f, 0x5f, 0xbc, 0xe1, 0xe7, 0xa7, 0xfb, 0x4c, 0xfa, 0x1b,
+	0xba, 0xe1, 0x54, 0x49, 0xd3, 0x41, 0xcd, 0x26, 0xe8, 0x28, 0xea, 0x56, 0x7b, 0x1f, 0x41, 0x55,
+	0x9d, 0x69, 0x52, 0xff, 0xbf, 0x59, 0x41, 0xdd, 0x30, 0x9f, 0xde, 0x0b, 0x42, 0x59, 0xbf, 0xb6,
+	0x4a, 0x65, 0xa2, 0x80, 0xed, 0x50, 0x8a, 0x42, 0xb7, 0x44, 0x2c, 0xb3, 0x04, 0xab, 0x64, 0xba,
+	0x5c, 0x1f, 0x5f, 0x9c, 0x38, 0x29, 0x62, 0x22, 0x9c, 0x96, 0x59, 0x95, 0x51, 0xd2, 0xb8, 0x7f,
+	0x70, 0x34, 0x55, 0x7a, 0xff, 0x75, 0xaa, 0x1e, 0x46, 0xb8, 0x9d, 0xb5, 0xfd, 0x40, 0xc6, 0xbc,
+	0xc8, 0x93, 0xff, 0xcc, 0xab, 0xce, 0x33, 0x8e, 0xfb, 0x29, 0x28, 0x7b, 0x40, 0x35, 0xc7, 0x50,
+	0xe8, 0x15, 0xab, 0xee, 0xfd, 0x20, 0x94, 0x3d, 0x50, 0x18, 0xc5, 0x02, 0x61, 0x1d, 0xe0, 0x24,
+	0x5f, 0xbf, 0x69, 0x72, 0xc6, 0x34, 0xdb, 0xa4, 0x97, 0x43, 0xa1, 0x5a, 0xa2, 0xb3, 0x93, 0x29,
+	0x8c, 0x21, 0x41, 0x9b, 0x6a, 0xac, 0xe1, 0x1b, 0x1b, 0x5f, 0x8e, 0xa6, 0xee, 0x5e, 0xc0, 0xc6,
+	0x1a, 0x04, 0xcd, 0x4b, 0xa1, 0x50, 0x2b, 0xa7, 0x22, 0x2c, 0xa5, 0xd4, 0xc8, 0xa6, 0xdd, 0x28,
+	0x00, 0x55, 0x2d, 0xdb, 0xd0, 0x93, 0x43, 0x43, 0xaf, 0x41, 0x60, 0x73, 0x2f, 0x15, 0xb9, 0xef,
+	0x5d, 0xac, 0x60, 0x11, 0x3d, 0x14, 0xea, 0x91, 0xad, 0xe1, 0x7d, 0x70, 0xe8, 0xb5, 0x33, 0xd1,
+	0x8b, 0xf6, 0xd7, 0x69, 0x79, 0x0b, 0xa0, 0x18, 0xee, 0xcd, 0x21, 0xc3, 0x35, 0x64, 0x43, 0x31,
+	0x5d, 0x32, 0x9e, 0x33, 0x05, 0x1d, 0xdb, 0x84, 0x91, 0x66, 0x25, 0x14, 0x6a, 0x53, 0x41, 0xe7,
+	0xdc, 0x0c, 0xcb, 0xff, 0x72, 0x86, 0x6c, 0x8b, 0x8e, 0x6e, 0xed, 0x0a, 0x6c, 0x19, 0xd7, 0x23,
+	0x7f, 0xbf, 0x52, 0xc5, 0x88, 0xaf, 0x03, 0x2c, 0xbe, 0x73, 0x68, 0xe5, 0x71, 0xbe, 0x9d, 0xec,
+	0x25, 0xa1, 0xf4, 0xd7, 0xd5, 0x65, 0x33, 0xfe, 0xf9, 0x3d, 0xf5, 0x07, 0x96, 0xa6, 0x76, 0xfb,
+	0xf7, 0xa4, 0xbc, 0xfd, 0x5e, 0xfd, 0xd5, 0xa7, 0xef, 0x6f, 0x1d, 0xcf, 0xbb, 0xc5, 0x07, 0x9e,
+	0x86, 0x20, 0x67, 0xb7, 0x50, 0xe8, 0x65, 0x32, 0xc7, 0x5e, 0x13, 0x3a, 0xde, 0x37, 0x40, 0x36,
+	0x44, 0x7f, 0xf0, 0x6a, 0xd7, 0xee, 0xfc, 0x81, 0x55, 0xd8, 0x98, 0xb5, 0x36, 0x66, 0x3c, 0x77,
+	0xd0, 0x06, 0x14, 0x74, 0xd3, 0xf0, 0x65, 0x32, 0xd7, 0x78, 0x78, 0x70, 0xec, 0x92, 0xc3, 0x63,
+	0x97, 0x7c, 0x3b, 0x76, 0xc9, 0x9b, 0x9e, 0x5b, 0xfa, 0xd8, 0x73, 0xc9, 0x61, 0xcf, 0x2d, 0x7d,
+	0xee, 0xb9, 0xa5, 0xa7, 0x73, 0x7d, 0x8d, 0xb6, 0x52, 0xf3, 0xb1, 0x4c, 0x60, 0x9f, 0x07, 0xb2,
+	0x0b, 0x3c, 0xc8, 0x14, 0xca, 0x98, 0x8b, 0x0c, 0xb7, 0x39, 0xea, 0xf6, 0x7f, 0xf6, 0xc9, 0x59,
+	0xfa, 0x39, 0x00, 0x72, 0x02, 0xd6, 0x7a, 0x16, 0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -193,8 +345,11 @@ const _ = grpc.SupportPackageIsVersion4
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type ServiceClient interface {
-	// EstimateFee simulates executing a transaction for estimating gas usage.
+	// ComputeTax computes the stability tax of a transaction.
 	ComputeTax(ctx context.Context, in *ComputeTaxRequest, opts ...grpc.CallOption) (*ComputeTaxResponse, error)
+	// EstimateFee simulates executing a transaction for estimating gas usage,
+	// and returns the fee covering the gas and the stability tax.
+	EstimateFee(ctx context.Context, in *EstimateFeeRequest, opts ...grpc.CallOption) (*EstimateFeeResponse, error)
 }
 
 type serviceClient struct {
@@ -214,10 +369,22 @@ func (c *serviceClient) ComputeTax(ctx context.Context, in *ComputeTaxRequest, o
 	return out, nil
 }
 
+func (c *serviceClient) EstimateFee(ctx context.Context, in *EstimateFeeRequest, opts ...grpc.CallOption) (*EstimateFeeResponse, error) {
+	out := new(EstimateFeeResponse)
+	err := c.cc.Invoke(ctx, "/terra.tx.v1beta1.Service/EstimateFee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ServiceServer is the server API for Service service.
 type ServiceServer interface {
-	// EstimateFee simulates executing a transaction for estimating gas usage.
+	// ComputeTax computes the stability tax of a transaction.
 	ComputeTax(context.Context, *ComputeTaxRequest) (*ComputeTaxResponse, error)
+	// EstimateFee simulates executing a transaction for estimating gas usage,
+	// and returns the fee covering the gas and the stability tax.
+	EstimateFee(context.Context, *EstimateFeeRequest) (*EstimateFeeResponse, error)
 }
 
 // UnimplementedServiceServer can be embedded to have forward compatible implementations.
@@ -227,6 +394,9 @@ type UnimplementedServiceServer struct {
 func (*UnimplementedServiceServer) ComputeTax(ctx context.Context, req *ComputeTaxRequest) (*ComputeTaxResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ComputeTax not implemented")
 }
+func (*UnimplementedServiceServer) EstimateFee(ctx context.Context, req *EstimateFeeRequest) (*EstimateFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EstimateFee not implemented")
+}
 
 func RegisterServiceServer(s grpc1.Server, srv ServiceServer) {
 	s.RegisterService(&_Service_serviceDesc, srv)
@@ -250,6 +420,24 @@ func _Service_ComputeTax_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Service_EstimateFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EstimateFeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).EstimateFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/terra.tx.v1beta1.Service/EstimateFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).EstimateFee(ctx, req.(*EstimateFeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Service_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "terra.tx.v1beta1.Service",
 	HandlerType: (*ServiceServer)(nil),
@@ -258,6 +446,10 @@ var _Service_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ComputeTax",
 			Handler:    _Service_ComputeTax_Handler,
 		},
+		{
+			MethodName: "EstimateFee",
+			Handler:    _Service_EstimateFee_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "terra/tx/v1beta1/service.proto",
@@ -342,6 +534,128 @@ func (m *ComputeTaxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EstimateFeeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EstimateFeeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EstimateFeeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.GasPrices) > 0 {
+		for iNdEx := len(m.GasPrices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GasPrices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintService(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size := m.GasAdjustment.Size()
+		i -= size
+		if _, err := m.GasAdjustment.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintService(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.TxBytes) > 0 {
+		i -= len(m.TxBytes)
+		copy(dAtA[i:], m.TxBytes)
+		i = encodeVarintService(dAtA, i, uint64(len(m.TxBytes)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EstimateFeeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EstimateFeeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EstimateFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FlatFee) > 0 {
+		for iNdEx := len(m.FlatFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FlatFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintService(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.TaxAmount) > 0 {
+		for iNdEx := len(m.TaxAmount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TaxAmount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintService(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.GasUsed != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Fee != nil {
+		{
+			size, err := m.Fee.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintService(dAtA []byte, offset int, v uint64) int {
 	offset -= sovService(v)
 	base := offset
@@ -385,6 +699,55 @@ func (m *ComputeTaxResponse) Size() (n int) {
 	return n
 }
 
+func (m *EstimateFeeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TxBytes)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	l = m.GasAdjustment.Size()
+	n += 1 + l + sovService(uint64(l))
+	if len(m.GasPrices) > 0 {
+		for _, e := range m.GasPrices {
+			l = e.Size()
+			n += 1 + l + sovService(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EstimateFeeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Fee != nil {
+		l = m.Fee.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.GasUsed != 0 {
+		n += 1 + sovService(uint64(m.GasUsed))
+	}
+	if len(m.TaxAmount) > 0 {
+		for _, e := range m.TaxAmount {
+			l = e.Size()
+			n += 1 + l + sovService(uint64(l))
+		}
+	}
+	if len(m.FlatFee) > 0 {
+		for _, e := range m.FlatFee {
+			l = e.Size()
+			n += 1 + l + sovService(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovService(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -595,6 +958,331 @@ func (m *ComputeTaxResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EstimateFeeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EstimateFeeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EstimateFeeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxBytes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxBytes = append(m.TxBytes[:0], dAtA[iNdEx:postIndex]...)
+			if m.TxBytes == nil {
+				m.TxBytes = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasAdjustment", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GasAdjustment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasPrices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GasPrices = append(m.GasPrices, types.DecCoin{})
+			if err := m.GasPrices[len(m.GasPrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EstimateFeeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EstimateFeeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EstimateFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Fee == nil {
+				m.Fee = &tx.Fee{}
+			}
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+			}
+			m.GasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaxAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaxAmount = append(m.TaxAmount, types.Coin{})
+			if err := m.TaxAmount[len(m.TaxAmount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FlatFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FlatFee = append(m.FlatFee, types.Coin{})
+			if err := m.FlatFee[len(m.FlatFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipService(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
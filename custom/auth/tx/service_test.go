@@ -0,0 +1,163 @@
+package tx_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	terraapp "github.com/terra-money/core/app"
+	customante "github.com/terra-money/core/custom/auth/ante"
+	customtx "github.com/terra-money/core/custom/auth/tx"
+	core "github.com/terra-money/core/types"
+	oracletypes "github.com/terra-money/core/x/oracle/types"
+	treasurytypes "github.com/terra-money/core/x/treasury/types"
+	wasmconfig "github.com/terra-money/core/x/wasm/config"
+)
+
+const simulatedGasUsed = 100_001
+
+func setupEstimateFee(t *testing.T, baseGasPrice *sdk.DecCoin) (*terraapp.TerraApp, sdk.Context, customtx.ServiceServer, []byte) {
+	encodingConfig := terraapp.MakeEncodingConfig()
+	app := terraapp.NewTerraApp(
+		log.NewNopLogger(), dbm.NewMemDB(), nil, true, map[int64]bool{},
+		t.TempDir(), simapp.FlagPeriodValue, encodingConfig,
+		simapp.EmptyAppOptions{}, wasmconfig.DefaultConfig(),
+	)
+	ctx := app.BaseApp.NewContext(true, tmproto.Header{})
+	app.AccountKeeper.SetParams(ctx, authtypes.DefaultParams())
+	app.TreasuryKeeper.SetParams(ctx, treasurytypes.DefaultParams())
+	app.OracleKeeper.SetParams(ctx, oracletypes.DefaultParams())
+	app.TreasuryKeeper.SetTaxRate(ctx, sdk.NewDecWithPrec(1, 3))
+	app.TreasuryKeeper.SetTaxCap(ctx, core.MicroUSDDenom, sdk.NewInt(1_000_000_000))
+
+	_, _, addr1 := testdata.KeyTestPubAddr()
+	_, _, addr2 := testdata.KeyTestPubAddr()
+	txBuilder := encodingConfig.TxConfig.NewTxBuilder()
+	require.NoError(t, txBuilder.SetMsgs(banktypes.NewMsgSend(addr1, addr2, sdk.NewCoins(sdk.NewInt64Coin(core.MicroUSDDenom, 1_000_000)))))
+	txBytes, err := encodingConfig.TxConfig.TxEncoder()(txBuilder.GetTx())
+	require.NoError(t, err)
+
+	simulate := func(txBytes []byte) (sdk.GasInfo, *sdk.Result, error) {
+		return sdk.GasInfo{GasUsed: simulatedGasUsed}, &sdk.Result{}, nil
+	}
+	clientCtx := client.Context{}.WithTxConfig(encodingConfig.TxConfig)
+	minGasPriceDecorator := customante.NewDynamicMinGasPriceDecorator(baseGasPrice, app.OracleKeeper, app.MarketKeeper)
+	server := customtx.NewTxServer(clientCtx, simulate, minGasPriceDecorator.LocalMinGasPrices, app.TreasuryKeeper, app.TaxableMsgRegistry())
+
+	return app, ctx, server, txBytes
+}
+
+func TestEstimateFee(t *testing.T) {
+	_, ctx, server, txBytes := setupEstimateFee(t, nil)
+	tax := sdk.NewInt64Coin(core.MicroUSDDenom, 1000)
+
+	// the gas limit is rounded up and the gas fee is paid in the first denom only
+	res, err := server.EstimateFee(sdk.WrapSDKContext(ctx), &customtx.EstimateFeeRequest{
+		TxBytes:       txBytes,
+		GasAdjustment: sdk.NewDecWithPrec(15, 1),
+		GasPrices: sdk.NewDecCoins(
+			sdk.NewDecCoinFromDec(core.MicroSDRDenom, sdk.NewDecWithPrec(1, 1)),
+			sdk.NewDecCoinFromDec(core.MicroUSDDenom, sdk.NewDecWithPrec(15, 2)),
+		),
+	})
+	require.NoError(t, err)
+	require.Equal(t, uint64(simulatedGasUsed), res.GasUsed)
+	require.Equal(t, uint64(150_002), res.Fee.GasLimit)
+	require.Equal(t, sdk.NewCoins(tax), res.TaxAmount)
+	require.Equal(t, sdk.NewCoins(tax, sdk.NewInt64Coin(core.MicroSDRDenom, 15_001)), res.Fee.Amount)
+
+	// a gas adjustment below 1 is rejected
+	_, err = server.EstimateFee(sdk.WrapSDKContext(ctx), &customtx.EstimateFeeRequest{
+		TxBytes:       txBytes,
+		GasAdjustment: sdk.NewDecWithPrec(9, 1),
+	})
+	require.Error(t, err)
+
+	// a missing gas adjustment defaults to 1
+	res, err = server.EstimateFee(sdk.WrapSDKContext(ctx), &customtx.EstimateFeeRequest{
+		TxBytes:   txBytes,
+		GasPrices: sdk.NewDecCoins(sdk.NewDecCoinFromDec(core.MicroUSDDenom, sdk.NewDecWithPrec(15, 2))),
+	})
+	require.NoError(t, err)
+	require.Equal(t, uint64(simulatedGasUsed), res.Fee.GasLimit)
+	require.Equal(t, sdk.NewCoins(tax.AddAmount(sdk.NewInt(15_001))), res.Fee.Amount)
+}
+
+func TestEstimateFeeDefaultGasPrices(t *testing.T) {
+	app, ctx, server, txBytes := setupEstimateFee(t, nil)
+	tax := sdk.NewInt64Coin(core.MicroUSDDenom, 1000)
+
+	// without global min gas prices, the fee meets the local min gas prices of the node
+	localMinGasPrices := sdk.NewDecCoins(sdk.NewDecCoinFromDec(core.MicroUSDDenom, sdk.NewDecWithPrec(15, 2)))
+	res, err := server.EstimateFee(sdk.WrapSDKContext(ctx.WithMinGasPrices(localMinGasPrices)), &customtx.EstimateFeeRequest{
+		TxBytes: txBytes,
+	})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(tax.AddAmount(sdk.NewInt(15_001))), res.Fee.Amount)
+
+	// the global min gas prices take precedence over the local ones
+	params := app.TreasuryKeeper.GetParams(ctx)
+	params.MinGasPrices = sdk.NewDecCoins(sdk.NewDecCoinFromDec(core.MicroSDRDenom, sdk.NewDecWithPrec(1, 1)))
+	app.TreasuryKeeper.SetParams(ctx, params)
+
+	res, err = server.EstimateFee(sdk.WrapSDKContext(ctx.WithMinGasPrices(localMinGasPrices)), &customtx.EstimateFeeRequest{
+		TxBytes: txBytes,
+	})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(tax, sdk.NewInt64Coin(core.MicroSDRDenom, 10_001)), res.Fee.Amount)
+}
+
+func TestEstimateFeeDynamicGasPrices(t *testing.T) {
+	baseGasPrice := sdk.NewDecCoinFromDec(core.MicroUSDDenom, sdk.NewDecWithPrec(15, 2))
+	app, ctx, server, txBytes := setupEstimateFee(t, &baseGasPrice)
+	app.OracleKeeper.SetLunaExchangeRate(ctx, core.MicroUSDDenom, sdk.NewDec(100))
+	tax := sdk.NewInt64Coin(core.MicroUSDDenom, 1000)
+
+	// the local min gas prices are derived from the base gas price, as in the ante handler,
+	// overriding the static ones; 100001 gas * 0.0015uluna = 150.0015uluna
+	staticMinGasPrices := sdk.NewDecCoins(sdk.NewDecCoinFromDec(core.MicroLunaDenom, sdk.NewDec(1)))
+	res, err := server.EstimateFee(sdk.WrapSDKContext(ctx.WithMinGasPrices(staticMinGasPrices)), &customtx.EstimateFeeRequest{
+		TxBytes: txBytes,
+	})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(tax, sdk.NewInt64Coin(core.MicroLunaDenom, 151)), res.Fee.Amount)
+}
+
+func TestEstimateFeeWaivedGasFee(t *testing.T) {
+	app, ctx, server, _ := setupEstimateFee(t, nil)
+
+	_, _, addr := testdata.KeyTestPubAddr()
+	consentMsg := oracletypes.NewMsgDelegateFeedConsent(sdk.ValAddress(addr), addr)
+	txConfig := terraapp.MakeEncodingConfig().TxConfig
+	txBuilder := txConfig.NewTxBuilder()
+	require.NoError(t, txBuilder.SetMsgs(consentMsg))
+	txBytes, err := txConfig.TxEncoder()(txBuilder.GetTx())
+	require.NoError(t, err)
+
+	params := app.TreasuryKeeper.GetParams(ctx)
+	params.MinGasPrices = sdk.NewDecCoins(sdk.NewDecCoinFromDec(core.MicroSDRDenom, sdk.NewDecWithPrec(1, 1)))
+	params.MsgFeeRequirements = []treasurytypes.MsgFeeRequirement{
+		{MsgTypeUrl: sdk.MsgTypeURL(consentMsg), GasPriceMultiplier: sdk.ZeroDec()},
+	}
+	app.TreasuryKeeper.SetParams(ctx, params)
+
+	// the zero multiplier does not waive the gas fee beyond the gas limit of the waiver
+	localMinGasPrices := sdk.NewDecCoins(sdk.NewDecCoinFromDec(core.MicroUSDDenom, sdk.NewDecWithPrec(15, 2)))
+	res, err := server.EstimateFee(sdk.WrapSDKContext(ctx.WithMinGasPrices(localMinGasPrices)), &customtx.EstimateFeeRequest{
+		TxBytes: txBytes,
+	})
+	require.NoError(t, err)
+	require.Greater(t, res.Fee.GasLimit, customante.MaxFeeWaivedMsgGasUsage)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(core.MicroSDRDenom, 10_001)), res.Fee.Amount)
+}
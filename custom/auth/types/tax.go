@@ -0,0 +1,59 @@
+package types
+
+import (
+	"fmt"
+	"reflect"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/modules/apps/transfer/types"
+)
+
+// TaxablePrincipal is an amount of a message subject to the stability tax.
+// The tax is waived when all of its parties belong to the same tax exemption zone;
+// a principal without parties is always taxed.
+type TaxablePrincipal struct {
+	Amount  sdk.Coins
+	Parties []string
+}
+
+// TaxableMsgExtractor returns the taxable principals of a message
+type TaxableMsgExtractor func(msg sdk.Msg) ([]TaxablePrincipal, error)
+
+// TaxableMsgRegistry maps msg types to their taxable amount extractor.
+// Keyed by go type, as proto names are not yet registered when the app is built.
+type TaxableMsgRegistry map[reflect.Type]TaxableMsgExtractor
+
+// NewTaxableMsgRegistry returns an empty taxable msg registry
+func NewTaxableMsgRegistry() TaxableMsgRegistry {
+	return TaxableMsgRegistry{}
+}
+
+// RegisterTaxableMsg registers the taxable amount extractor of the msg type
+func (r TaxableMsgRegistry) RegisterTaxableMsg(msg sdk.Msg, extractor TaxableMsgExtractor) {
+	msgType := reflect.TypeOf(msg)
+	if _, ok := r[msgType]; ok {
+		panic(fmt.Sprintf("taxable msg %s already registered", msgType))
+	}
+
+	r[msgType] = extractor
+}
+
+// GetTaxablePrincipals returns the taxable principals of the msg;
+// empty for msgs without registered extractor
+func (r TaxableMsgRegistry) GetTaxablePrincipals(msg sdk.Msg) ([]TaxablePrincipal, error) {
+	extractor, ok := r[reflect.TypeOf(msg)]
+	if !ok {
+		return nil, nil
+	}
+
+	return extractor(msg)
+}
+
+// RegisterTaxableMsgs registers the taxable msgs of the modules without custom wrapper
+func RegisterTaxableMsgs(registry TaxableMsgRegistry) {
+	// The receiver of an ibc transfer lives on the counterparty chain,
+	// so transfers are never exempt.
+	registry.RegisterTaxableMsg(&ibctransfertypes.MsgTransfer{}, func(msg sdk.Msg) ([]TaxablePrincipal, error) {
+		return []TaxablePrincipal{{Amount: sdk.NewCoins(msg.(*ibctransfertypes.MsgTransfer).Token)}}, nil
+	})
+}
@@ -0,0 +1,31 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+
+	customauthtypes "github.com/terra-money/core/custom/auth/types"
+)
+
+// RegisterTaxableMsgs registers the taxable msgs of the module
+func RegisterTaxableMsgs(registry customauthtypes.TaxableMsgRegistry) {
+	// executed msgs are taxed as if they were sent directly
+	registry.RegisterTaxableMsg(&authz.MsgExec{}, func(msg sdk.Msg) ([]customauthtypes.TaxablePrincipal, error) {
+		messages, err := msg.(*authz.MsgExec).GetMessages()
+		if err != nil {
+			return nil, err
+		}
+
+		var principals []customauthtypes.TaxablePrincipal
+		for _, message := range messages {
+			msgPrincipals, err := registry.GetTaxablePrincipals(message)
+			if err != nil {
+				return nil, err
+			}
+
+			principals = append(principals, msgPrincipals...)
+		}
+
+		return principals, nil
+	})
+}
@@ -0,0 +1,44 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	customauthtypes "github.com/terra-money/core/custom/auth/types"
+)
+
+// RegisterTaxableMsgs registers the taxable msgs of the module
+func RegisterTaxableMsgs(registry customauthtypes.TaxableMsgRegistry) {
+	registry.RegisterTaxableMsg(&types.MsgSend{}, func(msg sdk.Msg) ([]customauthtypes.TaxablePrincipal, error) {
+		msgSend := msg.(*types.MsgSend)
+		return []customauthtypes.TaxablePrincipal{{
+			Amount:  msgSend.Amount,
+			Parties: []string{msgSend.FromAddress, msgSend.ToAddress},
+		}}, nil
+	})
+
+	// each input is taxed separately, but exempt only when
+	// all inputs and outputs belong to the same zone
+	registry.RegisterTaxableMsg(&types.MsgMultiSend{}, func(msg sdk.Msg) ([]customauthtypes.TaxablePrincipal, error) {
+		msgMultiSend := msg.(*types.MsgMultiSend)
+
+		parties := make([]string, 0, len(msgMultiSend.Inputs)+len(msgMultiSend.Outputs))
+		for _, input := range msgMultiSend.Inputs {
+			parties = append(parties, input.Address)
+		}
+
+		for _, output := range msgMultiSend.Outputs {
+			parties = append(parties, output.Address)
+		}
+
+		principals := make([]customauthtypes.TaxablePrincipal, len(msgMultiSend.Inputs))
+		for i, input := range msgMultiSend.Inputs {
+			principals[i] = customauthtypes.TaxablePrincipal{
+				Amount:  input.Coins,
+				Parties: parties,
+			}
+		}
+
+		return principals, nil
+	})
+}
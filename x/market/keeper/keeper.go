@@ -21,6 +21,9 @@ type Keeper struct {
 	AccountKeeper types.AccountKeeper
 	BankKeeper    types.BankKeeper
 	OracleKeeper  types.OracleKeeper
+	DistrKeeper   types.DistributionKeeper
+
+	distributionModuleName string
 }
 
 // NewKeeper constructs a new keeper for oracle
@@ -31,6 +34,8 @@ func NewKeeper(
 	accountKeeper types.AccountKeeper,
 	bankKeeper types.BankKeeper,
 	oracleKeeper types.OracleKeeper,
+	distrKeeper types.DistributionKeeper,
+	distributionModuleName string,
 ) Keeper {
 
 	// ensure market module account is set
@@ -50,6 +55,9 @@ func NewKeeper(
 		AccountKeeper: accountKeeper,
 		BankKeeper:    bankKeeper,
 		OracleKeeper:  oracleKeeper,
+		DistrKeeper:   distrKeeper,
+
+		distributionModuleName: distributionModuleName,
 	}
 }
 
@@ -0,0 +1,50 @@
+package keeper
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/terra-money/core/x/market/types"
+)
+
+func TestMigrate1to2(t *testing.T) {
+	input := CreateTestInput(t)
+	encodingConfig := MakeEncodingConfig(t)
+
+	// the version 1 params store does not have the swap fee distribution
+	keyParams := sdk.NewKVStoreKey(paramstypes.StoreKey)
+	tKeyParams := sdk.NewTransientStoreKey(paramstypes.TStoreKey)
+	db := dbm.NewMemDB()
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(keyParams, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(tKeyParams, sdk.StoreTypeTransient, db)
+	require.NoError(t, ms.LoadLatestVersion())
+	ctx := sdk.NewContext(ms, tmproto.Header{}, false, log.NewNopLogger())
+
+	keeper := input.MarketKeeper
+	keeper.paramSpace = paramstypes.NewSubspace(encodingConfig.Marshaler, encodingConfig.Amino, keyParams, tKeyParams, types.ModuleName).
+		WithKeyTable(types.ParamKeyTable())
+
+	defaultParams := types.DefaultParams()
+	for _, pair := range defaultParams.ParamSetPairs() {
+		if !bytes.Equal(pair.Key, types.KeySwapFeeDistribution) {
+			keeper.paramSpace.Set(ctx, pair.Key, pair.Value)
+		}
+	}
+	require.False(t, keeper.paramSpace.Has(ctx, types.KeySwapFeeDistribution))
+
+	migrator := NewMigrator(keeper)
+	require.NoError(t, migrator.Migrate1to2(ctx))
+	require.Equal(t, types.DefaultSwapFeeDistribution, keeper.SwapFeeDistribution(ctx))
+	require.Equal(t, defaultParams, keeper.GetParams(ctx))
+}
@@ -6,7 +6,6 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/terra-money/core/x/market/types"
-	oracletypes "github.com/terra-money/core/x/oracle/types"
 )
 
 type msgServer struct {
@@ -49,8 +48,11 @@ func (k msgServer) SwapSend(goCtx context.Context, msg *types.MsgSwapSend) (*typ
 	}
 
 	return &types.MsgSwapSendResponse{
-		SwapCoin: res.SwapCoin,
-		SwapFee:  res.SwapFee,
+		SwapCoin:         res.SwapCoin,
+		SwapFee:          res.SwapFee,
+		OracleRewardFee:  res.OracleRewardFee,
+		CommunityPoolFee: res.CommunityPoolFee,
+		BurnedFee:        res.BurnedFee,
 	}, nil
 }
 
@@ -115,13 +117,10 @@ func (k msgServer) handleSwapRequest(ctx sdk.Context,
 		return nil, err
 	}
 
-	// Send swap fee to oracle account
-	if feeCoin.IsPositive() {
-		feeCoins := sdk.NewCoins(feeCoin)
-		err = k.BankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, oracletypes.ModuleName, feeCoins)
-		if err != nil {
-			return nil, err
-		}
+	// Distribute swap fee to oracle reward pool, community pool and burn
+	oracleRewardFee, communityPoolFee, burnedFee, err := k.DistributeSwapFee(ctx, feeCoin)
+	if err != nil {
+		return nil, err
 	}
 
 	ctx.EventManager().EmitEvents(sdk.Events{
@@ -140,7 +139,10 @@ func (k msgServer) handleSwapRequest(ctx sdk.Context,
 	})
 
 	return &types.MsgSwapResponse{
-		SwapCoin: swapCoin,
-		SwapFee:  feeCoin,
+		SwapCoin:         swapCoin,
+		SwapFee:          feeCoin,
+		OracleRewardFee:  oracleRewardFee,
+		CommunityPoolFee: communityPoolFee,
+		BurnedFee:        burnedFee,
 	}, nil
 }
@@ -25,6 +25,12 @@ func (k Keeper) PoolRecoveryPeriod(ctx sdk.Context) (res uint64) {
 	return
 }
 
+// SwapFeeDistribution is the weighted split of swap fees among oracle reward pool, community pool and burn
+func (k Keeper) SwapFeeDistribution(ctx sdk.Context) (res types.SwapFeeDistribution) {
+	k.paramSpace.Get(ctx, types.KeySwapFeeDistribution, &res)
+	return
+}
+
 // GetParams returns the total set of market parameters.
 func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
 	k.paramSpace.GetParamSet(ctx, &params)
@@ -0,0 +1,60 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/terra-money/core/x/market/types"
+	oracletypes "github.com/terra-money/core/x/oracle/types"
+)
+
+// DistributeSwapFee splits the swap fee held by the market module account among
+// the oracle reward pool, the community pool and burn following SwapFeeDistribution.
+// The truncation remainder is added to the oracle reward portion.
+func (k Keeper) DistributeSwapFee(ctx sdk.Context, feeCoin sdk.Coin) (oracleRewardFee, communityPoolFee, burnedFee sdk.Coin, err error) {
+	distribution := k.SwapFeeDistribution(ctx)
+
+	communityPoolFee = sdk.NewCoin(feeCoin.Denom, distribution.CommunityPoolWeight.MulInt(feeCoin.Amount).TruncateInt())
+	burnedFee = sdk.NewCoin(feeCoin.Denom, distribution.BurnWeight.MulInt(feeCoin.Amount).TruncateInt())
+	oracleRewardFee = feeCoin.Sub(communityPoolFee).Sub(burnedFee)
+
+	// Send oracle reward portion to oracle account
+	if oracleRewardFee.IsPositive() {
+		err = k.BankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, oracletypes.ModuleName, sdk.NewCoins(oracleRewardFee))
+		if err != nil {
+			return
+		}
+	}
+
+	// Send community pool portion to distribution module
+	if communityPoolFee.IsPositive() {
+		communityPoolCoins := sdk.NewCoins(communityPoolFee)
+		err = k.BankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, k.distributionModuleName, communityPoolCoins)
+		if err != nil {
+			return
+		}
+
+		// Update distribution community pool
+		feePool := k.DistrKeeper.GetFeePool(ctx)
+		feePool.CommunityPool = feePool.CommunityPool.Add(sdk.NewDecCoinsFromCoins(communityPoolCoins...)...)
+		k.DistrKeeper.SetFeePool(ctx, feePool)
+	}
+
+	// Burn the rest
+	if burnedFee.IsPositive() {
+		err = k.BankKeeper.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(burnedFee))
+		if err != nil {
+			return
+		}
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventSwapFee,
+			sdk.NewAttribute(types.AttributeKeyOracleReward, oracleRewardFee.String()),
+			sdk.NewAttribute(types.AttributeKeyCommunityPool, communityPoolFee.String()),
+			sdk.NewAttribute(types.AttributeKeyBurn, burnedFee.String()),
+		),
+	)
+
+	return
+}
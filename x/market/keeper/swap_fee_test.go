@@ -0,0 +1,49 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	core "github.com/terra-money/core/types"
+	"github.com/terra-money/core/x/market/types"
+	oracletypes "github.com/terra-money/core/x/oracle/types"
+)
+
+func TestDistributeSwapFee(t *testing.T) {
+	input := CreateTestInput(t)
+
+	params := input.MarketKeeper.GetParams(input.Ctx)
+	params.SwapFeeDistribution = types.SwapFeeDistribution{
+		OracleRewardWeight:  sdk.NewDecWithPrec(5, 1),
+		CommunityPoolWeight: sdk.NewDecWithPrec(3, 1),
+		BurnWeight:          sdk.NewDecWithPrec(2, 1),
+	}
+	input.MarketKeeper.SetParams(input.Ctx, params)
+
+	feeCoin := sdk.NewCoin(core.MicroSDRDenom, sdk.NewInt(1001))
+	require.NoError(t, input.BankKeeper.MintCoins(input.Ctx, types.ModuleName, sdk.NewCoins(feeCoin)))
+	supplyBefore := input.BankKeeper.GetSupply(input.Ctx, core.MicroSDRDenom)
+
+	oracleRewardFee, communityPoolFee, burnedFee, err := input.MarketKeeper.DistributeSwapFee(input.Ctx, feeCoin)
+	require.NoError(t, err)
+
+	// truncation remainder goes to the oracle reward pool
+	require.Equal(t, sdk.NewInt(501), oracleRewardFee.Amount)
+	require.Equal(t, sdk.NewInt(300), communityPoolFee.Amount)
+	require.Equal(t, sdk.NewInt(200), burnedFee.Amount)
+
+	oracleAcc := input.AccountKeeper.GetModuleAddress(oracletypes.ModuleName)
+	require.Equal(t, oracleRewardFee, input.BankKeeper.GetBalance(input.Ctx, oracleAcc, core.MicroSDRDenom))
+
+	feePool := input.MarketKeeper.DistrKeeper.GetFeePool(input.Ctx)
+	require.Equal(t, sdk.NewDecCoinsFromCoins(communityPoolFee), feePool.CommunityPool)
+
+	supplyAfter := input.BankKeeper.GetSupply(input.Ctx, core.MicroSDRDenom)
+	require.Equal(t, supplyBefore.Sub(burnedFee), supplyAfter)
+
+	marketAcc := input.AccountKeeper.GetModuleAddress(types.ModuleName)
+	require.True(t, input.BankKeeper.GetBalance(input.Ctx, marketAcc, core.MicroSDRDenom).IsZero())
+}
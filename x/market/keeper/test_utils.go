@@ -250,6 +250,8 @@ func CreateTestInput(t *testing.T) TestInput {
 		accountKeeper,
 		bankKeeper,
 		oracleKeeper,
+		distrKeeper,
+		distrtypes.ModuleName,
 	)
 	keeper.SetParams(ctx, types.DefaultParams())
 
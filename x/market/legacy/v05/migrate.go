@@ -18,9 +18,10 @@ func Migrate(
 	return &v05market.GenesisState{
 		TerraPoolDelta: sdk.ZeroDec(),
 		Params: v05market.Params{
-			BasePool:           marketGenState.Params.BasePool,
-			PoolRecoveryPeriod: uint64(marketGenState.Params.PoolRecoveryPeriod),
-			MinStabilitySpread: marketGenState.Params.MinStabilitySpread,
+			BasePool:            marketGenState.Params.BasePool,
+			PoolRecoveryPeriod:  uint64(marketGenState.Params.PoolRecoveryPeriod),
+			MinStabilitySpread:  marketGenState.Params.MinStabilitySpread,
+			SwapFeeDistribution: v05market.DefaultSwapFeeDistribution,
 		},
 	}
 }
@@ -53,7 +53,12 @@ func TestMigrate(t *testing.T) {
 	"params": {
 		"base_pool": "1000000.000000000000000000",
 		"min_stability_spread": "0.020000000000000000",
-		"pool_recovery_period": "10000"
+		"pool_recovery_period": "10000",
+		"swap_fee_distribution": {
+			"burn_weight": "0.000000000000000000",
+			"community_pool_weight": "0.000000000000000000",
+			"oracle_reward_weight": "1.000000000000000000"
+		}
 	},
 	"terra_pool_delta": "0.000000000000000000"
 }`
@@ -15,9 +15,10 @@ import (
 
 // Simulation parameter constants
 const (
-	basePoolKey           = "base_pool"
-	poolRecoveryPeriodKey = "pool_recovery_period"
-	minStabilitySpreadKey = "min_spread"
+	basePoolKey            = "base_pool"
+	poolRecoveryPeriodKey  = "pool_recovery_period"
+	minStabilitySpreadKey  = "min_spread"
+	swapFeeDistributionKey = "swap_fee_distribution"
 )
 
 // GenBasePool randomized MintBasePool
@@ -35,6 +36,18 @@ func GenMinSpread(r *rand.Rand) sdk.Dec {
 	return sdk.NewDecWithPrec(1, 2).Add(sdk.NewDecWithPrec(int64(r.Intn(100)), 3))
 }
 
+// GenSwapFeeDistribution randomized SwapFeeDistribution
+func GenSwapFeeDistribution(r *rand.Rand) types.SwapFeeDistribution {
+	oracleRewardWeight := sdk.NewDecWithPrec(int64(r.Intn(101)), 2)
+	communityPoolWeight := sdk.NewDecWithPrec(int64(r.Intn(101)), 2).Mul(sdk.OneDec().Sub(oracleRewardWeight))
+
+	return types.SwapFeeDistribution{
+		OracleRewardWeight:  oracleRewardWeight,
+		CommunityPoolWeight: communityPoolWeight,
+		BurnWeight:          sdk.OneDec().Sub(oracleRewardWeight).Sub(communityPoolWeight),
+	}
+}
+
 // RandomizedGenState generates a random GenesisState for gov
 func RandomizedGenState(simState *module.SimulationState) {
 
@@ -56,12 +69,19 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { minStabilitySpread = GenMinSpread(r) },
 	)
 
+	var swapFeeDistribution types.SwapFeeDistribution
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, swapFeeDistributionKey, &swapFeeDistribution, simState.Rand,
+		func(r *rand.Rand) { swapFeeDistribution = GenSwapFeeDistribution(r) },
+	)
+
 	marketGenesis := types.NewGenesisState(
 		sdk.ZeroDec(),
 		types.Params{
-			BasePool:           basePool,
-			PoolRecoveryPeriod: poolRecoveryPeriod,
-			MinStabilitySpread: minStabilitySpread,
+			BasePool:            basePool,
+			PoolRecoveryPeriod:  poolRecoveryPeriod,
+			MinStabilitySpread:  minStabilitySpread,
+			SwapFeeDistribution: swapFeeDistribution,
 		},
 	)
 
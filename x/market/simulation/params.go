@@ -31,5 +31,12 @@ func ParamChanges(r *rand.Rand) []simtypes.ParamChange {
 				return fmt.Sprintf("\"%s\"", GenMinSpread(r))
 			},
 		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeySwapFeeDistribution),
+			func(r *rand.Rand) string {
+				d := GenSwapFeeDistribution(r)
+				return fmt.Sprintf("{\"oracle_reward_weight\":\"%s\",\"community_pool_weight\":\"%s\",\"burn_weight\":\"%s\"}",
+					d.OracleRewardWeight, d.CommunityPoolWeight, d.BurnWeight)
+			},
+		),
 	}
 }
@@ -2,7 +2,8 @@ package types
 
 // Market module event types
 const (
-	EventSwap = "swap"
+	EventSwap    = "swap"
+	EventSwapFee = "swap_fee"
 
 	AttributeKeyOffer     = "offer"
 	AttributeKeyTrader    = "trader"
@@ -10,5 +11,9 @@ const (
 	AttributeKeySwapCoin  = "swap_coin"
 	AttributeKeySwapFee   = "swap_fee"
 
+	AttributeKeyOracleReward  = "oracle_reward"
+	AttributeKeyCommunityPool = "community_pool"
+	AttributeKeyBurn          = "burn"
+
 	AttributeValueCategory = ModuleName
 )
@@ -3,6 +3,7 @@ package types
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 )
 
 // AccountKeeper is expected keeper for auth module
@@ -37,3 +38,9 @@ type OracleKeeper interface {
 	SetLunaExchangeRate(ctx sdk.Context, denom string, exchangeRate sdk.Dec)
 	SetTobinTax(ctx sdk.Context, denom string, tobinTax sdk.Dec)
 }
+
+// DistributionKeeper defines expected distribution keeper
+type DistributionKeeper interface {
+	GetFeePool(ctx sdk.Context) (feePool distrtypes.FeePool)
+	SetFeePool(ctx sdk.Context, feePool distrtypes.FeePool)
+}
@@ -26,9 +26,10 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // Params defines the parameters for the market module.
 type Params struct {
-	BasePool           github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=base_pool,json=basePool,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"base_pool" yaml:"base_pool"`
-	PoolRecoveryPeriod uint64                                 `protobuf:"varint,2,opt,name=pool_recovery_period,json=poolRecoveryPeriod,proto3" json:"pool_recovery_period,omitempty" yaml:"pool_recovery_period"`
-	MinStabilitySpread github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=min_stability_spread,json=minStabilitySpread,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_stability_spread" yaml:"min_stability_spread"`
+	BasePool            github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=base_pool,json=basePool,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"base_pool" yaml:"base_pool"`
+	PoolRecoveryPeriod  uint64                                 `protobuf:"varint,2,opt,name=pool_recovery_period,json=poolRecoveryPeriod,proto3" json:"pool_recovery_period,omitempty" yaml:"pool_recovery_period"`
+	MinStabilitySpread  github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=min_stability_spread,json=minStabilitySpread,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_stability_spread" yaml:"min_stability_spread"`
+	SwapFeeDistribution SwapFeeDistribution                    `protobuf:"bytes,4,opt,name=swap_fee_distribution,json=swapFeeDistribution,proto3" json:"swap_fee_distribution" yaml:"swap_fee_distribution"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -70,35 +71,93 @@ func (m *Params) GetPoolRecoveryPeriod() uint64 {
 	return 0
 }
 
+func (m *Params) GetSwapFeeDistribution() SwapFeeDistribution {
+	if m != nil {
+		return m.SwapFeeDistribution
+	}
+	return SwapFeeDistribution{}
+}
+
+// SwapFeeDistribution defines the weighted split of the swap spread fee among
+// the oracle reward pool, the community pool and burn.
+type SwapFeeDistribution struct {
+	OracleRewardWeight  github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=oracle_reward_weight,json=oracleRewardWeight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"oracle_reward_weight" yaml:"oracle_reward_weight"`
+	CommunityPoolWeight github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=community_pool_weight,json=communityPoolWeight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"community_pool_weight" yaml:"community_pool_weight"`
+	BurnWeight          github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=burn_weight,json=burnWeight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"burn_weight" yaml:"burn_weight"`
+}
+
+func (m *SwapFeeDistribution) Reset()      { *m = SwapFeeDistribution{} }
+func (*SwapFeeDistribution) ProtoMessage() {}
+func (*SwapFeeDistribution) Descriptor() ([]byte, []int) {
+	return fileDescriptor_114ea92c5ae3e66f, []int{1}
+}
+func (m *SwapFeeDistribution) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SwapFeeDistribution) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SwapFeeDistribution.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SwapFeeDistribution) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SwapFeeDistribution.Merge(m, src)
+}
+func (m *SwapFeeDistribution) XXX_Size() int {
+	return m.Size()
+}
+func (m *SwapFeeDistribution) XXX_DiscardUnknown() {
+	xxx_messageInfo_SwapFeeDistribution.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SwapFeeDistribution proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*Params)(nil), "terra.market.v1beta1.Params")
+	proto.RegisterType((*SwapFeeDistribution)(nil), "terra.market.v1beta1.SwapFeeDistribution")
 }
 
 func init() { proto.RegisterFile("terra/market/v1beta1/market.proto", fileDescriptor_114ea92c5ae3e66f) }
 
 var fileDescriptor_114ea92c5ae3e66f = []byte{
-	// 331 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x91, 0x31, 0x4b, 0xf3, 0x40,
-	0x18, 0xc7, 0x73, 0x7d, 0x5f, 0x4a, 0x0d, 0x0e, 0x12, 0x32, 0x14, 0x85, 0x5c, 0xcd, 0x20, 0x45,
-	0x68, 0x8e, 0xe2, 0xd6, 0x31, 0x74, 0x15, 0x6a, 0xba, 0xb9, 0x84, 0x4b, 0x7a, 0xd4, 0xd0, 0x5c,
-	0x9f, 0x70, 0x77, 0x16, 0x33, 0xf9, 0x15, 0x1c, 0x1d, 0xfb, 0x6d, 0xec, 0xd8, 0x51, 0x1c, 0x82,
-	0xb4, 0x8b, 0x73, 0x3f, 0x81, 0xe4, 0x7a, 0x15, 0x87, 0x2e, 0x4e, 0xf7, 0xdc, 0x8f, 0xdf, 0x3d,
-	0xf7, 0x87, 0xbf, 0x7d, 0xa9, 0x98, 0x10, 0x94, 0x70, 0x2a, 0x66, 0x4c, 0x91, 0x45, 0x3f, 0x61,
-	0x8a, 0xf6, 0xcd, 0x35, 0x28, 0x04, 0x28, 0x70, 0x5c, 0xad, 0x04, 0x86, 0x19, 0xe5, 0xdc, 0x9d,
-	0xc2, 0x14, 0xb4, 0x40, 0xea, 0x69, 0xef, 0xfa, 0x6f, 0x0d, 0xbb, 0x39, 0xa2, 0x82, 0x72, 0xe9,
-	0xc4, 0xf6, 0x49, 0x42, 0x25, 0x8b, 0x0b, 0x80, 0xbc, 0x8d, 0x3a, 0xa8, 0x7b, 0x1a, 0x86, 0xab,
-	0x0a, 0x5b, 0x1f, 0x15, 0xbe, 0x9a, 0x66, 0xea, 0xe1, 0x31, 0x09, 0x52, 0xe0, 0x24, 0x05, 0xc9,
-	0x41, 0x9a, 0xa3, 0x27, 0x27, 0x33, 0xa2, 0xca, 0x82, 0xc9, 0x60, 0xc8, 0xd2, 0x5d, 0x85, 0xcf,
-	0x4a, 0xca, 0xf3, 0x81, 0xff, 0xb3, 0xc8, 0x8f, 0x5a, 0xf5, 0x3c, 0x02, 0xc8, 0x9d, 0x3b, 0xdb,
-	0xad, 0x51, 0x2c, 0x58, 0x0a, 0x0b, 0x26, 0xca, 0xb8, 0x60, 0x22, 0x83, 0x49, 0xbb, 0xd1, 0x41,
-	0xdd, 0xff, 0x21, 0xde, 0x55, 0xf8, 0x62, 0xff, 0xfa, 0x98, 0xe5, 0x47, 0x4e, 0x8d, 0x23, 0x43,
-	0x47, 0x1a, 0x3a, 0xcf, 0xb6, 0xcb, 0xb3, 0x79, 0x2c, 0x15, 0x4d, 0xb2, 0x3c, 0x53, 0x65, 0x2c,
-	0x0b, 0xc1, 0xe8, 0xa4, 0xfd, 0x4f, 0xc7, 0xbf, 0xfd, 0x73, 0x7c, 0x13, 0xe0, 0xd8, 0x4e, 0x3f,
-	0x72, 0x78, 0x36, 0x1f, 0x1f, 0xe8, 0x58, 0xc3, 0x41, 0xeb, 0x75, 0x89, 0xad, 0xaf, 0x25, 0x46,
-	0xe1, 0x70, 0xb5, 0xf1, 0xd0, 0x7a, 0xe3, 0xa1, 0xcf, 0x8d, 0x87, 0x5e, 0xb6, 0x9e, 0xb5, 0xde,
-	0x7a, 0xd6, 0xfb, 0xd6, 0xb3, 0xee, 0xaf, 0x7f, 0x7d, 0xaf, 0xab, 0xe9, 0x71, 0x98, 0xb3, 0x92,
-	0xa4, 0x20, 0x18, 0x79, 0x3a, 0x54, 0xa9, 0x63, 0x24, 0x4d, 0x5d, 0xcb, 0xcd, 0x77, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0xd8, 0x4a, 0x5f, 0x32, 0xe7, 0x01, 0x00, 0x00,
+	// 493 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x93, 0x31, 0x6f, 0x13, 0x31,
+	0x14, 0xc7, 0xe3, 0xa6, 0xaa, 0xca, 0x95, 0x01, 0x39, 0xa9, 0x14, 0x01, 0xba, 0x0b, 0x16, 0x42,
+	0x01, 0xa9, 0x77, 0x2a, 0x6c, 0x1d, 0xa3, 0x88, 0x0d, 0x14, 0x2e, 0x03, 0x12, 0x8b, 0xe5, 0xbb,
+	0x3c, 0x52, 0xab, 0xf1, 0xf9, 0x64, 0x3b, 0x0d, 0x99, 0x90, 0x60, 0x65, 0x60, 0x64, 0xec, 0xc7,
+	0xe9, 0x82, 0xd4, 0x11, 0x31, 0x9c, 0x50, 0xb2, 0x30, 0xe7, 0x13, 0xa0, 0xf3, 0x39, 0x6d, 0x25,
+	0x6e, 0x89, 0x3a, 0xd9, 0xfe, 0xe9, 0xbd, 0xff, 0xfb, 0xeb, 0xbd, 0x67, 0xef, 0x89, 0x01, 0xa5,
+	0x58, 0x24, 0x98, 0x3a, 0x03, 0x13, 0x9d, 0x1f, 0x27, 0x60, 0xd8, 0xb1, 0x7b, 0x86, 0xb9, 0x92,
+	0x46, 0xe2, 0xb6, 0x0d, 0x09, 0x1d, 0x73, 0x21, 0x0f, 0xdb, 0x13, 0x39, 0x91, 0x36, 0x20, 0x2a,
+	0x6f, 0x55, 0x2c, 0xf9, 0xd9, 0xf4, 0xf6, 0x86, 0x4c, 0x31, 0xa1, 0x31, 0xf5, 0xee, 0x25, 0x4c,
+	0x03, 0xcd, 0xa5, 0x9c, 0x76, 0x50, 0x17, 0xf5, 0xee, 0xf7, 0xfb, 0x97, 0x45, 0xd0, 0xf8, 0x5d,
+	0x04, 0xcf, 0x26, 0xdc, 0x9c, 0xce, 0x92, 0x30, 0x95, 0x22, 0x4a, 0xa5, 0x16, 0x52, 0xbb, 0xe3,
+	0x48, 0x8f, 0xcf, 0x22, 0xb3, 0xc8, 0x41, 0x87, 0x03, 0x48, 0xd7, 0x45, 0xf0, 0x60, 0xc1, 0xc4,
+	0xf4, 0x84, 0x5c, 0x0b, 0x91, 0x78, 0xbf, 0xbc, 0x0f, 0xa5, 0x9c, 0xe2, 0x77, 0x5e, 0xbb, 0x44,
+	0x54, 0x41, 0x2a, 0xcf, 0x41, 0x2d, 0x68, 0x0e, 0x8a, 0xcb, 0x71, 0x67, 0xa7, 0x8b, 0x7a, 0xbb,
+	0xfd, 0x60, 0x5d, 0x04, 0x8f, 0xaa, 0xec, 0xba, 0x28, 0x12, 0xe3, 0x12, 0xc7, 0x8e, 0x0e, 0x2d,
+	0xc4, 0x9f, 0xbd, 0xb6, 0xe0, 0x19, 0xd5, 0x86, 0x25, 0x7c, 0xca, 0xcd, 0x82, 0xea, 0x5c, 0x01,
+	0x1b, 0x77, 0x9a, 0xd6, 0xfe, 0x9b, 0xad, 0xed, 0x3b, 0x03, 0x75, 0x9a, 0x24, 0xc6, 0x82, 0x67,
+	0xa3, 0x0d, 0x1d, 0x59, 0x88, 0xbf, 0x22, 0xef, 0x50, 0xcf, 0x59, 0x4e, 0x3f, 0x02, 0xd0, 0x31,
+	0xd7, 0x46, 0xf1, 0x64, 0x66, 0xb8, 0xcc, 0x3a, 0xbb, 0x5d, 0xd4, 0x3b, 0x78, 0xf9, 0x3c, 0xac,
+	0x1b, 0x46, 0x38, 0x9a, 0xb3, 0xfc, 0x35, 0xc0, 0xe0, 0x56, 0x42, 0xff, 0x69, 0xe9, 0x76, 0x5d,
+	0x04, 0x8f, 0x2b, 0x0f, 0xb5, 0xaa, 0x24, 0x6e, 0xe9, 0xff, 0x53, 0x4f, 0xf6, 0x7f, 0x5c, 0x04,
+	0x8d, 0xbf, 0x17, 0x01, 0x22, 0xdf, 0x9a, 0x5e, 0xab, 0x46, 0xbc, 0x6c, 0x94, 0x54, 0x2c, 0x9d,
+	0x02, 0x55, 0x30, 0x67, 0x6a, 0x4c, 0xe7, 0xc0, 0x27, 0xa7, 0xa6, 0x83, 0xee, 0xd6, 0xa8, 0x3a,
+	0x4d, 0x12, 0xe3, 0x0a, 0xc7, 0x96, 0xbe, 0xb7, 0x10, 0x7f, 0x41, 0xde, 0x61, 0x2a, 0x85, 0x98,
+	0x65, 0x65, 0x4b, 0xed, 0x84, 0x9d, 0x85, 0x1d, 0x6b, 0xe1, 0xed, 0xd6, 0x16, 0x5c, 0x9f, 0x6a,
+	0x45, 0x49, 0xdc, 0xba, 0xe6, 0xe5, 0xee, 0x39, 0x13, 0xe0, 0x1d, 0x24, 0x33, 0x95, 0x6d, 0x2a,
+	0x57, 0x5b, 0x32, 0xd8, 0xba, 0x32, 0x76, 0x4b, 0x7e, 0x23, 0x45, 0x62, 0xaf, 0x7c, 0x55, 0x65,
+	0x6e, 0xc6, 0xd1, 0x1f, 0x5c, 0x2e, 0x7d, 0x74, 0xb5, 0xf4, 0xd1, 0x9f, 0xa5, 0x8f, 0xbe, 0xaf,
+	0xfc, 0xc6, 0xd5, 0xca, 0x6f, 0xfc, 0x5a, 0xf9, 0x8d, 0x0f, 0x2f, 0x6e, 0x55, 0xb3, 0x2b, 0x72,
+	0x24, 0x64, 0x06, 0x8b, 0x28, 0x95, 0x0a, 0xa2, 0x4f, 0x9b, 0xff, 0x6d, 0xab, 0x26, 0x7b, 0xf6,
+	0xaf, 0xbe, 0xfa, 0x37, 0x00, 0x12, 0x24, 0xfe, 0x20, 0xfc, 0x03, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -129,6 +188,39 @@ func (this *Params) Equal(that interface{}) bool {
 	if !this.MinStabilitySpread.Equal(that1.MinStabilitySpread) {
 		return false
 	}
+	if !this.SwapFeeDistribution.Equal(&that1.SwapFeeDistribution) {
+		return false
+	}
+	return true
+}
+func (this *SwapFeeDistribution) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SwapFeeDistribution)
+	if !ok {
+		that2, ok := that.(SwapFeeDistribution)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.OracleRewardWeight.Equal(that1.OracleRewardWeight) {
+		return false
+	}
+	if !this.CommunityPoolWeight.Equal(that1.CommunityPoolWeight) {
+		return false
+	}
+	if !this.BurnWeight.Equal(that1.BurnWeight) {
+		return false
+	}
 	return true
 }
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -151,6 +243,16 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.SwapFeeDistribution.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
 	{
 		size := m.MinStabilitySpread.Size()
 		i -= size
@@ -179,6 +281,59 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SwapFeeDistribution) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SwapFeeDistribution) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SwapFeeDistribution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.BurnWeight.Size()
+		i -= size
+		if _, err := m.BurnWeight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.CommunityPoolWeight.Size()
+		i -= size
+		if _, err := m.CommunityPoolWeight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.OracleRewardWeight.Size()
+		i -= size
+		if _, err := m.OracleRewardWeight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintMarket(dAtA []byte, offset int, v uint64) int {
 	offset -= sovMarket(v)
 	base := offset
@@ -203,6 +358,23 @@ func (m *Params) Size() (n int) {
 	}
 	l = m.MinStabilitySpread.Size()
 	n += 1 + l + sovMarket(uint64(l))
+	l = m.SwapFeeDistribution.Size()
+	n += 1 + l + sovMarket(uint64(l))
+	return n
+}
+
+func (m *SwapFeeDistribution) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.OracleRewardWeight.Size()
+	n += 1 + l + sovMarket(uint64(l))
+	l = m.CommunityPoolWeight.Size()
+	n += 1 + l + sovMarket(uint64(l))
+	l = m.BurnWeight.Size()
+	n += 1 + l + sovMarket(uint64(l))
 	return n
 }
 
@@ -326,6 +498,188 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapFeeDistribution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SwapFeeDistribution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SwapFeeDistribution) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SwapFeeDistribution: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SwapFeeDistribution: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleRewardWeight", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OracleRewardWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPoolWeight", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CommunityPoolWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnWeight", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BurnWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMarket(dAtA[iNdEx:])
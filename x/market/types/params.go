@@ -19,6 +19,8 @@ var (
 	KeyPoolRecoveryPeriod = []byte("PoolRecoveryPeriod")
 	// Min spread
 	KeyMinStabilitySpread = []byte("MinStabilitySpread")
+	// Swap fee distribution among oracle reward pool, community pool and burn
+	KeySwapFeeDistribution = []byte("SwapFeeDistribution")
 )
 
 // Default parameter values
@@ -26,6 +28,12 @@ var (
 	DefaultBasePool           = sdk.NewDec(1000000 * core.MicroUnit) // 1000,000sdr = 1000,000,000,000usdr
 	DefaultPoolRecoveryPeriod = core.BlocksPerDay                    // 14,400
 	DefaultMinStabilitySpread = sdk.NewDecWithPrec(2, 2)             // 2%
+	// all swap fees go to the oracle reward pool
+	DefaultSwapFeeDistribution = SwapFeeDistribution{
+		OracleRewardWeight:  sdk.OneDec(),
+		CommunityPoolWeight: sdk.ZeroDec(),
+		BurnWeight:          sdk.ZeroDec(),
+	}
 )
 
 var _ paramstypes.ParamSet = &Params{}
@@ -33,9 +41,10 @@ var _ paramstypes.ParamSet = &Params{}
 // DefaultParams creates default market module parameters
 func DefaultParams() Params {
 	return Params{
-		BasePool:           DefaultBasePool,
-		PoolRecoveryPeriod: DefaultPoolRecoveryPeriod,
-		MinStabilitySpread: DefaultMinStabilitySpread,
+		BasePool:            DefaultBasePool,
+		PoolRecoveryPeriod:  DefaultPoolRecoveryPeriod,
+		MinStabilitySpread:  DefaultMinStabilitySpread,
+		SwapFeeDistribution: DefaultSwapFeeDistribution,
 	}
 }
 
@@ -57,6 +66,7 @@ func (p *Params) ParamSetPairs() paramstypes.ParamSetPairs {
 		paramstypes.NewParamSetPair(KeyBasePool, &p.BasePool, validateBasePool),
 		paramstypes.NewParamSetPair(KeyPoolRecoveryPeriod, &p.PoolRecoveryPeriod, validatePoolRecoveryPeriod),
 		paramstypes.NewParamSetPair(KeyMinStabilitySpread, &p.MinStabilitySpread, validateMinStabilitySpread),
+		paramstypes.NewParamSetPair(KeySwapFeeDistribution, &p.SwapFeeDistribution, validateSwapFeeDistribution),
 	}
 }
 
@@ -71,6 +81,9 @@ func (p Params) Validate() error {
 	if p.MinStabilitySpread.IsNegative() || p.MinStabilitySpread.GT(sdk.OneDec()) {
 		return fmt.Errorf("market minimum stability spead should be a value between [0,1], is %s", p.MinStabilitySpread)
 	}
+	if err := p.SwapFeeDistribution.Validate(); err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -117,3 +130,38 @@ func validateMinStabilitySpread(i interface{}) error {
 
 	return nil
 }
+
+func validateSwapFeeDistribution(i interface{}) error {
+	v, ok := i.(SwapFeeDistribution)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return v.Validate()
+}
+
+// String implements fmt.Stringer interface
+func (d SwapFeeDistribution) String() string {
+	out, _ := yaml.Marshal(d)
+	return string(out)
+}
+
+// Validate checks every weight is within [0,1] and the weights sum to one
+func (d SwapFeeDistribution) Validate() error {
+	weights := []sdk.Dec{d.OracleRewardWeight, d.CommunityPoolWeight, d.BurnWeight}
+
+	sum := sdk.ZeroDec()
+	for _, w := range weights {
+		if w.IsNil() || w.IsNegative() || w.GT(sdk.OneDec()) {
+			return fmt.Errorf("swap fee distribution weight should be a value between [0,1], is %s", w)
+		}
+
+		sum = sum.Add(w)
+	}
+
+	if !sum.Equal(sdk.OneDec()) {
+		return fmt.Errorf("swap fee distribution weights should sum to one, is %s", sum)
+	}
+
+	return nil
+}
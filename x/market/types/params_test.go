@@ -30,6 +30,21 @@ func TestParamsEqual(t *testing.T) {
 	err = p4.Validate()
 	require.Error(t, err)
 
+	// invalid swap fee distribution
+	p6 := DefaultParams()
+	p6.SwapFeeDistribution.BurnWeight = sdk.NewDecWithPrec(1, 1)
+	err = p6.Validate()
+	require.Error(t, err)
+
+	p6.SwapFeeDistribution.OracleRewardWeight = sdk.NewDecWithPrec(9, 1)
+	err = p6.Validate()
+	require.NoError(t, err)
+
+	p6.SwapFeeDistribution.CommunityPoolWeight = sdk.NewDecWithPrec(-1, 1)
+	p6.SwapFeeDistribution.OracleRewardWeight = sdk.OneDec()
+	err = p6.Validate()
+	require.Error(t, err)
+
 	p5 := DefaultParams()
 	require.NotNil(t, p5.ParamSetPairs())
 	require.NotNil(t, p5.String())
@@ -0,0 +1,18 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	customauthtypes "github.com/terra-money/core/custom/auth/types"
+)
+
+// RegisterTaxableMsgs registers the taxable msgs of the module
+func RegisterTaxableMsgs(registry customauthtypes.TaxableMsgRegistry) {
+	registry.RegisterTaxableMsg(&MsgSwapSend{}, func(msg sdk.Msg) ([]customauthtypes.TaxablePrincipal, error) {
+		msgSwapSend := msg.(*MsgSwapSend)
+		return []customauthtypes.TaxablePrincipal{{
+			Amount:  sdk.NewCoins(msgSwapSend.OfferCoin),
+			Parties: []string{msgSwapSend.FromAddress, msgSwapSend.ToAddress},
+		}}, nil
+	})
+}
@@ -73,6 +73,10 @@ var xxx_messageInfo_MsgSwap proto.InternalMessageInfo
 type MsgSwapResponse struct {
 	SwapCoin types.Coin `protobuf:"bytes,1,opt,name=swap_coin,json=swapCoin,proto3" json:"swap_coin" yaml:"swap_coin"`
 	SwapFee  types.Coin `protobuf:"bytes,2,opt,name=swap_fee,json=swapFee,proto3" json:"swap_fee" yaml:"swap_fee"`
+	// swap fee portions routed to each destination by the swap fee distribution
+	OracleRewardFee  types.Coin `protobuf:"bytes,3,opt,name=oracle_reward_fee,json=oracleRewardFee,proto3" json:"oracle_reward_fee" yaml:"oracle_reward_fee"`
+	CommunityPoolFee types.Coin `protobuf:"bytes,4,opt,name=community_pool_fee,json=communityPoolFee,proto3" json:"community_pool_fee" yaml:"community_pool_fee"`
+	BurnedFee        types.Coin `protobuf:"bytes,5,opt,name=burned_fee,json=burnedFee,proto3" json:"burned_fee" yaml:"burned_fee"`
 }
 
 func (m *MsgSwapResponse) Reset()         { *m = MsgSwapResponse{} }
@@ -122,6 +126,27 @@ func (m *MsgSwapResponse) GetSwapFee() types.Coin {
 	return types.Coin{}
 }
 
+func (m *MsgSwapResponse) GetOracleRewardFee() types.Coin {
+	if m != nil {
+		return m.OracleRewardFee
+	}
+	return types.Coin{}
+}
+
+func (m *MsgSwapResponse) GetCommunityPoolFee() types.Coin {
+	if m != nil {
+		return m.CommunityPoolFee
+	}
+	return types.Coin{}
+}
+
+func (m *MsgSwapResponse) GetBurnedFee() types.Coin {
+	if m != nil {
+		return m.BurnedFee
+	}
+	return types.Coin{}
+}
+
 // MsgSwapSend represents a message to swap coin and send all result coin to recipient
 type MsgSwapSend struct {
 	FromAddress string     `protobuf:"bytes,1,opt,name=from_address,json=fromAddress,proto3" json:"from_address,omitempty" yaml:"from_address"`
@@ -167,6 +192,10 @@ var xxx_messageInfo_MsgSwapSend proto.InternalMessageInfo
 type MsgSwapSendResponse struct {
 	SwapCoin types.Coin `protobuf:"bytes,1,opt,name=swap_coin,json=swapCoin,proto3" json:"swap_coin" yaml:"swap_coin"`
 	SwapFee  types.Coin `protobuf:"bytes,2,opt,name=swap_fee,json=swapFee,proto3" json:"swap_fee" yaml:"swap_fee"`
+	// swap fee portions routed to each destination by the swap fee distribution
+	OracleRewardFee  types.Coin `protobuf:"bytes,3,opt,name=oracle_reward_fee,json=oracleRewardFee,proto3" json:"oracle_reward_fee" yaml:"oracle_reward_fee"`
+	CommunityPoolFee types.Coin `protobuf:"bytes,4,opt,name=community_pool_fee,json=communityPoolFee,proto3" json:"community_pool_fee" yaml:"community_pool_fee"`
+	BurnedFee        types.Coin `protobuf:"bytes,5,opt,name=burned_fee,json=burnedFee,proto3" json:"burned_fee" yaml:"burned_fee"`
 }
 
 func (m *MsgSwapSendResponse) Reset()         { *m = MsgSwapSendResponse{} }
@@ -216,6 +245,27 @@ func (m *MsgSwapSendResponse) GetSwapFee() types.Coin {
 	return types.Coin{}
 }
 
+func (m *MsgSwapSendResponse) GetOracleRewardFee() types.Coin {
+	if m != nil {
+		return m.OracleRewardFee
+	}
+	return types.Coin{}
+}
+
+func (m *MsgSwapSendResponse) GetCommunityPoolFee() types.Coin {
+	if m != nil {
+		return m.CommunityPoolFee
+	}
+	return types.Coin{}
+}
+
+func (m *MsgSwapSendResponse) GetBurnedFee() types.Coin {
+	if m != nil {
+		return m.BurnedFee
+	}
+	return types.Coin{}
+}
+
 func init() {
 	proto.RegisterType((*MsgSwap)(nil), "terra.market.v1beta1.MsgSwap")
 	proto.RegisterType((*MsgSwapResponse)(nil), "terra.market.v1beta1.MsgSwapResponse")
@@ -226,39 +276,45 @@ func init() {
 func init() { proto.RegisterFile("terra/market/v1beta1/tx.proto", fileDescriptor_7dcd4b152743bd0f) }
 
 var fileDescriptor_7dcd4b152743bd0f = []byte{
-	// 510 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x54, 0x3f, 0x6f, 0xd3, 0x4c,
-	0x18, 0xf7, 0x25, 0x55, 0x1b, 0x5f, 0xde, 0x57, 0xa5, 0x6e, 0x50, 0xd3, 0x48, 0xb5, 0xcb, 0x49,
-	0x48, 0x2d, 0x12, 0xb6, 0x52, 0x98, 0xb2, 0x11, 0x2a, 0x26, 0x22, 0x21, 0x67, 0x41, 0x2c, 0xd1,
-	0x25, 0x7e, 0x6c, 0xa2, 0x60, 0x9f, 0x75, 0x77, 0xd0, 0xe6, 0x1b, 0x30, 0xc2, 0x37, 0xe8, 0x17,
-	0x60, 0x61, 0xe0, 0x23, 0xa0, 0x8e, 0x1d, 0x99, 0x2c, 0x94, 0x2c, 0xcc, 0xf9, 0x04, 0xc8, 0x77,
-	0x8e, 0x13, 0x24, 0xd4, 0x88, 0x81, 0x81, 0xed, 0x39, 0xff, 0xfe, 0xdc, 0xef, 0xb9, 0xc7, 0x77,
-	0xf8, 0x48, 0x02, 0xe7, 0xd4, 0x8b, 0x29, 0x9f, 0x80, 0xf4, 0xde, 0xb5, 0x87, 0x20, 0x69, 0xdb,
-	0x93, 0x97, 0x6e, 0xca, 0x99, 0x64, 0x56, 0x43, 0xc1, 0xae, 0x86, 0xdd, 0x02, 0x6e, 0x35, 0x22,
-	0x16, 0x31, 0x45, 0xf0, 0xf2, 0x4a, 0x73, 0x5b, 0xf6, 0x88, 0x89, 0x98, 0x09, 0x6f, 0x48, 0x05,
-	0x94, 0x4e, 0x23, 0x36, 0x4e, 0x34, 0x4e, 0xbe, 0x22, 0xbc, 0xd3, 0x13, 0x51, 0xff, 0x82, 0xa6,
-	0xd6, 0x29, 0xde, 0x96, 0x9c, 0x06, 0xc0, 0x9b, 0xe8, 0x18, 0x9d, 0x98, 0xdd, 0xbd, 0x45, 0xe6,
-	0xfc, 0x3f, 0xa5, 0xf1, 0x9b, 0x0e, 0xd1, 0xdf, 0x89, 0x5f, 0x10, 0xac, 0x3e, 0xc6, 0x2c, 0x0c,
-	0x81, 0x0f, 0x72, 0xab, 0x66, 0xe5, 0x18, 0x9d, 0xd4, 0xcf, 0x0e, 0x5d, 0xbd, 0x97, 0x9b, 0xef,
-	0xb5, 0x8c, 0xe5, 0x3e, 0x65, 0xe3, 0xa4, 0x7b, 0x78, 0x9d, 0x39, 0xc6, 0x22, 0x73, 0xf6, 0xb4,
-	0xdb, 0x4a, 0x4a, 0x7c, 0x53, 0x2d, 0x72, 0x96, 0xd5, 0xc6, 0x26, 0x15, 0x93, 0x41, 0x00, 0x09,
-	0x8b, 0x9b, 0x55, 0x15, 0xa1, 0xb1, 0xc8, 0x9c, 0x3b, 0x5a, 0x54, 0x42, 0xc4, 0xaf, 0x51, 0x31,
-	0x39, 0xcf, 0xcb, 0x4e, 0xed, 0xfd, 0x95, 0x63, 0xfc, 0xb8, 0x72, 0x0c, 0xf2, 0x19, 0xe1, 0xdd,
-	0xa2, 0x11, 0x1f, 0x44, 0xca, 0x12, 0x01, 0xd6, 0x0b, 0x6c, 0x8a, 0x0b, 0x9a, 0xea, 0x90, 0x68,
-	0x53, 0xc8, 0x66, 0x11, 0xb2, 0xd8, 0xaf, 0x54, 0x12, 0xbf, 0x96, 0xd7, 0x2a, 0x62, 0x0f, 0xab,
-	0x7a, 0x10, 0x02, 0x6c, 0xee, 0xfa, 0xa0, 0x30, 0xdc, 0x5d, 0x33, 0x0c, 0x01, 0x88, 0xbf, 0x93,
-	0x97, 0xcf, 0x00, 0xc8, 0xc7, 0x0a, 0xae, 0x17, 0xa1, 0xfb, 0x90, 0x04, 0x56, 0x07, 0xff, 0x17,
-	0x72, 0x16, 0x0f, 0x68, 0x10, 0x70, 0x10, 0xa2, 0x98, 0xc3, 0xc1, 0x22, 0x73, 0xf6, 0xb5, 0xc7,
-	0x3a, 0x4a, 0xfc, 0x7a, 0xbe, 0x7c, 0xa2, 0x57, 0xd6, 0x63, 0x8c, 0x25, 0x2b, 0x95, 0x15, 0xa5,
-	0xbc, 0xbb, 0x3a, 0xf3, 0x15, 0x46, 0x7c, 0x53, 0xb2, 0xa5, 0xea, 0xd7, 0x41, 0x56, 0xff, 0xc2,
-	0x20, 0xb7, 0xfe, 0x70, 0x90, 0x5f, 0x10, 0xde, 0x5f, 0x3b, 0x93, 0x7f, 0x66, 0x98, 0x67, 0x9f,
-	0x10, 0xae, 0xf6, 0x44, 0x64, 0x3d, 0xc7, 0x5b, 0xea, 0x3a, 0x1d, 0xb9, 0xbf, 0xbb, 0xa7, 0x6e,
-	0xd1, 0x5b, 0xeb, 0xfe, 0xad, 0x70, 0xd9, 0xf6, 0x4b, 0x5c, 0x2b, 0x7f, 0x8f, 0x7b, 0xb7, 0x4a,
-	0x72, 0x4a, 0xeb, 0x74, 0x23, 0x65, 0xe9, 0xdc, 0x3d, 0xbf, 0x9e, 0xd9, 0xe8, 0x66, 0x66, 0xa3,
-	0xef, 0x33, 0x1b, 0x7d, 0x98, 0xdb, 0xc6, 0xcd, 0xdc, 0x36, 0xbe, 0xcd, 0x6d, 0xe3, 0xd5, 0x83,
-	0x68, 0x2c, 0x5f, 0xbf, 0x1d, 0xba, 0x23, 0x16, 0x7b, 0xca, 0xee, 0x61, 0xcc, 0x12, 0x98, 0x7a,
-	0x23, 0xc6, 0xc1, 0xbb, 0x5c, 0xbe, 0x4b, 0x72, 0x9a, 0x82, 0x18, 0x6e, 0xab, 0x77, 0xe4, 0xd1,
-	0xcf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x82, 0x1d, 0x9f, 0xd3, 0xb4, 0x04, 0x00, 0x00,
+	// 599 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x55, 0xcd, 0x4e, 0xdb, 0x4c,
+	0x14, 0xb5, 0x31, 0x1f, 0xc4, 0xc3, 0x57, 0x01, 0x86, 0x8a, 0x10, 0x09, 0x1b, 0x46, 0xaa, 0x04,
+	0x95, 0x6a, 0x0b, 0xda, 0x15, 0xbb, 0x52, 0xc4, 0xaa, 0x48, 0x68, 0xb2, 0xa9, 0xba, 0xb1, 0x26,
+	0xf6, 0x8d, 0x9b, 0x12, 0x7b, 0xac, 0x19, 0x53, 0xc8, 0x1b, 0x74, 0xd9, 0xbe, 0x01, 0x2f, 0xd0,
+	0xd7, 0xa8, 0x58, 0xb2, 0xec, 0xa2, 0x8a, 0x10, 0xd9, 0x74, 0x9d, 0x27, 0xa8, 0x3c, 0x33, 0x71,
+	0xd2, 0x1f, 0x11, 0xb1, 0xe8, 0xa6, 0xea, 0xee, 0xce, 0x3d, 0xf7, 0x9c, 0x73, 0xc7, 0x39, 0xb1,
+	0xd1, 0x46, 0x01, 0x9c, 0xd3, 0x20, 0xa5, 0xfc, 0x14, 0x8a, 0xe0, 0xdd, 0x6e, 0x0b, 0x0a, 0xba,
+	0x1b, 0x14, 0x17, 0x7e, 0xce, 0x59, 0xc1, 0x9c, 0x55, 0x09, 0xfb, 0x0a, 0xf6, 0x35, 0xdc, 0x58,
+	0x4d, 0x58, 0xc2, 0xe4, 0x40, 0x50, 0x56, 0x6a, 0xb6, 0xe1, 0x46, 0x4c, 0xa4, 0x4c, 0x04, 0x2d,
+	0x2a, 0xa0, 0x52, 0x8a, 0x58, 0x27, 0x53, 0x38, 0xfe, 0x6c, 0xa2, 0xf9, 0x63, 0x91, 0x34, 0xcf,
+	0x69, 0xee, 0xec, 0xa0, 0xb9, 0x82, 0xd3, 0x18, 0x78, 0xdd, 0xdc, 0x34, 0xb7, 0xed, 0x83, 0xe5,
+	0x61, 0xdf, 0x7b, 0xd0, 0xa3, 0x69, 0x77, 0x1f, 0xab, 0x3e, 0x26, 0x7a, 0xc0, 0x69, 0x22, 0xc4,
+	0xda, 0x6d, 0xe0, 0x61, 0x29, 0x55, 0x9f, 0xd9, 0x34, 0xb7, 0x17, 0xf6, 0xd6, 0x7d, 0xe5, 0xe5,
+	0x97, 0x5e, 0xa3, 0xb5, 0xfc, 0x17, 0xac, 0x93, 0x1d, 0xac, 0x5f, 0xf5, 0x3d, 0x63, 0xd8, 0xf7,
+	0x96, 0x95, 0xda, 0x98, 0x8a, 0x89, 0x2d, 0x0f, 0xe5, 0x94, 0xb3, 0x8b, 0x6c, 0x2a, 0x4e, 0xc3,
+	0x18, 0x32, 0x96, 0xd6, 0x2d, 0xb9, 0xc2, 0xea, 0xb0, 0xef, 0x2d, 0x29, 0x52, 0x05, 0x61, 0x52,
+	0xa3, 0xe2, 0xf4, 0xb0, 0x2c, 0xf7, 0x6b, 0xef, 0x2f, 0x3d, 0xe3, 0xdb, 0xa5, 0x67, 0xe0, 0xaf,
+	0x16, 0x5a, 0xd4, 0x17, 0x21, 0x20, 0x72, 0x96, 0x09, 0x70, 0x4e, 0x90, 0x2d, 0xce, 0x69, 0xae,
+	0x96, 0x34, 0xa7, 0x2d, 0x59, 0xd7, 0x4b, 0x6a, 0xbf, 0x8a, 0x89, 0x49, 0xad, 0xac, 0xe5, 0x8a,
+	0xc7, 0x48, 0xd6, 0x61, 0x1b, 0x60, 0xfa, 0xad, 0xd7, 0xb4, 0xe0, 0xe2, 0x84, 0x60, 0x1b, 0x00,
+	0x93, 0xf9, 0xb2, 0x3c, 0x02, 0x70, 0x12, 0xb4, 0xcc, 0x38, 0x8d, 0xba, 0x10, 0x72, 0x38, 0xa7,
+	0x3c, 0x96, 0xba, 0xd6, 0x34, 0xdd, 0x4d, 0xad, 0x5b, 0xd7, 0x4f, 0xf3, 0x67, 0x05, 0x4c, 0x16,
+	0x55, 0x8f, 0xc8, 0x56, 0x69, 0xf4, 0x16, 0x39, 0x11, 0x4b, 0xd3, 0xb3, 0xac, 0x53, 0xf4, 0xc2,
+	0x9c, 0xb1, 0xae, 0x74, 0x9a, 0x9d, 0xe6, 0xb4, 0xa5, 0x9d, 0xd6, 0x95, 0xd3, 0xaf, 0x12, 0x98,
+	0x2c, 0x55, 0xcd, 0x13, 0xc6, 0xba, 0xa5, 0x57, 0x13, 0xa1, 0xd6, 0x19, 0xcf, 0x40, 0xdd, 0xe6,
+	0xbf, 0x7b, 0x66, 0x63, 0x4c, 0xc5, 0xc4, 0x56, 0x87, 0x23, 0x00, 0xfc, 0x71, 0x06, 0x2d, 0xe8,
+	0x9f, 0xb7, 0x09, 0x59, 0xec, 0xec, 0xa3, 0xff, 0xdb, 0x9c, 0xa5, 0x21, 0x8d, 0x63, 0x0e, 0x42,
+	0xe8, 0xc4, 0xae, 0x0d, 0xfb, 0xde, 0x8a, 0xd2, 0x99, 0x44, 0x31, 0x59, 0x28, 0x8f, 0xcf, 0xd5,
+	0xc9, 0x79, 0x86, 0x50, 0xc1, 0x2a, 0xe6, 0x8c, 0x64, 0x3e, 0x1c, 0x6f, 0x30, 0xc6, 0x30, 0xb1,
+	0x0b, 0x36, 0x62, 0xfd, 0x18, 0x79, 0xeb, 0x0f, 0x44, 0x7e, 0xf6, 0x9e, 0x91, 0xbf, 0xb1, 0xd0,
+	0xca, 0xc4, 0x33, 0xf9, 0x17, 0xfb, 0xbf, 0x2e, 0xf6, 0x7b, 0x9f, 0x4c, 0x64, 0x1d, 0x8b, 0xc4,
+	0x79, 0x89, 0x66, 0xe5, 0x2b, 0x7a, 0xc3, 0xff, 0xdd, 0xbb, 0xdf, 0xd7, 0x29, 0x68, 0x3c, 0xba,
+	0x13, 0xae, 0x02, 0xf2, 0x0a, 0xd5, 0xaa, 0x3f, 0xd2, 0xd6, 0x9d, 0x94, 0x72, 0xa4, 0xb1, 0x33,
+	0x75, 0x64, 0xa4, 0x7c, 0x70, 0x78, 0x75, 0xeb, 0x9a, 0xd7, 0xb7, 0xae, 0x79, 0x73, 0xeb, 0x9a,
+	0x1f, 0x06, 0xae, 0x71, 0x3d, 0x70, 0x8d, 0x2f, 0x03, 0xd7, 0x78, 0xfd, 0x38, 0xe9, 0x14, 0x6f,
+	0xce, 0x5a, 0x7e, 0xc4, 0xd2, 0x40, 0xca, 0x3d, 0x49, 0x59, 0x06, 0xbd, 0x20, 0x62, 0x1c, 0x82,
+	0x8b, 0xd1, 0xb7, 0xae, 0xe8, 0xe5, 0x20, 0x5a, 0x73, 0xf2, 0xdb, 0xf4, 0xf4, 0xfb, 0x00, 0x92,
+	0x15, 0x2f, 0x25, 0x08, 0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -452,6 +508,36 @@ func (m *MsgSwapResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.BurnedFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.CommunityPoolFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.OracleRewardFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
 	{
 		size, err := m.SwapFee.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -549,6 +635,36 @@ func (m *MsgSwapSendResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.BurnedFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.CommunityPoolFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.OracleRewardFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
 	{
 		size, err := m.SwapFee.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -612,6 +728,12 @@ func (m *MsgSwapResponse) Size() (n int) {
 	n += 1 + l + sovTx(uint64(l))
 	l = m.SwapFee.Size()
 	n += 1 + l + sovTx(uint64(l))
+	l = m.OracleRewardFee.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.CommunityPoolFee.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.BurnedFee.Size()
+	n += 1 + l + sovTx(uint64(l))
 	return n
 }
 
@@ -648,6 +770,12 @@ func (m *MsgSwapSendResponse) Size() (n int) {
 	n += 1 + l + sovTx(uint64(l))
 	l = m.SwapFee.Size()
 	n += 1 + l + sovTx(uint64(l))
+	l = m.OracleRewardFee.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.CommunityPoolFee.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.BurnedFee.Size()
+	n += 1 + l + sovTx(uint64(l))
 	return n
 }
 
@@ -899,6 +1027,105 @@ func (m *MsgSwapResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleRewardFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OracleRewardFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPoolFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CommunityPoolFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnedFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BurnedFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1194,6 +1421,105 @@ func (m *MsgSwapSendResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleRewardFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OracleRewardFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPoolFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CommunityPoolFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnedFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BurnedFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -6,11 +6,19 @@ import (
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
-	core "github.com/terra-money/core/types"
 	"github.com/terra-money/core/x/treasury/keeper"
 	"github.com/terra-money/core/x/treasury/types"
 )
 
+// BeginBlocker is called at the beginning of every block
+func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyBeginBlocker)
+
+	// Burn and send to community pool the configured portions of
+	// the last block tax proceeds, before distribution allocates fees
+	k.SettleTaxProceeds(ctx)
+}
+
 // EndBlocker is called at the end of every block
 func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
@@ -18,8 +26,11 @@ func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
 	// Burn all coins from the burn module account
 	k.BurnCoinsFromBurnAccount(ctx)
 
+	// Remap the stored epoch indices if the epoch length has changed
+	k.ApplyEpochLength(ctx)
+
 	// Check epoch last block
-	if !core.IsPeriodLastBlock(ctx, core.BlocksPerWeek) {
+	if !k.IsEpochLastBlock(ctx) {
 		return
 	}
 
@@ -29,13 +40,16 @@ func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
 	// Compute & Update internal indicators for the current epoch
 	k.UpdateIndicators(ctx)
 
+	// Prune indicators of the epochs out of the retention window
+	k.PruneEpochStates(ctx)
+
 	// Check probation period
-	if ctx.BlockHeight() < int64(core.BlocksPerWeek*k.WindowProbation(ctx)) {
+	if k.GetEpoch(ctx) < int64(k.WindowProbation(ctx)) {
 		return
 	}
 
 	// Settle seigniorage to oracle & distribution(community-pool) module-account
-	k.SettleSeigniorage(ctx)
+	seigniorage, _ := k.SettleSeigniorage(ctx)
 
 	// Update tax-rate and reward-weight of next epoch
 	taxRate := k.UpdateTaxPolicy(ctx)
@@ -50,4 +64,6 @@ func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
 		),
 	)
 
+	// Notify the subscribers of the new policy
+	k.AfterEpochEnd(ctx, k.GetEpoch(ctx), taxRate, rewardWeight, taxCap, seigniorage)
 }
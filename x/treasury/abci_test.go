@@ -5,6 +5,9 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+
 	core "github.com/terra-money/core/types"
 	"github.com/terra-money/core/x/treasury/keeper"
 	"github.com/terra-money/core/x/treasury/types"
@@ -95,3 +98,175 @@ func TestEmptyIndicator(t *testing.T) {
 	newRewardWeight := input.TreasuryKeeper.GetRewardWeight(input.Ctx)
 	require.Equal(t, rewardWeight.Add(input.TreasuryKeeper.RewardPolicy(input.Ctx).ChangeRateMax), newRewardWeight)
 }
+
+func TestEndBlockerEpochLengthTransition(t *testing.T) {
+	input := keeper.CreateTestInput(t)
+
+	windowProbation := input.TreasuryKeeper.WindowProbation(input.Ctx)
+
+	// run weekly epochs until the first policy update
+	targetEpoch := int64(windowProbation + 1)
+	for epoch := int64(1); epoch <= targetEpoch; epoch++ {
+		input.Ctx = input.Ctx.WithBlockHeight(int64(core.BlocksPerWeek)*epoch - 1)
+		EndBlocker(input.Ctx, input.TreasuryKeeper)
+	}
+
+	lastTaxRate := input.TreasuryKeeper.GetHistoricalTaxRate(input.Ctx, targetEpoch-1)
+	taxRate := input.TreasuryKeeper.GetTaxRate(input.Ctx)
+	require.False(t, lastTaxRate.IsZero())
+
+	// switch to daily epochs in the middle of the week
+	params := input.TreasuryKeeper.GetParams(input.Ctx)
+	params.EpochLength = core.BlocksPerDay
+	input.TreasuryKeeper.SetParams(input.Ctx, params)
+
+	transitionHeight := int64(core.BlocksPerWeek)*targetEpoch + int64(3*core.BlocksPerDay) + 10
+	input.Ctx = input.Ctx.WithBlockHeight(transitionHeight)
+	EndBlocker(input.Ctx, input.TreasuryKeeper)
+
+	// the days of the last week carry its records
+	newEpoch := transitionHeight / int64(core.BlocksPerDay)
+	require.Equal(t, newEpoch, input.TreasuryKeeper.GetEpoch(input.Ctx))
+	for day := int64(1); day <= 7; day++ {
+		require.Equal(t, lastTaxRate, input.TreasuryKeeper.GetHistoricalTaxRate(input.Ctx, newEpoch-3-day))
+	}
+	require.Equal(t, sdk.ZeroDec(), input.TreasuryKeeper.GetHistoricalTaxRate(input.Ctx, newEpoch-1))
+	require.Equal(t, taxRate, input.TreasuryKeeper.GetTaxRate(input.Ctx))
+
+	// the epoch in progress ends at the next daily boundary, covering the days since the week started
+	input.Ctx = input.Ctx.WithBlockHeight((newEpoch+1)*int64(core.BlocksPerDay) - 1)
+	EndBlocker(input.Ctx, input.TreasuryKeeper)
+
+	for day := newEpoch - 3; day <= newEpoch; day++ {
+		require.Equal(t, taxRate, input.TreasuryKeeper.GetHistoricalTaxRate(input.Ctx, day))
+	}
+	_, found := input.TreasuryKeeper.GetEpochStartHeight(input.Ctx)
+	require.False(t, found)
+	require.Equal(t, taxRate.Add(input.TreasuryKeeper.TaxPolicy(input.Ctx).ChangeRateMax), input.TreasuryKeeper.GetTaxRate(input.Ctx))
+}
+
+func TestEndBlockerEpochLengthTransitionSteadyActivity(t *testing.T) {
+	input := keeper.CreateTestInput(t)
+
+	// the tax rewards are 4 times the seigniorage rewards
+	const taxPerBlock = 100
+	const burnPerBlock = 250
+
+	params := input.TreasuryKeeper.GetParams(input.Ctx)
+	params.MiningIncrement = sdk.OneDec()
+	params.SeigniorageBurdenTarget = sdk.NewDecWithPrec(2, 1)
+	input.TreasuryKeeper.SetParams(input.Ctx, params)
+	input.TreasuryKeeper.SetRewardWeight(input.Ctx, sdk.NewDecWithPrec(1, 1))
+	input.OracleKeeper.SetLunaExchangeRate(input.Ctx, core.MicroSDRDenom, sdk.OneDec())
+
+	sh := staking.NewHandler(input.StakingKeeper)
+	_, err := sh(input.Ctx, keeper.NewTestMsgCreateValidator(keeper.ValAddrs[0], keeper.ValPubKeys[0], sdk.TokensFromConsensusPower(100, sdk.DefaultPowerReduction)))
+	require.NoError(t, err)
+	staking.EndBlocker(input.Ctx, input.StakingKeeper)
+
+	// the luna to be burned is minted upfront
+	burnCoins := func(blocks int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewInt64Coin(core.MicroLunaDenom, burnPerBlock*blocks))
+	}
+	require.NoError(t, input.BankKeeper.MintCoins(input.Ctx, types.ModuleName, burnCoins(int64(core.BlocksPerYear))))
+	input.TreasuryKeeper.BurnCoinsFromBurnAccount(input.Ctx)
+	input.TreasuryKeeper.RecordEpochInitialIssuance(input.Ctx)
+
+	// every block pays the same taxes and burns the same amount
+	lastHeight := int64(-1)
+	endBlock := func(height int64) {
+		blocks := height - lastHeight
+		input.TreasuryKeeper.RecordEpochTaxProceeds(input.Ctx, sdk.NewCoins(sdk.NewInt64Coin(core.MicroSDRDenom, taxPerBlock*blocks)))
+		require.NoError(t, input.BankKeeper.SendCoinsFromModuleToModule(input.Ctx, types.ModuleName, types.BurnModuleName, burnCoins(blocks)))
+
+		input.Ctx = input.Ctx.WithBlockHeight(height)
+		EndBlocker(input.Ctx, input.TreasuryKeeper)
+		lastHeight = height
+	}
+
+	taxRate := input.TreasuryKeeper.GetTaxRate(input.Ctx)
+	rewardWeight := input.TreasuryKeeper.GetRewardWeight(input.Ctx)
+
+	// weekly epochs beyond the probation period
+	numWeeks := int64(input.TreasuryKeeper.WindowProbation(input.Ctx) + 4)
+	for epoch := int64(0); epoch < numWeeks; epoch++ {
+		endBlock(int64(core.BlocksPerWeek)*(epoch+1) - 1)
+		require.Equal(t, taxRate, input.TreasuryKeeper.GetTaxRate(input.Ctx))
+		require.Equal(t, rewardWeight, input.TreasuryKeeper.GetRewardWeight(input.Ctx))
+	}
+
+	// switch to daily epochs in the middle of the week, along with the windows in days
+	params = input.TreasuryKeeper.GetParams(input.Ctx)
+	params.EpochLength = core.BlocksPerDay
+	params.WindowShort *= 7
+	params.WindowLong *= 7
+	params.WindowProbation *= 7
+	params.IndicatorRetentionMargin *= 7
+	input.TreasuryKeeper.SetParams(input.Ctx, params)
+
+	transitionHeight := int64(core.BlocksPerWeek)*numWeeks + int64(3*core.BlocksPerDay) + 10
+	endBlock(transitionHeight)
+	require.Equal(t, params, input.TreasuryKeeper.GetParams(input.Ctx))
+
+	// the policy stays the same over the daily epochs
+	firstDay := transitionHeight / int64(core.BlocksPerDay)
+	for epoch := firstDay; epoch < firstDay+int64(params.WindowShort)+7; epoch++ {
+		endBlock(int64(core.BlocksPerDay)*(epoch+1) - 1)
+		require.Equal(t, epoch, input.TreasuryKeeper.GetEpoch(input.Ctx))
+		require.Equal(t, taxRate, input.TreasuryKeeper.GetTaxRate(input.Ctx))
+		require.Equal(t, rewardWeight, input.TreasuryKeeper.GetRewardWeight(input.Ctx))
+	}
+
+	for epoch := int64(0); epoch <= firstDay; epoch++ {
+		require.Equal(t, sdk.NewDec(taxPerBlock*int64(core.BlocksPerDay)), input.TreasuryKeeper.GetTR(input.Ctx, epoch))
+	}
+}
+
+type mockTreasuryHooks struct {
+	epochs       []int64
+	taxRate      sdk.Dec
+	rewardWeight sdk.Dec
+	taxCaps      sdk.Coins
+	seigniorage  sdk.Int
+}
+
+func (h *mockTreasuryHooks) AfterEpochEnd(ctx sdk.Context, epoch int64, taxRate sdk.Dec, rewardWeight sdk.Dec, taxCaps sdk.Coins, seigniorage sdk.Int) {
+	h.epochs = append(h.epochs, epoch)
+	h.taxRate = taxRate
+	h.rewardWeight = rewardWeight
+	h.taxCaps = taxCaps
+	h.seigniorage = seigniorage
+}
+
+func TestEndBlockerAfterEpochEndHook(t *testing.T) {
+	input := keeper.CreateTestInput(t)
+
+	hooks := &mockTreasuryHooks{}
+	input.TreasuryKeeper.SetHooks(types.NewMultiTreasuryHooks(hooks))
+
+	windowProbation := input.TreasuryKeeper.WindowProbation(input.Ctx)
+
+	// no hook call during the probation period
+	targetEpoch := int64(windowProbation + 1)
+	for epoch := int64(1); epoch < targetEpoch; epoch++ {
+		input.Ctx = input.Ctx.WithBlockHeight(int64(core.BlocksPerWeek)*epoch - 1)
+		EndBlocker(input.Ctx, input.TreasuryKeeper)
+	}
+	require.Empty(t, hooks.epochs)
+
+	// no hook call in the middle of the epoch
+	input.Ctx = input.Ctx.WithBlockHeight(int64(core.BlocksPerWeek) * (targetEpoch - 1))
+	EndBlocker(input.Ctx, input.TreasuryKeeper)
+	require.Empty(t, hooks.epochs)
+
+	input.Ctx = input.Ctx.WithBlockHeight(int64(core.BlocksPerWeek)*targetEpoch - 1)
+	EndBlocker(input.Ctx, input.TreasuryKeeper)
+
+	require.Equal(t, []int64{targetEpoch - 1}, hooks.epochs)
+	require.Equal(t, input.TreasuryKeeper.GetTaxRate(input.Ctx), hooks.taxRate)
+	require.Equal(t, input.TreasuryKeeper.GetRewardWeight(input.Ctx), hooks.rewardWeight)
+	require.True(t, hooks.seigniorage.IsZero())
+	for _, taxCap := range hooks.taxCaps {
+		require.Equal(t, input.TreasuryKeeper.GetTaxCap(input.Ctx, taxCap.Denom), taxCap.Amount)
+	}
+}
@@ -2,6 +2,7 @@ package cli
 
 import (
 	"context"
+	"strconv"
 	"strings"
 
 	"github.com/terra-money/core/x/treasury/types"
@@ -30,10 +31,15 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryTaxRate(),
 		GetCmdQueryTaxCap(),
 		GetCmdQueryTaxCaps(),
+		GetCmdQueryTaxExemptions(),
 		GetCmdQueryRewardWeight(),
 		GetCmdQueryTaxProceeds(),
 		GetCmdQuerySeigniorageProceeds(),
 		GetCmdQueryIndicators(),
+		GetCmdQueryEpochStates(),
+		GetCmdQueryNextPolicy(),
+		GetCmdQueryBurned(),
+		GetCmdQueryMinGasPrices(),
 		GetCmdQueryParams(),
 	)
 
@@ -44,13 +50,17 @@ func GetQueryCmd() *cobra.Command {
 // GetCmdQueryTaxRate implements the query tax-rate command.
 func GetCmdQueryTaxRate() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "tax-rate",
-		Args:  cobra.NoArgs,
+		Use:   "tax-rate [denom]",
+		Args:  cobra.RangeArgs(0, 1),
 		Short: "Query the stability tax rate",
 		Long: strings.TrimSpace(`
 Query the stability tax rate of the current epoch.
 
 $ terrad query treasury tax-rate
+
+Or, query the stability tax rate applied to a denom asset, which may override the global rate.
+
+$ terrad query treasury tax-rate ukrw
 `),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientQueryContext(cmd)
@@ -59,7 +69,12 @@ $ terrad query treasury tax-rate
 			}
 			queryClient := types.NewQueryClient(clientCtx)
 
-			res, err := queryClient.TaxRate(context.Background(), &types.QueryTaxRateRequest{})
+			req := &types.QueryTaxRateRequest{}
+			if len(args) == 1 {
+				req.Denom = args[0]
+			}
+
+			res, err := queryClient.TaxRate(context.Background(), req)
 			if err != nil {
 				return err
 			}
@@ -139,6 +154,38 @@ $ terrad query treasury tax-caps
 	return cmd
 }
 
+// GetCmdQueryTaxExemptions implements the query tax-exemptions command.
+func GetCmdQueryTaxExemptions() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tax-exemptions",
+		Args:  cobra.NoArgs,
+		Short: "Query the stability tax exemption list",
+		Long: strings.TrimSpace(`
+Query all tax exempt addresses with their tax exemption zones.
+Transfers between addresses of the same zone are not charged stability tax.
+
+$ terrad query treasury tax-exemptions
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.TaxExemptions(context.Background(), &types.QueryTaxExemptionsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 // GetCmdQueryRewardWeight implements the query reward-weight command.
 func GetCmdQueryRewardWeight() *cobra.Command {
 	cmd := &cobra.Command{
@@ -257,6 +304,150 @@ func GetCmdQueryIndicators() *cobra.Command {
 	return cmd
 }
 
+// GetCmdQueryEpochStates implements the query epoch-states command.
+func GetCmdQueryEpochStates() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "epoch-states [start-epoch] [end-epoch]",
+		Args:  cobra.RangeArgs(0, 2),
+		Short: "Query the recorded Treasury indicators, tax rate and reward weight of the epochs",
+		Long: strings.TrimSpace(`
+Query the recorded tax rewards, seigniorage rewards, total staked luna, tax rate and reward weight
+of each epoch in range. Epochs older than the retention window have been pruned.
+The end epoch defaults to the current epoch.
+
+$ terrad query treasury epoch-states 10 20
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryEpochStatesRequest{}
+			if len(args) > 0 {
+				if req.StartEpoch, err = strconv.ParseUint(args[0], 10, 64); err != nil {
+					return err
+				}
+			}
+
+			if len(args) > 1 {
+				if req.EndEpoch, err = strconv.ParseUint(args[1], 10, 64); err != nil {
+					return err
+				}
+			}
+
+			if req.Pagination, err = client.ReadPageRequest(cmd.Flags()); err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.EpochStates(context.Background(), req)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "epoch states")
+	return cmd
+}
+
+// GetCmdQueryNextPolicy implements the query next policy command.
+func GetCmdQueryNextPolicy() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "next-policy",
+		Args:  cobra.NoArgs,
+		Short: "Query the policy projected for the next epoch",
+		Long: strings.TrimSpace(`
+Query the tax rate, reward weight, tax caps and seigniorage settlement the treasury
+would apply if the current epoch ended now, along with the blocks left in the epoch.
+
+$ terrad query treasury next-policy
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.NextPolicy(context.Background(), &types.QueryNextPolicyRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryBurned implements the query burned command.
+func GetCmdQueryBurned() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "burned",
+		Args:  cobra.NoArgs,
+		Short: "Query the amounts burned from the burn module account",
+		Long: strings.TrimSpace(`
+Query the cumulative amount burned from the burn module account, and the amount burned during the current epoch.
+
+$ terrad query treasury burned
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.Burned(context.Background(), &types.QueryBurnedRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryMinGasPrices implements the query min gas prices command.
+func GetCmdQueryMinGasPrices() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "min-gas-prices",
+		Args:  cobra.NoArgs,
+		Short: "Query the global minimum gas prices",
+		Long: strings.TrimSpace(`
+Query the global minimum gas prices, enforced by consensus on top of the local minimum gas prices of each node.
+
+$ terrad query treasury min-gas-prices
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.MinGasPrices(context.Background(), &types.QueryMinGasPricesRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 // GetCmdQueryParams implements the query params command.
 func GetCmdQueryParams() *cobra.Command {
 	cmd := &cobra.Command{
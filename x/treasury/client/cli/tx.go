@@ -0,0 +1,126 @@
+package cli
+
+import (
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/terra-money/core/x/treasury/types"
+)
+
+// GetCmdSubmitAddTaxExemptionProposal implements the command to submit an add-tax-exemption proposal
+func GetCmdSubmitAddTaxExemptionProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-tax-exemption [zone] [address1] [address2] ...",
+		Args:  cobra.MinimumNArgs(2),
+		Short: "Submit a proposal to add addresses to a tax exemption zone",
+		Long: strings.TrimSpace(`
+Submit a proposal to add addresses to a tax exemption zone along with an initial deposit.
+Transfers between addresses of the same zone are not charged stability tax.
+
+$ terrad tx gov submit-proposal add-tax-exemption exchange terra1... terra1... --title="..." --description="..." --deposit="1000000uluna" --from=mykey
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			title, description, deposit, err := parseProposalFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewAddTaxExemptionProposal(title, description, args[0], args[1:])
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	addProposalFlags(cmd)
+	return cmd
+}
+
+// GetCmdSubmitRemoveTaxExemptionProposal implements the command to submit a remove-tax-exemption proposal
+func GetCmdSubmitRemoveTaxExemptionProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove-tax-exemption [address1] [address2] ...",
+		Args:  cobra.MinimumNArgs(1),
+		Short: "Submit a proposal to remove addresses from their tax exemption zones",
+		Long: strings.TrimSpace(`
+Submit a proposal to remove addresses from their tax exemption zones along with an initial deposit.
+
+$ terrad tx gov submit-proposal remove-tax-exemption terra1... terra1... --title="..." --description="..." --deposit="1000000uluna" --from=mykey
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			title, description, deposit, err := parseProposalFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewRemoveTaxExemptionProposal(title, description, args)
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	addProposalFlags(cmd)
+	return cmd
+}
+
+func addProposalFlags(cmd *cobra.Command) {
+	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+	cmd.MarkFlagRequired(govcli.FlagTitle)
+	cmd.MarkFlagRequired(govcli.FlagDescription)
+}
+
+func parseProposalFlags(cmd *cobra.Command) (title, description string, deposit sdk.Coins, err error) {
+	title, err = cmd.Flags().GetString(govcli.FlagTitle)
+	if err != nil {
+		return
+	}
+
+	description, err = cmd.Flags().GetString(govcli.FlagDescription)
+	if err != nil {
+		return
+	}
+
+	depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+	if err != nil {
+		return
+	}
+
+	deposit, err = sdk.ParseCoinsNormalized(depositStr)
+	return
+}
@@ -0,0 +1,14 @@
+package client
+
+import (
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+
+	"github.com/terra-money/core/x/treasury/client/cli"
+	"github.com/terra-money/core/x/treasury/client/rest"
+)
+
+// Tax exemption proposal handlers.
+var (
+	AddTaxExemptionProposalHandler    = govclient.NewProposalHandler(cli.GetCmdSubmitAddTaxExemptionProposal, rest.AddTaxExemptionProposalRESTHandler)
+	RemoveTaxExemptionProposalHandler = govclient.NewProposalHandler(cli.GetCmdSubmitRemoveTaxExemptionProposal, rest.RemoveTaxExemptionProposalRESTHandler)
+)
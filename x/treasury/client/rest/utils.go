@@ -27,4 +27,27 @@ type (
 		Proposer     sdk.AccAddress `json:"proposer" yaml:"proposer"`
 		Deposit      sdk.Coins      `json:"deposit" yaml:"deposit"`
 	}
+
+	// AddTaxExemptionProposalReq defines an add-tax-exemption proposal request body.
+	AddTaxExemptionProposalReq struct {
+		BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+		Title       string         `json:"title" yaml:"title"`
+		Description string         `json:"description" yaml:"description"`
+		Zone        string         `json:"zone" yaml:"zone"`
+		Addresses   []string       `json:"addresses" yaml:"addresses"`
+		Proposer    sdk.AccAddress `json:"proposer" yaml:"proposer"`
+		Deposit     sdk.Coins      `json:"deposit" yaml:"deposit"`
+	}
+
+	// RemoveTaxExemptionProposalReq defines a remove-tax-exemption proposal request body.
+	RemoveTaxExemptionProposalReq struct {
+		BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+		Title       string         `json:"title" yaml:"title"`
+		Description string         `json:"description" yaml:"description"`
+		Addresses   []string       `json:"addresses" yaml:"addresses"`
+		Proposer    sdk.AccAddress `json:"proposer" yaml:"proposer"`
+		Deposit     sdk.Coins      `json:"deposit" yaml:"deposit"`
+	}
 )
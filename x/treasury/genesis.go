@@ -5,7 +5,6 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
-	core "github.com/terra-money/core/types"
 	"github.com/terra-money/core/x/treasury/keeper"
 	"github.com/terra-money/core/x/treasury/types"
 )
@@ -15,9 +14,17 @@ import (
 func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, data *types.GenesisState) {
 	keeper.SetParams(ctx, data.Params)
 
+	// exported epoch indices are based on the exported epoch length
+	keeper.SetAppliedEpochLength(ctx, data.Params.EpochLength)
+
 	keeper.SetTaxRate(ctx, data.TaxRate)
 	keeper.SetRewardWeight(ctx, data.RewardWeight)
 	keeper.SetEpochTaxProceeds(ctx, data.TaxProceeds)
+	keeper.SetEpochBurnedTaxProceeds(ctx, data.BurnedTaxProceeds)
+	keeper.SetEpochCommunityPoolTaxProceeds(ctx, data.CommunityPoolTaxProceeds)
+	keeper.SetBurned(ctx, data.Burned)
+	keeper.SetPendingTaxBurn(ctx, data.PendingTaxBurn)
+	keeper.SetPendingTaxCommunityPool(ctx, data.PendingTaxCommunityPool)
 
 	// If EpochInitialIssuance is empty, we use current supply as epoch initial issuance
 	if data.EpochInitialIssuance.IsZero() {
@@ -35,6 +42,37 @@ func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, data *types.GenesisState
 		keeper.SetTR(ctx, int64(epochState.Epoch), epochState.TaxReward)
 		keeper.SetSR(ctx, int64(epochState.Epoch), epochState.SeigniorageReward)
 		keeper.SetTSL(ctx, int64(epochState.Epoch), epochState.TotalStakedLuna)
+
+		// epoch states exported before the policy levers were recorded have none
+		if !epochState.TaxRate.IsNil() {
+			keeper.SetHistoricalTaxRate(ctx, int64(epochState.Epoch), epochState.TaxRate)
+		}
+
+		if !epochState.RewardWeight.IsNil() {
+			keeper.SetHistoricalRewardWeight(ctx, int64(epochState.Epoch), epochState.RewardWeight)
+		}
+
+		if !epochState.Burned.Empty() {
+			keeper.SetEpochBurned(ctx, int64(epochState.Epoch), epochState.Burned)
+		}
+	}
+
+	// the current epoch is exported apart from the finished epochs
+	if !data.EpochBurned.Empty() {
+		keeper.SetEpochBurned(ctx, keeper.GetEpoch(ctx), data.EpochBurned)
+	}
+
+	for _, exemption := range data.TaxExemptions {
+		addr, err := sdk.AccAddressFromBech32(exemption.Address)
+		if err != nil {
+			panic(err)
+		}
+
+		keeper.SetTaxExemptionZone(ctx, addr, exemption.Zone)
+	}
+
+	for _, denomTaxRate := range data.DenomTaxRates {
+		keeper.SetDenomTaxRate(ctx, denomTaxRate.Denom, denomTaxRate.TaxRate)
 	}
 
 	// check if the module account exists
@@ -59,7 +97,13 @@ func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) (data *types.GenesisSt
 	taxRate := keeper.GetTaxRate(ctx)
 	rewardWeight := keeper.GetRewardWeight(ctx)
 	taxProceeds := keeper.PeekEpochTaxProceeds(ctx)
+	burnedTaxProceeds := keeper.PeekEpochBurnedTaxProceeds(ctx)
+	communityPoolTaxProceeds := keeper.PeekEpochCommunityPoolTaxProceeds(ctx)
 	epochInitialIssuance := keeper.GetEpochInitialIssuance(ctx)
+	burned := keeper.GetBurned(ctx)
+	epochBurned := keeper.GetEpochBurned(ctx, keeper.GetEpoch(ctx))
+	pendingTaxBurn := keeper.PeekPendingTaxBurn(ctx)
+	pendingTaxCommunityPool := keeper.PeekPendingTaxCommunityPool(ctx)
 
 	var taxCaps []types.TaxCap
 	keeper.IterateTaxCap(ctx, func(denom string, taxCap sdk.Int) bool {
@@ -73,16 +117,31 @@ func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) (data *types.GenesisSt
 	var epochStates []types.EpochState
 
 	curEpoch := keeper.GetEpoch(ctx)
-	for e := int64(0); e < curEpoch ||
-		(e == curEpoch && core.IsPeriodLastBlock(ctx, core.BlocksPerWeek)); e++ {
-		epochStates = append(epochStates, types.EpochState{
-			Epoch:             uint64(e),
-			TaxReward:         keeper.GetTR(ctx, e),
-			SeigniorageReward: keeper.GetSR(ctx, e),
-			TotalStakedLuna:   keeper.GetTSL(ctx, e),
-		})
+	for e := keeper.OldestRetainedEpoch(ctx); e < curEpoch ||
+		(e == curEpoch && keeper.IsEpochLastBlock(ctx)); e++ {
+		epochStates = append(epochStates, keeper.GetEpochState(ctx, e))
 	}
 
+	var taxExemptions []types.TaxExemption
+	keeper.IterateTaxExemptionZones(ctx, func(addr sdk.AccAddress, zone string) bool {
+		taxExemptions = append(taxExemptions, types.TaxExemption{
+			Address: addr.String(),
+			Zone:    zone,
+		})
+		return false
+	})
+
+	var denomTaxRates []types.DenomTaxRate
+	keeper.IterateDenomTaxRates(ctx, func(denom string, taxRate sdk.Dec) bool {
+		denomTaxRates = append(denomTaxRates, types.DenomTaxRate{
+			Denom:   denom,
+			TaxRate: taxRate,
+		})
+		return false
+	})
+
 	return types.NewGenesisState(params, taxRate, rewardWeight,
-		taxCaps, taxProceeds, epochInitialIssuance, epochStates)
+		taxCaps, taxProceeds, epochInitialIssuance, epochStates, taxExemptions,
+		burnedTaxProceeds, communityPoolTaxProceeds, denomTaxRates, burned, epochBurned,
+		pendingTaxBurn, pendingTaxCommunityPool)
 }
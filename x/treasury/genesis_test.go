@@ -20,6 +20,9 @@ func TestExportInitGenesis(t *testing.T) {
 	input.TreasuryKeeper.SetTaxCap(input.Ctx, "foo", sdk.NewInt(1234))
 	input.TreasuryKeeper.SetTaxRate(input.Ctx, sdk.NewDec(5435))
 	input.TreasuryKeeper.SetEpochTaxProceeds(input.Ctx, sdk.NewCoins(sdk.NewCoin("foo", sdk.NewInt(923))))
+	input.TreasuryKeeper.SetEpochBurnedTaxProceeds(input.Ctx, sdk.NewCoins(sdk.NewCoin("foo", sdk.NewInt(92))))
+	input.TreasuryKeeper.SetEpochCommunityPoolTaxProceeds(input.Ctx, sdk.NewCoins(sdk.NewCoin("foo", sdk.NewInt(46))))
+	input.TreasuryKeeper.SetDenomTaxRate(input.Ctx, "foo", sdk.NewDecWithPrec(3, 3))
 	input.TreasuryKeeper.SetTR(input.Ctx, int64(0), sdk.NewDec(123))
 	input.TreasuryKeeper.SetTR(input.Ctx, int64(1), sdk.NewDec(345))
 	input.TreasuryKeeper.SetTR(input.Ctx, int64(2), sdk.NewDec(567))
@@ -29,7 +32,16 @@ func TestExportInitGenesis(t *testing.T) {
 	input.TreasuryKeeper.SetTSL(input.Ctx, int64(0), sdk.NewInt(123))
 	input.TreasuryKeeper.SetTSL(input.Ctx, int64(1), sdk.NewInt(345))
 	input.TreasuryKeeper.SetTSL(input.Ctx, int64(2), sdk.NewInt(567))
+	input.TreasuryKeeper.SetTaxExemptionZone(input.Ctx, keeper.Addrs[0], "exchange")
+	input.TreasuryKeeper.SetTaxExemptionZone(input.Ctx, keeper.Addrs[1], "exchange")
+	input.TreasuryKeeper.SetEpochBurned(input.Ctx, int64(2), sdk.NewCoins(sdk.NewCoin("foo", sdk.NewInt(12))))
+	input.TreasuryKeeper.RecordBurned(input.Ctx, sdk.NewCoins(sdk.NewCoin("foo", sdk.NewInt(34))))
+	input.TreasuryKeeper.SetPendingTaxBurn(input.Ctx, sdk.NewCoins(sdk.NewCoin("foo", sdk.NewInt(9))))
+	input.TreasuryKeeper.SetPendingTaxCommunityPool(input.Ctx, sdk.NewCoins(sdk.NewCoin("foo", sdk.NewInt(4))))
 	genesis := ExportGenesis(input.Ctx, input.TreasuryKeeper)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin("foo", sdk.NewInt(34))), genesis.EpochBurned)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin("foo", sdk.NewInt(9))), genesis.PendingTaxBurn)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin("foo", sdk.NewInt(4))), genesis.PendingTaxCommunityPool)
 
 	newInput := keeper.CreateTestInput(t)
 	newInput.Ctx = newInput.Ctx.WithBlockHeight(int64(core.BlocksPerWeek) * 3)
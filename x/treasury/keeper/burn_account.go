@@ -14,7 +14,41 @@ func (k Keeper) BurnCoinsFromBurnAccount(ctx sdk.Context) {
 		if err != nil {
 			panic(err)
 		}
+
+		k.RecordBurned(ctx, coins)
 	}
 
 	return
 }
+
+// RecordBurned adds the burned coins to the cumulative and the current epoch burned amounts
+func (k Keeper) RecordBurned(ctx sdk.Context, coins sdk.Coins) {
+	if coins.Empty() {
+		return
+	}
+
+	k.SetBurned(ctx, k.GetBurned(ctx).Add(coins...))
+
+	epoch := k.GetEpoch(ctx)
+	k.SetEpochBurned(ctx, epoch, k.GetEpochBurned(ctx, epoch).Add(coins...))
+}
+
+// GetBurned returns the cumulative amount burned from the burn account
+func (k Keeper) GetBurned(ctx sdk.Context) sdk.Coins {
+	return k.getCoins(ctx, types.BurnedKey)
+}
+
+// SetBurned sets the cumulative amount burned from the burn account
+func (k Keeper) SetBurned(ctx sdk.Context, coins sdk.Coins) {
+	k.setCoins(ctx, types.BurnedKey, coins)
+}
+
+// GetEpochBurned returns the amount burned from the burn account during the epoch
+func (k Keeper) GetEpochBurned(ctx sdk.Context, epoch int64) sdk.Coins {
+	return k.getCoins(ctx, types.GetEpochBurnedKey(epoch))
+}
+
+// SetEpochBurned sets the amount burned from the burn account during the epoch
+func (k Keeper) SetEpochBurned(ctx sdk.Context, epoch int64, coins sdk.Coins) {
+	k.setCoins(ctx, types.GetEpochBurnedKey(epoch), coins)
+}
@@ -4,6 +4,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+
+	core "github.com/terra-money/core/types"
 	"github.com/terra-money/core/x/treasury/types"
 )
 
@@ -17,4 +19,18 @@ func TestBurnCoinsFromBurnAccount(t *testing.T) {
 	input.TreasuryKeeper.BurnCoinsFromBurnAccount(input.Ctx)
 	coins = input.BankKeeper.GetAllBalances(input.Ctx, burnAddress)
 	require.True(t, coins.IsZero())
+
+	// burned coins are recorded
+	require.Equal(t, InitCoins, input.TreasuryKeeper.GetBurned(input.Ctx))
+	require.Equal(t, InitCoins, input.TreasuryKeeper.GetEpochBurned(input.Ctx, input.TreasuryKeeper.GetEpoch(input.Ctx)))
+
+	// next epoch burn accumulates the cumulative amount only
+	err := input.BankKeeper.SendCoinsFromModuleToModule(input.Ctx, faucetAccountName, types.BurnModuleName, InitCoins)
+	require.NoError(t, err)
+
+	input.Ctx = input.Ctx.WithBlockHeight(int64(core.BlocksPerWeek))
+	input.TreasuryKeeper.BurnCoinsFromBurnAccount(input.Ctx)
+	require.Equal(t, InitCoins.Add(InitCoins...), input.TreasuryKeeper.GetBurned(input.Ctx))
+	require.Equal(t, InitCoins, input.TreasuryKeeper.GetEpochBurned(input.Ctx, 1))
+	require.Equal(t, InitCoins, input.TreasuryKeeper.GetEpochBurned(input.Ctx, 0))
 }
@@ -0,0 +1,28 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/terra-money/core/x/treasury/types"
+)
+
+// Implements TreasuryHooks interface
+var _ types.TreasuryHooks = Keeper{}
+
+// SetHooks sets the treasury hooks
+func (k *Keeper) SetHooks(th types.TreasuryHooks) *Keeper {
+	if k.hooks != nil {
+		panic("cannot set treasury hooks twice")
+	}
+
+	k.hooks = th
+
+	return k
+}
+
+// AfterEpochEnd - call hook if registered
+func (k Keeper) AfterEpochEnd(ctx sdk.Context, epoch int64, taxRate sdk.Dec, rewardWeight sdk.Dec, taxCaps sdk.Coins, seigniorage sdk.Int) {
+	if k.hooks != nil {
+		k.hooks.AfterEpochEnd(ctx, epoch, taxRate, rewardWeight, taxCaps, seigniorage)
+	}
+}
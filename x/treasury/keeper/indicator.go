@@ -1,14 +1,31 @@
 package keeper
 
 import (
+	"encoding/binary"
+	"fmt"
+	"sort"
+
 	core "github.com/terra-money/core/types"
+	"github.com/terra-money/core/x/treasury/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // GetEpoch returns current epoch of (current block height + cumulated block height of past chains)
 func (k Keeper) GetEpoch(ctx sdk.Context) int64 {
-	return ctx.BlockHeight() / int64(core.BlocksPerWeek)
+	return ctx.BlockHeight() / int64(k.EpochLength(ctx))
+}
+
+// IsEpochLastBlock returns true if the current block is the last block of the epoch
+func (k Keeper) IsEpochLastBlock(ctx sdk.Context) bool {
+	return core.IsPeriodLastBlock(ctx, k.EpochLength(ctx))
+}
+
+// epochStatePrefixes are the store prefixes of the records keyed by epoch
+var epochStatePrefixes = [][]byte{
+	types.TRKey, types.SRKey, types.TSLKey,
+	types.HistoricalTaxRateKey, types.HistoricalRewardWeightKey,
+	types.EpochBurnedKey,
 }
 
 //
@@ -39,23 +56,22 @@ func (k Keeper) alignCoins(ctx sdk.Context, coins sdk.DecCoins, denom string) (a
 	return alignedAmt
 }
 
-// UpdateIndicators updates internal indicators
+// UpdateIndicators updates internal indicators. If the epoch in progress has started under
+// a previous epoch length, the indicators are split over the epochs it spans.
 func (k Keeper) UpdateIndicators(ctx sdk.Context) {
 	epoch := k.GetEpoch(ctx)
 
 	// Compute Total Staked Luna (TSL)
 	totalStakedLuna := k.stakingKeeper.TotalBondedTokens(ctx)
 
-	k.SetTSL(ctx, epoch, totalStakedLuna)
-
-	// Compute Tax Rewards (TR)
-	taxRewards := sdk.NewDecCoinsFromCoins(k.PeekEpochTaxProceeds(ctx)...)
+	// Compute Tax Rewards (TR); burned and community pool portions are not rewards
+	taxRewards := sdk.NewDecCoinsFromCoins(k.PeekEpochTaxRewards(ctx)...)
 	TR := k.alignCoins(ctx, taxRewards, core.MicroSDRDenom)
 
-	k.SetTR(ctx, epoch, TR)
-
 	// Reset tax proceeds after computing TRL for the next epoch
 	k.SetEpochTaxProceeds(ctx, sdk.Coins{})
+	k.SetEpochBurnedTaxProceeds(ctx, sdk.Coins{})
+	k.SetEpochCommunityPoolTaxProceeds(ctx, sdk.Coins{})
 
 	// Compute Seigniorage Rewards (SR)
 	seigniorage := k.PeekEpochSeigniorage(ctx)
@@ -63,7 +79,74 @@ func (k Keeper) UpdateIndicators(ctx sdk.Context) {
 	seigniorageRewards := sdk.DecCoins{sdk.NewDecCoinFromDec(core.MicroLunaDenom, seigniorageRewardsAmt)}
 	SR := k.alignCoins(ctx, seigniorageRewards, core.MicroSDRDenom)
 
-	k.SetSR(ctx, epoch, SR)
+	begin, found := k.GetEpochStartHeight(ctx)
+	if found {
+		k.DeleteEpochStartHeight(ctx)
+	} else {
+		begin = epoch * int64(k.EpochLength(ctx))
+	}
+
+	// Record the indicators along with the policy levers applied during the epoch
+	end := ctx.BlockHeight() + 1
+	forEachEpoch(begin, end, int64(k.EpochLength(ctx)), func(epoch int64, from int64, to int64) {
+		k.SetTSL(ctx, epoch, totalStakedLuna)
+		k.SetTR(ctx, epoch, k.GetTR(ctx, epoch).Add(shareDec(TR, from, to, end-begin)))
+		k.SetSR(ctx, epoch, k.GetSR(ctx, epoch).Add(shareDec(SR, from, to, end-begin)))
+		k.SetHistoricalTaxRate(ctx, epoch, k.GetTaxRate(ctx))
+		k.SetHistoricalRewardWeight(ctx, epoch, k.GetRewardWeight(ctx))
+	})
+}
+
+// GetEpochState returns the recorded indicators and policy levers of the epoch
+func (k Keeper) GetEpochState(ctx sdk.Context, epoch int64) types.EpochState {
+	return types.EpochState{
+		Epoch:             uint64(epoch),
+		TaxReward:         k.GetTR(ctx, epoch),
+		SeigniorageReward: k.GetSR(ctx, epoch),
+		TotalStakedLuna:   k.GetTSL(ctx, epoch),
+		TaxRate:           k.GetHistoricalTaxRate(ctx, epoch),
+		RewardWeight:      k.GetHistoricalRewardWeight(ctx, epoch),
+		Burned:            k.GetEpochBurned(ctx, epoch),
+	}
+}
+
+// OldestRetainedEpoch returns the oldest epoch whose indicators are kept,
+// WindowLong plus IndicatorRetentionMargin epochs back from the current epoch
+func (k Keeper) OldestRetainedEpoch(ctx sdk.Context) int64 {
+	retention := int64(k.WindowLong(ctx) + k.IndicatorRetentionMargin(ctx))
+	oldestEpoch := k.GetEpoch(ctx) - retention + 1
+	if oldestEpoch < 0 {
+		return 0
+	}
+
+	return oldestEpoch
+}
+
+// PruneEpochStates deletes the indicators and policy levers of the epochs
+// older than the retention window
+func (k Keeper) PruneEpochStates(ctx sdk.Context) {
+	oldestEpoch := k.OldestRetainedEpoch(ctx)
+	if oldestEpoch == 0 {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	for _, prefix := range epochStatePrefixes {
+		// epochs are little endian encoded, so the keys are not ordered by epoch
+		var expiredKeys [][]byte
+		iter := sdk.KVStorePrefixIterator(store, prefix)
+		for ; iter.Valid(); iter.Next() {
+			epoch := int64(binary.LittleEndian.Uint64(iter.Key()[len(prefix):]))
+			if epoch < oldestEpoch {
+				expiredKeys = append(expiredKeys, iter.Key())
+			}
+		}
+		iter.Close()
+
+		for _, key := range expiredKeys {
+			store.Delete(key)
+		}
+	}
 }
 
 // TRL returns Tax Rewards per Luna for the epoch
@@ -124,3 +207,215 @@ func (k Keeper) rollingAverageIndicator(ctx sdk.Context, epochs int64,
 
 	return sum.QuoInt64(computedEpochs)
 }
+
+// ApplyEpochLength puts a change of the EpochLength param into effect. The records keyed by
+// epoch are remapped to the epochs of the new length covering the same blocks: the tax and
+// seigniorage rewards and the burned amounts are split in proportion to the blocks shared,
+// while the staked luna, tax rates and reward weights are copied. The epoch in progress carries
+// on until the end of the current epoch under the new length; if it has started earlier, its
+// start height is kept so that its indicators are split the same way when it ends.
+func (k Keeper) ApplyEpochLength(ctx sdk.Context) {
+	epochLength := k.EpochLength(ctx)
+	appliedEpochLength := k.GetAppliedEpochLength(ctx)
+	if epochLength == appliedEpochLength {
+		return
+	}
+
+	k.SetAppliedEpochLength(ctx, epochLength)
+
+	// nothing recorded under a previous epoch length
+	if appliedEpochLength == 0 {
+		return
+	}
+
+	startHeight, found := k.GetEpochStartHeight(ctx)
+	if !found {
+		startHeight = ctx.BlockHeight() / int64(appliedEpochLength) * int64(appliedEpochLength)
+	}
+
+	k.remapEpochStates(ctx, int64(appliedEpochLength), int64(epochLength))
+	k.rescaleWindows(ctx, appliedEpochLength, epochLength)
+
+	epoch := k.GetEpoch(ctx)
+	if startHeight == epoch*int64(epochLength) {
+		k.DeleteEpochStartHeight(ctx)
+	} else {
+		k.SetEpochStartHeight(ctx, startHeight)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.EventTypeEpochLengthUpdate,
+			sdk.NewAttribute(types.AttributeKeyEpochLength, fmt.Sprint(epochLength)),
+			sdk.NewAttribute(types.AttributeKeyEpoch, fmt.Sprint(epoch)),
+		),
+	)
+}
+
+// rescaleWindows converts the params counted in epochs from the applied epoch length
+// to the new one, so that they keep covering the same number of blocks. The params set
+// in the same block as the epoch length are already counted in epochs of the new length.
+func (k Keeper) rescaleWindows(ctx sdk.Context, appliedEpochLength uint64, epochLength uint64) {
+	rescale := func(key []byte, epochs uint64) uint64 {
+		if k.paramSpace.Modified(ctx, key) {
+			return epochs
+		}
+
+		return sdk.NewUint(epochs).MulUint64(appliedEpochLength).QuoUint64(epochLength).Uint64()
+	}
+
+	windowShort := rescale(types.KeyWindowShort, k.WindowShort(ctx))
+	if windowShort == 0 {
+		windowShort = 1
+	}
+
+	// WindowLong must stay bigger than WindowShort
+	windowLong := rescale(types.KeyWindowLong, k.WindowLong(ctx))
+	if windowLong <= windowShort {
+		windowLong = windowShort + 1
+	}
+
+	windowProbation := rescale(types.KeyWindowProbation, k.WindowProbation(ctx))
+	indicatorRetentionMargin := rescale(types.KeyIndicatorRetentionMargin, k.IndicatorRetentionMargin(ctx))
+
+	k.paramSpace.Set(ctx, types.KeyWindowShort, windowShort)
+	k.paramSpace.Set(ctx, types.KeyWindowLong, windowLong)
+	k.paramSpace.Set(ctx, types.KeyWindowProbation, windowProbation)
+	k.paramSpace.Set(ctx, types.KeyIndicatorRetentionMargin, indicatorRetentionMargin)
+}
+
+// remapEpochStates moves the records keyed by epochs of the applied epoch length
+// to the epochs of the new length sharing their blocks
+func (k Keeper) remapEpochStates(ctx sdk.Context, appliedEpochLength int64, epochLength int64) {
+	store := ctx.KVStore(k.storeKey)
+
+	// collect all the records first, as remapped keys may collide with unvisited ones
+	recorded := make(map[int64]bool)
+	for _, prefix := range epochStatePrefixes {
+		iter := sdk.KVStorePrefixIterator(store, prefix)
+		for ; iter.Valid(); iter.Next() {
+			recorded[int64(binary.LittleEndian.Uint64(iter.Key()[len(prefix):]))] = true
+		}
+		iter.Close()
+	}
+
+	epochs := make([]int64, 0, len(recorded))
+	for epoch := range recorded {
+		epochs = append(epochs, epoch)
+	}
+
+	// the copied values of the latest epoch prevail
+	sort.Slice(epochs, func(i, j int) bool { return epochs[i] < epochs[j] })
+
+	states := make([]types.EpochState, len(epochs))
+	for i, epoch := range epochs {
+		states[i] = k.GetEpochState(ctx, epoch)
+		for _, prefix := range epochStatePrefixes {
+			store.Delete(types.GetSubkeyByEpoch(prefix, epoch))
+		}
+	}
+
+	var newEpochs []int64
+	remapped := make(map[int64]*types.EpochState)
+	for i, epoch := range epochs {
+		state := states[i]
+
+		// the epoch in progress only covers the blocks up to the current one
+		begin := epoch * appliedEpochLength
+		end := begin + appliedEpochLength
+		if end > ctx.BlockHeight()+1 {
+			end = ctx.BlockHeight() + 1
+		}
+
+		forEachEpoch(begin, end, epochLength, func(newEpoch int64, from int64, to int64) {
+			newState, ok := remapped[newEpoch]
+			if !ok {
+				newState = &types.EpochState{
+					Epoch:             uint64(newEpoch),
+					TaxReward:         sdk.ZeroDec(),
+					SeigniorageReward: sdk.ZeroDec(),
+					TotalStakedLuna:   sdk.ZeroInt(),
+					TaxRate:           sdk.ZeroDec(),
+					RewardWeight:      sdk.ZeroDec(),
+				}
+				remapped[newEpoch] = newState
+				newEpochs = append(newEpochs, newEpoch)
+			}
+
+			newState.TaxReward = newState.TaxReward.Add(shareDec(state.TaxReward, from, to, end-begin))
+			newState.SeigniorageReward = newState.SeigniorageReward.Add(shareDec(state.SeigniorageReward, from, to, end-begin))
+			newState.Burned = newState.Burned.Add(shareCoins(state.Burned, from, to, end-begin)...)
+
+			if !state.TotalStakedLuna.IsZero() {
+				newState.TotalStakedLuna = state.TotalStakedLuna
+			}
+
+			if !state.TaxRate.IsZero() {
+				newState.TaxRate = state.TaxRate
+			}
+
+			if !state.RewardWeight.IsZero() {
+				newState.RewardWeight = state.RewardWeight
+			}
+		})
+	}
+
+	for _, newEpoch := range newEpochs {
+		state := remapped[newEpoch]
+		k.SetTR(ctx, newEpoch, state.TaxReward)
+		k.SetSR(ctx, newEpoch, state.SeigniorageReward)
+
+		if !state.TotalStakedLuna.IsZero() {
+			k.SetTSL(ctx, newEpoch, state.TotalStakedLuna)
+		}
+
+		if !state.TaxRate.IsZero() {
+			k.SetHistoricalTaxRate(ctx, newEpoch, state.TaxRate)
+		}
+
+		if !state.RewardWeight.IsZero() {
+			k.SetHistoricalRewardWeight(ctx, newEpoch, state.RewardWeight)
+		}
+
+		if !state.Burned.Empty() {
+			k.SetEpochBurned(ctx, newEpoch, state.Burned)
+		}
+	}
+}
+
+// forEachEpoch calls fn with each epoch of the given length sharing blocks with the range
+// [begin, end), along with the range of the shared blocks relative to begin
+func forEachEpoch(begin int64, end int64, epochLength int64, fn func(epoch int64, from int64, to int64)) {
+	for epoch := begin / epochLength; epoch*epochLength < end; epoch++ {
+		from := epoch*epochLength - begin
+		if from < 0 {
+			from = 0
+		}
+
+		to := (epoch+1)*epochLength - begin
+		if to > end-begin {
+			to = end - begin
+		}
+
+		fn(epoch, from, to)
+	}
+}
+
+// shareDec returns the part of the value accrued over the blocks [from, to) out of the given
+// number of blocks; the parts of adjacent ranges add up to the value
+func shareDec(value sdk.Dec, from int64, to int64, blocks int64) sdk.Dec {
+	return value.MulInt64(to).QuoInt64(blocks).Sub(value.MulInt64(from).QuoInt64(blocks))
+}
+
+// shareCoins returns the part of the coins accrued over the blocks [from, to) out of the given
+// number of blocks; the parts of adjacent ranges add up to the coins
+func shareCoins(coins sdk.Coins, from int64, to int64, blocks int64) sdk.Coins {
+	share := sdk.Coins{}
+	for _, coin := range coins {
+		amount := coin.Amount.MulRaw(to).QuoRaw(blocks).Sub(coin.Amount.MulRaw(from).QuoRaw(blocks))
+		if amount.IsPositive() {
+			share = share.Add(sdk.NewCoin(coin.Denom, amount))
+		}
+	}
+
+	return share
+}
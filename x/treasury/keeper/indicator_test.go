@@ -5,6 +5,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 	core "github.com/terra-money/core/types"
+	"github.com/terra-money/core/x/treasury/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -148,10 +149,163 @@ func TestLoadIndicatorByEpoch(t *testing.T) {
 	require.Equal(t, sdk.ZeroDec(), MR(input.Ctx, 5, input.TreasuryKeeper))
 }
 
+func TestPruneEpochStates(t *testing.T) {
+	input := CreateTestInput(t)
+
+	params := input.TreasuryKeeper.GetParams(input.Ctx)
+	params.WindowLong = 4
+	params.IndicatorRetentionMargin = 2
+	input.TreasuryKeeper.SetParams(input.Ctx, params)
+
+	// record epoch 0 to 9
+	for epoch := int64(0); epoch < 10; epoch++ {
+		input.TreasuryKeeper.SetTR(input.Ctx, epoch, sdk.NewDec(epoch+1))
+		input.TreasuryKeeper.SetSR(input.Ctx, epoch, sdk.NewDec(epoch+1))
+		input.TreasuryKeeper.SetTSL(input.Ctx, epoch, sdk.NewInt(epoch+1))
+		input.TreasuryKeeper.SetHistoricalTaxRate(input.Ctx, epoch, sdk.NewDecWithPrec(epoch+1, 3))
+		input.TreasuryKeeper.SetHistoricalRewardWeight(input.Ctx, epoch, sdk.NewDecWithPrec(epoch+1, 2))
+		input.TreasuryKeeper.SetEpochBurned(input.Ctx, epoch, sdk.NewCoins(sdk.NewInt64Coin(core.MicroLunaDenom, epoch+1)))
+	}
+
+	// epoch 9 keeps WindowLong + IndicatorRetentionMargin = 6 epochs
+	input.Ctx = input.Ctx.WithBlockHeight(int64(9 * core.BlocksPerWeek))
+	require.Equal(t, int64(4), input.TreasuryKeeper.OldestRetainedEpoch(input.Ctx))
+	input.TreasuryKeeper.PruneEpochStates(input.Ctx)
+
+	for epoch := int64(0); epoch < 4; epoch++ {
+		require.Equal(t, types.EpochState{
+			Epoch:             uint64(epoch),
+			TaxReward:         sdk.ZeroDec(),
+			SeigniorageReward: sdk.ZeroDec(),
+			TotalStakedLuna:   sdk.ZeroInt(),
+			TaxRate:           sdk.ZeroDec(),
+			RewardWeight:      sdk.ZeroDec(),
+			Burned:            sdk.Coins{},
+		}, input.TreasuryKeeper.GetEpochState(input.Ctx, epoch))
+	}
+
+	for epoch := int64(4); epoch < 10; epoch++ {
+		require.Equal(t, types.EpochState{
+			Epoch:             uint64(epoch),
+			TaxReward:         sdk.NewDec(epoch + 1),
+			SeigniorageReward: sdk.NewDec(epoch + 1),
+			TotalStakedLuna:   sdk.NewInt(epoch + 1),
+			TaxRate:           sdk.NewDecWithPrec(epoch+1, 3),
+			RewardWeight:      sdk.NewDecWithPrec(epoch+1, 2),
+			Burned:            sdk.NewCoins(sdk.NewInt64Coin(core.MicroLunaDenom, epoch+1)),
+		}, input.TreasuryKeeper.GetEpochState(input.Ctx, epoch))
+	}
+}
+
 func linearFn(_ sdk.Context, _ Keeper, epoch int64) sdk.Dec {
 	return sdk.NewDec(epoch)
 }
 
+func TestApplyEpochLength(t *testing.T) {
+	input := CreateTestInput(t)
+	input.Ctx = input.Ctx.WithBlockHeight(int64(5*core.BlocksPerWeek) + 10)
+	input.TreasuryKeeper.ApplyEpochLength(input.Ctx)
+	require.Equal(t, uint64(core.BlocksPerWeek), input.TreasuryKeeper.GetAppliedEpochLength(input.Ctx))
+	require.Equal(t, int64(5), input.TreasuryKeeper.GetEpoch(input.Ctx))
+
+	for epoch := int64(0); epoch < 5; epoch++ {
+		input.TreasuryKeeper.SetTR(input.Ctx, epoch, sdk.NewDec(7*(epoch+1)))
+		input.TreasuryKeeper.SetTSL(input.Ctx, epoch, sdk.NewInt(epoch+1))
+		input.TreasuryKeeper.SetHistoricalTaxRate(input.Ctx, epoch, sdk.NewDecWithPrec(epoch+1, 3))
+		input.TreasuryKeeper.SetEpochBurned(input.Ctx, epoch, sdk.NewCoins(sdk.NewInt64Coin(core.MicroLunaDenom, 7*(epoch+1))))
+	}
+
+	// a param change proposal only sets the params it changes in its block
+	setEpochLength := func(epochLength uint64) {
+		input.ResetParamChanges()
+		input.TreasuryKeeper.paramSpace.Set(input.Ctx, types.KeyEpochLength, epochLength)
+		input.TreasuryKeeper.ApplyEpochLength(input.Ctx)
+		require.Equal(t, epochLength, input.TreasuryKeeper.GetAppliedEpochLength(input.Ctx))
+	}
+
+	// weekly to daily epochs; each week is split over its days
+	setEpochLength(core.BlocksPerDay)
+	require.Equal(t, int64(35), input.TreasuryKeeper.GetEpoch(input.Ctx))
+	for epoch := int64(0); epoch < 35; epoch++ {
+		week := epoch / 7
+		require.Equal(t, sdk.NewDec(week+1), input.TreasuryKeeper.GetTR(input.Ctx, epoch))
+		require.Equal(t, sdk.NewInt(week+1), input.TreasuryKeeper.GetTSL(input.Ctx, epoch))
+		require.Equal(t, sdk.NewDecWithPrec(week+1, 3), input.TreasuryKeeper.GetHistoricalTaxRate(input.Ctx, epoch))
+		require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(core.MicroLunaDenom, week+1)), input.TreasuryKeeper.GetEpochBurned(input.Ctx, epoch))
+	}
+	require.Equal(t, sdk.ZeroDec(), input.TreasuryKeeper.GetTR(input.Ctx, 35))
+
+	// the epoch in progress has started with the current daily epoch
+	_, found := input.TreasuryKeeper.GetEpochStartHeight(input.Ctx)
+	require.False(t, found)
+
+	// the windows keep covering the same number of blocks
+	require.Equal(t, 7*types.DefaultWindowShort, input.TreasuryKeeper.WindowShort(input.Ctx))
+	require.Equal(t, 7*types.DefaultWindowLong, input.TreasuryKeeper.WindowLong(input.Ctx))
+	require.Equal(t, 7*types.DefaultWindowProbation, input.TreasuryKeeper.WindowProbation(input.Ctx))
+	require.Equal(t, 7*types.DefaultIndicatorRetentionMargin, input.TreasuryKeeper.IndicatorRetentionMargin(input.Ctx))
+
+	// applying the same epoch length again is a no-op
+	input.TreasuryKeeper.ApplyEpochLength(input.Ctx)
+	require.Equal(t, sdk.NewDec(5), input.TreasuryKeeper.GetTR(input.Ctx, 34))
+
+	// back to weekly epochs in the middle of a day; the days are merged into their week
+	input.Ctx = input.Ctx.WithBlockHeight(int64(5*core.BlocksPerWeek+3*core.BlocksPerDay) + 10)
+	setEpochLength(core.BlocksPerWeek)
+	require.Equal(t, int64(5), input.TreasuryKeeper.GetEpoch(input.Ctx))
+	for epoch := int64(0); epoch < 5; epoch++ {
+		require.Equal(t, sdk.NewDec(7*(epoch+1)), input.TreasuryKeeper.GetTR(input.Ctx, epoch))
+		require.Equal(t, sdk.NewInt(epoch+1), input.TreasuryKeeper.GetTSL(input.Ctx, epoch))
+		require.Equal(t, sdk.NewDecWithPrec(epoch+1, 3), input.TreasuryKeeper.GetHistoricalTaxRate(input.Ctx, epoch))
+		require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(core.MicroLunaDenom, 7*(epoch+1))), input.TreasuryKeeper.GetEpochBurned(input.Ctx, epoch))
+	}
+	require.Equal(t, types.DefaultParams(), input.TreasuryKeeper.GetParams(input.Ctx))
+
+	// the epoch in progress has started with the current daily epoch, after the current week
+	startHeight, found := input.TreasuryKeeper.GetEpochStartHeight(input.Ctx)
+	require.True(t, found)
+	require.Equal(t, int64(5*core.BlocksPerWeek+3*core.BlocksPerDay), startHeight)
+
+	// to monthly epochs, the current week being still in progress
+	setEpochLength(4 * core.BlocksPerWeek)
+	require.Equal(t, int64(1), input.TreasuryKeeper.GetEpoch(input.Ctx))
+	require.Equal(t, sdk.NewDec(70), input.TreasuryKeeper.GetTR(input.Ctx, 0))
+	require.Equal(t, sdk.NewDec(35), input.TreasuryKeeper.GetTR(input.Ctx, 1))
+	require.Equal(t, sdk.NewInt(5), input.TreasuryKeeper.GetTSL(input.Ctx, 1))
+	require.Equal(t, uint64(1), input.TreasuryKeeper.WindowShort(input.Ctx))
+	require.Equal(t, uint64(13), input.TreasuryKeeper.WindowLong(input.Ctx))
+	require.Equal(t, uint64(3), input.TreasuryKeeper.WindowProbation(input.Ctx))
+	require.Equal(t, uint64(1), input.TreasuryKeeper.IndicatorRetentionMargin(input.Ctx))
+
+	startHeight, found = input.TreasuryKeeper.GetEpochStartHeight(input.Ctx)
+	require.True(t, found)
+	require.Equal(t, int64(5*core.BlocksPerWeek+3*core.BlocksPerDay), startHeight)
+
+	numRecords := 0
+	iter := sdk.KVStorePrefixIterator(input.Ctx.KVStore(input.TreasuryKeeper.storeKey), types.TRKey)
+	for ; iter.Valid(); iter.Next() {
+		numRecords++
+	}
+	iter.Close()
+	require.Equal(t, 2, numRecords)
+}
+
+func TestApplyEpochLengthWithWindows(t *testing.T) {
+	input := CreateTestInput(t)
+	input.TreasuryKeeper.ApplyEpochLength(input.Ctx)
+
+	// the windows set along with the epoch length are given in epochs of the new length
+	input.ResetParamChanges()
+	input.TreasuryKeeper.paramSpace.Set(input.Ctx, types.KeyEpochLength, uint64(core.BlocksPerDay))
+	input.TreasuryKeeper.paramSpace.Set(input.Ctx, types.KeyWindowLong, uint64(100))
+	input.TreasuryKeeper.ApplyEpochLength(input.Ctx)
+
+	require.Equal(t, 7*types.DefaultWindowShort, input.TreasuryKeeper.WindowShort(input.Ctx))
+	require.Equal(t, uint64(100), input.TreasuryKeeper.WindowLong(input.Ctx))
+	require.Equal(t, 7*types.DefaultWindowProbation, input.TreasuryKeeper.WindowProbation(input.Ctx))
+	require.Equal(t, 7*types.DefaultIndicatorRetentionMargin, input.TreasuryKeeper.IndicatorRetentionMargin(input.Ctx))
+}
+
 func TestSumIndicator(t *testing.T) {
 	input := CreateTestInput(t)
 
@@ -3,6 +3,8 @@ package keeper
 import (
 	"fmt"
 
+	gogotypes "github.com/gogo/protobuf/types"
+
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
@@ -26,6 +28,7 @@ type Keeper struct {
 	stakingKeeper types.StakingKeeper
 	distrKeeper   types.DistributionKeeper
 	oracleKeeper  types.OracleKeeper
+	hooks         types.TreasuryHooks
 
 	distributionModuleName string
 }
@@ -160,6 +163,59 @@ func (k Keeper) IterateTaxCap(ctx sdk.Context, handler func(denom string, taxCap
 	return
 }
 
+// SetDenomTaxRate sets the tax rate overriding the global tax rate for the {denom}
+func (k Keeper) SetDenomTaxRate(ctx sdk.Context, denom string, taxRate sdk.Dec) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&sdk.DecProto{Dec: taxRate})
+	store.Set(types.GetDenomTaxRateKey(denom), bz)
+}
+
+// DeleteDenomTaxRate removes the tax rate override of the {denom}
+func (k Keeper) DeleteDenomTaxRate(ctx sdk.Context, denom string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetDenomTaxRateKey(denom))
+}
+
+// GetDenomTaxRate gets the tax rate applied to the {denom}
+func (k Keeper) GetDenomTaxRate(ctx sdk.Context, denom string) sdk.Dec {
+	if taxRate, found := k.GetDenomTaxRateOverride(ctx, denom); found {
+		return taxRate
+	}
+
+	// if no tax rate override registered, return global tax rate
+	return k.GetTaxRate(ctx)
+}
+
+// GetDenomTaxRateOverride gets the tax rate overriding the global tax rate for the {denom}
+func (k Keeper) GetDenomTaxRateOverride(ctx sdk.Context, denom string) (sdk.Dec, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetDenomTaxRateKey(denom))
+	if bz == nil {
+		return sdk.ZeroDec(), false
+	}
+
+	dp := sdk.DecProto{}
+	k.cdc.MustUnmarshal(bz, &dp)
+	return dp.Dec, true
+}
+
+// IterateDenomTaxRates iterates all tax rate overrides
+func (k Keeper) IterateDenomTaxRates(ctx sdk.Context, handler func(denom string, taxRate sdk.Dec) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.DenomTaxRateKey)
+
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		denom := string(iter.Key()[len(types.DenomTaxRateKey):])
+		var dp sdk.DecProto
+		k.cdc.MustUnmarshal(iter.Value(), &dp)
+
+		if handler(denom, dp.Dec) {
+			break
+		}
+	}
+}
+
 // RecordEpochTaxProceeds adds tax proceeds that have been added this epoch
 func (k Keeper) RecordEpochTaxProceeds(ctx sdk.Context, delta sdk.Coins) {
 	if delta.IsZero() {
@@ -170,6 +226,18 @@ func (k Keeper) RecordEpochTaxProceeds(ctx sdk.Context, delta sdk.Coins) {
 	proceeds = proceeds.Add(delta...)
 
 	k.SetEpochTaxProceeds(ctx, proceeds)
+
+	// Track the portions to be burned and sent to the community pool
+	burnCoins, communityPoolCoins := k.SplitTaxProceeds(ctx, delta)
+	if !burnCoins.IsZero() {
+		k.SetEpochBurnedTaxProceeds(ctx, k.PeekEpochBurnedTaxProceeds(ctx).Add(burnCoins...))
+		k.SetPendingTaxBurn(ctx, k.PeekPendingTaxBurn(ctx).Add(burnCoins...))
+	}
+
+	if !communityPoolCoins.IsZero() {
+		k.SetEpochCommunityPoolTaxProceeds(ctx, k.PeekEpochCommunityPoolTaxProceeds(ctx).Add(communityPoolCoins...))
+		k.SetPendingTaxCommunityPool(ctx, k.PeekPendingTaxCommunityPool(ctx).Add(communityPoolCoins...))
+	}
 }
 
 // SetEpochTaxProceeds stores tax proceeds for the given epoch
@@ -345,3 +413,97 @@ func (k Keeper) ClearTSLs(ctx sdk.Context) {
 		store.Delete(iter.Key())
 	}
 }
+
+// GetHistoricalTaxRate returns the tax rate applied during the epoch
+func (k Keeper) GetHistoricalTaxRate(ctx sdk.Context, epoch int64) sdk.Dec {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetHistoricalTaxRateKey(epoch))
+
+	dp := sdk.DecProto{}
+	if bz == nil {
+		dp.Dec = sdk.ZeroDec()
+	} else {
+		k.cdc.MustUnmarshal(bz, &dp)
+	}
+
+	return dp.Dec
+}
+
+// SetHistoricalTaxRate stores the tax rate applied during the epoch
+func (k Keeper) SetHistoricalTaxRate(ctx sdk.Context, epoch int64, taxRate sdk.Dec) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := k.cdc.MustMarshal(&sdk.DecProto{Dec: taxRate})
+	store.Set(types.GetHistoricalTaxRateKey(epoch), bz)
+}
+
+// GetHistoricalRewardWeight returns the reward weight applied during the epoch
+func (k Keeper) GetHistoricalRewardWeight(ctx sdk.Context, epoch int64) sdk.Dec {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetHistoricalRewardWeightKey(epoch))
+
+	dp := sdk.DecProto{}
+	if bz == nil {
+		dp.Dec = sdk.ZeroDec()
+	} else {
+		k.cdc.MustUnmarshal(bz, &dp)
+	}
+
+	return dp.Dec
+}
+
+// SetHistoricalRewardWeight stores the reward weight applied during the epoch
+func (k Keeper) SetHistoricalRewardWeight(ctx sdk.Context, epoch int64, rewardWeight sdk.Dec) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := k.cdc.MustMarshal(&sdk.DecProto{Dec: rewardWeight})
+	store.Set(types.GetHistoricalRewardWeightKey(epoch), bz)
+}
+
+// GetAppliedEpochLength returns the epoch length the stored epoch indices are based on;
+// zero if not recorded yet
+func (k Keeper) GetAppliedEpochLength(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.AppliedEpochLengthKey)
+	if bz == nil {
+		return 0
+	}
+
+	var epochLength gogotypes.UInt64Value
+	k.cdc.MustUnmarshal(bz, &epochLength)
+	return epochLength.Value
+}
+
+// SetAppliedEpochLength sets the epoch length the stored epoch indices are based on
+func (k Keeper) SetAppliedEpochLength(ctx sdk.Context, epochLength uint64) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&gogotypes.UInt64Value{Value: epochLength})
+	store.Set(types.AppliedEpochLengthKey, bz)
+}
+
+// GetEpochStartHeight returns the height the epoch in progress has started at,
+// if it has started under a previous epoch length
+func (k Keeper) GetEpochStartHeight(ctx sdk.Context) (int64, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.EpochStartHeightKey)
+	if bz == nil {
+		return 0, false
+	}
+
+	var height gogotypes.Int64Value
+	k.cdc.MustUnmarshal(bz, &height)
+	return height.Value, true
+}
+
+// SetEpochStartHeight sets the height the epoch in progress has started at
+func (k Keeper) SetEpochStartHeight(ctx sdk.Context, height int64) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&gogotypes.Int64Value{Value: height})
+	store.Set(types.EpochStartHeightKey, bz)
+}
+
+// DeleteEpochStartHeight deletes the height the epoch in progress has started at
+func (k Keeper) DeleteEpochStartHeight(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.EpochStartHeightKey)
+}
@@ -66,6 +66,33 @@ func TestIterateTaxCap(t *testing.T) {
 
 }
 
+func TestDenomTaxRate(t *testing.T) {
+	input := CreateTestInput(t)
+
+	// Falls back to the global tax rate without override
+	input.TreasuryKeeper.SetTaxRate(input.Ctx, sdk.NewDecWithPrec(1, 2))
+	require.Equal(t, sdk.NewDecWithPrec(1, 2), input.TreasuryKeeper.GetDenomTaxRate(input.Ctx, core.MicroKRWDenom))
+
+	input.TreasuryKeeper.SetDenomTaxRate(input.Ctx, core.MicroKRWDenom, sdk.NewDecWithPrec(3, 3))
+	require.Equal(t, sdk.NewDecWithPrec(3, 3), input.TreasuryKeeper.GetDenomTaxRate(input.Ctx, core.MicroKRWDenom))
+	require.Equal(t, sdk.NewDecWithPrec(1, 2), input.TreasuryKeeper.GetDenomTaxRate(input.Ctx, core.MicroUSDDenom))
+
+	taxRate, found := input.TreasuryKeeper.GetDenomTaxRateOverride(input.Ctx, core.MicroKRWDenom)
+	require.True(t, found)
+	require.Equal(t, sdk.NewDecWithPrec(3, 3), taxRate)
+	_, found = input.TreasuryKeeper.GetDenomTaxRateOverride(input.Ctx, core.MicroUSDDenom)
+	require.False(t, found)
+
+	input.TreasuryKeeper.IterateDenomTaxRates(input.Ctx, func(denom string, taxRate sdk.Dec) bool {
+		require.Equal(t, core.MicroKRWDenom, denom)
+		require.Equal(t, sdk.NewDecWithPrec(3, 3), taxRate)
+		return false
+	})
+
+	input.TreasuryKeeper.DeleteDenomTaxRate(input.Ctx, core.MicroKRWDenom)
+	require.Equal(t, sdk.NewDecWithPrec(1, 2), input.TreasuryKeeper.GetDenomTaxRate(input.Ctx, core.MicroKRWDenom))
+}
+
 func TestTaxProceeds(t *testing.T) {
 	input := CreateTestInput(t)
 
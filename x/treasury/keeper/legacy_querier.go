@@ -44,7 +44,7 @@ func queryIndicators(ctx sdk.Context, k Keeper, legacyQuerierCdc *codec.LegacyAm
 	TSL := k.stakingKeeper.TotalBondedTokens(ctx)
 
 	// Compute Tax Rewards (TR)
-	taxRewards := sdk.NewDecCoinsFromCoins(k.PeekEpochTaxProceeds(ctx)...)
+	taxRewards := sdk.NewDecCoinsFromCoins(k.PeekEpochTaxRewards(ctx)...)
 	TR := k.alignCoins(ctx, taxRewards, core.MicroSDRDenom)
 
 	epoch := k.GetEpoch(ctx)
@@ -56,7 +56,7 @@ func queryIndicators(ctx sdk.Context, k Keeper, legacyQuerierCdc *codec.LegacyAm
 		}
 	} else {
 		params := k.GetParams(ctx)
-		previousEpochCtx := ctx.WithBlockHeight(ctx.BlockHeight() - int64(core.BlocksPerWeek))
+		previousEpochCtx := ctx.WithBlockHeight(ctx.BlockHeight() - int64(params.EpochLength))
 		trlYear := k.rollingAverageIndicator(previousEpochCtx, int64(params.WindowLong-1), TRL)
 		trlMonth := k.rollingAverageIndicator(previousEpochCtx, int64(params.WindowShort-1), TRL)
 
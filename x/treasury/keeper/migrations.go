@@ -0,0 +1,31 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/terra-money/core/x/treasury/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates from version 1 to 2. The params added since version 1 are set
+// to their defaults; the default EpochLength of a week is the one the stored epoch indices are based on.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	defaultParams := types.DefaultParams()
+	for _, pair := range defaultParams.ParamSetPairs() {
+		if !m.keeper.paramSpace.Has(ctx, pair.Key) {
+			m.keeper.paramSpace.Set(ctx, pair.Key, pair.Value)
+		}
+	}
+
+	m.keeper.SetAppliedEpochLength(ctx, m.keeper.EpochLength(ctx))
+	return nil
+}
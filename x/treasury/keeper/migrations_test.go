@@ -0,0 +1,19 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	core "github.com/terra-money/core/types"
+)
+
+func TestMigrate1to2(t *testing.T) {
+	input := CreateTestInput(t)
+
+	migrator := NewMigrator(input.TreasuryKeeper)
+	require.NoError(t, migrator.Migrate1to2(input.Ctx))
+
+	require.Equal(t, uint64(core.BlocksPerWeek), input.TreasuryKeeper.EpochLength(input.Ctx))
+	require.Equal(t, uint64(core.BlocksPerWeek), input.TreasuryKeeper.GetAppliedEpochLength(input.Ctx))
+}
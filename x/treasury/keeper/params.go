@@ -48,6 +48,48 @@ func (k Keeper) WindowProbation(ctx sdk.Context) (res uint64) {
 	return
 }
 
+// BurnTaxSplit is the fraction of tax proceeds to be burned
+func (k Keeper) BurnTaxSplit(ctx sdk.Context) (res sdk.Dec) {
+	k.paramSpace.Get(ctx, types.KeyBurnTaxSplit, &res)
+	return
+}
+
+// CommunityPoolTaxSplit is the fraction of tax proceeds to be sent to the community pool
+func (k Keeper) CommunityPoolTaxSplit(ctx sdk.Context) (res sdk.Dec) {
+	k.paramSpace.Get(ctx, types.KeyCommunityPoolTaxSplit, &res)
+	return
+}
+
+// IndicatorRetentionMargin is the number of epochs of indicators kept in addition to WindowLong
+func (k Keeper) IndicatorRetentionMargin(ctx sdk.Context) (res uint64) {
+	k.paramSpace.Get(ctx, types.KeyIndicatorRetentionMargin, &res)
+	return
+}
+
+// EpochLength is the number of blocks in an epoch
+func (k Keeper) EpochLength(ctx sdk.Context) (res uint64) {
+	k.paramSpace.Get(ctx, types.KeyEpochLength, &res)
+	return
+}
+
+// SeigniorageDestinations are the weighted recipients of the epoch seigniorage
+func (k Keeper) SeigniorageDestinations(ctx sdk.Context) (res []types.SeigniorageDestination) {
+	k.paramSpace.Get(ctx, types.KeySeigniorageDestinations, &res)
+	return
+}
+
+// MinGasPrices is the global minimum gas prices enforced by consensus
+func (k Keeper) MinGasPrices(ctx sdk.Context) (res sdk.DecCoins) {
+	k.paramSpace.Get(ctx, types.KeyMinGasPrices, &res)
+	return
+}
+
+// MsgFeeRequirements are the fee requirements of the msg types
+func (k Keeper) MsgFeeRequirements(ctx sdk.Context) (res []types.MsgFeeRequirement) {
+	k.paramSpace.Get(ctx, types.KeyMsgFeeRequirements, &res)
+	return
+}
+
 // GetParams returns the total set of treasury parameters.
 func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
 	k.paramSpace.GetParamSet(ctx, &params)
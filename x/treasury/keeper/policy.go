@@ -28,6 +28,7 @@ func (k Keeper) UpdateTaxCap(ctx sdk.Context) sdk.Coins {
 }
 
 // UpdateTaxPolicy updates tax-rate with t(t+1) = t(t) * (TL_year(t) + INC) / TL_month(t)
+// and applies the same update to each denom tax rate override within its own policy constraints
 func (k Keeper) UpdateTaxPolicy(ctx sdk.Context) (newTaxRate sdk.Dec) {
 	params := k.GetParams(ctx)
 
@@ -37,7 +38,8 @@ func (k Keeper) UpdateTaxPolicy(ctx sdk.Context) (newTaxRate sdk.Dec) {
 	tlMonth := k.rollingAverageIndicator(ctx, int64(params.WindowShort), TRL)
 
 	// No revenues, hike as much as possible.
-	if tlMonth.Equal(sdk.ZeroDec()) {
+	noRevenues := tlMonth.Equal(sdk.ZeroDec())
+	if noRevenues {
 		newTaxRate = params.TaxPolicy.RateMax
 	} else {
 		newTaxRate = oldTaxRate.Mul(tlYear.Mul(inc)).Quo(tlMonth)
@@ -45,8 +47,43 @@ func (k Keeper) UpdateTaxPolicy(ctx sdk.Context) (newTaxRate sdk.Dec) {
 
 	newTaxRate = params.TaxPolicy.Clamp(oldTaxRate, newTaxRate)
 
+	// Update denom tax rate overrides; the global tax rate is not updated yet
+	hasPolicy := make(map[string]bool, len(params.DenomTaxPolicies))
+	for _, denomPolicy := range params.DenomTaxPolicies {
+		hasPolicy[denomPolicy.Denom] = true
+
+		// an override without stored rate starts from the old global rate;
+		// bound it first so a new or modified policy applies from this epoch
+		oldDenomTaxRate := k.GetDenomTaxRate(ctx, denomPolicy.Denom)
+		oldDenomTaxRate = sdk.MaxDec(oldDenomTaxRate, denomPolicy.Policy.RateMin)
+		oldDenomTaxRate = sdk.MinDec(oldDenomTaxRate, denomPolicy.Policy.RateMax)
+
+		var newDenomTaxRate sdk.Dec
+		if noRevenues {
+			newDenomTaxRate = denomPolicy.Policy.RateMax
+		} else {
+			newDenomTaxRate = oldDenomTaxRate.Mul(tlYear.Mul(inc)).Quo(tlMonth)
+		}
+
+		k.SetDenomTaxRate(ctx, denomPolicy.Denom, denomPolicy.Policy.Clamp(oldDenomTaxRate, newDenomTaxRate))
+	}
+
+	// Remove overrides whose policy has been removed
+	var staleDenoms []string
+	k.IterateDenomTaxRates(ctx, func(denom string, _ sdk.Dec) bool {
+		if !hasPolicy[denom] {
+			staleDenoms = append(staleDenoms, denom)
+		}
+		return false
+	})
+
+	for _, denom := range staleDenoms {
+		k.DeleteDenomTaxRate(ctx, denom)
+	}
+
 	// Set the new tax rate to the store
 	k.SetTaxRate(ctx, newTaxRate)
+
 	return
 }
 
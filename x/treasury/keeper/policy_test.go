@@ -44,6 +44,70 @@ func TestUpdateTaxRate(t *testing.T) {
 	require.Equal(t, types.DefaultTaxRate.Add(taxPolicy.ChangeRateMax), taxRate)
 }
 
+func TestUpdateDenomTaxRate(t *testing.T) {
+	input := CreateTestInput(t)
+	sh := staking.NewHandler(input.StakingKeeper)
+
+	// Create Validators
+	amt := sdk.TokensFromConsensusPower(1, sdk.DefaultPowerReduction)
+	addr, val := ValAddrs[0], ValPubKeys[0]
+	addr1, val1 := ValAddrs[1], ValPubKeys[1]
+	_, err := sh(input.Ctx, NewTestMsgCreateValidator(addr, val, amt))
+	require.NoError(t, err)
+	_, err = sh(input.Ctx, NewTestMsgCreateValidator(addr1, val1, amt))
+	require.NoError(t, err)
+	staking.EndBlocker(input.Ctx, input.StakingKeeper)
+
+	krwPolicy := types.PolicyConstraints{
+		RateMin:       sdk.NewDecWithPrec(2, 3),
+		RateMax:       sdk.NewDecWithPrec(3, 3),
+		Cap:           sdk.NewCoin("unused", sdk.ZeroInt()),
+		ChangeRateMax: sdk.NewDecWithPrec(5, 4),
+	}
+
+	sdrPolicy := types.PolicyConstraints{
+		RateMin:       sdk.ZeroDec(),
+		RateMax:       sdk.NewDecWithPrec(1, 2),
+		Cap:           sdk.NewCoin("unused", sdk.ZeroInt()),
+		ChangeRateMax: sdk.NewDecWithPrec(1, 4),
+	}
+
+	params := input.TreasuryKeeper.GetParams(input.Ctx)
+	params.DenomTaxPolicies = []types.DenomTaxPolicy{
+		{Denom: core.MicroKRWDenom, Policy: krwPolicy},
+		{Denom: core.MicroSDRDenom, Policy: sdrPolicy},
+	}
+	input.TreasuryKeeper.SetParams(input.Ctx, params)
+
+	// stale override without policy
+	input.TreasuryKeeper.SetDenomTaxRate(input.Ctx, core.MicroUSDDenom, sdk.NewDecWithPrec(5, 3))
+
+	windowLong := input.TreasuryKeeper.WindowLong(input.Ctx)
+
+	// zero reward tax proceeds
+	for i := uint64(0); i < windowLong; i++ {
+		input.Ctx = input.Ctx.WithBlockHeight(int64(i * core.BlocksPerWeek))
+
+		taxProceeds := sdk.NewCoins(sdk.NewCoin(core.MicroSDRDenom, sdk.ZeroInt()))
+		input.TreasuryKeeper.RecordEpochTaxProceeds(input.Ctx, taxProceeds)
+		input.TreasuryKeeper.UpdateIndicators(input.Ctx)
+	}
+
+	// override starts from the old global rate bounded by the denom policy and hikes within the change rate
+	oldTaxRate := input.TreasuryKeeper.GetTaxRate(input.Ctx)
+	input.TreasuryKeeper.UpdateTaxPolicy(input.Ctx)
+	require.NotEqual(t, oldTaxRate, input.TreasuryKeeper.GetTaxRate(input.Ctx))
+	require.Equal(t, krwPolicy.RateMin.Add(krwPolicy.ChangeRateMax), input.TreasuryKeeper.GetDenomTaxRate(input.Ctx, core.MicroKRWDenom))
+	require.Equal(t, oldTaxRate.Add(sdrPolicy.ChangeRateMax), input.TreasuryKeeper.GetDenomTaxRate(input.Ctx, core.MicroSDRDenom))
+
+	// override is bounded by the denom policy maximum
+	input.TreasuryKeeper.UpdateTaxPolicy(input.Ctx)
+	require.Equal(t, krwPolicy.RateMax, input.TreasuryKeeper.GetDenomTaxRate(input.Ctx, core.MicroKRWDenom))
+
+	// stale override falls back to the global tax rate
+	require.Equal(t, input.TreasuryKeeper.GetTaxRate(input.Ctx), input.TreasuryKeeper.GetDenomTaxRate(input.Ctx, core.MicroUSDDenom))
+}
+
 func TestUpdateRewardWeight(t *testing.T) {
 	input := CreateTestInput(t)
 	input.OracleKeeper.SetLunaExchangeRate(input.Ctx, core.MicroSDRDenom, sdk.OneDec())
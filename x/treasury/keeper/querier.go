@@ -2,12 +2,14 @@ package keeper
 
 import (
 	"context"
+	"encoding/binary"
 	"math"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	core "github.com/terra-money/core/types"
 	"github.com/terra-money/core/x/treasury/types"
@@ -32,10 +34,18 @@ func (q querier) Params(c context.Context, req *types.QueryParamsRequest) (*type
 	return &types.QueryParamsResponse{Params: q.GetParams(ctx)}, nil
 }
 
-// TaxRate return the current tax rate
+// TaxRate return the current tax rate, or the tax rate applied to a denom if given
 func (q querier) TaxRate(c context.Context, req *types.QueryTaxRateRequest) (*types.QueryTaxRateResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
-	return &types.QueryTaxRateResponse{TaxRate: q.GetTaxRate(ctx)}, nil
+	if req == nil || req.Denom == "" {
+		return &types.QueryTaxRateResponse{TaxRate: q.GetTaxRate(ctx)}, nil
+	}
+
+	if err := sdk.ValidateDenom(req.Denom); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid denom")
+	}
+
+	return &types.QueryTaxRateResponse{TaxRate: q.GetDenomTaxRate(ctx, req.Denom)}, nil
 }
 
 // TaxCap returns the tax cap of a denom
@@ -68,6 +78,22 @@ func (q querier) TaxCaps(c context.Context, req *types.QueryTaxCapsRequest) (*ty
 	return &types.QueryTaxCapsResponse{TaxCaps: taxCaps}, nil
 }
 
+// TaxExemptions returns all tax exemption entries
+func (q querier) TaxExemptions(c context.Context, req *types.QueryTaxExemptionsRequest) (*types.QueryTaxExemptionsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var taxExemptions []types.TaxExemption
+	q.IterateTaxExemptionZones(ctx, func(addr sdk.AccAddress, zone string) bool {
+		taxExemptions = append(taxExemptions, types.TaxExemption{
+			Address: addr.String(),
+			Zone:    zone,
+		})
+		return false
+	})
+
+	return &types.QueryTaxExemptionsResponse{TaxExemptions: taxExemptions}, nil
+}
+
 // RewardWeight return the current reward weight
 func (q querier) RewardWeight(c context.Context, req *types.QueryRewardWeightRequest) (*types.QueryRewardWeightResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
@@ -77,13 +103,21 @@ func (q querier) RewardWeight(c context.Context, req *types.QueryRewardWeightReq
 // SeigniorageProceeds return the current seigniorage proceeds
 func (q querier) SeigniorageProceeds(c context.Context, req *types.QuerySeigniorageProceedsRequest) (*types.QuerySeigniorageProceedsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
-	return &types.QuerySeigniorageProceedsResponse{SeigniorageProceeds: q.PeekEpochSeigniorage(ctx)}, nil
+	seigniorageProceeds := q.PeekEpochSeigniorage(ctx)
+	return &types.QuerySeigniorageProceedsResponse{
+		SeigniorageProceeds: seigniorageProceeds,
+		SeigniorageShares:   q.ComputeSeigniorageShares(ctx, seigniorageProceeds),
+	}, nil
 }
 
 // TaxProceeds return the current tax proceeds
 func (q querier) TaxProceeds(c context.Context, req *types.QueryTaxProceedsRequest) (*types.QueryTaxProceedsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
-	return &types.QueryTaxProceedsResponse{TaxProceeds: q.PeekEpochTaxProceeds(ctx)}, nil
+	return &types.QueryTaxProceedsResponse{
+		TaxProceeds:              q.PeekEpochTaxProceeds(ctx),
+		BurnedTaxProceeds:        q.PeekEpochBurnedTaxProceeds(ctx),
+		CommunityPoolTaxProceeds: q.PeekEpochCommunityPoolTaxProceeds(ctx),
+	}, nil
 }
 
 // Indicators return the current trl informations
@@ -94,7 +128,7 @@ func (q querier) Indicators(c context.Context, req *types.QueryIndicatorsRequest
 	TSL := q.stakingKeeper.TotalBondedTokens(ctx)
 
 	// Compute Tax Rewards (TR)
-	taxRewards := sdk.NewDecCoinsFromCoins(q.PeekEpochTaxProceeds(ctx)...)
+	taxRewards := sdk.NewDecCoinsFromCoins(q.PeekEpochTaxRewards(ctx)...)
 	TR := q.alignCoins(ctx, taxRewards, core.MicroSDRDenom)
 
 	epoch := q.GetEpoch(ctx)
@@ -106,7 +140,7 @@ func (q querier) Indicators(c context.Context, req *types.QueryIndicatorsRequest
 		}
 	} else {
 		params := q.GetParams(ctx)
-		previousEpochCtx := ctx.WithBlockHeight(ctx.BlockHeight() - int64(core.BlocksPerWeek))
+		previousEpochCtx := ctx.WithBlockHeight(ctx.BlockHeight() - int64(params.EpochLength))
 		trlYear := q.rollingAverageIndicator(previousEpochCtx, int64(params.WindowLong-1), TRL)
 		trlMonth := q.rollingAverageIndicator(previousEpochCtx, int64(params.WindowShort-1), TRL)
 
@@ -124,3 +158,141 @@ func (q querier) Indicators(c context.Context, req *types.QueryIndicatorsRequest
 
 	return &res, nil
 }
+
+// NextPolicy dry-runs the end of the current epoch on a cached context and returns the
+// resulting policy; the projection is based on the proceeds recorded so far in the epoch.
+func (q querier) NextPolicy(c context.Context, req *types.QueryNextPolicyRequest) (*types.QueryNextPolicyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	epochLength := int64(q.EpochLength(ctx))
+	remainingBlocks := epochLength - 1 - ctx.BlockHeight()%epochLength
+
+	// run the epoch end at the last block of the epoch, without committing any state change
+	cacheCtx, _ := ctx.CacheContext()
+	cacheCtx = cacheCtx.
+		WithBlockHeight(ctx.BlockHeight() + remainingBlocks).
+		WithEventManager(sdk.NewEventManager())
+
+	q.UpdateIndicators(cacheCtx)
+
+	seigniorage := sdk.ZeroInt()
+	var seigniorageShares []types.SeigniorageShare
+	if q.GetEpoch(cacheCtx) >= int64(q.WindowProbation(cacheCtx)) {
+		seigniorage, seigniorageShares = q.SettleSeigniorage(cacheCtx)
+		q.UpdateTaxPolicy(cacheCtx)
+		q.UpdateRewardPolicy(cacheCtx)
+		q.UpdateTaxCap(cacheCtx)
+	}
+
+	var taxCaps []types.QueryTaxCapsResponseItem
+	q.IterateTaxCap(cacheCtx, func(denom string, taxCap sdk.Int) bool {
+		taxCaps = append(taxCaps, types.QueryTaxCapsResponseItem{
+			Denom:  denom,
+			TaxCap: taxCap,
+		})
+		return false
+	})
+
+	var denomTaxRates []types.DenomTaxRate
+	q.IterateDenomTaxRates(cacheCtx, func(denom string, taxRate sdk.Dec) bool {
+		denomTaxRates = append(denomTaxRates, types.DenomTaxRate{
+			Denom:   denom,
+			TaxRate: taxRate,
+		})
+		return false
+	})
+
+	return &types.QueryNextPolicyResponse{
+		TaxRate:           q.GetTaxRate(cacheCtx),
+		RewardWeight:      q.GetRewardWeight(cacheCtx),
+		TaxCaps:           taxCaps,
+		DenomTaxRates:     denomTaxRates,
+		Seigniorage:       seigniorage,
+		SeigniorageShares: seigniorageShares,
+		RemainingBlocks:   remainingBlocks,
+	}, nil
+}
+
+// Burned returns the cumulative and the current epoch amounts burned from the burn account
+func (q querier) Burned(c context.Context, req *types.QueryBurnedRequest) (*types.QueryBurnedResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryBurnedResponse{
+		Burned:      q.GetBurned(ctx),
+		EpochBurned: q.GetEpochBurned(ctx, q.GetEpoch(ctx)),
+	}, nil
+}
+
+// MinGasPrices returns the global minimum gas prices enforced by consensus
+func (q querier) MinGasPrices(c context.Context, req *types.QueryMinGasPricesRequest) (*types.QueryMinGasPricesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryMinGasPricesResponse{MinGasPrices: q.Keeper.MinGasPrices(ctx)}, nil
+}
+
+// EpochStates returns the recorded indicators, tax rate and reward weight of the epochs in range.
+// Epochs older than the retention window are pruned and omitted from the range.
+func (q querier) EpochStates(c context.Context, req *types.QueryEpochStatesRequest) (*types.QueryEpochStatesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	startEpoch := req.StartEpoch
+	if oldestEpoch := uint64(q.OldestRetainedEpoch(ctx)); startEpoch < oldestEpoch {
+		startEpoch = oldestEpoch
+	}
+
+	endEpoch := req.EndEpoch
+	if curEpoch := uint64(q.GetEpoch(ctx)); endEpoch == 0 || endEpoch > curEpoch {
+		endEpoch = curEpoch
+	}
+
+	if startEpoch > endEpoch {
+		return nil, status.Errorf(codes.InvalidArgument, "start epoch %d is greater than end epoch %d", startEpoch, endEpoch)
+	}
+
+	pageReq := req.Pagination
+	if pageReq == nil {
+		pageReq = &query.PageRequest{}
+	}
+
+	if pageReq.Offset > 0 && pageReq.Key != nil {
+		return nil, status.Error(codes.InvalidArgument, "either offset or key is expected, got both")
+	}
+
+	limit := pageReq.Limit
+	if limit == 0 {
+		limit = query.DefaultLimit
+	}
+
+	// the next key of a page is the big endian encoded next epoch
+	firstEpoch := startEpoch + pageReq.Offset
+	if pageReq.Key != nil {
+		if len(pageReq.Key) != 8 {
+			return nil, status.Error(codes.InvalidArgument, "invalid pagination key")
+		}
+
+		firstEpoch = binary.BigEndian.Uint64(pageReq.Key)
+		if firstEpoch < startEpoch {
+			firstEpoch = startEpoch
+		}
+	}
+
+	var epochStates []types.EpochState
+	epoch := firstEpoch
+	for ; epoch <= endEpoch && uint64(len(epochStates)) < limit; epoch++ {
+		epochStates = append(epochStates, q.GetEpochState(ctx, int64(epoch)))
+	}
+
+	pageRes := &query.PageResponse{}
+	if epoch <= endEpoch {
+		pageRes.NextKey = make([]byte, 8)
+		binary.BigEndian.PutUint64(pageRes.NextKey, epoch)
+	}
+
+	if pageReq.CountTotal {
+		pageRes.Total = endEpoch - startEpoch + 1
+	}
+
+	return &types.QueryEpochStatesResponse{EpochStates: epochStates, Pagination: pageRes}, nil
+}
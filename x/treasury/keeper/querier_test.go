@@ -9,6 +9,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 )
 
@@ -43,6 +44,12 @@ func TestQueryTaxRate(t *testing.T) {
 	require.NoError(t, err)
 
 	require.Equal(t, input.TreasuryKeeper.GetTaxRate(input.Ctx), res.TaxRate)
+
+	input.TreasuryKeeper.SetDenomTaxRate(input.Ctx, core.MicroKRWDenom, sdk.NewDecWithPrec(3, 3))
+	res, err = querier.TaxRate(ctx, &types.QueryTaxRateRequest{Denom: core.MicroKRWDenom})
+	require.NoError(t, err)
+
+	require.Equal(t, sdk.NewDecWithPrec(3, 3), res.TaxRate)
 }
 
 func TestQueryTaxCap(t *testing.T) {
@@ -120,6 +127,107 @@ func TestQuerySeigniorageProceeds(t *testing.T) {
 
 	require.Equal(t, targetSeigniorage, res.SeigniorageProceeds)
 	require.Equal(t, input.TreasuryKeeper.PeekEpochSeigniorage(input.Ctx), res.SeigniorageProceeds)
+
+	params := input.TreasuryKeeper.GetParams(input.Ctx)
+	params.SeigniorageDestinations = []types.SeigniorageDestination{
+		{Type: types.SeigniorageDestinationBurn, Weight: sdk.NewDecWithPrec(5, 1)},
+		{Type: types.SeigniorageDestinationOracle, Weight: sdk.NewDecWithPrec(3, 1)},
+		{Type: types.SeigniorageDestinationCommunityPool, Weight: sdk.NewDecWithPrec(2, 1)},
+	}
+	input.TreasuryKeeper.SetParams(input.Ctx, params)
+
+	res, err = querier.SeigniorageProceeds(ctx, &types.QuerySeigniorageProceedsRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.SeigniorageShares, 3)
+	require.Equal(t, sdk.NewInt(5), res.SeigniorageShares[0].Amount)
+	require.Equal(t, sdk.NewInt(3), res.SeigniorageShares[1].Amount)
+	require.Equal(t, sdk.NewInt(2), res.SeigniorageShares[2].Amount)
+}
+
+func TestQueryBurned(t *testing.T) {
+	input := CreateTestInput(t)
+	ctx := sdk.WrapSDKContext(input.Ctx)
+
+	input.TreasuryKeeper.BurnCoinsFromBurnAccount(input.Ctx)
+
+	querier := NewQuerier(input.TreasuryKeeper)
+	res, err := querier.Burned(ctx, &types.QueryBurnedRequest{})
+	require.NoError(t, err)
+	require.Equal(t, InitCoins, res.Burned)
+	require.Equal(t, InitCoins, res.EpochBurned)
+
+	// the epoch amount starts over in the next epoch
+	res, err = querier.Burned(sdk.WrapSDKContext(input.Ctx.WithBlockHeight(int64(core.BlocksPerWeek))), &types.QueryBurnedRequest{})
+	require.NoError(t, err)
+	require.Equal(t, InitCoins, res.Burned)
+	require.True(t, res.EpochBurned.Empty())
+}
+
+func TestQueryMinGasPrices(t *testing.T) {
+	input := CreateTestInput(t)
+	ctx := sdk.WrapSDKContext(input.Ctx)
+	querier := NewQuerier(input.TreasuryKeeper)
+
+	res, err := querier.MinGasPrices(ctx, &types.QueryMinGasPricesRequest{})
+	require.NoError(t, err)
+	require.True(t, res.MinGasPrices.Empty())
+
+	minGasPrices := sdk.NewDecCoins(sdk.NewDecCoinFromDec(core.MicroSDRDenom, sdk.NewDecWithPrec(1, 1)))
+	params := input.TreasuryKeeper.GetParams(input.Ctx)
+	params.MinGasPrices = minGasPrices
+	input.TreasuryKeeper.SetParams(input.Ctx, params)
+
+	res, err = querier.MinGasPrices(ctx, &types.QueryMinGasPricesRequest{})
+	require.NoError(t, err)
+	require.Equal(t, minGasPrices, res.MinGasPrices)
+}
+
+func TestQueryEpochStates(t *testing.T) {
+	input := CreateTestInput(t)
+	input.Ctx = input.Ctx.WithBlockHeight(int64(9 * core.BlocksPerWeek))
+	ctx := sdk.WrapSDKContext(input.Ctx)
+
+	for epoch := int64(0); epoch < 10; epoch++ {
+		input.TreasuryKeeper.SetTR(input.Ctx, epoch, sdk.NewDec(epoch))
+		input.TreasuryKeeper.SetHistoricalTaxRate(input.Ctx, epoch, sdk.NewDecWithPrec(epoch, 3))
+	}
+
+	querier := NewQuerier(input.TreasuryKeeper)
+
+	// first page
+	res, err := querier.EpochStates(ctx, &types.QueryEpochStatesRequest{
+		StartEpoch: 2,
+		EndEpoch:   7,
+		Pagination: &query.PageRequest{Limit: 4, CountTotal: true},
+	})
+	require.NoError(t, err)
+	require.Len(t, res.EpochStates, 4)
+	require.Equal(t, uint64(2), res.EpochStates[0].Epoch)
+	require.Equal(t, sdk.NewDec(2), res.EpochStates[0].TaxReward)
+	require.Equal(t, sdk.NewDecWithPrec(2, 3), res.EpochStates[0].TaxRate)
+	require.Equal(t, uint64(6), res.Pagination.Total)
+	require.NotNil(t, res.Pagination.NextKey)
+
+	// next page
+	res, err = querier.EpochStates(ctx, &types.QueryEpochStatesRequest{
+		StartEpoch: 2,
+		EndEpoch:   7,
+		Pagination: &query.PageRequest{Limit: 4, Key: res.Pagination.NextKey},
+	})
+	require.NoError(t, err)
+	require.Len(t, res.EpochStates, 2)
+	require.Equal(t, uint64(6), res.EpochStates[0].Epoch)
+	require.Equal(t, uint64(7), res.EpochStates[1].Epoch)
+	require.Nil(t, res.Pagination.NextKey)
+
+	// end epoch defaults to the current epoch
+	res, err = querier.EpochStates(ctx, &types.QueryEpochStatesRequest{StartEpoch: 8})
+	require.NoError(t, err)
+	require.Len(t, res.EpochStates, 2)
+
+	// invalid range
+	_, err = querier.EpochStates(ctx, &types.QueryEpochStatesRequest{StartEpoch: 8, EndEpoch: 3})
+	require.Error(t, err)
 }
 
 func TestQueryIndicators(t *testing.T) {
@@ -163,3 +271,49 @@ func TestQueryIndicators(t *testing.T) {
 	res, err = querier.Indicators(ctx, &types.QueryIndicatorsRequest{})
 	require.Equal(t, targetIndicators, res)
 }
+
+func TestQueryNextPolicy(t *testing.T) {
+	input := CreateTestInput(t)
+	input.Ctx = input.Ctx.WithBlockHeight(int64(core.BlocksPerWeek*input.TreasuryKeeper.WindowProbation(input.Ctx)) + 10)
+	ctx := sdk.WrapSDKContext(input.Ctx)
+
+	sh := staking.NewHandler(input.StakingKeeper)
+	stakingAmt := sdk.TokensFromConsensusPower(1, sdk.DefaultPowerReduction)
+	_, err := sh(input.Ctx, NewTestMsgCreateValidator(ValAddrs[0], ValPubKeys[0], stakingAmt))
+	require.NoError(t, err)
+	staking.EndBlocker(input.Ctx, input.StakingKeeper)
+
+	input.TreasuryKeeper.RecordEpochInitialIssuance(input.Ctx)
+	seigniorageAmt := sdk.NewInt(1000)
+	err = input.BankKeeper.BurnCoins(input.Ctx, faucetAccountName, sdk.NewCoins(sdk.NewCoin(core.MicroLunaDenom, seigniorageAmt)))
+	require.NoError(t, err)
+	input.TreasuryKeeper.RecordEpochTaxProceeds(input.Ctx, sdk.NewCoins(sdk.NewCoin(core.MicroSDRDenom, sdk.NewInt(1000000))))
+
+	taxRate := input.TreasuryKeeper.GetTaxRate(input.Ctx)
+	rewardWeight := input.TreasuryKeeper.GetRewardWeight(input.Ctx)
+
+	querier := NewQuerier(input.TreasuryKeeper)
+	res, err := querier.NextPolicy(ctx, &types.QueryNextPolicyRequest{})
+	require.NoError(t, err)
+	require.Equal(t, int64(core.BlocksPerWeek)-11, res.RemainingBlocks)
+	require.Equal(t, seigniorageAmt, res.Seigniorage)
+	require.Len(t, res.SeigniorageShares, 2)
+	require.Equal(t, seigniorageAmt, res.SeigniorageShares[0].Amount.Add(res.SeigniorageShares[1].Amount))
+
+	// dry run leaves the state untouched
+	require.Equal(t, taxRate, input.TreasuryKeeper.GetTaxRate(input.Ctx))
+	require.Equal(t, rewardWeight, input.TreasuryKeeper.GetRewardWeight(input.Ctx))
+	require.Equal(t, seigniorageAmt, input.TreasuryKeeper.PeekEpochSeigniorage(input.Ctx))
+
+	// projection matches the actual epoch end
+	lastBlockCtx := input.Ctx.WithBlockHeight(input.Ctx.BlockHeight() + res.RemainingBlocks)
+	input.TreasuryKeeper.UpdateIndicators(lastBlockCtx)
+	input.TreasuryKeeper.SettleSeigniorage(lastBlockCtx)
+	require.Equal(t, input.TreasuryKeeper.UpdateTaxPolicy(lastBlockCtx), res.TaxRate)
+	require.Equal(t, input.TreasuryKeeper.UpdateRewardPolicy(lastBlockCtx), res.RewardWeight)
+	input.TreasuryKeeper.UpdateTaxCap(lastBlockCtx)
+
+	taxCaps, err := querier.TaxCaps(sdk.WrapSDKContext(lastBlockCtx), &types.QueryTaxCapsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, taxCaps.TaxCaps, res.TaxCaps)
+}
@@ -4,21 +4,48 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	core "github.com/terra-money/core/types"
+	oracletypes "github.com/terra-money/core/x/oracle/types"
 	"github.com/terra-money/core/x/treasury/types"
 )
 
-// SettleSeigniorage computes seigniorage and distributes it to oracle and distribution(community-pool) account
-func (k Keeper) SettleSeigniorage(ctx sdk.Context) {
-	// Mint seigniorage for oracle and community pool
+// ComputeSeigniorageShares splits the seigniorage amount among the SeigniorageDestinations.
+// Without destinations, the reward weight portion is burned and the rest goes to the community pool.
+// The truncation remainder is added to the last destination.
+func (k Keeper) ComputeSeigniorageShares(ctx sdk.Context, seigniorageAmt sdk.Int) []types.SeigniorageShare {
+	destinations := k.SeigniorageDestinations(ctx)
+	if len(destinations) == 0 {
+		rewardWeight := k.GetRewardWeight(ctx)
+		destinations = []types.SeigniorageDestination{
+			{Type: types.SeigniorageDestinationBurn, Weight: rewardWeight},
+			{Type: types.SeigniorageDestinationCommunityPool, Weight: sdk.OneDec().Sub(rewardWeight)},
+		}
+	}
+
+	shares := make([]types.SeigniorageShare, len(destinations))
+	leftAmt := seigniorageAmt
+	for i, destination := range destinations {
+		amt := destination.Weight.MulInt(seigniorageAmt).TruncateInt()
+		if i == len(destinations)-1 {
+			amt = leftAmt
+		}
+
+		shares[i] = types.SeigniorageShare{Destination: destination, Amount: amt}
+		leftAmt = leftAmt.Sub(amt)
+	}
+
+	return shares
+}
+
+// SettleSeigniorage mints the epoch seigniorage and distributes it to the SeigniorageDestinations.
+// Returns the minted seigniorage and the share of each destination.
+func (k Keeper) SettleSeigniorage(ctx sdk.Context) (seigniorageAmt sdk.Int, shares []types.SeigniorageShare) {
+	seigniorageAmt = sdk.ZeroInt()
+
 	seigniorageLunaAmt := k.PeekEpochSeigniorage(ctx)
 	if seigniorageLunaAmt.LTE(sdk.ZeroInt()) {
 		return
 	}
 
-	// Settle current epoch seigniorage
-	rewardWeight := k.GetRewardWeight(ctx)
-
-	// Align seigniorage to usdr
 	seigniorageDecCoin := sdk.NewDecCoin(core.MicroLunaDenom, seigniorageLunaAmt)
 
 	// Mint seigniorage
@@ -29,33 +56,70 @@ func (k Keeper) SettleSeigniorage(ctx sdk.Context) {
 			panic(err)
 		}
 	}
-	seigniorageAmt := seigniorageCoin.Amount
+	seigniorageAmt = seigniorageCoin.Amount
 
-	// Send reward to oracle module
-	burnAmt := rewardWeight.MulInt(seigniorageAmt).TruncateInt()
-	burnCoins := sdk.NewCoins(sdk.NewCoin(core.MicroLunaDenom, burnAmt))
-	if burnCoins.IsValid() {
-		if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, burnCoins); err != nil {
-			panic(err)
+	shares = k.ComputeSeigniorageShares(ctx, seigniorageAmt)
+	for _, share := range shares {
+		shareCoins := sdk.NewCoins(sdk.NewCoin(core.MicroLunaDenom, share.Amount))
+		if shareCoins.Empty() {
+			continue
 		}
+
+		k.settleSeigniorageShare(ctx, share.Destination, shareCoins)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(types.EventTypeSeigniorageSettle,
+				sdk.NewAttribute(types.AttributeKeyDestination, share.Destination.Type),
+				sdk.NewAttribute(types.AttributeKeyModule, share.Destination.ModuleName),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, shareCoins.String()),
+			),
+		)
 	}
 
-	// Send left to distribution module
-	leftAmt := seigniorageAmt.Sub(burnAmt)
-	leftCoins := sdk.NewCoins(sdk.NewCoin(core.MicroLunaDenom, leftAmt))
-	if leftCoins.IsValid() {
-		if err := k.bankKeeper.SendCoinsFromModuleToModule(
-			ctx,
-			types.ModuleName,
-			k.distributionModuleName,
-			leftCoins,
-		); err != nil {
+	return
+}
+
+// settleSeigniorageShare sends the seigniorage share held by the treasury module account to its destination.
+// A share to an unregistered module account goes to the community pool instead.
+func (k Keeper) settleSeigniorageShare(ctx sdk.Context, destination types.SeigniorageDestination, shareCoins sdk.Coins) {
+	switch destination.Type {
+	case types.SeigniorageDestinationBurn:
+		if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, shareCoins); err != nil {
+			panic(err)
+		}
+	case types.SeigniorageDestinationOracle:
+		if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, oracletypes.ModuleName, shareCoins); err != nil {
+			panic(err)
+		}
+	case types.SeigniorageDestinationModule:
+		if k.accountKeeper.GetModuleAddress(destination.ModuleName) == nil {
+			k.Logger(ctx).Error("seigniorage destination module account is not registered; sent to the community pool",
+				"module", destination.ModuleName)
+			k.fundCommunityPool(ctx, shareCoins)
+			return
+		}
+
+		if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, destination.ModuleName, shareCoins); err != nil {
 			panic(err)
 		}
+	default:
+		k.fundCommunityPool(ctx, shareCoins)
+	}
+}
 
-		// Update distribution community pool
-		feePool := k.distrKeeper.GetFeePool(ctx)
-		feePool.CommunityPool = feePool.CommunityPool.Add(sdk.NewDecCoinsFromCoins(leftCoins...)...)
-		k.distrKeeper.SetFeePool(ctx, feePool)
+// fundCommunityPool sends coins held by the treasury module account to the community pool
+func (k Keeper) fundCommunityPool(ctx sdk.Context, coins sdk.Coins) {
+	if err := k.bankKeeper.SendCoinsFromModuleToModule(
+		ctx,
+		types.ModuleName,
+		k.distributionModuleName,
+		coins,
+	); err != nil {
+		panic(err)
 	}
+
+	// Update distribution community pool
+	feePool := k.distrKeeper.GetFeePool(ctx)
+	feePool.CommunityPool = feePool.CommunityPool.Add(sdk.NewDecCoinsFromCoins(coins...)...)
+	k.distrKeeper.SetFeePool(ctx, feePool)
 }
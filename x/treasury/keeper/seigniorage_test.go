@@ -5,6 +5,8 @@ import (
 	"testing"
 
 	core "github.com/terra-money/core/types"
+	oracletypes "github.com/terra-money/core/x/oracle/types"
+	"github.com/terra-money/core/x/treasury/types"
 
 	"github.com/stretchr/testify/require"
 
@@ -64,3 +66,56 @@ func TestOneRewardWeightSettle(t *testing.T) {
 	require.Equal(t, lunaSupply.Amount, initialLunaSupply.Amount.Sub(burnAmt))
 	require.Equal(t, sdk.ZeroInt(), feePool.CommunityPool.AmountOf(core.MicroLunaDenom).TruncateInt())
 }
+
+func TestSettleSeigniorageDestinations(t *testing.T) {
+	input := CreateTestInput(t)
+
+	params := input.TreasuryKeeper.GetParams(input.Ctx)
+	params.SeigniorageDestinations = []types.SeigniorageDestination{
+		{Type: types.SeigniorageDestinationBurn, Weight: sdk.NewDecWithPrec(4, 1)},
+		{Type: types.SeigniorageDestinationOracle, Weight: sdk.NewDecWithPrec(3, 1)},
+		{Type: types.SeigniorageDestinationModule, ModuleName: types.BurnModuleName, Weight: sdk.NewDecWithPrec(1, 1)},
+		{Type: types.SeigniorageDestinationModule, ModuleName: "unregistered", Weight: sdk.NewDecWithPrec(1, 1)},
+		{Type: types.SeigniorageDestinationCommunityPool, Weight: sdk.NewDecWithPrec(1, 1)},
+	}
+	input.TreasuryKeeper.SetParams(input.Ctx, params)
+
+	burnAcc := input.AccountKeeper.GetModuleAddress(types.BurnModuleName)
+	oracleAcc := input.AccountKeeper.GetModuleAddress(oracletypes.ModuleName)
+	burnAccBalance := input.BankKeeper.GetBalance(input.Ctx, burnAcc, core.MicroLunaDenom)
+	oracleBalance := input.BankKeeper.GetBalance(input.Ctx, oracleAcc, core.MicroLunaDenom)
+
+	input.TreasuryKeeper.RecordEpochInitialIssuance(input.Ctx)
+	seigniorageAmt := sdk.NewInt(1001)
+	err := input.BankKeeper.BurnCoins(input.Ctx, faucetAccountName, sdk.NewCoins(sdk.NewCoin(core.MicroLunaDenom, seigniorageAmt)))
+	require.NoError(t, err)
+	supply := input.BankKeeper.GetSupply(input.Ctx, core.MicroLunaDenom)
+
+	input.Ctx = input.Ctx.WithEventManager(sdk.NewEventManager())
+	settledAmt, shares := input.TreasuryKeeper.SettleSeigniorage(input.Ctx)
+	require.Equal(t, seigniorageAmt, settledAmt)
+	require.Len(t, shares, 5)
+
+	// truncation remainder goes to the last destination
+	require.Equal(t, sdk.NewInt(400), shares[0].Amount)
+	require.Equal(t, sdk.NewInt(300), shares[1].Amount)
+	require.Equal(t, sdk.NewInt(100), shares[2].Amount)
+	require.Equal(t, sdk.NewInt(100), shares[3].Amount)
+	require.Equal(t, sdk.NewInt(101), shares[4].Amount)
+
+	require.Equal(t, supply.Amount.Add(seigniorageAmt).Sub(sdk.NewInt(400)), input.BankKeeper.GetSupply(input.Ctx, core.MicroLunaDenom).Amount)
+	require.Equal(t, oracleBalance.Amount.AddRaw(300), input.BankKeeper.GetBalance(input.Ctx, oracleAcc, core.MicroLunaDenom).Amount)
+	require.Equal(t, burnAccBalance.Amount.AddRaw(100), input.BankKeeper.GetBalance(input.Ctx, burnAcc, core.MicroLunaDenom).Amount)
+
+	// the share of an unregistered module account goes to the community pool
+	feePool := input.DistrKeeper.GetFeePool(input.Ctx)
+	require.Equal(t, sdk.NewInt(201), feePool.CommunityPool.AmountOf(core.MicroLunaDenom).TruncateInt())
+
+	numEvents := 0
+	for _, event := range input.Ctx.EventManager().Events() {
+		if event.Type == types.EventTypeSeigniorageSettle {
+			numEvents++
+		}
+	}
+	require.Equal(t, 5, numEvents)
+}
@@ -0,0 +1,121 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/terra-money/core/x/treasury/types"
+)
+
+// SetTaxExemptionZone assigns the address to the given tax exemption zone
+func (k Keeper) SetTaxExemptionZone(ctx sdk.Context, addr sdk.AccAddress, zone string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetTaxExemptionKey(addr), []byte(zone))
+}
+
+// GetTaxExemptionZone returns the tax exemption zone of the address
+func (k Keeper) GetTaxExemptionZone(ctx sdk.Context, addr sdk.AccAddress) (zone string, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetTaxExemptionKey(addr))
+	if bz == nil {
+		return "", false
+	}
+
+	return string(bz), true
+}
+
+// DeleteTaxExemptionZone removes the address from its tax exemption zone
+func (k Keeper) DeleteTaxExemptionZone(ctx sdk.Context, addr sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetTaxExemptionKey(addr))
+}
+
+// IterateTaxExemptionZones iterates all tax exempt addresses with their zones
+func (k Keeper) IterateTaxExemptionZones(ctx sdk.Context, handler func(addr sdk.AccAddress, zone string) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.TaxExemptionKey)
+
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		// key = prefix | addr length (1 byte) | addr
+		addr := sdk.AccAddress(iter.Key()[len(types.TaxExemptionKey)+1:])
+		if handler(addr, string(iter.Value())) {
+			break
+		}
+	}
+}
+
+// IsTaxExempt returns true when all the given addresses belong
+// to the same tax exemption zone
+func (k Keeper) IsTaxExempt(ctx sdk.Context, addresses ...string) bool {
+	if len(addresses) == 0 {
+		return false
+	}
+
+	var zone string
+	for i, bech32Addr := range addresses {
+		addr, err := sdk.AccAddressFromBech32(bech32Addr)
+		if err != nil {
+			return false
+		}
+
+		addrZone, found := k.GetTaxExemptionZone(ctx, addr)
+		if !found {
+			return false
+		}
+
+		if i == 0 {
+			zone = addrZone
+		} else if zone != addrZone {
+			return false
+		}
+	}
+
+	return true
+}
+
+// HandleAddTaxExemptionProposal is a handler for executing a passed add tax exemption proposal
+func HandleAddTaxExemptionProposal(ctx sdk.Context, k Keeper, p *types.AddTaxExemptionProposal) error {
+	for _, bech32Addr := range p.Addresses {
+		addr, err := sdk.AccAddressFromBech32(bech32Addr)
+		if err != nil {
+			return err
+		}
+
+		k.SetTaxExemptionZone(ctx, addr, p.Zone)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeAddTaxExemption,
+				sdk.NewAttribute(types.AttributeKeyAddress, bech32Addr),
+				sdk.NewAttribute(types.AttributeKeyZone, p.Zone),
+			),
+		)
+	}
+
+	k.Logger(ctx).Info("added tax exemptions", "zone", p.Zone, "addresses", p.Addresses)
+
+	return nil
+}
+
+// HandleRemoveTaxExemptionProposal is a handler for executing a passed remove tax exemption proposal
+func HandleRemoveTaxExemptionProposal(ctx sdk.Context, k Keeper, p *types.RemoveTaxExemptionProposal) error {
+	for _, bech32Addr := range p.Addresses {
+		addr, err := sdk.AccAddressFromBech32(bech32Addr)
+		if err != nil {
+			return err
+		}
+
+		k.DeleteTaxExemptionZone(ctx, addr)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeRemoveTaxExemption,
+				sdk.NewAttribute(types.AttributeKeyAddress, bech32Addr),
+			),
+		)
+	}
+
+	k.Logger(ctx).Info("removed tax exemptions", "addresses", p.Addresses)
+
+	return nil
+}
@@ -0,0 +1,65 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/terra-money/core/x/treasury/types"
+)
+
+func TestIsTaxExempt(t *testing.T) {
+	input := CreateTestInput(t)
+
+	input.TreasuryKeeper.SetTaxExemptionZone(input.Ctx, Addrs[0], "exchange")
+	input.TreasuryKeeper.SetTaxExemptionZone(input.Ctx, Addrs[1], "exchange")
+	input.TreasuryKeeper.SetTaxExemptionZone(input.Ctx, Addrs[2], "bridge")
+
+	require.True(t, input.TreasuryKeeper.IsTaxExempt(input.Ctx, Addrs[0].String(), Addrs[1].String()))
+	require.True(t, input.TreasuryKeeper.IsTaxExempt(input.Ctx, Addrs[0].String(), Addrs[0].String()))
+
+	// different zones
+	require.False(t, input.TreasuryKeeper.IsTaxExempt(input.Ctx, Addrs[0].String(), Addrs[2].String()))
+
+	// one party not in any zone
+	outsider := sdk.AccAddress([]byte("outsider____________"))
+	require.False(t, input.TreasuryKeeper.IsTaxExempt(input.Ctx, Addrs[0].String(), outsider.String()))
+
+	// invalid address and empty input
+	require.False(t, input.TreasuryKeeper.IsTaxExempt(input.Ctx, Addrs[0].String(), "invalid"))
+	require.False(t, input.TreasuryKeeper.IsTaxExempt(input.Ctx))
+
+	input.TreasuryKeeper.DeleteTaxExemptionZone(input.Ctx, Addrs[1])
+	require.False(t, input.TreasuryKeeper.IsTaxExempt(input.Ctx, Addrs[0].String(), Addrs[1].String()))
+}
+
+func TestTaxExemptionProposals(t *testing.T) {
+	input := CreateTestInput(t)
+
+	addProposal := types.NewAddTaxExemptionProposal("title", "description", "exchange",
+		[]string{Addrs[0].String(), Addrs[1].String()})
+	require.NoError(t, addProposal.ValidateBasic())
+	require.NoError(t, HandleAddTaxExemptionProposal(input.Ctx, input.TreasuryKeeper, addProposal))
+
+	zones := map[string]string{}
+	input.TreasuryKeeper.IterateTaxExemptionZones(input.Ctx, func(addr sdk.AccAddress, zone string) bool {
+		zones[addr.String()] = zone
+		return false
+	})
+	require.Equal(t, map[string]string{
+		Addrs[0].String(): "exchange",
+		Addrs[1].String(): "exchange",
+	}, zones)
+
+	removeProposal := types.NewRemoveTaxExemptionProposal("title", "description", []string{Addrs[0].String()})
+	require.NoError(t, removeProposal.ValidateBasic())
+	require.NoError(t, HandleRemoveTaxExemptionProposal(input.Ctx, input.TreasuryKeeper, removeProposal))
+
+	_, found := input.TreasuryKeeper.GetTaxExemptionZone(input.Ctx, Addrs[0])
+	require.False(t, found)
+	zone, found := input.TreasuryKeeper.GetTaxExemptionZone(input.Ctx, Addrs[1])
+	require.True(t, found)
+	require.Equal(t, "exchange", zone)
+}
@@ -0,0 +1,145 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/terra-money/core/x/treasury/types"
+)
+
+// SplitTaxProceeds computes the portions of the given tax proceeds
+// to be burned and sent to the community pool. The rest is left to stakers.
+func (k Keeper) SplitTaxProceeds(ctx sdk.Context, taxes sdk.Coins) (burnCoins, communityPoolCoins sdk.Coins) {
+	burnSplit := k.BurnTaxSplit(ctx)
+	communityPoolSplit := k.CommunityPoolTaxSplit(ctx)
+
+	// the splits can be changed one at a time by governance, so their sum is not
+	// guaranteed by the param validation; the burn takes precedence when it exceeds 1
+	if burnSplit.Add(communityPoolSplit).GT(sdk.OneDec()) {
+		communityPoolSplit = sdk.OneDec().Sub(burnSplit)
+	}
+
+	burnCoins = sdk.Coins{}
+	communityPoolCoins = sdk.Coins{}
+	for _, coin := range taxes {
+		burnAmt := burnSplit.MulInt(coin.Amount).TruncateInt()
+		if burnAmt.IsPositive() {
+			burnCoins = burnCoins.Add(sdk.NewCoin(coin.Denom, burnAmt))
+		}
+
+		communityPoolAmt := communityPoolSplit.MulInt(coin.Amount).TruncateInt()
+		if communityPoolAmt.IsPositive() {
+			communityPoolCoins = communityPoolCoins.Add(sdk.NewCoin(coin.Denom, communityPoolAmt))
+		}
+	}
+
+	return
+}
+
+// SettleTaxProceeds moves the tax proceeds recorded since the last block out of
+// the fee collector; the burn portion to the burn module account and the community
+// pool portion to the distribution module. It must run before the distribution
+// module allocates the collected fees.
+func (k Keeper) SettleTaxProceeds(ctx sdk.Context) {
+	burnCoins := k.PeekPendingTaxBurn(ctx)
+	communityPoolCoins := k.PeekPendingTaxCommunityPool(ctx)
+	if burnCoins.IsZero() && communityPoolCoins.IsZero() {
+		return
+	}
+
+	if !burnCoins.IsZero() {
+		if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, authtypes.FeeCollectorName, types.BurnModuleName, burnCoins); err != nil {
+			panic(err)
+		}
+	}
+
+	if !communityPoolCoins.IsZero() {
+		if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, authtypes.FeeCollectorName, k.distributionModuleName, communityPoolCoins); err != nil {
+			panic(err)
+		}
+
+		// Update distribution community pool
+		feePool := k.distrKeeper.GetFeePool(ctx)
+		feePool.CommunityPool = feePool.CommunityPool.Add(sdk.NewDecCoinsFromCoins(communityPoolCoins...)...)
+		k.distrKeeper.SetFeePool(ctx, feePool)
+	}
+
+	k.SetPendingTaxBurn(ctx, sdk.Coins{})
+	k.SetPendingTaxCommunityPool(ctx, sdk.Coins{})
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.EventTypeTaxProceedsSplit,
+			sdk.NewAttribute(types.AttributeKeyBurn, burnCoins.String()),
+			sdk.NewAttribute(types.AttributeKeyCommunityPool, communityPoolCoins.String()),
+		),
+	)
+}
+
+// SetEpochBurnedTaxProceeds stores the burned portion of the epoch tax proceeds
+func (k Keeper) SetEpochBurnedTaxProceeds(ctx sdk.Context, coins sdk.Coins) {
+	k.setCoins(ctx, types.BurnedTaxProceedsKey, coins)
+}
+
+// PeekEpochBurnedTaxProceeds returns the burned portion of the epoch tax proceeds
+func (k Keeper) PeekEpochBurnedTaxProceeds(ctx sdk.Context) sdk.Coins {
+	return k.getCoins(ctx, types.BurnedTaxProceedsKey)
+}
+
+// SetEpochCommunityPoolTaxProceeds stores the community pool portion of the epoch tax proceeds
+func (k Keeper) SetEpochCommunityPoolTaxProceeds(ctx sdk.Context, coins sdk.Coins) {
+	k.setCoins(ctx, types.CommunityPoolTaxProceedsKey, coins)
+}
+
+// PeekEpochCommunityPoolTaxProceeds returns the community pool portion of the epoch tax proceeds
+func (k Keeper) PeekEpochCommunityPoolTaxProceeds(ctx sdk.Context) sdk.Coins {
+	return k.getCoins(ctx, types.CommunityPoolTaxProceedsKey)
+}
+
+// SetPendingTaxBurn stores the tax proceeds to be burned at the next begin block
+func (k Keeper) SetPendingTaxBurn(ctx sdk.Context, coins sdk.Coins) {
+	k.setCoins(ctx, types.PendingTaxBurnKey, coins)
+}
+
+// PeekPendingTaxBurn returns the tax proceeds to be burned at the next begin block
+func (k Keeper) PeekPendingTaxBurn(ctx sdk.Context) sdk.Coins {
+	return k.getCoins(ctx, types.PendingTaxBurnKey)
+}
+
+// SetPendingTaxCommunityPool stores the tax proceeds to be sent to the community pool at the next begin block
+func (k Keeper) SetPendingTaxCommunityPool(ctx sdk.Context, coins sdk.Coins) {
+	k.setCoins(ctx, types.PendingTaxCommunityPoolKey, coins)
+}
+
+// PeekPendingTaxCommunityPool returns the tax proceeds to be sent to the community pool at the next begin block
+func (k Keeper) PeekPendingTaxCommunityPool(ctx sdk.Context) sdk.Coins {
+	return k.getCoins(ctx, types.PendingTaxCommunityPoolKey)
+}
+
+// PeekEpochTaxRewards returns the portion of the epoch tax proceeds left to stakers
+func (k Keeper) PeekEpochTaxRewards(ctx sdk.Context) sdk.Coins {
+	taxRewards, hasNeg := k.PeekEpochTaxProceeds(ctx).
+		SafeSub(k.PeekEpochBurnedTaxProceeds(ctx).Add(k.PeekEpochCommunityPoolTaxProceeds(ctx)...))
+	if hasNeg {
+		return sdk.Coins{}
+	}
+
+	return taxRewards
+}
+
+func (k Keeper) setCoins(ctx sdk.Context, key []byte, coins sdk.Coins) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&types.EpochTaxProceeds{TaxProceeds: coins})
+	store.Set(key, bz)
+}
+
+func (k Keeper) getCoins(ctx sdk.Context, key []byte) sdk.Coins {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(key)
+	if bz == nil {
+		return sdk.Coins{}
+	}
+
+	proceeds := types.EpochTaxProceeds{}
+	k.cdc.MustUnmarshal(bz, &proceeds)
+	return proceeds.TaxProceeds
+}
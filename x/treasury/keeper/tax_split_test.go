@@ -0,0 +1,76 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	core "github.com/terra-money/core/types"
+	"github.com/terra-money/core/x/treasury/types"
+)
+
+func TestSettleTaxProceeds(t *testing.T) {
+	input := CreateTestInput(t)
+
+	params := input.TreasuryKeeper.GetParams(input.Ctx)
+	params.BurnTaxSplit = sdk.NewDecWithPrec(2, 1)
+	params.CommunityPoolTaxSplit = sdk.NewDecWithPrec(1, 1)
+	input.TreasuryKeeper.SetParams(input.Ctx, params)
+
+	// taxes collected by the fee collector
+	taxes := sdk.NewCoins(sdk.NewInt64Coin(core.MicroSDRDenom, 1000), sdk.NewInt64Coin(core.MicroKRWDenom, 15))
+	require.NoError(t, input.BankKeeper.MintCoins(input.Ctx, faucetAccountName, taxes))
+	require.NoError(t, input.BankKeeper.SendCoinsFromModuleToModule(input.Ctx, faucetAccountName, authtypes.FeeCollectorName, taxes))
+	input.TreasuryKeeper.RecordEpochTaxProceeds(input.Ctx, taxes)
+
+	expectedBurn := sdk.NewCoins(sdk.NewInt64Coin(core.MicroSDRDenom, 200), sdk.NewInt64Coin(core.MicroKRWDenom, 3))
+	expectedCommunityPool := sdk.NewCoins(sdk.NewInt64Coin(core.MicroSDRDenom, 100), sdk.NewInt64Coin(core.MicroKRWDenom, 1))
+	expectedRewards := sdk.NewCoins(sdk.NewInt64Coin(core.MicroSDRDenom, 700), sdk.NewInt64Coin(core.MicroKRWDenom, 11))
+
+	require.Equal(t, taxes, input.TreasuryKeeper.PeekEpochTaxProceeds(input.Ctx))
+	require.Equal(t, expectedBurn, input.TreasuryKeeper.PeekEpochBurnedTaxProceeds(input.Ctx))
+	require.Equal(t, expectedCommunityPool, input.TreasuryKeeper.PeekEpochCommunityPoolTaxProceeds(input.Ctx))
+	require.Equal(t, expectedRewards, input.TreasuryKeeper.PeekEpochTaxRewards(input.Ctx))
+
+	input.TreasuryKeeper.SettleTaxProceeds(input.Ctx)
+
+	burnAddr := input.AccountKeeper.GetModuleAddress(types.BurnModuleName)
+	for _, coin := range expectedBurn {
+		require.Equal(t, coin, input.BankKeeper.GetBalance(input.Ctx, burnAddr, coin.Denom))
+	}
+
+	feePool := input.DistrKeeper.GetFeePool(input.Ctx)
+	require.Equal(t, sdk.NewDecCoinsFromCoins(expectedCommunityPool...), feePool.CommunityPool)
+
+	feeCollectorAddr := input.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	require.Equal(t, expectedRewards, input.BankKeeper.GetAllBalances(input.Ctx, feeCollectorAddr))
+
+	// pending amounts are settled only once
+	input.TreasuryKeeper.SettleTaxProceeds(input.Ctx)
+	require.Equal(t, expectedRewards, input.BankKeeper.GetAllBalances(input.Ctx, feeCollectorAddr))
+}
+
+func TestSplitTaxProceedsExceedingSum(t *testing.T) {
+	input := CreateTestInput(t)
+
+	// each split is changed on its own by governance, so the sum can exceed 1
+	input.TreasuryKeeper.paramSpace.Set(input.Ctx, types.KeyBurnTaxSplit, sdk.NewDecWithPrec(8, 1))
+	input.TreasuryKeeper.paramSpace.Set(input.Ctx, types.KeyCommunityPoolTaxSplit, sdk.NewDecWithPrec(5, 1))
+
+	taxes := sdk.NewCoins(sdk.NewInt64Coin(core.MicroSDRDenom, 1000))
+	burnCoins, communityPoolCoins := input.TreasuryKeeper.SplitTaxProceeds(input.Ctx, taxes)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(core.MicroSDRDenom, 800)), burnCoins)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(core.MicroSDRDenom, 200)), communityPoolCoins)
+
+	require.NoError(t, input.BankKeeper.MintCoins(input.Ctx, faucetAccountName, taxes))
+	require.NoError(t, input.BankKeeper.SendCoinsFromModuleToModule(input.Ctx, faucetAccountName, authtypes.FeeCollectorName, taxes))
+	input.TreasuryKeeper.RecordEpochTaxProceeds(input.Ctx, taxes)
+
+	// the fee collector is never over-withdrawn
+	require.NotPanics(t, func() { input.TreasuryKeeper.SettleTaxProceeds(input.Ctx) })
+	feeCollectorAddr := input.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	require.True(t, input.BankKeeper.GetAllBalances(input.Ctx, feeCollectorAddr).IsZero())
+}
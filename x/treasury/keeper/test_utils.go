@@ -124,6 +124,13 @@ type TestInput struct {
 	StakingKeeper  stakingkeeper.Keeper
 	MarketKeeper   types.MarketKeeper
 	OracleKeeper   types.OracleKeeper
+
+	tKeyParams sdk.StoreKey
+}
+
+// ResetParamChanges discards the record of the params set in the block, as a commit does
+func (input TestInput) ResetParamChanges() {
+	input.Ctx.MultiStore().(sdk.CommitMultiStore).GetCommitKVStore(input.tKeyParams).Commit()
 }
 
 // CreateTestInput nolint
@@ -264,6 +271,8 @@ func CreateTestInput(t *testing.T) TestInput {
 		accountKeeper,
 		bankKeeper,
 		oracleKeeper,
+		distrKeeper,
+		distrtypes.ModuleName,
 	)
 	marketKeeper.SetParams(ctx, markettypes.DefaultParams())
 
@@ -281,7 +290,7 @@ func CreateTestInput(t *testing.T) TestInput {
 
 	treasuryKeeper.SetParams(ctx, types.DefaultParams())
 
-	return TestInput{ctx, legacyAmino, treasuryKeeper, accountKeeper, bankKeeper, distrKeeper, stakingKeeper, marketKeeper, oracleKeeper}
+	return TestInput{ctx, legacyAmino, treasuryKeeper, accountKeeper, bankKeeper, distrKeeper, stakingKeeper, marketKeeper, oracleKeeper, tKeyParams}
 }
 
 // NewTestMsgCreateValidator test msg creator
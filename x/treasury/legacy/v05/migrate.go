@@ -48,6 +48,8 @@ func Migrate(
 			TaxReward:         treasuryGenState.TRs[i],
 			SeigniorageReward: treasuryGenState.SRs[i],
 			TotalStakedLuna:   treasuryGenState.TSLs[i],
+			TaxRate:           sdk.ZeroDec(), // not recorded in v04
+			RewardWeight:      sdk.ZeroDec(),
 		}
 	}
 
@@ -71,11 +73,19 @@ func Migrate(
 				Cap:           treasuryGenState.Params.RewardPolicy.Cap,
 				ChangeRateMax: sdk.ZeroDec(),
 			},
-			MiningIncrement:         treasuryGenState.Params.MiningIncrement,
-			SeigniorageBurdenTarget: treasuryGenState.Params.SeigniorageBurdenTarget,
-			WindowShort:             uint64(treasuryGenState.Params.WindowShort),
-			WindowLong:              uint64(treasuryGenState.Params.WindowLong),
-			WindowProbation:         uint64(treasuryGenState.Params.WindowProbation),
+			MiningIncrement:          treasuryGenState.Params.MiningIncrement,
+			SeigniorageBurdenTarget:  treasuryGenState.Params.SeigniorageBurdenTarget,
+			WindowShort:              uint64(treasuryGenState.Params.WindowShort),
+			WindowLong:               uint64(treasuryGenState.Params.WindowLong),
+			WindowProbation:          uint64(treasuryGenState.Params.WindowProbation),
+			BurnTaxSplit:             v05treasury.DefaultBurnTaxSplit,
+			CommunityPoolTaxSplit:    v05treasury.DefaultCommunityPoolTaxSplit,
+			DenomTaxPolicies:         v05treasury.DefaultDenomTaxPolicies,
+			IndicatorRetentionMargin: v05treasury.DefaultIndicatorRetentionMargin,
+			EpochLength:              v05treasury.DefaultEpochLength,
+			SeigniorageDestinations:  v05treasury.DefaultSeigniorageDestinations,
+			MinGasPrices:             v05treasury.DefaultMinGasPrices,
+			MsgFeeRequirements:       v05treasury.DefaultMsgFeeRequirements,
 		},
 	}
 }
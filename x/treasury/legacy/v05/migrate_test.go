@@ -95,6 +95,11 @@ func TestMigrate(t *testing.T) {
 	// Make sure about:
 	// - EpochState has correct JSON.
 	expected := `{
+	"burned": [],
+	"burned_tax_proceeds": [],
+	"community_pool_tax_proceeds": [],
+	"denom_tax_rates": [],
+	"epoch_burned": [],
 	"epoch_initial_issuance": [
 		{
 			"amount": "100",
@@ -111,26 +116,42 @@ func TestMigrate(t *testing.T) {
 	],
 	"epoch_states": [
 		{
+			"burned": [],
 			"epoch": "0",
+			"reward_weight": "0.000000000000000000",
 			"seigniorage_reward": "100.000000000000000000",
+			"tax_rate": "0.000000000000000000",
 			"tax_reward": "100.000000000000000000",
 			"total_staked_luna": "100"
 		},
 		{
+			"burned": [],
 			"epoch": "1",
+			"reward_weight": "0.000000000000000000",
 			"seigniorage_reward": "200.000000000000000000",
+			"tax_rate": "0.000000000000000000",
 			"tax_reward": "200.000000000000000000",
 			"total_staked_luna": "200"
 		},
 		{
+			"burned": [],
 			"epoch": "2",
+			"reward_weight": "0.000000000000000000",
 			"seigniorage_reward": "300.000000000000000000",
+			"tax_rate": "0.000000000000000000",
 			"tax_reward": "300.000000000000000000",
 			"total_staked_luna": "300"
 		}
 	],
 	"params": {
+		"burn_tax_split": "0.000000000000000000",
+		"community_pool_tax_split": "0.000000000000000000",
+		"denom_tax_policies": [],
+		"epoch_length": "100800",
+		"indicator_retention_margin": "4",
+		"min_gas_prices": [],
 		"mining_increment": "1.070000000000000000",
+		"msg_fee_requirements": [],
 		"reward_policy": {
 			"cap": {
 				"amount": "0",
@@ -141,6 +162,7 @@ func TestMigrate(t *testing.T) {
 			"rate_min": "0.000000000000000000"
 		},
 		"seigniorage_burden_target": "0.670000000000000000",
+		"seigniorage_destinations": [],
 		"tax_policy": {
 			"cap": {
 				"amount": "1000000",
@@ -154,6 +176,8 @@ func TestMigrate(t *testing.T) {
 		"window_probation": "18",
 		"window_short": "4"
 	},
+	"pending_tax_burn": [],
+	"pending_tax_community_pool": [],
 	"reward_weight": "1.000000000000000000",
 	"tax_caps": [
 		{
@@ -165,6 +189,7 @@ func TestMigrate(t *testing.T) {
 			"tax_cap": "100"
 		}
 	],
+	"tax_exemptions": [],
 	"tax_proceeds": [
 		{
 			"amount": "100",
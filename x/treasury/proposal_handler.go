@@ -0,0 +1,26 @@
+package treasury
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/terra-money/core/x/treasury/keeper"
+	"github.com/terra-money/core/x/treasury/types"
+)
+
+// NewProposalHandler creates a new handler for treasury governance proposals
+func NewProposalHandler(k keeper.Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		switch c := content.(type) {
+		case *types.AddTaxExemptionProposal:
+			return keeper.HandleAddTaxExemptionProposal(ctx, k, c)
+
+		case *types.RemoveTaxExemptionProposal:
+			return keeper.HandleRemoveTaxExemptionProposal(ctx, k, c)
+
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized treasury proposal content type: %T", c)
+		}
+	}
+}
@@ -4,6 +4,8 @@ import (
 	"bytes"
 	"fmt"
 
+	gogotypes "github.com/gogo/protobuf/types"
+
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/kv"
@@ -56,6 +58,35 @@ func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
 			cdc.MustUnmarshal(kvA.Value, &TotalStakedLunaA)
 			cdc.MustUnmarshal(kvB.Value, &TotalStakedLunaB)
 			return fmt.Sprintf("%v\n%v", TotalStakedLunaA, TotalStakedLunaB)
+		case bytes.Equal(kvA.Key[:1], types.TaxExemptionKey):
+			return fmt.Sprintf("%v\n%v", string(kvA.Value), string(kvB.Value))
+		case bytes.Equal(kvA.Key[:1], types.BurnedTaxProceedsKey),
+			bytes.Equal(kvA.Key[:1], types.CommunityPoolTaxProceedsKey),
+			bytes.Equal(kvA.Key[:1], types.PendingTaxBurnKey),
+			bytes.Equal(kvA.Key[:1], types.PendingTaxCommunityPoolKey),
+			bytes.Equal(kvA.Key[:1], types.BurnedKey),
+			bytes.Equal(kvA.Key[:1], types.EpochBurnedKey):
+			var taxProceedsA, taxProceedsB types.EpochTaxProceeds
+			cdc.MustUnmarshal(kvA.Value, &taxProceedsA)
+			cdc.MustUnmarshal(kvB.Value, &taxProceedsB)
+			return fmt.Sprintf("%v\n%v", taxProceedsA.TaxProceeds, taxProceedsB.TaxProceeds)
+		case bytes.Equal(kvA.Key[:1], types.DenomTaxRateKey),
+			bytes.Equal(kvA.Key[:1], types.HistoricalTaxRateKey),
+			bytes.Equal(kvA.Key[:1], types.HistoricalRewardWeightKey):
+			var rateA, rateB sdk.DecProto
+			cdc.MustUnmarshal(kvA.Value, &rateA)
+			cdc.MustUnmarshal(kvB.Value, &rateB)
+			return fmt.Sprintf("%v\n%v", rateA, rateB)
+		case bytes.Equal(kvA.Key[:1], types.AppliedEpochLengthKey):
+			var epochLengthA, epochLengthB gogotypes.UInt64Value
+			cdc.MustUnmarshal(kvA.Value, &epochLengthA)
+			cdc.MustUnmarshal(kvB.Value, &epochLengthB)
+			return fmt.Sprintf("%v\n%v", epochLengthA.Value, epochLengthB.Value)
+		case bytes.Equal(kvA.Key[:1], types.EpochStartHeightKey):
+			var heightA, heightB gogotypes.Int64Value
+			cdc.MustUnmarshal(kvA.Value, &heightA)
+			cdc.MustUnmarshal(kvB.Value, &heightB)
+			return fmt.Sprintf("%v\n%v", heightA.Value, heightB.Value)
 		default:
 			panic(fmt.Sprintf("invalid oracle key prefix %X", kvA.Key[:1]))
 		}
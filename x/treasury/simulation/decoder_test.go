@@ -6,6 +6,8 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	gogotypes "github.com/gogo/protobuf/types"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/kv"
 
@@ -27,6 +29,9 @@ func TestDecodeDistributionStore(t *testing.T) {
 	TR := sdk.NewDecWithPrec(123, 2)
 	SR := sdk.NewDecWithPrec(43523, 4)
 	TSL := sdk.NewInt(1245213)
+	epochLength := uint64(core.BlocksPerDay)
+	epochStartHeight := int64(3 * core.BlocksPerDay)
+	burned := sdk.NewCoins(sdk.NewInt64Coin(core.MicroLunaDenom, 3412))
 
 	kvPairs := kv.Pairs{
 		Pairs: []kv.Pair{
@@ -38,6 +43,12 @@ func TestDecodeDistributionStore(t *testing.T) {
 			{Key: types.TRKey, Value: cdc.MustMarshal(&sdk.DecProto{Dec: TR})},
 			{Key: types.SRKey, Value: cdc.MustMarshal(&sdk.DecProto{Dec: SR})},
 			{Key: types.TSLKey, Value: cdc.MustMarshal(&sdk.IntProto{Int: TSL})},
+			{Key: types.HistoricalTaxRateKey, Value: cdc.MustMarshal(&sdk.DecProto{Dec: taxRate})},
+			{Key: types.HistoricalRewardWeightKey, Value: cdc.MustMarshal(&sdk.DecProto{Dec: rewardWeight})},
+			{Key: types.AppliedEpochLengthKey, Value: cdc.MustMarshal(&gogotypes.UInt64Value{Value: epochLength})},
+			{Key: types.BurnedKey, Value: cdc.MustMarshal(&types.EpochTaxProceeds{TaxProceeds: burned})},
+			{Key: types.EpochBurnedKey, Value: cdc.MustMarshal(&types.EpochTaxProceeds{TaxProceeds: burned})},
+			{Key: types.EpochStartHeightKey, Value: cdc.MustMarshal(&gogotypes.Int64Value{Value: epochStartHeight})},
 			{Key: []byte{0x99}, Value: []byte{0x99}},
 		},
 	}
@@ -54,6 +65,12 @@ func TestDecodeDistributionStore(t *testing.T) {
 		{"TR", fmt.Sprintf("%v\n%v", TR, TR)},
 		{"SR", fmt.Sprintf("%v\n%v", SR, SR)},
 		{"TSL", fmt.Sprintf("%v\n%v", TSL, TSL)},
+		{"HistoricalTaxRate", fmt.Sprintf("%v\n%v", taxRate, taxRate)},
+		{"HistoricalRewardWeight", fmt.Sprintf("%v\n%v", rewardWeight, rewardWeight)},
+		{"AppliedEpochLength", fmt.Sprintf("%v\n%v", epochLength, epochLength)},
+		{"Burned", fmt.Sprintf("%v\n%v", burned, burned)},
+		{"EpochBurned", fmt.Sprintf("%v\n%v", burned, burned)},
+		{"EpochStartHeight", fmt.Sprintf("%v\n%v", epochStartHeight, epochStartHeight)},
 		{"other", ""},
 	}
 
@@ -16,13 +16,18 @@ import (
 
 // Simulation parameter constants
 const (
-	taxPolicyKey               = "tax_policy"
-	rewardPolicyKey            = "reward_policy"
-	seigniorageBurdenTargetKey = "seigniorage_burden_target"
-	miningIncrementKey         = "mining_increment"
-	windowShortKey             = "window_short"
-	windowLongKey              = "window_long"
-	windowProbationKey         = "window_probation"
+	taxPolicyKey                = "tax_policy"
+	rewardPolicyKey             = "reward_policy"
+	seigniorageBurdenTargetKey  = "seigniorage_burden_target"
+	miningIncrementKey          = "mining_increment"
+	windowShortKey              = "window_short"
+	windowLongKey               = "window_long"
+	windowProbationKey          = "window_probation"
+	burnTaxSplitKey             = "burn_tax_split"
+	communityPoolTaxSplitKey    = "community_pool_tax_split"
+	indicatorRetentionMarginKey = "indicator_retention_margin"
+	epochLengthKey              = "epoch_length"
+	seigniorageDestinationsKey  = "seigniorage_destinations"
 )
 
 // GenTaxPolicy randomized TaxPolicy
@@ -70,6 +75,35 @@ func GenWindowProbation(r *rand.Rand) uint64 {
 	return uint64(1 + r.Intn(6))
 }
 
+// GenBurnTaxSplit randomized BurnTaxSplit
+func GenBurnTaxSplit(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(int64(r.Intn(50)), 2)
+}
+
+// GenCommunityPoolTaxSplit randomized CommunityPoolTaxSplit
+func GenCommunityPoolTaxSplit(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(int64(r.Intn(50)), 2)
+}
+
+// GenIndicatorRetentionMargin randomized IndicatorRetentionMargin
+func GenIndicatorRetentionMargin(r *rand.Rand) uint64 {
+	return uint64(r.Intn(12))
+}
+
+// GenEpochLength randomized EpochLength
+func GenEpochLength(r *rand.Rand) uint64 {
+	return core.BlocksPerDay * uint64(1+r.Intn(7))
+}
+
+// GenSeigniorageDestinations randomized SeigniorageDestinations
+func GenSeigniorageDestinations(r *rand.Rand) []types.SeigniorageDestination {
+	burnWeight := sdk.NewDecWithPrec(int64(r.Intn(101)), 2)
+	return []types.SeigniorageDestination{
+		{Type: types.SeigniorageDestinationBurn, Weight: burnWeight},
+		{Type: types.SeigniorageDestinationCommunityPool, Weight: sdk.OneDec().Sub(burnWeight)},
+	}
+}
+
 // RandomizedGenState generates a random GenesisState for gov
 func RandomizedGenState(simState *module.SimulationState) {
 
@@ -115,15 +149,50 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { windowProbation = GenWindowProbation(r) },
 	)
 
+	var burnTaxSplit sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, burnTaxSplitKey, &burnTaxSplit, simState.Rand,
+		func(r *rand.Rand) { burnTaxSplit = GenBurnTaxSplit(r) },
+	)
+
+	var communityPoolTaxSplit sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, communityPoolTaxSplitKey, &communityPoolTaxSplit, simState.Rand,
+		func(r *rand.Rand) { communityPoolTaxSplit = GenCommunityPoolTaxSplit(r) },
+	)
+
+	var indicatorRetentionMargin uint64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, indicatorRetentionMarginKey, &indicatorRetentionMargin, simState.Rand,
+		func(r *rand.Rand) { indicatorRetentionMargin = GenIndicatorRetentionMargin(r) },
+	)
+
+	var epochLength uint64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, epochLengthKey, &epochLength, simState.Rand,
+		func(r *rand.Rand) { epochLength = GenEpochLength(r) },
+	)
+
+	var seigniorageDestinations []types.SeigniorageDestination
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, seigniorageDestinationsKey, &seigniorageDestinations, simState.Rand,
+		func(r *rand.Rand) { seigniorageDestinations = GenSeigniorageDestinations(r) },
+	)
+
 	treasuryGenesis := types.NewGenesisState(
 		types.Params{
-			TaxPolicy:               taxPolicy,
-			RewardPolicy:            rewardPolicy,
-			SeigniorageBurdenTarget: seigniorageBurdenTarget,
-			MiningIncrement:         miningIncrement,
-			WindowShort:             windowShort,
-			WindowLong:              windowLong,
-			WindowProbation:         windowProbation,
+			TaxPolicy:                taxPolicy,
+			RewardPolicy:             rewardPolicy,
+			SeigniorageBurdenTarget:  seigniorageBurdenTarget,
+			MiningIncrement:          miningIncrement,
+			WindowShort:              windowShort,
+			WindowLong:               windowLong,
+			WindowProbation:          windowProbation,
+			BurnTaxSplit:             burnTaxSplit,
+			CommunityPoolTaxSplit:    communityPoolTaxSplit,
+			IndicatorRetentionMargin: indicatorRetentionMargin,
+			EpochLength:              epochLength,
+			SeigniorageDestinations:  seigniorageDestinations,
 		},
 		taxPolicy.RateMin,
 		rewardPolicy.RateMin,
@@ -131,6 +200,14 @@ func RandomizedGenState(simState *module.SimulationState) {
 		sdk.Coins{},
 		sdk.Coins{},
 		[]types.EpochState{},
+		[]types.TaxExemption{},
+		sdk.Coins{},
+		sdk.Coins{},
+		[]types.DenomTaxRate{},
+		sdk.Coins{},
+		sdk.Coins{},
+		sdk.Coins{},
+		sdk.Coins{},
 	)
 
 	bz, err := json.MarshalIndent(&treasuryGenesis.Params, "", " ")
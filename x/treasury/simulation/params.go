@@ -54,5 +54,25 @@ func ParamChanges(r *rand.Rand) []simtypes.ParamChange {
 				return fmt.Sprintf("\"%d\"", GenWindowProbation(r))
 			},
 		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyBurnTaxSplit),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%s\"", GenBurnTaxSplit(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyCommunityPoolTaxSplit),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%s\"", GenCommunityPoolTaxSplit(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyIndicatorRetentionMargin),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%d\"", GenIndicatorRetentionMargin(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyEpochLength),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%d\"", GenEpochLength(r))
+			},
+		),
 	}
 }
@@ -0,0 +1,47 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	customgovtypes "github.com/terra-money/core/custom/gov/types"
+)
+
+// RegisterLegacyAminoCodec registers the necessary x/treasury interfaces and concrete types
+// on the provided LegacyAmino codec. These types are used for Amino JSON serialization.
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&AddTaxExemptionProposal{}, "treasury/AddTaxExemptionProposal", nil)
+	cdc.RegisterConcrete(&RemoveTaxExemptionProposal{}, "treasury/RemoveTaxExemptionProposal", nil)
+}
+
+// RegisterInterfaces registers the x/treasury interfaces types with the interface registry
+func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
+	registry.RegisterImplementations(
+		(*govtypes.Content)(nil),
+		&AddTaxExemptionProposal{},
+		&RemoveTaxExemptionProposal{},
+	)
+}
+
+var (
+	amino = codec.NewLegacyAmino()
+
+	// ModuleCdc references the global x/treasury module codec. Note, the codec should
+	// ONLY be used in certain instances of tests and for JSON encoding as Amino is
+	// still used for that purpose.
+	//
+	// The actual codec used for serialization should be provided to x/treasury and
+	// defined at the application level.
+	ModuleCdc = codec.NewAminoCodec(amino)
+)
+
+func init() {
+	RegisterLegacyAminoCodec(amino)
+	cryptocodec.RegisterCrypto(amino)
+	amino.Seal()
+
+	customgovtypes.RegisterProposalTypeCodec(&AddTaxExemptionProposal{}, "treasury/AddTaxExemptionProposal")
+	customgovtypes.RegisterProposalTypeCodec(&RemoveTaxExemptionProposal{}, "treasury/RemoveTaxExemptionProposal")
+}
@@ -0,0 +1,12 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Treasury errors
+var (
+	ErrEmptyTaxExemptionZone      = sdkerrors.Register(ModuleName, 2, "tax exemption zone cannot be empty")
+	ErrEmptyTaxExemptionAddresses = sdkerrors.Register(ModuleName, 3, "tax exemption addresses cannot be empty")
+	ErrDuplicateTaxExemption      = sdkerrors.Register(ModuleName, 4, "duplicate tax exemption address")
+)
@@ -5,10 +5,23 @@ const (
 	EventTypePolicyUpdate       = "policy_update"
 	EventTypeTaxRateUpdate      = "tax_rate_update"
 	EventTypeRewardWeightUpdate = "reward_weight_update"
+	EventTypeAddTaxExemption    = "add_tax_exemption"
+	EventTypeRemoveTaxExemption = "remove_tax_exemption"
+	EventTypeTaxProceedsSplit   = "tax_proceeds_split"
+	EventTypeEpochLengthUpdate  = "epoch_length_update"
+	EventTypeSeigniorageSettle  = "seigniorage_settle"
 
-	AttributeKeyTaxRate      = "tax_rate"
-	AttributeKeyRewardWeight = "reward_weight"
-	AttributeKeyTaxCap       = "tax_cap"
+	AttributeKeyTaxRate       = "tax_rate"
+	AttributeKeyRewardWeight  = "reward_weight"
+	AttributeKeyTaxCap        = "tax_cap"
+	AttributeKeyAddress       = "address"
+	AttributeKeyZone          = "zone"
+	AttributeKeyBurn          = "burn"
+	AttributeKeyCommunityPool = "community_pool"
+	AttributeKeyEpochLength   = "epoch_length"
+	AttributeKeyEpoch         = "epoch"
+	AttributeKeyDestination   = "destination"
+	AttributeKeyModule        = "module"
 
 	AttributeValueCategory = ModuleName
 )
@@ -47,3 +47,11 @@ type OracleKeeper interface {
 	SetLunaExchangeRate(ctx sdk.Context, denom string, exchangeRate sdk.Dec)
 	SetWhitelist(ctx sdk.Context, whitelist oracletypes.DenomList)
 }
+
+// TreasuryHooks event hooks for the treasury epoch updates
+type TreasuryHooks interface {
+	// AfterEpochEnd is called at the end of each epoch past the probation period, after the policy update.
+	// It is not called for the epochs ending within WindowProbation, where the policy is not updated
+	// and no seigniorage is settled, so the hooks cannot rely on being called from the first epoch.
+	AfterEpochEnd(ctx sdk.Context, epoch int64, taxRate sdk.Dec, rewardWeight sdk.Dec, taxCaps sdk.Coins, seigniorage sdk.Int)
+}
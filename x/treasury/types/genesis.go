@@ -3,6 +3,7 @@ package types
 import (
 	"encoding/json"
 	"fmt"
+	"strings"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -11,28 +12,47 @@ import (
 // NewGenesisState creates a new GenesisState object
 func NewGenesisState(params Params, taxRate sdk.Dec, rewardWeight sdk.Dec,
 	taxCaps []TaxCap, taxProceeds sdk.Coins, epochInitialIssuance sdk.Coins,
-	epochStates []EpochState) *GenesisState {
+	epochStates []EpochState, taxExemptions []TaxExemption,
+	burnedTaxProceeds sdk.Coins, communityPoolTaxProceeds sdk.Coins,
+	denomTaxRates []DenomTaxRate, burned sdk.Coins, epochBurned sdk.Coins,
+	pendingTaxBurn sdk.Coins, pendingTaxCommunityPool sdk.Coins) *GenesisState {
 	return &GenesisState{
-		Params:               params,
-		TaxRate:              taxRate,
-		RewardWeight:         rewardWeight,
-		TaxCaps:              taxCaps,
-		TaxProceeds:          taxProceeds,
-		EpochInitialIssuance: epochInitialIssuance,
-		EpochStates:          epochStates,
+		Params:                   params,
+		TaxRate:                  taxRate,
+		RewardWeight:             rewardWeight,
+		TaxCaps:                  taxCaps,
+		TaxProceeds:              taxProceeds,
+		EpochInitialIssuance:     epochInitialIssuance,
+		EpochStates:              epochStates,
+		TaxExemptions:            taxExemptions,
+		BurnedTaxProceeds:        burnedTaxProceeds,
+		CommunityPoolTaxProceeds: communityPoolTaxProceeds,
+		DenomTaxRates:            denomTaxRates,
+		Burned:                   burned,
+		EpochBurned:              epochBurned,
+		PendingTaxBurn:           pendingTaxBurn,
+		PendingTaxCommunityPool:  pendingTaxCommunityPool,
 	}
 }
 
 // DefaultGenesisState gets raw genesis raw message for testing
 func DefaultGenesisState() *GenesisState {
 	return &GenesisState{
-		Params:               DefaultParams(),
-		TaxRate:              DefaultTaxRate,
-		RewardWeight:         DefaultRewardWeight,
-		TaxCaps:              []TaxCap{},
-		TaxProceeds:          sdk.Coins{},
-		EpochInitialIssuance: sdk.Coins{},
-		EpochStates:          []EpochState{},
+		Params:                   DefaultParams(),
+		TaxRate:                  DefaultTaxRate,
+		RewardWeight:             DefaultRewardWeight,
+		TaxCaps:                  []TaxCap{},
+		TaxProceeds:              sdk.Coins{},
+		EpochInitialIssuance:     sdk.Coins{},
+		EpochStates:              []EpochState{},
+		TaxExemptions:            []TaxExemption{},
+		BurnedTaxProceeds:        sdk.Coins{},
+		CommunityPoolTaxProceeds: sdk.Coins{},
+		DenomTaxRates:            []DenomTaxRate{},
+		Burned:                   sdk.Coins{},
+		EpochBurned:              sdk.Coins{},
+		PendingTaxBurn:           sdk.Coins{},
+		PendingTaxCommunityPool:  sdk.Coins{},
 	}
 }
 
@@ -48,6 +68,33 @@ func ValidateGenesis(data *GenesisState) error {
 		return fmt.Errorf("reward_weight must less than WeightMax(%s) and bigger than RateMin(%s)", data.Params.RewardPolicy.RateMax, data.Params.RewardPolicy.RateMin)
 	}
 
+	for _, denomTaxRate := range data.DenomTaxRates {
+		if err := sdk.ValidateDenom(denomTaxRate.Denom); err != nil {
+			return fmt.Errorf("invalid denom tax rate denom: %w", err)
+		}
+
+		if denomTaxRate.TaxRate.IsNegative() {
+			return fmt.Errorf("tax rate of %s must be positive: %s", denomTaxRate.Denom, denomTaxRate.TaxRate)
+		}
+	}
+
+	seen := make(map[string]bool, len(data.TaxExemptions))
+	for _, exemption := range data.TaxExemptions {
+		if _, err := sdk.AccAddressFromBech32(exemption.Address); err != nil {
+			return fmt.Errorf("invalid tax exemption address %s: %w", exemption.Address, err)
+		}
+
+		if len(strings.TrimSpace(exemption.Zone)) == 0 {
+			return fmt.Errorf("tax exemption zone for %s cannot be empty", exemption.Address)
+		}
+
+		if seen[exemption.Address] {
+			return fmt.Errorf("duplicate tax exemption address %s", exemption.Address)
+		}
+
+		seen[exemption.Address] = true
+	}
+
 	return data.Params.Validate()
 }
 
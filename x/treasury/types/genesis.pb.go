@@ -27,13 +27,25 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // GenesisState defines the oracle module's genesis state.
 type GenesisState struct {
-	Params               Params                                   `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
-	TaxRate              github_com_cosmos_cosmos_sdk_types.Dec   `protobuf:"bytes,2,opt,name=tax_rate,json=taxRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"tax_rate"`
-	RewardWeight         github_com_cosmos_cosmos_sdk_types.Dec   `protobuf:"bytes,3,opt,name=reward_weight,json=rewardWeight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"reward_weight"`
-	TaxCaps              []TaxCap                                 `protobuf:"bytes,4,rep,name=tax_caps,json=taxCaps,proto3" json:"tax_caps"`
-	TaxProceeds          github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=tax_proceeds,json=taxProceeds,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"tax_proceeds"`
-	EpochInitialIssuance github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=epoch_initial_issuance,json=epochInitialIssuance,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"epoch_initial_issuance"`
-	EpochStates          []EpochState                             `protobuf:"bytes,7,rep,name=epoch_states,json=epochStates,proto3" json:"epoch_states"`
+	Params                   Params                                   `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	TaxRate                  github_com_cosmos_cosmos_sdk_types.Dec   `protobuf:"bytes,2,opt,name=tax_rate,json=taxRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"tax_rate"`
+	RewardWeight             github_com_cosmos_cosmos_sdk_types.Dec   `protobuf:"bytes,3,opt,name=reward_weight,json=rewardWeight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"reward_weight"`
+	TaxCaps                  []TaxCap                                 `protobuf:"bytes,4,rep,name=tax_caps,json=taxCaps,proto3" json:"tax_caps"`
+	TaxProceeds              github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=tax_proceeds,json=taxProceeds,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"tax_proceeds"`
+	EpochInitialIssuance     github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=epoch_initial_issuance,json=epochInitialIssuance,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"epoch_initial_issuance"`
+	EpochStates              []EpochState                             `protobuf:"bytes,7,rep,name=epoch_states,json=epochStates,proto3" json:"epoch_states"`
+	TaxExemptions            []TaxExemption                           `protobuf:"bytes,8,rep,name=tax_exemptions,json=taxExemptions,proto3" json:"tax_exemptions"`
+	BurnedTaxProceeds        github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,9,rep,name=burned_tax_proceeds,json=burnedTaxProceeds,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"burned_tax_proceeds"`
+	CommunityPoolTaxProceeds github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,10,rep,name=community_pool_tax_proceeds,json=communityPoolTaxProceeds,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"community_pool_tax_proceeds"`
+	DenomTaxRates            []DenomTaxRate                           `protobuf:"bytes,11,rep,name=denom_tax_rates,json=denomTaxRates,proto3" json:"denom_tax_rates"`
+	// burned is the cumulative amount burned from the burn module account
+	Burned github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,12,rep,name=burned,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"burned"`
+	// epoch_burned is the amount burned from the burn module account during the current epoch
+	EpochBurned github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,13,rep,name=epoch_burned,json=epochBurned,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"epoch_burned"`
+	// pending_tax_burn is the tax proceeds to be burned at the next begin block
+	PendingTaxBurn github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,14,rep,name=pending_tax_burn,json=pendingTaxBurn,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"pending_tax_burn"`
+	// pending_tax_community_pool is the tax proceeds to be sent to the community pool at the next begin block
+	PendingTaxCommunityPool github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,15,rep,name=pending_tax_community_pool,json=pendingTaxCommunityPool,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"pending_tax_community_pool"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -104,6 +116,108 @@ func (m *GenesisState) GetEpochStates() []EpochState {
 	return nil
 }
 
+func (m *GenesisState) GetTaxExemptions() []TaxExemption {
+	if m != nil {
+		return m.TaxExemptions
+	}
+	return nil
+}
+
+func (m *GenesisState) GetBurnedTaxProceeds() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.BurnedTaxProceeds
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCommunityPoolTaxProceeds() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.CommunityPoolTaxProceeds
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDenomTaxRates() []DenomTaxRate {
+	if m != nil {
+		return m.DenomTaxRates
+	}
+	return nil
+}
+
+func (m *GenesisState) GetBurned() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Burned
+	}
+	return nil
+}
+
+func (m *GenesisState) GetEpochBurned() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.EpochBurned
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPendingTaxBurn() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.PendingTaxBurn
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPendingTaxCommunityPool() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.PendingTaxCommunityPool
+	}
+	return nil
+}
+
+// DenomTaxRate is the tax rate overriding the global tax rate for the given denom
+type DenomTaxRate struct {
+	Denom   string                                 `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	TaxRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=tax_rate,json=taxRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"tax_rate"`
+}
+
+func (m *DenomTaxRate) Reset()         { *m = DenomTaxRate{} }
+func (m *DenomTaxRate) String() string { return proto.CompactTextString(m) }
+func (*DenomTaxRate) ProtoMessage()    {}
+func (*DenomTaxRate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c440a3f50aabab34, []int{1}
+}
+func (m *DenomTaxRate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomTaxRate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomTaxRate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DenomTaxRate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomTaxRate.Merge(m, src)
+}
+func (m *DenomTaxRate) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomTaxRate) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomTaxRate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomTaxRate proto.InternalMessageInfo
+
+func (m *DenomTaxRate) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
 // TaxCap is the max tax amount can be charged for the given denom
 type TaxCap struct {
 	Denom  string                                 `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
@@ -114,7 +228,7 @@ func (m *TaxCap) Reset()         { *m = TaxCap{} }
 func (m *TaxCap) String() string { return proto.CompactTextString(m) }
 func (*TaxCap) ProtoMessage()    {}
 func (*TaxCap) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c440a3f50aabab34, []int{1}
+	return fileDescriptor_c440a3f50aabab34, []int{2}
 }
 func (m *TaxCap) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -156,13 +270,17 @@ type EpochState struct {
 	TaxReward         github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=tax_reward,json=taxReward,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"tax_reward"`
 	SeigniorageReward github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=seigniorage_reward,json=seigniorageReward,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"seigniorage_reward"`
 	TotalStakedLuna   github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=total_staked_luna,json=totalStakedLuna,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"total_staked_luna"`
+	TaxRate           github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=tax_rate,json=taxRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"tax_rate"`
+	RewardWeight      github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=reward_weight,json=rewardWeight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"reward_weight"`
+	// burned is the amount burned from the burn module account during the epoch
+	Burned github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,7,rep,name=burned,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"burned"`
 }
 
 func (m *EpochState) Reset()         { *m = EpochState{} }
 func (m *EpochState) String() string { return proto.CompactTextString(m) }
 func (*EpochState) ProtoMessage()    {}
 func (*EpochState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c440a3f50aabab34, []int{2}
+	return fileDescriptor_c440a3f50aabab34, []int{3}
 }
 func (m *EpochState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -198,8 +316,16 @@ func (m *EpochState) GetEpoch() uint64 {
 	return 0
 }
 
+func (m *EpochState) GetBurned() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Burned
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "terra.treasury.v1beta1.GenesisState")
+	proto.RegisterType((*DenomTaxRate)(nil), "terra.treasury.v1beta1.DenomTaxRate")
 	proto.RegisterType((*TaxCap)(nil), "terra.treasury.v1beta1.TaxCap")
 	proto.RegisterType((*EpochState)(nil), "terra.treasury.v1beta1.EpochState")
 }
@@ -209,42 +335,55 @@ func init() {
 }
 
 var fileDescriptor_c440a3f50aabab34 = []byte{
-	// 558 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0x41, 0x6b, 0x13, 0x4f,
-	0x18, 0xc6, 0xb3, 0x6d, 0x9a, 0xfc, 0x33, 0xc9, 0x1f, 0xe9, 0x10, 0xca, 0xda, 0xc3, 0x26, 0x04,
-	0x95, 0x1c, 0xec, 0xae, 0xd5, 0xab, 0x20, 0x24, 0x6a, 0x09, 0x2a, 0x94, 0x8d, 0x20, 0x14, 0x24,
-	0xbc, 0xd9, 0xbc, 0x6c, 0x86, 0x26, 0x33, 0xcb, 0xcc, 0xc4, 0x26, 0x47, 0xbf, 0x81, 0x9f, 0x43,
-	0xfc, 0x20, 0x3d, 0xf6, 0x28, 0x1e, 0xaa, 0x24, 0x77, 0x3f, 0x83, 0xcc, 0xcc, 0x36, 0xed, 0xc1,
-	0x8a, 0x04, 0x4f, 0xc9, 0xee, 0x3e, 0xf3, 0x7b, 0x9e, 0x7d, 0xdf, 0x87, 0x25, 0xf7, 0x34, 0x4a,
-	0x09, 0x91, 0x96, 0x08, 0x6a, 0x26, 0x17, 0xd1, 0x87, 0xc3, 0x21, 0x6a, 0x38, 0x8c, 0x52, 0xe4,
-	0xa8, 0x98, 0x0a, 0x33, 0x29, 0xb4, 0xa0, 0x7b, 0x56, 0x15, 0x5e, 0xa9, 0xc2, 0x5c, 0xb5, 0x5f,
-	0x4f, 0x45, 0x2a, 0xac, 0x24, 0x32, 0xff, 0x9c, 0x7a, 0xff, 0xfe, 0x2d, 0xcc, 0xf5, 0x71, 0x27,
-	0x0b, 0x12, 0xa1, 0xa6, 0x42, 0x45, 0x43, 0x50, 0xb8, 0xd6, 0x24, 0x82, 0x71, 0xf7, 0xbc, 0xf5,
-	0xb3, 0x48, 0x6a, 0x47, 0x2e, 0x46, 0x5f, 0x83, 0x46, 0xfa, 0x94, 0x94, 0x32, 0x90, 0x30, 0x55,
-	0xbe, 0xd7, 0xf4, 0xda, 0xd5, 0xc7, 0x41, 0xf8, 0xfb, 0x58, 0xe1, 0xb1, 0x55, 0x75, 0x8a, 0xe7,
-	0x97, 0x8d, 0x42, 0x9c, 0x9f, 0xa1, 0x3d, 0xf2, 0x9f, 0x86, 0xf9, 0x40, 0x82, 0x46, 0x7f, 0xab,
-	0xe9, 0xb5, 0x2b, 0x9d, 0xd0, 0x3c, 0xff, 0x76, 0xd9, 0x78, 0x90, 0x32, 0x3d, 0x9e, 0x0d, 0xc3,
-	0x44, 0x4c, 0xa3, 0x3c, 0x93, 0xfb, 0x39, 0x50, 0xa3, 0xd3, 0x48, 0x2f, 0x32, 0x54, 0xe1, 0x73,
-	0x4c, 0xe2, 0xb2, 0x86, 0x79, 0x6c, 0x82, 0xf4, 0xc9, 0xff, 0x12, 0xcf, 0x40, 0x8e, 0x06, 0x67,
-	0xc8, 0xd2, 0xb1, 0xf6, 0xb7, 0x37, 0xe2, 0xd5, 0x1c, 0xe4, 0x9d, 0x65, 0xd0, 0x67, 0x2e, 0x5f,
-	0x02, 0x99, 0xf2, 0x8b, 0xcd, 0xed, 0x3f, 0xbd, 0xdf, 0x5b, 0x98, 0x77, 0x21, 0xcb, 0xdf, 0xcf,
-	0xa4, 0xea, 0x42, 0xa6, 0x28, 0x27, 0x35, 0x03, 0xc8, 0xa4, 0x48, 0x10, 0x47, 0xca, 0xdf, 0xb1,
-	0x90, 0xbb, 0xa1, 0xf3, 0x0e, 0xcd, 0x98, 0xd7, 0x84, 0xae, 0x60, 0xbc, 0xf3, 0xc8, 0x9c, 0xff,
-	0xfc, 0xbd, 0xd1, 0xfe, 0x8b, 0xbc, 0xe6, 0x80, 0x8a, 0xab, 0x1a, 0xe6, 0xc7, 0x39, 0x9f, 0x7e,
-	0xf4, 0xc8, 0x1e, 0x66, 0x22, 0x19, 0x0f, 0x18, 0x67, 0x9a, 0xc1, 0x64, 0xc0, 0x94, 0x9a, 0x01,
-	0x4f, 0xd0, 0x2f, 0xfd, 0x7b, 0xeb, 0xba, 0xb5, 0xea, 0x39, 0xa7, 0x5e, 0x6e, 0x44, 0x5f, 0x91,
-	0x9a, 0x8b, 0xa0, 0x4c, 0x43, 0x94, 0x5f, 0xb6, 0xc6, 0xad, 0xdb, 0x06, 0xf7, 0xc2, 0x68, 0x6d,
-	0x99, 0xf2, 0xe1, 0x55, 0x71, 0x7d, 0x47, 0xb5, 0x52, 0x52, 0x72, 0x93, 0xa5, 0x75, 0xb2, 0x33,
-	0x42, 0x2e, 0xa6, 0xb6, 0x68, 0x95, 0xd8, 0x5d, 0xd0, 0x23, 0x52, 0xce, 0x37, 0xb4, 0x41, 0x81,
-	0x7a, 0x5c, 0xc7, 0x25, 0xb7, 0xaa, 0xd6, 0x97, 0x2d, 0x42, 0xae, 0xa3, 0x18, 0x37, 0x1b, 0xc3,
-	0xba, 0x15, 0x63, 0x77, 0x41, 0xdf, 0x10, 0x62, 0xfb, 0x6a, 0x3b, 0xb2, 0x61, 0x63, 0x2b, 0xa6,
-	0xb1, 0x16, 0x40, 0xdf, 0x13, 0xaa, 0x90, 0xa5, 0x9c, 0x09, 0x09, 0x29, 0x5e, 0x61, 0x37, 0x2b,
-	0xee, 0xee, 0x0d, 0x52, 0x8e, 0x3f, 0x21, 0xbb, 0x5a, 0x68, 0x98, 0x98, 0x45, 0x9c, 0xe2, 0x68,
-	0x30, 0x99, 0x71, 0xf0, 0x8b, 0x1b, 0x4d, 0xe9, 0x8e, 0x05, 0xf5, 0x2d, 0xe7, 0xf5, 0x8c, 0x43,
-	0xe7, 0xe5, 0xf9, 0x32, 0xf0, 0x2e, 0x96, 0x81, 0xf7, 0x63, 0x19, 0x78, 0x9f, 0x56, 0x41, 0xe1,
-	0x62, 0x15, 0x14, 0xbe, 0xae, 0x82, 0xc2, 0xc9, 0xc3, 0x1b, 0x48, 0xbb, 0xf2, 0x83, 0xa9, 0xe0,
-	0xb8, 0x88, 0x12, 0x21, 0x31, 0x9a, 0x5f, 0x7f, 0x81, 0x2c, 0x7c, 0x58, 0xb2, 0xdf, 0x95, 0x27,
-	0xbf, 0x02, 0x00, 0x00, 0xff, 0xff, 0x1f, 0x0b, 0xe8, 0x53, 0xf4, 0x04, 0x00, 0x00,
+	// 760 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0x4f, 0x6b, 0xdb, 0x3c,
+	0x1c, 0x8e, 0xdf, 0xa6, 0x49, 0xa3, 0xa4, 0xed, 0x5b, 0xbd, 0xa5, 0xaf, 0xdf, 0xbe, 0xe0, 0x96,
+	0xd0, 0x8d, 0x1e, 0x56, 0x7b, 0xdd, 0xae, 0x83, 0x41, 0xd2, 0xae, 0x84, 0x6d, 0xd0, 0xb9, 0x81,
+	0x41, 0x61, 0x18, 0xc5, 0x16, 0xae, 0x68, 0x2c, 0x19, 0x4b, 0x5e, 0x13, 0x76, 0xda, 0x6d, 0xec,
+	0xb4, 0xcf, 0xb1, 0xdb, 0xbe, 0x45, 0x8f, 0x3d, 0x8e, 0x1d, 0xba, 0xd1, 0x7e, 0x87, 0x9d, 0x87,
+	0x24, 0x27, 0x71, 0x60, 0x2d, 0x23, 0xb8, 0xa7, 0xc4, 0xf2, 0xa3, 0xe7, 0x79, 0x7e, 0x7f, 0xf4,
+	0x93, 0xc1, 0x96, 0xc0, 0x49, 0x82, 0x1c, 0x91, 0x60, 0xc4, 0xd3, 0x64, 0xe8, 0xbc, 0xdd, 0xed,
+	0x61, 0x81, 0x76, 0x9d, 0x10, 0x53, 0xcc, 0x09, 0xb7, 0xe3, 0x84, 0x09, 0x06, 0xd7, 0x14, 0xca,
+	0x1e, 0xa1, 0xec, 0x0c, 0xb5, 0xbe, 0x1a, 0xb2, 0x90, 0x29, 0x88, 0x23, 0xff, 0x69, 0xf4, 0xfa,
+	0xbd, 0x1b, 0x38, 0xc7, 0xdb, 0x35, 0xcc, 0xf2, 0x19, 0x8f, 0x18, 0x77, 0x7a, 0x88, 0xe3, 0x31,
+	0xc6, 0x67, 0x84, 0xea, 0xf7, 0xcd, 0x9f, 0x75, 0xd0, 0x38, 0xd0, 0x36, 0x8e, 0x04, 0x12, 0x18,
+	0x3e, 0x01, 0x95, 0x18, 0x25, 0x28, 0xe2, 0xa6, 0xb1, 0x69, 0x6c, 0xd7, 0x1f, 0x59, 0xf6, 0xef,
+	0x6d, 0xd9, 0x87, 0x0a, 0xd5, 0x2a, 0x9f, 0x5f, 0x6e, 0x94, 0xdc, 0x6c, 0x0f, 0xec, 0x80, 0x05,
+	0x81, 0x06, 0x5e, 0x82, 0x04, 0x36, 0xff, 0xda, 0x34, 0xb6, 0x6b, 0x2d, 0x5b, 0xbe, 0xff, 0x76,
+	0xb9, 0x71, 0x3f, 0x24, 0xe2, 0x24, 0xed, 0xd9, 0x3e, 0x8b, 0x9c, 0xcc, 0x93, 0xfe, 0xd9, 0xe1,
+	0xc1, 0xa9, 0x23, 0x86, 0x31, 0xe6, 0xf6, 0x1e, 0xf6, 0xdd, 0xaa, 0x40, 0x03, 0x57, 0x1a, 0x39,
+	0x02, 0x8b, 0x09, 0x3e, 0x43, 0x49, 0xe0, 0x9d, 0x61, 0x12, 0x9e, 0x08, 0x73, 0x6e, 0x26, 0xbe,
+	0x86, 0x26, 0x79, 0xad, 0x38, 0xe0, 0x53, 0xed, 0xcf, 0x47, 0x31, 0x37, 0xcb, 0x9b, 0x73, 0xb7,
+	0xc5, 0xd7, 0x45, 0x83, 0x36, 0x8a, 0xb3, 0xf8, 0xa4, 0xab, 0x36, 0x8a, 0x39, 0xa4, 0xa0, 0x21,
+	0x09, 0xe2, 0x84, 0xf9, 0x18, 0x07, 0xdc, 0x9c, 0x57, 0x24, 0xff, 0xd9, 0x5a, 0xdb, 0x96, 0x69,
+	0x1e, 0x33, 0xb4, 0x19, 0xa1, 0xad, 0x87, 0x72, 0xff, 0xe7, 0xef, 0x1b, 0xdb, 0x7f, 0xe0, 0x57,
+	0x6e, 0xe0, 0x6e, 0x5d, 0xa0, 0xc1, 0x61, 0xc6, 0x0f, 0xdf, 0x1b, 0x60, 0x0d, 0xc7, 0xcc, 0x3f,
+	0xf1, 0x08, 0x25, 0x82, 0xa0, 0xbe, 0x47, 0x38, 0x4f, 0x11, 0xf5, 0xb1, 0x59, 0x29, 0x5e, 0x7a,
+	0x55, 0x49, 0x75, 0xb4, 0x52, 0x27, 0x13, 0x82, 0xcf, 0x41, 0x43, 0x5b, 0xe0, 0xb2, 0x43, 0xb8,
+	0x59, 0x55, 0xc2, 0xcd, 0x9b, 0x12, 0xb7, 0x2f, 0xb1, 0xaa, 0x99, 0xb2, 0xe4, 0xd5, 0xf1, 0x78,
+	0x85, 0xc3, 0x57, 0x60, 0x49, 0x26, 0x10, 0x0f, 0x70, 0x14, 0x0b, 0xc2, 0x28, 0x37, 0x17, 0x14,
+	0xdd, 0xd6, 0x2d, 0x75, 0xd8, 0x1f, 0x81, 0x33, 0xc2, 0x45, 0x91, 0x5b, 0xe3, 0xf0, 0x1d, 0xf8,
+	0xa7, 0x97, 0x26, 0x14, 0x07, 0xde, 0x54, 0x69, 0x6a, 0xc5, 0xe7, 0x67, 0x45, 0xeb, 0x74, 0x73,
+	0x05, 0xfa, 0x68, 0x80, 0xff, 0x7d, 0x16, 0x45, 0x29, 0x25, 0x62, 0xe8, 0xc5, 0x8c, 0xf5, 0xa7,
+	0x5d, 0x80, 0xe2, 0x5d, 0x98, 0x63, 0xbd, 0x43, 0xc6, 0xfa, 0x79, 0x33, 0x2e, 0x58, 0x0e, 0x30,
+	0x65, 0x91, 0x37, 0x3a, 0x84, 0xdc, 0xac, 0xdf, 0x9e, 0xdd, 0x3d, 0x09, 0xef, 0xea, 0x23, 0x37,
+	0xca, 0x6e, 0x90, 0x5b, 0xe3, 0xd0, 0x07, 0x15, 0x1d, 0xb5, 0xd9, 0x28, 0x3e, 0x94, 0x8c, 0x5a,
+	0x1e, 0x2b, 0xdd, 0x62, 0x99, 0xd4, 0xe2, 0x1d, 0x1c, 0x2b, 0x25, 0xd0, 0xd2, 0x7a, 0x29, 0xf8,
+	0x3b, 0xc6, 0x34, 0x20, 0x34, 0x54, 0xa9, 0x92, 0xaa, 0xe6, 0x52, 0xf1, 0x9a, 0x4b, 0x99, 0x48,
+	0x17, 0x0d, 0xa4, 0x30, 0xfc, 0x60, 0x80, 0xf5, 0xbc, 0xee, 0x74, 0xe3, 0x98, 0xcb, 0xc5, 0x3b,
+	0xf8, 0x77, 0xe2, 0xa0, 0x9d, 0xef, 0x9a, 0x26, 0x03, 0x8d, 0x7c, 0xed, 0xe1, 0x2a, 0x98, 0x57,
+	0x75, 0x57, 0x63, 0xbf, 0xe6, 0xea, 0x87, 0x02, 0xe7, 0x79, 0x33, 0x04, 0x15, 0x3d, 0x52, 0x6f,
+	0x90, 0x3a, 0x00, 0xd5, 0x6c, 0x34, 0xcf, 0xa0, 0xd4, 0xa1, 0xc2, 0xad, 0xe8, 0x19, 0xdd, 0xfc,
+	0x52, 0x06, 0x60, 0x32, 0x83, 0xa4, 0x9a, 0xaa, 0xbc, 0x52, 0x2b, 0xbb, 0xfa, 0x01, 0xbe, 0x04,
+	0x40, 0x05, 0xa6, 0x2e, 0x87, 0x19, 0x43, 0xab, 0xc9, 0xd0, 0x14, 0x01, 0x7c, 0x03, 0x20, 0xc7,
+	0x24, 0xa4, 0x84, 0x25, 0x28, 0xc4, 0x23, 0xda, 0xd9, 0x6e, 0xac, 0x95, 0x1c, 0x53, 0x46, 0x7f,
+	0x0c, 0x56, 0x04, 0x13, 0xa8, 0x2f, 0x27, 0xf0, 0x29, 0x0e, 0xbc, 0x7e, 0x4a, 0x91, 0x59, 0x9e,
+	0x29, 0x4b, 0xcb, 0x8a, 0xe8, 0x48, 0xf1, 0xbc, 0x48, 0x29, 0x9a, 0x2a, 0xf1, 0x7c, 0xc1, 0x57,
+	0x76, 0xa5, 0x80, 0x2b, 0x7b, 0x32, 0x7f, 0xaa, 0x77, 0x36, 0x7f, 0x5a, 0xcf, 0xce, 0xaf, 0x2c,
+	0xe3, 0xe2, 0xca, 0x32, 0x7e, 0x5c, 0x59, 0xc6, 0xa7, 0x6b, 0xab, 0x74, 0x71, 0x6d, 0x95, 0xbe,
+	0x5e, 0x5b, 0xa5, 0xe3, 0x07, 0x39, 0x2e, 0x35, 0x43, 0x77, 0x22, 0x46, 0xf1, 0xd0, 0xf1, 0x59,
+	0x82, 0x9d, 0xc1, 0xe4, 0xfb, 0x4b, 0xb1, 0xf6, 0x2a, 0xea, 0xab, 0xea, 0xf1, 0xaf, 0x01, 0x00,
+	0x1a, 0xf5, 0x12, 0x4a, 0xf2, 0x09, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -267,6 +406,118 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PendingTaxCommunityPool) > 0 {
+		for iNdEx := len(m.PendingTaxCommunityPool) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingTaxCommunityPool[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if len(m.PendingTaxBurn) > 0 {
+		for iNdEx := len(m.PendingTaxBurn) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingTaxBurn[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.EpochBurned) > 0 {
+		for iNdEx := len(m.EpochBurned) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EpochBurned[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.Burned) > 0 {
+		for iNdEx := len(m.Burned) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Burned[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.DenomTaxRates) > 0 {
+		for iNdEx := len(m.DenomTaxRates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DenomTaxRates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.CommunityPoolTaxProceeds) > 0 {
+		for iNdEx := len(m.CommunityPoolTaxProceeds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CommunityPoolTaxProceeds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.BurnedTaxProceeds) > 0 {
+		for iNdEx := len(m.BurnedTaxProceeds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BurnedTaxProceeds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.TaxExemptions) > 0 {
+		for iNdEx := len(m.TaxExemptions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TaxExemptions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
 	if len(m.EpochStates) > 0 {
 		for iNdEx := len(m.EpochStates) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -356,6 +607,46 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *DenomTaxRate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomTaxRate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomTaxRate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TaxRate.Size()
+		i -= size
+		if _, err := m.TaxRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *TaxCap) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -416,6 +707,40 @@ func (m *EpochState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Burned) > 0 {
+		for iNdEx := len(m.Burned) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Burned[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	{
+		size := m.RewardWeight.Size()
+		i -= size
+		if _, err := m.RewardWeight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.TaxRate.Size()
+		i -= size
+		if _, err := m.TaxRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
 	{
 		size := m.TotalStakedLuna.Size()
 		i -= size
@@ -501,10 +826,58 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.TaxExemptions) > 0 {
+		for _, e := range m.TaxExemptions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.BurnedTaxProceeds) > 0 {
+		for _, e := range m.BurnedTaxProceeds {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.CommunityPoolTaxProceeds) > 0 {
+		for _, e := range m.CommunityPoolTaxProceeds {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DenomTaxRates) > 0 {
+		for _, e := range m.DenomTaxRates {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Burned) > 0 {
+		for _, e := range m.Burned {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.EpochBurned) > 0 {
+		for _, e := range m.EpochBurned {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PendingTaxBurn) > 0 {
+		for _, e := range m.PendingTaxBurn {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PendingTaxCommunityPool) > 0 {
+		for _, e := range m.PendingTaxCommunityPool {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
-func (m *TaxCap) Size() (n int) {
+func (m *DenomTaxRate) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -514,7 +887,22 @@ func (m *TaxCap) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovGenesis(uint64(l))
 	}
-	l = m.TaxCap.Size()
+	l = m.TaxRate.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *TaxCap) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.TaxCap.Size()
 	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
@@ -534,6 +922,16 @@ func (m *EpochState) Size() (n int) {
 	n += 1 + l + sovGenesis(uint64(l))
 	l = m.TotalStakedLuna.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	l = m.TaxRate.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.RewardWeight.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Burned) > 0 {
+		for _, e := range m.Burned {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -574,7 +972,278 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaxRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TaxRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardWeight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RewardWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaxCaps", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaxCaps = append(m.TaxCaps, TaxCap{})
+			if err := m.TaxCaps[len(m.TaxCaps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaxProceeds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaxProceeds = append(m.TaxProceeds, types.Coin{})
+			if err := m.TaxProceeds[len(m.TaxProceeds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochInitialIssuance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EpochInitialIssuance = append(m.EpochInitialIssuance, types.Coin{})
+			if err := m.EpochInitialIssuance[len(m.EpochInitialIssuance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochStates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EpochStates = append(m.EpochStates, EpochState{})
+			if err := m.EpochStates[len(m.EpochStates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaxExemptions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaxExemptions = append(m.TaxExemptions, TaxExemption{})
+			if err := m.TaxExemptions[len(m.TaxExemptions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnedTaxProceeds", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -601,15 +1270,16 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.BurnedTaxProceeds = append(m.BurnedTaxProceeds, types.Coin{})
+			if err := m.BurnedTaxProceeds[len(m.BurnedTaxProceeds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaxRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPoolTaxProceeds", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -619,31 +1289,31 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TaxRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.CommunityPoolTaxProceeds = append(m.CommunityPoolTaxProceeds, types.Coin{})
+			if err := m.CommunityPoolTaxProceeds[len(m.CommunityPoolTaxProceeds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RewardWeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomTaxRates", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -653,29 +1323,29 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RewardWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.DenomTaxRates = append(m.DenomTaxRates, DenomTaxRate{})
+			if err := m.DenomTaxRates[len(m.DenomTaxRates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaxCaps", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Burned", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -702,14 +1372,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TaxCaps = append(m.TaxCaps, TaxCap{})
-			if err := m.TaxCaps[len(m.TaxCaps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Burned = append(m.Burned, types.Coin{})
+			if err := m.Burned[len(m.Burned)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaxProceeds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochBurned", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -736,14 +1406,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TaxProceeds = append(m.TaxProceeds, types.Coin{})
-			if err := m.TaxProceeds[len(m.TaxProceeds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.EpochBurned = append(m.EpochBurned, types.Coin{})
+			if err := m.EpochBurned[len(m.EpochBurned)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EpochInitialIssuance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingTaxBurn", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -770,14 +1440,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EpochInitialIssuance = append(m.EpochInitialIssuance, types.Coin{})
-			if err := m.EpochInitialIssuance[len(m.EpochInitialIssuance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PendingTaxBurn = append(m.PendingTaxBurn, types.Coin{})
+			if err := m.PendingTaxBurn[len(m.PendingTaxBurn)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EpochStates", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingTaxCommunityPool", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -804,8 +1474,124 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EpochStates = append(m.EpochStates, EpochState{})
-			if err := m.EpochStates[len(m.EpochStates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PendingTaxCommunityPool = append(m.PendingTaxCommunityPool, types.Coin{})
+			if err := m.PendingTaxCommunityPool[len(m.PendingTaxCommunityPool)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DenomTaxRate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomTaxRate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomTaxRate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaxRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TaxRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1096,6 +1882,108 @@ func (m *EpochState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaxRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TaxRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardWeight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RewardWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Burned", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Burned = append(m.Burned, types.Coin{})
+			if err := m.Burned[len(m.Burned)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
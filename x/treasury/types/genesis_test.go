@@ -48,4 +48,33 @@ func TestGenesisValidation(t *testing.T) {
 
 	// Valid
 	require.NoError(t, ValidateGenesis(genState))
+
+	addr := sdk.AccAddress([]byte("addr1_______________")).String()
+	genState.TaxExemptions = []TaxExemption{{Address: addr, Zone: "exchange"}}
+	require.NoError(t, ValidateGenesis(genState))
+
+	// Error - duplicate address
+	genState.TaxExemptions = append(genState.TaxExemptions, TaxExemption{Address: addr, Zone: "bridge"})
+	require.Error(t, ValidateGenesis(genState))
+
+	// Error - empty zone
+	genState.TaxExemptions = []TaxExemption{{Address: addr, Zone: ""}}
+	require.Error(t, ValidateGenesis(genState))
+
+	// Error - invalid address
+	genState.TaxExemptions = []TaxExemption{{Address: "invalid", Zone: "exchange"}}
+	require.Error(t, ValidateGenesis(genState))
+	genState.TaxExemptions = []TaxExemption{}
+
+	// Valid
+	genState.DenomTaxRates = []DenomTaxRate{{Denom: "ukrw", TaxRate: sdk.NewDecWithPrec(1, 3)}}
+	require.NoError(t, ValidateGenesis(genState))
+
+	// Error - negative denom tax rate
+	genState.DenomTaxRates = []DenomTaxRate{{Denom: "ukrw", TaxRate: sdk.NewDec(-1)}}
+	require.Error(t, ValidateGenesis(genState))
+
+	// Error - invalid denom
+	genState.DenomTaxRates = []DenomTaxRate{{Denom: "", TaxRate: sdk.NewDecWithPrec(1, 3)}}
+	require.Error(t, ValidateGenesis(genState))
 }
@@ -0,0 +1,22 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ TreasuryHooks = MultiTreasuryHooks{}
+
+// MultiTreasuryHooks combines multiple treasury hooks, all hook functions are run in array sequence
+type MultiTreasuryHooks []TreasuryHooks
+
+// NewMultiTreasuryHooks returns new MultiTreasuryHooks
+func NewMultiTreasuryHooks(hooks ...TreasuryHooks) MultiTreasuryHooks {
+	return hooks
+}
+
+// AfterEpochEnd runs AfterEpochEnd of all hooks
+func (h MultiTreasuryHooks) AfterEpochEnd(ctx sdk.Context, epoch int64, taxRate sdk.Dec, rewardWeight sdk.Dec, taxCaps sdk.Coins, seigniorage sdk.Int) {
+	for i := range h {
+		h[i].AfterEpochEnd(ctx, epoch, taxRate, rewardWeight, taxCaps, seigniorage)
+	}
+}
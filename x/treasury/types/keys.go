@@ -2,6 +2,9 @@ package types
 
 import (
 	"encoding/binary"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
 )
 
 const (
@@ -42,6 +45,30 @@ const BurnModuleName = "burn"
 // - 0x08<epoch_Bytes>: sdk.Int
 //
 // - 0x09: int64
+//
+// - 0x0A<addr_Bytes>: string
+//
+// - 0x0B: sdk.Coins
+//
+// - 0x0C: sdk.Coins
+//
+// - 0x0D: sdk.Coins
+//
+// - 0x0E: sdk.Coins
+//
+// - 0x0F<denom_Bytes>: sdk.Dec
+//
+// - 0x10<epoch_Bytes>: sdk.Dec
+//
+// - 0x11<epoch_Bytes>: sdk.Dec
+//
+// - 0x12: uint64
+//
+// - 0x13: sdk.Coins
+//
+// - 0x14<epoch_Bytes>: sdk.Coins
+//
+// - 0x15: int64
 var (
 	// Keys for store prefixes
 	TaxRateKey              = []byte{0x01} // a key for a tax-rate
@@ -50,11 +77,33 @@ var (
 	TaxProceedsKey          = []byte{0x04} // a key for a tax-proceeds
 	EpochInitialIssuanceKey = []byte{0x05} // a key for a initial epoch issuance
 	CumulativeHeightKey     = []byte{0x09} // a key for a cumulated height
+	TaxExemptionKey         = []byte{0x0A} // prefix for each key to a tax exemption zone
+
+	// Keys for tax proceeds split between burn and community pool
+	BurnedTaxProceedsKey        = []byte{0x0B} // a key for a burned tax-proceeds of the epoch
+	CommunityPoolTaxProceedsKey = []byte{0x0C} // a key for a community pool tax-proceeds of the epoch
+	PendingTaxBurnKey           = []byte{0x0D} // a key for tax proceeds to be burned at the next begin block
+	PendingTaxCommunityPoolKey  = []byte{0x0E} // a key for tax proceeds to be sent to the community pool at the next begin block
+
+	// Key for the tax rates overriding the global tax rate
+	DenomTaxRateKey = []byte{0x0F} // prefix for each key to a denom tax-rate override
 
 	// Keys for store prefixes of internal purpose variables
 	TRKey  = []byte{0x06} // prefix for each key to a TR
 	SRKey  = []byte{0x07} // prefix for each key to a SR
 	TSLKey = []byte{0x08} // prefix for each key to a TSL
+
+	// Keys for store prefixes of policy levers applied in each epoch
+	HistoricalTaxRateKey      = []byte{0x10} // prefix for each key to a tax-rate of an epoch
+	HistoricalRewardWeightKey = []byte{0x11} // prefix for each key to a reward-weight of an epoch
+
+	// Key for the epoch length the stored epoch indices are based on
+	AppliedEpochLengthKey = []byte{0x12} // a key for an applied epoch length
+	EpochStartHeightKey   = []byte{0x15} // a key for the start height of an epoch in progress across an epoch length change
+
+	// Keys for the amounts burned from the burn module account
+	BurnedKey      = []byte{0x13} // a key for the cumulative burned amount
+	EpochBurnedKey = []byte{0x14} // prefix for each key to a burned amount of an epoch
 )
 
 // GetTaxCapKey - stored by *denom*
@@ -62,6 +111,16 @@ func GetTaxCapKey(denom string) []byte {
 	return append(TaxCapKey, []byte(denom)...)
 }
 
+// GetDenomTaxRateKey - stored by *denom*
+func GetDenomTaxRateKey(denom string) []byte {
+	return append(DenomTaxRateKey, []byte(denom)...)
+}
+
+// GetTaxExemptionKey - stored by *address*
+func GetTaxExemptionKey(addr sdk.AccAddress) []byte {
+	return append(TaxExemptionKey, address.MustLengthPrefix(addr)...)
+}
+
 // GetTRKey - stored by *epoch*
 func GetTRKey(epoch int64) []byte {
 	return GetSubkeyByEpoch(TRKey, epoch)
@@ -77,6 +136,21 @@ func GetTSLKey(epoch int64) []byte {
 	return GetSubkeyByEpoch(TSLKey, epoch)
 }
 
+// GetHistoricalTaxRateKey - stored by *epoch*
+func GetHistoricalTaxRateKey(epoch int64) []byte {
+	return GetSubkeyByEpoch(HistoricalTaxRateKey, epoch)
+}
+
+// GetEpochBurnedKey - stored by *epoch*
+func GetEpochBurnedKey(epoch int64) []byte {
+	return GetSubkeyByEpoch(EpochBurnedKey, epoch)
+}
+
+// GetHistoricalRewardWeightKey - stored by *epoch*
+func GetHistoricalRewardWeightKey(epoch int64) []byte {
+	return GetSubkeyByEpoch(HistoricalRewardWeightKey, epoch)
+}
+
 // GetSubkeyByEpoch - stored by *epoch*
 func GetSubkeyByEpoch(prefix []byte, epoch int64) []byte {
 	b := make([]byte, 8)
@@ -2,24 +2,43 @@ package types
 
 import (
 	"fmt"
+	"strings"
 
 	"gopkg.in/yaml.v2"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	core "github.com/terra-money/core/types"
 )
 
 // Parameter keys
 var (
-	KeyTaxPolicy               = []byte("TaxPolicy")
-	KeyRewardPolicy            = []byte("RewardPolicy")
-	KeySeigniorageBurdenTarget = []byte("SeigniorageBurdenTarget")
-	KeyMiningIncrement         = []byte("MiningIncrement")
-	KeyWindowShort             = []byte("WindowShort")
-	KeyWindowLong              = []byte("WindowLong")
-	KeyWindowProbation         = []byte("WindowProbation")
+	KeyTaxPolicy                = []byte("TaxPolicy")
+	KeyRewardPolicy             = []byte("RewardPolicy")
+	KeySeigniorageBurdenTarget  = []byte("SeigniorageBurdenTarget")
+	KeyMiningIncrement          = []byte("MiningIncrement")
+	KeyWindowShort              = []byte("WindowShort")
+	KeyWindowLong               = []byte("WindowLong")
+	KeyWindowProbation          = []byte("WindowProbation")
+	KeyBurnTaxSplit             = []byte("BurnTaxSplit")
+	KeyCommunityPoolTaxSplit    = []byte("CommunityPoolTaxSplit")
+	KeyDenomTaxPolicies         = []byte("DenomTaxPolicies")
+	KeyIndicatorRetentionMargin = []byte("IndicatorRetentionMargin")
+	KeyEpochLength              = []byte("EpochLength")
+	KeySeigniorageDestinations  = []byte("SeigniorageDestinations")
+	KeyMinGasPrices             = []byte("MinGasPrices")
+	KeyMsgFeeRequirements       = []byte("MsgFeeRequirements")
+)
+
+// Bounds of the epoch length; the windows are counted in epochs, so
+// shorter epochs make the indicator sums iterate over more records
+const (
+	MinEpochLength = uint64(core.BlocksPerDay)
+	MaxEpochLength = uint64(core.BlocksPerYear)
 )
 
 // Default parameter values
@@ -36,13 +55,21 @@ var (
 		ChangeRateMax: sdk.NewDecWithPrec(25, 3),            // 2.5%
 		Cap:           sdk.NewCoin("unused", sdk.ZeroInt()), // UNUSED
 	}
-	DefaultSeigniorageBurdenTarget = sdk.NewDecWithPrec(67, 2)  // 67%
-	DefaultMiningIncrement         = sdk.NewDecWithPrec(107, 2) // 1.07 mining increment; exponential growth
-	DefaultWindowShort             = uint64(4)                  // a month
-	DefaultWindowLong              = uint64(52)                 // a year
-	DefaultWindowProbation         = uint64(12)                 // 3 month
-	DefaultTaxRate                 = sdk.NewDecWithPrec(1, 3)   // 0.1%
-	DefaultRewardWeight            = sdk.NewDecWithPrec(5, 2)   // 5%
+	DefaultSeigniorageBurdenTarget  = sdk.NewDecWithPrec(67, 2)  // 67%
+	DefaultMiningIncrement          = sdk.NewDecWithPrec(107, 2) // 1.07 mining increment; exponential growth
+	DefaultWindowShort              = uint64(4)                  // a month
+	DefaultWindowLong               = uint64(52)                 // a year
+	DefaultWindowProbation          = uint64(12)                 // 3 month
+	DefaultTaxRate                  = sdk.NewDecWithPrec(1, 3)   // 0.1%
+	DefaultRewardWeight             = sdk.NewDecWithPrec(5, 2)   // 5%
+	DefaultBurnTaxSplit             = sdk.ZeroDec()              // 0%; all tax proceeds go to stakers
+	DefaultCommunityPoolTaxSplit    = sdk.ZeroDec()              // 0%
+	DefaultDenomTaxPolicies         []DenomTaxPolicy             // no overrides; all denoms use the global tax rate
+	DefaultIndicatorRetentionMargin = uint64(4)                  // a month beyond WindowLong
+	DefaultEpochLength              = uint64(core.BlocksPerWeek) // a week
+	DefaultSeigniorageDestinations  []SeigniorageDestination     // none; burn the reward weight portion, the rest to the community pool
+	DefaultMinGasPrices             sdk.DecCoins                 // none; only the local min gas prices apply
+	DefaultMsgFeeRequirements       []MsgFeeRequirement          // none; all msgs share the min gas prices
 )
 
 var _ paramstypes.ParamSet = &Params{}
@@ -50,13 +77,21 @@ var _ paramstypes.ParamSet = &Params{}
 // DefaultParams creates default treasury module parameters
 func DefaultParams() Params {
 	return Params{
-		TaxPolicy:               DefaultTaxPolicy,
-		RewardPolicy:            DefaultRewardPolicy,
-		SeigniorageBurdenTarget: DefaultSeigniorageBurdenTarget,
-		MiningIncrement:         DefaultMiningIncrement,
-		WindowShort:             DefaultWindowShort,
-		WindowLong:              DefaultWindowLong,
-		WindowProbation:         DefaultWindowProbation,
+		TaxPolicy:                DefaultTaxPolicy,
+		RewardPolicy:             DefaultRewardPolicy,
+		SeigniorageBurdenTarget:  DefaultSeigniorageBurdenTarget,
+		MiningIncrement:          DefaultMiningIncrement,
+		WindowShort:              DefaultWindowShort,
+		WindowLong:               DefaultWindowLong,
+		WindowProbation:          DefaultWindowProbation,
+		BurnTaxSplit:             DefaultBurnTaxSplit,
+		CommunityPoolTaxSplit:    DefaultCommunityPoolTaxSplit,
+		DenomTaxPolicies:         DefaultDenomTaxPolicies,
+		IndicatorRetentionMargin: DefaultIndicatorRetentionMargin,
+		EpochLength:              DefaultEpochLength,
+		SeigniorageDestinations:  DefaultSeigniorageDestinations,
+		MinGasPrices:             DefaultMinGasPrices,
+		MsgFeeRequirements:       DefaultMsgFeeRequirements,
 	}
 }
 
@@ -83,6 +118,14 @@ func (p *Params) ParamSetPairs() paramstypes.ParamSetPairs {
 		paramstypes.NewParamSetPair(KeyWindowShort, &p.WindowShort, validateWindowShort),
 		paramstypes.NewParamSetPair(KeyWindowLong, &p.WindowLong, validateWindowLong),
 		paramstypes.NewParamSetPair(KeyWindowProbation, &p.WindowProbation, validateWindowProbation),
+		paramstypes.NewParamSetPair(KeyBurnTaxSplit, &p.BurnTaxSplit, validateTaxSplit),
+		paramstypes.NewParamSetPair(KeyCommunityPoolTaxSplit, &p.CommunityPoolTaxSplit, validateTaxSplit),
+		paramstypes.NewParamSetPair(KeyDenomTaxPolicies, &p.DenomTaxPolicies, validateDenomTaxPolicies),
+		paramstypes.NewParamSetPair(KeyIndicatorRetentionMargin, &p.IndicatorRetentionMargin, validateIndicatorRetentionMargin),
+		paramstypes.NewParamSetPair(KeyEpochLength, &p.EpochLength, validateEpochLength),
+		paramstypes.NewParamSetPair(KeySeigniorageDestinations, &p.SeigniorageDestinations, validateSeigniorageDestinations),
+		paramstypes.NewParamSetPair(KeyMinGasPrices, &p.MinGasPrices, validateMinGasPrices),
+		paramstypes.NewParamSetPair(KeyMsgFeeRequirements, &p.MsgFeeRequirements, validateMsgFeeRequirements),
 	}
 }
 
@@ -130,7 +173,35 @@ func (p Params) Validate() error {
 		return fmt.Errorf("treasury parameter WindowLong must be bigger than WindowShort: (%d, %d)", p.WindowLong, p.WindowShort)
 	}
 
-	return nil
+	if p.BurnTaxSplit.IsNegative() || p.BurnTaxSplit.GT(sdk.OneDec()) {
+		return fmt.Errorf("treasury parameter BurnTaxSplit must be between [0, 1]: %s", p.BurnTaxSplit)
+	}
+
+	if p.CommunityPoolTaxSplit.IsNegative() || p.CommunityPoolTaxSplit.GT(sdk.OneDec()) {
+		return fmt.Errorf("treasury parameter CommunityPoolTaxSplit must be between [0, 1]: %s", p.CommunityPoolTaxSplit)
+	}
+
+	if p.BurnTaxSplit.Add(p.CommunityPoolTaxSplit).GT(sdk.OneDec()) {
+		return fmt.Errorf("treasury parameter BurnTaxSplit + CommunityPoolTaxSplit must not exceed 1: (%s, %s)", p.BurnTaxSplit, p.CommunityPoolTaxSplit)
+	}
+
+	if p.EpochLength < MinEpochLength || p.EpochLength > MaxEpochLength {
+		return fmt.Errorf("treasury parameter EpochLength must be between [%d, %d]: %d", MinEpochLength, MaxEpochLength, p.EpochLength)
+	}
+
+	if err := validateSeigniorageDestinations(p.SeigniorageDestinations); err != nil {
+		return err
+	}
+
+	if err := validateMinGasPrices(p.MinGasPrices); err != nil {
+		return err
+	}
+
+	if err := validateMsgFeeRequirements(p.MsgFeeRequirements); err != nil {
+		return err
+	}
+
+	return validateDenomTaxPolicies(p.DenomTaxPolicies)
 }
 
 func validateTaxPolicy(i interface{}) error {
@@ -231,3 +302,184 @@ func validateWindowProbation(i interface{}) error {
 
 	return nil
 }
+
+func validateIndicatorRetentionMargin(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateEpochLength(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v < MinEpochLength || v > MaxEpochLength {
+		return fmt.Errorf("epoch length must be between [%d, %d]: %d", MinEpochLength, MaxEpochLength, v)
+	}
+
+	return nil
+}
+
+func validateTaxSplit(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("tax split must not be nil")
+	}
+
+	if v.IsNegative() {
+		return fmt.Errorf("tax split must be positive: %s", v)
+	}
+
+	if v.GT(sdk.OneDec()) {
+		return fmt.Errorf("tax split is too large: %s", v)
+	}
+
+	return nil
+}
+
+func validateDenomTaxPolicies(i interface{}) error {
+	v, ok := i.([]DenomTaxPolicy)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[string]bool, len(v))
+	for _, denomPolicy := range v {
+		if err := sdk.ValidateDenom(denomPolicy.Denom); err != nil {
+			return fmt.Errorf("invalid denom tax policy denom: %w", err)
+		}
+
+		if seen[denomPolicy.Denom] {
+			return fmt.Errorf("duplicate denom tax policy: %s", denomPolicy.Denom)
+		}
+
+		seen[denomPolicy.Denom] = true
+
+		// the cap of the policy is unused; tax caps are kept per denom
+		policy := denomPolicy.Policy
+		if policy.RateMin.IsNil() || policy.RateMax.IsNil() || policy.ChangeRateMax.IsNil() {
+			return fmt.Errorf("denom tax policy of %s must not have nil rates", denomPolicy.Denom)
+		}
+
+		if policy.RateMin.IsNegative() {
+			return fmt.Errorf("rate min of %s must be positive: %s", denomPolicy.Denom, policy)
+		}
+
+		if policy.RateMax.LT(policy.RateMin) {
+			return fmt.Errorf("rate max of %s must be bigger than rate min: %s", denomPolicy.Denom, policy)
+		}
+
+		if policy.ChangeRateMax.IsNegative() {
+			return fmt.Errorf("max change rate of %s must be positive: %s", denomPolicy.Denom, policy)
+		}
+	}
+
+	return nil
+}
+
+func validateSeigniorageDestinations(i interface{}) error {
+	v, ok := i.([]SeigniorageDestination)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	// empty destinations keep the reward weight split
+	if len(v) == 0 {
+		return nil
+	}
+
+	seen := make(map[string]bool, len(v))
+	totalWeight := sdk.ZeroDec()
+	for _, destination := range v {
+		switch destination.Type {
+		case SeigniorageDestinationBurn, SeigniorageDestinationCommunityPool, SeigniorageDestinationOracle:
+			if destination.ModuleName != "" {
+				return fmt.Errorf("seigniorage destination %s must not have a module name", destination.Type)
+			}
+		case SeigniorageDestinationModule:
+			if destination.ModuleName == "" {
+				return fmt.Errorf("seigniorage destination %s must have a module name", destination.Type)
+			}
+
+			// the balances of these module accounts are tracked by their modules, so coins
+			// sent there directly would break the distribution, staking and gov invariants
+			switch destination.ModuleName {
+			case distrtypes.ModuleName, stakingtypes.BondedPoolName, stakingtypes.NotBondedPoolName, govtypes.ModuleName:
+				return fmt.Errorf("seigniorage destination %s must not be the %s module account", destination.Type, destination.ModuleName)
+			}
+		default:
+			return fmt.Errorf("invalid seigniorage destination type: %s", destination.Type)
+		}
+
+		id := destination.Type + "/" + destination.ModuleName
+		if seen[id] {
+			return fmt.Errorf("duplicate seigniorage destination: %s", id)
+		}
+
+		seen[id] = true
+
+		if destination.Weight.IsNil() || destination.Weight.IsNegative() || destination.Weight.GT(sdk.OneDec()) {
+			return fmt.Errorf("seigniorage destination %s weight must be between [0, 1]: %s", id, destination.Weight)
+		}
+
+		totalWeight = totalWeight.Add(destination.Weight)
+	}
+
+	if !totalWeight.Equal(sdk.OneDec()) {
+		return fmt.Errorf("seigniorage destination weights must sum to 1: %s", totalWeight)
+	}
+
+	return nil
+}
+
+func validateMinGasPrices(i interface{}) error {
+	v, ok := i.(sdk.DecCoins)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if err := v.Validate(); err != nil {
+		return fmt.Errorf("invalid min gas prices: %w", err)
+	}
+
+	return nil
+}
+
+func validateMsgFeeRequirements(i interface{}) error {
+	v, ok := i.([]MsgFeeRequirement)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[string]bool, len(v))
+	for _, requirement := range v {
+		if !strings.HasPrefix(requirement.MsgTypeUrl, "/") {
+			return fmt.Errorf("invalid msg fee requirement type url: %s", requirement.MsgTypeUrl)
+		}
+
+		if seen[requirement.MsgTypeUrl] {
+			return fmt.Errorf("duplicate msg fee requirement: %s", requirement.MsgTypeUrl)
+		}
+
+		seen[requirement.MsgTypeUrl] = true
+
+		if requirement.GasPriceMultiplier.IsNil() || requirement.GasPriceMultiplier.IsNegative() {
+			return fmt.Errorf("gas price multiplier of %s must be positive: %s", requirement.MsgTypeUrl, requirement.GasPriceMultiplier)
+		}
+
+		if err := requirement.FlatFee.Validate(); err != nil {
+			return fmt.Errorf("invalid flat fee of %s: %w", requirement.MsgTypeUrl, err)
+		}
+	}
+
+	return nil
+}
@@ -48,10 +48,119 @@ func TestParams(t *testing.T) {
 	params.WindowLong = 0
 	require.Error(t, params.Validate())
 
+	params = DefaultParams()
+	params.EpochLength = MinEpochLength - 1
+	require.Error(t, params.Validate())
+
+	params = DefaultParams()
+	params.EpochLength = MaxEpochLength + 1
+	require.Error(t, params.Validate())
+
 	params = DefaultParams()
 	params.RewardPolicy.RateMin = sdk.NewDec(-1)
 	require.Error(t, params.Validate())
 
+	params = DefaultParams()
+	params.BurnTaxSplit = sdk.NewDec(-1)
+	require.Error(t, params.Validate())
+
+	params = DefaultParams()
+	params.CommunityPoolTaxSplit = sdk.NewDecWithPrec(11, 1)
+	require.Error(t, params.Validate())
+
+	params = DefaultParams()
+	params.BurnTaxSplit = sdk.NewDecWithPrec(6, 1)
+	params.CommunityPoolTaxSplit = sdk.NewDecWithPrec(5, 1)
+	require.Error(t, params.Validate())
+
+	params = DefaultParams()
+	params.BurnTaxSplit = sdk.NewDecWithPrec(5, 1)
+	params.CommunityPoolTaxSplit = sdk.NewDecWithPrec(5, 1)
+	require.NoError(t, params.Validate())
+
+	denomTaxPolicy := DenomTaxPolicy{Denom: "ukrw", Policy: DefaultTaxPolicy}
+	params.DenomTaxPolicies = []DenomTaxPolicy{denomTaxPolicy}
+	require.NoError(t, params.Validate())
+
+	// duplicate denom
+	params.DenomTaxPolicies = []DenomTaxPolicy{denomTaxPolicy, denomTaxPolicy}
+	require.Error(t, params.Validate())
+
+	// invalid denom
+	params.DenomTaxPolicies = []DenomTaxPolicy{{Denom: "", Policy: DefaultTaxPolicy}}
+	require.Error(t, params.Validate())
+
+	// rate max lower than rate min
+	denomTaxPolicy.Policy.RateMax = sdk.ZeroDec()
+	params.DenomTaxPolicies = []DenomTaxPolicy{denomTaxPolicy}
+	require.Error(t, params.Validate())
+	params.DenomTaxPolicies = DefaultDenomTaxPolicies
+
+	params.SeigniorageDestinations = []SeigniorageDestination{
+		{Type: SeigniorageDestinationBurn, Weight: sdk.NewDecWithPrec(5, 1)},
+		{Type: SeigniorageDestinationModule, ModuleName: "oracle", Weight: sdk.NewDecWithPrec(5, 1)},
+	}
+	require.NoError(t, params.Validate())
+
+	// weights not summing to one
+	params.SeigniorageDestinations[1].Weight = sdk.NewDecWithPrec(4, 1)
+	require.Error(t, params.Validate())
+
+	// module destination without module name
+	params.SeigniorageDestinations[1] = SeigniorageDestination{Type: SeigniorageDestinationModule, Weight: sdk.NewDecWithPrec(5, 1)}
+	require.Error(t, params.Validate())
+
+	// module accounts tracked by their modules
+	for _, moduleName := range []string{"distribution", "bonded_tokens_pool", "not_bonded_tokens_pool", "gov"} {
+		params.SeigniorageDestinations[1] = SeigniorageDestination{Type: SeigniorageDestinationModule, ModuleName: moduleName, Weight: sdk.NewDecWithPrec(5, 1)}
+		require.Error(t, params.Validate())
+	}
+
+	// unknown destination type
+	params.SeigniorageDestinations[1] = SeigniorageDestination{Type: "unknown", Weight: sdk.NewDecWithPrec(5, 1)}
+	require.Error(t, params.Validate())
+
+	// duplicate destination
+	params.SeigniorageDestinations[1] = params.SeigniorageDestinations[0]
+	require.Error(t, params.Validate())
+	params.SeigniorageDestinations = DefaultSeigniorageDestinations
+
+	params.MinGasPrices = sdk.NewDecCoins(sdk.NewDecCoinFromDec("uusd", sdk.NewDecWithPrec(15, 2)))
+	require.NoError(t, params.Validate())
+
+	// unsorted min gas prices
+	params.MinGasPrices = sdk.DecCoins{
+		sdk.NewDecCoinFromDec("uusd", sdk.NewDecWithPrec(15, 2)),
+		sdk.NewDecCoinFromDec("uluna", sdk.NewDecWithPrec(15, 4)),
+	}
+	require.Error(t, params.Validate())
+
+	// zero min gas price
+	params.MinGasPrices = sdk.DecCoins{sdk.NewDecCoinFromDec("uusd", sdk.ZeroDec())}
+	require.Error(t, params.Validate())
+	params.MinGasPrices = DefaultMinGasPrices
+
+	requirement := MsgFeeRequirement{
+		MsgTypeUrl:         "/terra.wasm.v1beta1.MsgStoreCode",
+		GasPriceMultiplier: sdk.NewDec(2),
+		FlatFee:            sdk.NewCoins(sdk.NewInt64Coin("uusd", 1000)),
+	}
+	params.MsgFeeRequirements = []MsgFeeRequirement{requirement}
+	require.NoError(t, params.Validate())
+
+	// duplicate msg type
+	params.MsgFeeRequirements = []MsgFeeRequirement{requirement, requirement}
+	require.Error(t, params.Validate())
+
+	// invalid msg type url
+	params.MsgFeeRequirements = []MsgFeeRequirement{{MsgTypeUrl: "MsgStoreCode", GasPriceMultiplier: sdk.OneDec()}}
+	require.Error(t, params.Validate())
+
+	// negative multiplier
+	params.MsgFeeRequirements = []MsgFeeRequirement{{MsgTypeUrl: requirement.MsgTypeUrl, GasPriceMultiplier: sdk.NewDec(-1)}}
+	require.Error(t, params.Validate())
+	params.MsgFeeRequirements = DefaultMsgFeeRequirements
+
 	require.NotNil(t, params.ParamSetPairs())
 	require.NotNil(t, params.String())
 }
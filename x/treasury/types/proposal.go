@@ -0,0 +1,127 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	// ProposalTypeAddTaxExemption defines the type for a AddTaxExemptionProposal
+	ProposalTypeAddTaxExemption = "AddTaxExemption"
+
+	// ProposalTypeRemoveTaxExemption defines the type for a RemoveTaxExemptionProposal
+	ProposalTypeRemoveTaxExemption = "RemoveTaxExemption"
+)
+
+// Assert proposals implement govtypes.Content at compile-time
+var (
+	_ govtypes.Content = &AddTaxExemptionProposal{}
+	_ govtypes.Content = &RemoveTaxExemptionProposal{}
+)
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeAddTaxExemption)
+	govtypes.RegisterProposalType(ProposalTypeRemoveTaxExemption)
+}
+
+// NewAddTaxExemptionProposal creates a new add tax exemption proposal.
+func NewAddTaxExemptionProposal(title, description, zone string, addresses []string) *AddTaxExemptionProposal {
+	return &AddTaxExemptionProposal{title, description, zone, addresses}
+}
+
+// GetTitle returns the title of an add tax exemption proposal.
+func (p *AddTaxExemptionProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of an add tax exemption proposal.
+func (p *AddTaxExemptionProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of an add tax exemption proposal.
+func (p *AddTaxExemptionProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of an add tax exemption proposal.
+func (p *AddTaxExemptionProposal) ProposalType() string { return ProposalTypeAddTaxExemption }
+
+// ValidateBasic runs basic stateless validity checks
+func (p *AddTaxExemptionProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+
+	if len(strings.TrimSpace(p.Zone)) == 0 {
+		return ErrEmptyTaxExemptionZone
+	}
+
+	return validateTaxExemptionAddresses(p.Addresses)
+}
+
+// String implements the Stringer interface.
+func (p AddTaxExemptionProposal) String() string {
+	return fmt.Sprintf(`Add Tax Exemption Proposal:
+  Title:       %s
+  Description: %s
+  Zone:        %s
+  Addresses:   %s
+`, p.Title, p.Description, p.Zone, strings.Join(p.Addresses, ","))
+}
+
+// NewRemoveTaxExemptionProposal creates a new remove tax exemption proposal.
+func NewRemoveTaxExemptionProposal(title, description string, addresses []string) *RemoveTaxExemptionProposal {
+	return &RemoveTaxExemptionProposal{title, description, addresses}
+}
+
+// GetTitle returns the title of a remove tax exemption proposal.
+func (p *RemoveTaxExemptionProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of a remove tax exemption proposal.
+func (p *RemoveTaxExemptionProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of a remove tax exemption proposal.
+func (p *RemoveTaxExemptionProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a remove tax exemption proposal.
+func (p *RemoveTaxExemptionProposal) ProposalType() string { return ProposalTypeRemoveTaxExemption }
+
+// ValidateBasic runs basic stateless validity checks
+func (p *RemoveTaxExemptionProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+
+	return validateTaxExemptionAddresses(p.Addresses)
+}
+
+// String implements the Stringer interface.
+func (p RemoveTaxExemptionProposal) String() string {
+	return fmt.Sprintf(`Remove Tax Exemption Proposal:
+  Title:       %s
+  Description: %s
+  Addresses:   %s
+`, p.Title, p.Description, strings.Join(p.Addresses, ","))
+}
+
+func validateTaxExemptionAddresses(addresses []string) error {
+	if len(addresses) == 0 {
+		return ErrEmptyTaxExemptionAddresses
+	}
+
+	seen := make(map[string]bool, len(addresses))
+	for _, addr := range addresses {
+		if _, err := sdk.AccAddressFromBech32(addr); err != nil {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+		}
+
+		if seen[addr] {
+			return sdkerrors.Wrap(ErrDuplicateTaxExemption, addr)
+		}
+
+		seen[addr] = true
+	}
+
+	return nil
+}
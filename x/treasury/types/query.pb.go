@@ -8,6 +8,7 @@ import (
 	fmt "fmt"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
@@ -33,6 +34,9 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // QueryTaxRateRequest is the request type for the Query/TaxRate RPC method.
 type QueryTaxRateRequest struct {
+	// denom defines the denomination to query the tax rate for;
+	// the global tax rate is returned when empty or not overridden.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
 func (m *QueryTaxRateRequest) Reset()         { *m = QueryTaxRateRequest{} }
@@ -68,6 +72,13 @@ func (m *QueryTaxRateRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryTaxRateRequest proto.InternalMessageInfo
 
+func (m *QueryTaxRateRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
 // QueryTaxRateResponse is response type for the
 // Query/TaxRate RPC method.
 type QueryTaxRateResponse struct {
@@ -432,6 +443,10 @@ var xxx_messageInfo_QueryTaxProceedsRequest proto.InternalMessageInfo
 // Query/TaxProceeds RPC method.
 type QueryTaxProceedsResponse struct {
 	TaxProceeds github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=tax_proceeds,json=taxProceeds,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"tax_proceeds"`
+	// burned_tax_proceeds is the portion of tax_proceeds burned this epoch
+	BurnedTaxProceeds github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=burned_tax_proceeds,json=burnedTaxProceeds,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"burned_tax_proceeds"`
+	// community_pool_tax_proceeds is the portion of tax_proceeds sent to the community pool this epoch
+	CommunityPoolTaxProceeds github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=community_pool_tax_proceeds,json=communityPoolTaxProceeds,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"community_pool_tax_proceeds"`
 }
 
 func (m *QueryTaxProceedsResponse) Reset()         { *m = QueryTaxProceedsResponse{} }
@@ -474,6 +489,20 @@ func (m *QueryTaxProceedsResponse) GetTaxProceeds() github_com_cosmos_cosmos_sdk
 	return nil
 }
 
+func (m *QueryTaxProceedsResponse) GetBurnedTaxProceeds() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.BurnedTaxProceeds
+	}
+	return nil
+}
+
+func (m *QueryTaxProceedsResponse) GetCommunityPoolTaxProceeds() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.CommunityPoolTaxProceeds
+	}
+	return nil
+}
+
 // QuerySeigniorageProceedsRequest is the request type for the Query/SeigniorageProceeds RPC method.
 type QuerySeigniorageProceedsRequest struct {
 }
@@ -515,6 +544,8 @@ var xxx_messageInfo_QuerySeigniorageProceedsRequest proto.InternalMessageInfo
 // Query/SeigniorageProceeds RPC method.
 type QuerySeigniorageProceedsResponse struct {
 	SeigniorageProceeds github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=seigniorage_proceeds,json=seigniorageProceeds,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"seigniorage_proceeds"`
+	// seigniorage_shares are the portions of seigniorage_proceeds each destination would receive
+	SeigniorageShares []SeigniorageShare `protobuf:"bytes,2,rep,name=seigniorage_shares,json=seigniorageShares,proto3" json:"seigniorage_shares"`
 }
 
 func (m *QuerySeigniorageProceedsResponse) Reset()         { *m = QuerySeigniorageProceedsResponse{} }
@@ -550,6 +581,13 @@ func (m *QuerySeigniorageProceedsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QuerySeigniorageProceedsResponse proto.InternalMessageInfo
 
+func (m *QuerySeigniorageProceedsResponse) GetSeigniorageShares() []SeigniorageShare {
+	if m != nil {
+		return m.SeigniorageShares
+	}
+	return nil
+}
+
 // QueryIndicatorsRequest is the request type for the Query/Indicators RPC method.
 type QueryIndicatorsRequest struct {
 }
@@ -627,22 +665,28 @@ func (m *QueryIndicatorsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryIndicatorsResponse proto.InternalMessageInfo
 
-// QueryParamsRequest is the request type for the Query/Params RPC method.
-type QueryParamsRequest struct {
+// QueryEpochStatesRequest is the request type for the Query/EpochStates RPC method.
+type QueryEpochStatesRequest struct {
+	// start_epoch is the first epoch of the range, inclusive
+	StartEpoch uint64 `protobuf:"varint,1,opt,name=start_epoch,json=startEpoch,proto3" json:"start_epoch,omitempty"`
+	// end_epoch is the last epoch of the range, inclusive; the current epoch if zero
+	EndEpoch uint64 `protobuf:"varint,2,opt,name=end_epoch,json=endEpoch,proto3" json:"end_epoch,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
-func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryParamsRequest) ProtoMessage()    {}
-func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+func (m *QueryEpochStatesRequest) Reset()         { *m = QueryEpochStatesRequest{} }
+func (m *QueryEpochStatesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEpochStatesRequest) ProtoMessage()    {}
+func (*QueryEpochStatesRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_699c8c29293c9a9b, []int{15}
 }
-func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryEpochStatesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryEpochStatesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryEpochStatesRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -652,36 +696,59 @@ func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+func (m *QueryEpochStatesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEpochStatesRequest.Merge(m, src)
 }
-func (m *QueryParamsRequest) XXX_Size() int {
+func (m *QueryEpochStatesRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryParamsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
+func (m *QueryEpochStatesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEpochStatesRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryEpochStatesRequest proto.InternalMessageInfo
 
-// QueryParamsResponse is the response type for the Query/Params RPC method.
-type QueryParamsResponse struct {
-	// params defines the parameters of the module.
-	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+func (m *QueryEpochStatesRequest) GetStartEpoch() uint64 {
+	if m != nil {
+		return m.StartEpoch
+	}
+	return 0
 }
 
-func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
-func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryParamsResponse) ProtoMessage()    {}
-func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+func (m *QueryEpochStatesRequest) GetEndEpoch() uint64 {
+	if m != nil {
+		return m.EndEpoch
+	}
+	return 0
+}
+
+func (m *QueryEpochStatesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryEpochStatesResponse is response type for the
+// Query/EpochStates RPC method.
+type QueryEpochStatesResponse struct {
+	EpochStates []EpochState `protobuf:"bytes,1,rep,name=epoch_states,json=epochStates,proto3" json:"epoch_states"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryEpochStatesResponse) Reset()         { *m = QueryEpochStatesResponse{} }
+func (m *QueryEpochStatesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEpochStatesResponse) ProtoMessage()    {}
+func (*QueryEpochStatesResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_699c8c29293c9a9b, []int{16}
 }
-func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryEpochStatesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryEpochStatesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryEpochStatesResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -691,1178 +758,3610 @@ func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+func (m *QueryEpochStatesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEpochStatesResponse.Merge(m, src)
 }
-func (m *QueryParamsResponse) XXX_Size() int {
+func (m *QueryEpochStatesResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryParamsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+func (m *QueryEpochStatesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEpochStatesResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryEpochStatesResponse proto.InternalMessageInfo
 
-func (m *QueryParamsResponse) GetParams() Params {
+func (m *QueryEpochStatesResponse) GetEpochStates() []EpochState {
 	if m != nil {
-		return m.Params
+		return m.EpochStates
 	}
-	return Params{}
+	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryTaxRateRequest)(nil), "terra.treasury.v1beta1.QueryTaxRateRequest")
-	proto.RegisterType((*QueryTaxRateResponse)(nil), "terra.treasury.v1beta1.QueryTaxRateResponse")
-	proto.RegisterType((*QueryTaxCapRequest)(nil), "terra.treasury.v1beta1.QueryTaxCapRequest")
-	proto.RegisterType((*QueryTaxCapResponse)(nil), "terra.treasury.v1beta1.QueryTaxCapResponse")
-	proto.RegisterType((*QueryTaxCapsRequest)(nil), "terra.treasury.v1beta1.QueryTaxCapsRequest")
-	proto.RegisterType((*QueryTaxCapsResponseItem)(nil), "terra.treasury.v1beta1.QueryTaxCapsResponseItem")
-	proto.RegisterType((*QueryTaxCapsResponse)(nil), "terra.treasury.v1beta1.QueryTaxCapsResponse")
-	proto.RegisterType((*QueryRewardWeightRequest)(nil), "terra.treasury.v1beta1.QueryRewardWeightRequest")
-	proto.RegisterType((*QueryRewardWeightResponse)(nil), "terra.treasury.v1beta1.QueryRewardWeightResponse")
-	proto.RegisterType((*QueryTaxProceedsRequest)(nil), "terra.treasury.v1beta1.QueryTaxProceedsRequest")
-	proto.RegisterType((*QueryTaxProceedsResponse)(nil), "terra.treasury.v1beta1.QueryTaxProceedsResponse")
-	proto.RegisterType((*QuerySeigniorageProceedsRequest)(nil), "terra.treasury.v1beta1.QuerySeigniorageProceedsRequest")
-	proto.RegisterType((*QuerySeigniorageProceedsResponse)(nil), "terra.treasury.v1beta1.QuerySeigniorageProceedsResponse")
-	proto.RegisterType((*QueryIndicatorsRequest)(nil), "terra.treasury.v1beta1.QueryIndicatorsRequest")
-	proto.RegisterType((*QueryIndicatorsResponse)(nil), "terra.treasury.v1beta1.QueryIndicatorsResponse")
-	proto.RegisterType((*QueryParamsRequest)(nil), "terra.treasury.v1beta1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "terra.treasury.v1beta1.QueryParamsResponse")
+func (m *QueryEpochStatesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func init() {
-	proto.RegisterFile("terra/treasury/v1beta1/query.proto", fileDescriptor_699c8c29293c9a9b)
+// QueryNextPolicyRequest is the request type for the Query/NextPolicy RPC method.
+type QueryNextPolicyRequest struct {
 }
 
-var fileDescriptor_699c8c29293c9a9b = []byte{
-	// 909 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x96, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xc7, 0x3d, 0x85, 0x3a, 0xc9, 0x24, 0x5c, 0x26, 0xa6, 0x38, 0x16, 0xda, 0x35, 0xa3, 0x36,
-	0x58, 0x89, 0xb3, 0xeb, 0x84, 0x4a, 0x40, 0xc5, 0xc9, 0x45, 0x20, 0x4b, 0x45, 0x6a, 0x37, 0x96,
-	0x2a, 0x38, 0x60, 0x8d, 0xd7, 0xa3, 0xcd, 0x8a, 0x78, 0x67, 0x3b, 0x3b, 0xa6, 0xb1, 0x10, 0x17,
-	0x24, 0x24, 0xe0, 0x80, 0x90, 0x7a, 0xe2, 0x82, 0x2a, 0x8e, 0xfc, 0x11, 0x9c, 0x73, 0xac, 0xc4,
-	0x05, 0x71, 0x08, 0xc8, 0xe1, 0xc0, 0x89, 0xbf, 0x01, 0xcd, 0xec, 0xac, 0x77, 0xdd, 0x7a, 0xed,
-	0x75, 0x38, 0x65, 0x33, 0xef, 0xd7, 0x67, 0xde, 0x9b, 0xf7, 0x4d, 0x20, 0x16, 0x94, 0x73, 0x62,
-	0x0b, 0x4e, 0x49, 0x34, 0xe2, 0x63, 0xfb, 0xf3, 0xc3, 0x3e, 0x15, 0xe4, 0xd0, 0x7e, 0x34, 0xa2,
-	0x7c, 0x6c, 0x85, 0x9c, 0x09, 0x86, 0x6e, 0x28, 0x1f, 0x2b, 0xf1, 0xb1, 0xb4, 0x4f, 0xad, 0xe2,
-	0x31, 0x8f, 0x29, 0x17, 0x5b, 0x7e, 0xc5, 0xde, 0xb5, 0xd7, 0x3d, 0xc6, 0xbc, 0x53, 0x6a, 0x93,
-	0xd0, 0xb7, 0x49, 0x10, 0x30, 0x41, 0x84, 0xcf, 0x82, 0x48, 0x5b, 0x6f, 0xe5, 0xd4, 0x9b, 0x26,
-	0x8f, 0xdd, 0x0c, 0x97, 0x45, 0x43, 0x16, 0xd9, 0x7d, 0x12, 0xd1, 0xa9, 0x8f, 0xcb, 0xfc, 0x20,
-	0xb6, 0xe3, 0x57, 0xe1, 0xf6, 0x03, 0x49, 0xd8, 0x25, 0x67, 0x0e, 0x11, 0xd4, 0xa1, 0x8f, 0x46,
-	0x34, 0x12, 0x98, 0xc0, 0xca, 0xec, 0x71, 0x14, 0xb2, 0x20, 0xa2, 0xa8, 0x03, 0xd7, 0x05, 0x39,
-	0xeb, 0x71, 0x22, 0x68, 0x15, 0xd4, 0x41, 0x63, 0xa3, 0x6d, 0x9d, 0x5f, 0x98, 0xa5, 0x3f, 0x2e,
-	0xcc, 0x5d, 0xcf, 0x17, 0x27, 0xa3, 0xbe, 0xe5, 0xb2, 0xa1, 0xad, 0x6b, 0xc6, 0x3f, 0x0e, 0xa2,
-	0xc1, 0x67, 0xb6, 0x18, 0x87, 0x34, 0xb2, 0xde, 0xa7, 0xae, 0xb3, 0x26, 0xe2, 0x94, 0xf8, 0x36,
-	0x44, 0x49, 0x89, 0xbb, 0x24, 0xd4, 0x85, 0x51, 0x05, 0x5e, 0x1f, 0xd0, 0x80, 0x0d, 0xe3, 0xec,
-	0x4e, 0xfc, 0xcb, 0x9d, 0xf5, 0x6f, 0x9e, 0x9a, 0xa5, 0x7f, 0x9e, 0x9a, 0x25, 0xfc, 0x69, 0xca,
-	0xab, 0xa2, 0x34, 0xd7, 0x87, 0x50, 0xe6, 0xed, 0xb9, 0x24, 0xbc, 0x02, 0x56, 0x27, 0x10, 0x4e,
-	0x59, 0xa8, 0x84, 0xd8, 0x9c, 0xc9, 0x1f, 0x69, 0xac, 0x0c, 0xc0, 0x18, 0x56, 0x67, 0x1d, 0x62,
-	0x82, 0x8e, 0xa0, 0xc3, 0xf9, 0xf0, 0x59, 0xb6, 0x6b, 0xff, 0x8b, 0xcd, 0x4f, 0x87, 0x92, 0x2d,
-	0x8d, 0x1e, 0xc4, 0x43, 0x71, 0x49, 0x18, 0x55, 0x41, 0xfd, 0xa5, 0xc6, 0xe6, 0x51, 0xcb, 0x9a,
-	0xff, 0xd2, 0xac, 0x3c, 0xf4, 0xf6, 0xcb, 0x92, 0x49, 0x0d, 0x47, 0x9a, 0x70, 0x4d, 0xdf, 0xd2,
-	0xa1, 0x8f, 0x09, 0x1f, 0x3c, 0xa4, 0xbe, 0x77, 0x22, 0x92, 0xb7, 0x11, 0xc2, 0x9d, 0x39, 0x36,
-	0xcd, 0x72, 0x0c, 0x5f, 0xe1, 0xea, 0xbc, 0xf7, 0x58, 0x19, 0xae, 0xf8, 0x4a, 0xb6, 0x78, 0x26,
-	0x39, 0xde, 0x81, 0xaf, 0x25, 0xe0, 0xf7, 0x39, 0x73, 0x29, 0x1d, 0x24, 0x83, 0xc1, 0xdf, 0x81,
-	0x74, 0x1e, 0xa9, 0x4d, 0xc3, 0x04, 0x70, 0x4b, 0x36, 0x26, 0xd4, 0xe7, 0xba, 0x39, 0x3b, 0x56,
-	0x5c, 0xd2, 0x92, 0x3b, 0x31, 0xed, 0xcc, 0x5d, 0xe6, 0x07, 0xed, 0x96, 0xc4, 0xfc, 0xe5, 0x4f,
-	0xb3, 0x51, 0x00, 0x53, 0x06, 0x44, 0xce, 0xa6, 0x48, 0xeb, 0xe2, 0x37, 0xa0, 0xa9, 0x58, 0x8e,
-	0xa9, 0xef, 0x05, 0x3e, 0xe3, 0xc4, 0xa3, 0xcf, 0xf3, 0x7e, 0x0d, 0x60, 0x3d, 0xdf, 0x47, 0x73,
-	0x13, 0x58, 0x89, 0x52, 0x73, 0x96, 0xff, 0x2a, 0xcf, 0x67, 0x3b, 0x7a, 0xb1, 0x14, 0xae, 0xc2,
-	0x1b, 0x0a, 0xa3, 0x13, 0x0c, 0x7c, 0x97, 0x08, 0xc6, 0xa7, 0x84, 0xe7, 0x40, 0x77, 0x3b, 0x6b,
-	0xd2, 0x60, 0x5d, 0xb8, 0x2e, 0xf8, 0x69, 0x6f, 0x4c, 0x09, 0xd7, 0x30, 0xef, 0xae, 0x36, 0xd8,
-	0xc9, 0x85, 0xb9, 0xd6, 0x75, 0xee, 0x7d, 0x4c, 0x09, 0x77, 0xd6, 0x04, 0x3f, 0x95, 0x1f, 0xe8,
-	0x21, 0xdc, 0x90, 0x59, 0x87, 0x2c, 0x10, 0x27, 0x7a, 0x45, 0xee, 0xac, 0x9c, 0x76, 0xbd, 0xeb,
-	0xdc, 0xfb, 0x48, 0x66, 0x70, 0x24, 0xa2, 0xfa, 0xc2, 0x15, 0x2d, 0x31, 0xf7, 0x09, 0x27, 0xc3,
-	0xe9, 0x05, 0x8f, 0xf5, 0x8a, 0x27, 0xa7, 0xfa, 0x6e, 0xef, 0xc1, 0x72, 0xa8, 0x4e, 0xd4, 0xcd,
-	0x36, 0x8f, 0x8c, 0xbc, 0x1d, 0x8a, 0xe3, 0xf4, 0xc6, 0xe8, 0x98, 0xa3, 0x7f, 0x37, 0xe0, 0x75,
-	0x95, 0x15, 0x7d, 0x0f, 0xe0, 0x9a, 0x96, 0x4d, 0xb4, 0xbf, 0x6c, 0x0f, 0x33, 0x9a, 0x5b, 0x6b,
-	0x16, 0x73, 0x8e, 0x71, 0x71, 0xe3, 0xab, 0xdf, 0xfe, 0x7e, 0x72, 0x0d, 0xa3, 0xba, 0x9d, 0xf7,
-	0x87, 0x40, 0xeb, 0x34, 0x7a, 0x02, 0x60, 0x39, 0x5e, 0x79, 0xb4, 0x57, 0x40, 0x17, 0x12, 0x9c,
-	0xfd, 0x42, 0xbe, 0x9a, 0xa6, 0xa5, 0x68, 0xf6, 0x50, 0x63, 0x11, 0x8d, 0x14, 0x28, 0xfb, 0x0b,
-	0x25, 0x8a, 0x5f, 0x26, 0x6d, 0x92, 0x6a, 0x83, 0xf6, 0x8b, 0xc9, 0x55, 0xc1, 0x36, 0x65, 0xb5,
-	0xad, 0x58, 0x9b, 0x24, 0x18, 0xfa, 0x19, 0xc0, 0xad, 0xac, 0xa4, 0xa1, 0xc5, 0x22, 0x3a, 0x47,
-	0x19, 0x6b, 0x87, 0x2b, 0x44, 0x68, 0xbe, 0x03, 0xc5, 0xf7, 0x26, 0xba, 0x95, 0xc7, 0x37, 0xa3,
-	0xa6, 0xe8, 0x57, 0x00, 0xb7, 0xe7, 0x28, 0x07, 0x7a, 0x7b, 0x61, 0xe5, 0x7c, 0x3d, 0xaa, 0xbd,
-	0xb3, 0x7a, 0xa0, 0x26, 0xbf, 0xad, 0xc8, 0x2d, 0xd4, 0xcc, 0x23, 0x9f, 0x27, 0x61, 0xe8, 0x27,
-	0x00, 0x37, 0x33, 0x52, 0x8d, 0xec, 0x65, 0xd3, 0x7c, 0x1e, 0xb8, 0x55, 0x3c, 0x40, 0x83, 0x36,
-	0x15, 0xe8, 0x2e, 0xba, 0xb9, 0xe8, 0x09, 0x4c, 0x01, 0x7f, 0x04, 0x10, 0xa6, 0xca, 0x87, 0xac,
-	0x85, 0xe5, 0x5e, 0x50, 0xcf, 0x9a, 0x5d, 0xd8, 0x5f, 0xd3, 0xed, 0x29, 0xba, 0x9b, 0x08, 0xe7,
-	0xd1, 0xf9, 0x29, 0xcc, 0xb7, 0x00, 0x96, 0x63, 0xf5, 0x59, 0xb2, 0xc9, 0x33, 0x82, 0xb7, 0x64,
-	0x93, 0x67, 0x65, 0x10, 0xef, 0x2a, 0x9e, 0x3a, 0x32, 0xf2, 0x78, 0x62, 0xc1, 0x6b, 0x7f, 0x70,
-	0x3e, 0x31, 0xc0, 0xb3, 0x89, 0x01, 0xfe, 0x9a, 0x18, 0xe0, 0x87, 0x4b, 0xa3, 0xf4, 0xec, 0xd2,
-	0x28, 0xfd, 0x7e, 0x69, 0x94, 0x3e, 0x69, 0x66, 0x34, 0x5b, 0xe5, 0x38, 0x18, 0xb2, 0x80, 0x8e,
-	0x6d, 0x97, 0x71, 0x6a, 0x9f, 0xa5, 0x09, 0x95, 0x7a, 0xf7, 0xcb, 0xea, 0xff, 0xd0, 0xb7, 0xfe,
-	0x0b, 0x00, 0x00, 0xff, 0xff, 0x47, 0x4b, 0x04, 0xb0, 0x40, 0x0b, 0x00, 0x00,
+func (m *QueryNextPolicyRequest) Reset()         { *m = QueryNextPolicyRequest{} }
+func (m *QueryNextPolicyRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryNextPolicyRequest) ProtoMessage()    {}
+func (*QueryNextPolicyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_699c8c29293c9a9b, []int{17}
+}
+func (m *QueryNextPolicyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNextPolicyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNextPolicyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryNextPolicyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNextPolicyRequest.Merge(m, src)
+}
+func (m *QueryNextPolicyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNextPolicyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNextPolicyRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryNextPolicyRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// TaxRate return the current tax rate
-	TaxRate(ctx context.Context, in *QueryTaxRateRequest, opts ...grpc.CallOption) (*QueryTaxRateResponse, error)
-	// TaxCap returns the tax cap of a denom
-	TaxCap(ctx context.Context, in *QueryTaxCapRequest, opts ...grpc.CallOption) (*QueryTaxCapResponse, error)
-	// TaxCaps returns the all tax caps
-	TaxCaps(ctx context.Context, in *QueryTaxCapsRequest, opts ...grpc.CallOption) (*QueryTaxCapsResponse, error)
-	// RewardWeight return the current reward weight
-	RewardWeight(ctx context.Context, in *QueryRewardWeightRequest, opts ...grpc.CallOption) (*QueryRewardWeightResponse, error)
-	// SeigniorageProceeds return the current seigniorage proceeds
-	SeigniorageProceeds(ctx context.Context, in *QuerySeigniorageProceedsRequest, opts ...grpc.CallOption) (*QuerySeigniorageProceedsResponse, error)
-	// TaxProceeds return the current tax proceeds
-	TaxProceeds(ctx context.Context, in *QueryTaxProceedsRequest, opts ...grpc.CallOption) (*QueryTaxProceedsResponse, error)
-	// Indicators return the current trl informations
-	Indicators(ctx context.Context, in *QueryIndicatorsRequest, opts ...grpc.CallOption) (*QueryIndicatorsResponse, error)
-	// Params queries all parameters.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+// QueryNextPolicyResponse is response type for the
+// Query/NextPolicy RPC method.
+type QueryNextPolicyResponse struct {
+	// tax_rate is the projected global tax rate
+	TaxRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=tax_rate,json=taxRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"tax_rate"`
+	// reward_weight is the projected reward weight
+	RewardWeight github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=reward_weight,json=rewardWeight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"reward_weight"`
+	// tax_caps are the projected tax caps
+	TaxCaps []QueryTaxCapsResponseItem `protobuf:"bytes,3,rep,name=tax_caps,json=taxCaps,proto3" json:"tax_caps"`
+	// denom_tax_rates are the projected per denom tax rate overrides
+	DenomTaxRates []DenomTaxRate `protobuf:"bytes,4,rep,name=denom_tax_rates,json=denomTaxRates,proto3" json:"denom_tax_rates"`
+	// seigniorage is the seigniorage to be settled at the end of the epoch
+	Seigniorage github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=seigniorage,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"seigniorage"`
+	// seigniorage_shares are the portions of seigniorage to be settled to each destination
+	SeigniorageShares []SeigniorageShare `protobuf:"bytes,6,rep,name=seigniorage_shares,json=seigniorageShares,proto3" json:"seigniorage_shares"`
+	// remaining_blocks is the number of blocks left until the end of the current epoch
+	RemainingBlocks int64 `protobuf:"varint,7,opt,name=remaining_blocks,json=remainingBlocks,proto3" json:"remaining_blocks,omitempty"`
+}
+
+func (m *QueryNextPolicyResponse) Reset()         { *m = QueryNextPolicyResponse{} }
+func (m *QueryNextPolicyResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryNextPolicyResponse) ProtoMessage()    {}
+func (*QueryNextPolicyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_699c8c29293c9a9b, []int{18}
+}
+func (m *QueryNextPolicyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryNextPolicyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNextPolicyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryNextPolicyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNextPolicyResponse.Merge(m, src)
+}
+func (m *QueryNextPolicyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNextPolicyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNextPolicyResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) TaxRate(ctx context.Context, in *QueryTaxRateRequest, opts ...grpc.CallOption) (*QueryTaxRateResponse, error) {
-	out := new(QueryTaxRateResponse)
-	err := c.cc.Invoke(ctx, "/terra.treasury.v1beta1.Query/TaxRate", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryNextPolicyResponse proto.InternalMessageInfo
+
+func (m *QueryNextPolicyResponse) GetTaxCaps() []QueryTaxCapsResponseItem {
+	if m != nil {
+		return m.TaxCaps
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) TaxCap(ctx context.Context, in *QueryTaxCapRequest, opts ...grpc.CallOption) (*QueryTaxCapResponse, error) {
-	out := new(QueryTaxCapResponse)
-	err := c.cc.Invoke(ctx, "/terra.treasury.v1beta1.Query/TaxCap", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryNextPolicyResponse) GetDenomTaxRates() []DenomTaxRate {
+	if m != nil {
+		return m.DenomTaxRates
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) TaxCaps(ctx context.Context, in *QueryTaxCapsRequest, opts ...grpc.CallOption) (*QueryTaxCapsResponse, error) {
-	out := new(QueryTaxCapsResponse)
-	err := c.cc.Invoke(ctx, "/terra.treasury.v1beta1.Query/TaxCaps", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryNextPolicyResponse) GetSeigniorageShares() []SeigniorageShare {
+	if m != nil {
+		return m.SeigniorageShares
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) RewardWeight(ctx context.Context, in *QueryRewardWeightRequest, opts ...grpc.CallOption) (*QueryRewardWeightResponse, error) {
-	out := new(QueryRewardWeightResponse)
-	err := c.cc.Invoke(ctx, "/terra.treasury.v1beta1.Query/RewardWeight", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryNextPolicyResponse) GetRemainingBlocks() int64 {
+	if m != nil {
+		return m.RemainingBlocks
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) SeigniorageProceeds(ctx context.Context, in *QuerySeigniorageProceedsRequest, opts ...grpc.CallOption) (*QuerySeigniorageProceedsResponse, error) {
-	out := new(QuerySeigniorageProceedsResponse)
-	err := c.cc.Invoke(ctx, "/terra.treasury.v1beta1.Query/SeigniorageProceeds", in, out, opts...)
-	if err != nil {
-		return nil, err
+// QueryBurnedRequest is the request type for the Query/Burned RPC method.
+type QueryBurnedRequest struct {
+}
+
+func (m *QueryBurnedRequest) Reset()         { *m = QueryBurnedRequest{} }
+func (m *QueryBurnedRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBurnedRequest) ProtoMessage()    {}
+func (*QueryBurnedRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_699c8c29293c9a9b, []int{19}
+}
+func (m *QueryBurnedRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBurnedRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBurnedRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryBurnedRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBurnedRequest.Merge(m, src)
+}
+func (m *QueryBurnedRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBurnedRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBurnedRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) TaxProceeds(ctx context.Context, in *QueryTaxProceedsRequest, opts ...grpc.CallOption) (*QueryTaxProceedsResponse, error) {
-	out := new(QueryTaxProceedsResponse)
-	err := c.cc.Invoke(ctx, "/terra.treasury.v1beta1.Query/TaxProceeds", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryBurnedRequest proto.InternalMessageInfo
+
+// QueryBurnedResponse is response type for the
+// Query/Burned RPC method.
+type QueryBurnedResponse struct {
+	// burned is the cumulative amount burned
+	Burned github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=burned,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"burned"`
+	// epoch_burned is the amount burned during the current epoch
+	EpochBurned github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=epoch_burned,json=epochBurned,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"epoch_burned"`
+}
+
+func (m *QueryBurnedResponse) Reset()         { *m = QueryBurnedResponse{} }
+func (m *QueryBurnedResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBurnedResponse) ProtoMessage()    {}
+func (*QueryBurnedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_699c8c29293c9a9b, []int{20}
+}
+func (m *QueryBurnedResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBurnedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBurnedResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryBurnedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBurnedResponse.Merge(m, src)
+}
+func (m *QueryBurnedResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBurnedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBurnedResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) Indicators(ctx context.Context, in *QueryIndicatorsRequest, opts ...grpc.CallOption) (*QueryIndicatorsResponse, error) {
-	out := new(QueryIndicatorsResponse)
-	err := c.cc.Invoke(ctx, "/terra.treasury.v1beta1.Query/Indicators", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryBurnedResponse proto.InternalMessageInfo
+
+func (m *QueryBurnedResponse) GetBurned() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Burned
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/terra.treasury.v1beta1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryBurnedResponse) GetEpochBurned() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.EpochBurned
 	}
-	return out, nil
+	return nil
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// TaxRate return the current tax rate
-	TaxRate(context.Context, *QueryTaxRateRequest) (*QueryTaxRateResponse, error)
-	// TaxCap returns the tax cap of a denom
-	TaxCap(context.Context, *QueryTaxCapRequest) (*QueryTaxCapResponse, error)
-	// TaxCaps returns the all tax caps
-	TaxCaps(context.Context, *QueryTaxCapsRequest) (*QueryTaxCapsResponse, error)
-	// RewardWeight return the current reward weight
-	RewardWeight(context.Context, *QueryRewardWeightRequest) (*QueryRewardWeightResponse, error)
-	// SeigniorageProceeds return the current seigniorage proceeds
-	SeigniorageProceeds(context.Context, *QuerySeigniorageProceedsRequest) (*QuerySeigniorageProceedsResponse, error)
-	// TaxProceeds return the current tax proceeds
-	TaxProceeds(context.Context, *QueryTaxProceedsRequest) (*QueryTaxProceedsResponse, error)
-	// Indicators return the current trl informations
-	Indicators(context.Context, *QueryIndicatorsRequest) (*QueryIndicatorsResponse, error)
-	// Params queries all parameters.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+// QueryMinGasPricesRequest is the request type for the Query/MinGasPrices RPC method.
+type QueryMinGasPricesRequest struct {
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QueryMinGasPricesRequest) Reset()         { *m = QueryMinGasPricesRequest{} }
+func (m *QueryMinGasPricesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMinGasPricesRequest) ProtoMessage()    {}
+func (*QueryMinGasPricesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_699c8c29293c9a9b, []int{21}
 }
-
-func (*UnimplementedQueryServer) TaxRate(ctx context.Context, req *QueryTaxRateRequest) (*QueryTaxRateResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method TaxRate not implemented")
+func (m *QueryMinGasPricesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) TaxCap(ctx context.Context, req *QueryTaxCapRequest) (*QueryTaxCapResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method TaxCap not implemented")
+func (m *QueryMinGasPricesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMinGasPricesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) TaxCaps(ctx context.Context, req *QueryTaxCapsRequest) (*QueryTaxCapsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method TaxCaps not implemented")
+func (m *QueryMinGasPricesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMinGasPricesRequest.Merge(m, src)
 }
-func (*UnimplementedQueryServer) RewardWeight(ctx context.Context, req *QueryRewardWeightRequest) (*QueryRewardWeightResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RewardWeight not implemented")
+func (m *QueryMinGasPricesRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) SeigniorageProceeds(ctx context.Context, req *QuerySeigniorageProceedsRequest) (*QuerySeigniorageProceedsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SeigniorageProceeds not implemented")
+func (m *QueryMinGasPricesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMinGasPricesRequest.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) TaxProceeds(ctx context.Context, req *QueryTaxProceedsRequest) (*QueryTaxProceedsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method TaxProceeds not implemented")
+
+var xxx_messageInfo_QueryMinGasPricesRequest proto.InternalMessageInfo
+
+// QueryMinGasPricesResponse is response type for the
+// Query/MinGasPrices RPC method.
+type QueryMinGasPricesResponse struct {
+	MinGasPrices github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,1,rep,name=min_gas_prices,json=minGasPrices,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"min_gas_prices"`
 }
-func (*UnimplementedQueryServer) Indicators(ctx context.Context, req *QueryIndicatorsRequest) (*QueryIndicatorsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Indicators not implemented")
+
+func (m *QueryMinGasPricesResponse) Reset()         { *m = QueryMinGasPricesResponse{} }
+func (m *QueryMinGasPricesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMinGasPricesResponse) ProtoMessage()    {}
+func (*QueryMinGasPricesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_699c8c29293c9a9b, []int{22}
 }
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryMinGasPricesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *QueryMinGasPricesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMinGasPricesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMinGasPricesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMinGasPricesResponse.Merge(m, src)
+}
+func (m *QueryMinGasPricesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMinGasPricesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMinGasPricesResponse.DiscardUnknown(m)
 }
 
-func _Query_TaxRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryTaxRateRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).TaxRate(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/terra.treasury.v1beta1.Query/TaxRate",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).TaxRate(ctx, req.(*QueryTaxRateRequest))
+var xxx_messageInfo_QueryMinGasPricesResponse proto.InternalMessageInfo
+
+func (m *QueryMinGasPricesResponse) GetMinGasPrices() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.MinGasPrices
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_TaxCap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryTaxCapRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).TaxCap(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/terra.treasury.v1beta1.Query/TaxCap",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).TaxCap(ctx, req.(*QueryTaxCapRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryTaxExemptionsRequest is the request type for the Query/TaxExemptions RPC method.
+type QueryTaxExemptionsRequest struct {
 }
 
-func _Query_TaxCaps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryTaxCapsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).TaxCaps(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/terra.treasury.v1beta1.Query/TaxCaps",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).TaxCaps(ctx, req.(*QueryTaxCapsRequest))
+func (m *QueryTaxExemptionsRequest) Reset()         { *m = QueryTaxExemptionsRequest{} }
+func (m *QueryTaxExemptionsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTaxExemptionsRequest) ProtoMessage()    {}
+func (*QueryTaxExemptionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_699c8c29293c9a9b, []int{23}
+}
+func (m *QueryTaxExemptionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTaxExemptionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTaxExemptionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryTaxExemptionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTaxExemptionsRequest.Merge(m, src)
+}
+func (m *QueryTaxExemptionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTaxExemptionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTaxExemptionsRequest.DiscardUnknown(m)
 }
 
-func _Query_RewardWeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryRewardWeightRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).RewardWeight(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/terra.treasury.v1beta1.Query/RewardWeight",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).RewardWeight(ctx, req.(*QueryRewardWeightRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_QueryTaxExemptionsRequest proto.InternalMessageInfo
+
+// QueryTaxExemptionsResponse is response type for the
+// Query/TaxExemptions RPC method.
+type QueryTaxExemptionsResponse struct {
+	TaxExemptions []TaxExemption `protobuf:"bytes,1,rep,name=tax_exemptions,json=taxExemptions,proto3" json:"tax_exemptions"`
 }
 
-func _Query_SeigniorageProceeds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QuerySeigniorageProceedsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).SeigniorageProceeds(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/terra.treasury.v1beta1.Query/SeigniorageProceeds",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).SeigniorageProceeds(ctx, req.(*QuerySeigniorageProceedsRequest))
+func (m *QueryTaxExemptionsResponse) Reset()         { *m = QueryTaxExemptionsResponse{} }
+func (m *QueryTaxExemptionsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTaxExemptionsResponse) ProtoMessage()    {}
+func (*QueryTaxExemptionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_699c8c29293c9a9b, []int{24}
+}
+func (m *QueryTaxExemptionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTaxExemptionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTaxExemptionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryTaxExemptionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTaxExemptionsResponse.Merge(m, src)
+}
+func (m *QueryTaxExemptionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTaxExemptionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTaxExemptionsResponse.DiscardUnknown(m)
 }
 
-func _Query_TaxProceeds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryTaxProceedsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).TaxProceeds(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/terra.treasury.v1beta1.Query/TaxProceeds",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).TaxProceeds(ctx, req.(*QueryTaxProceedsRequest))
+var xxx_messageInfo_QueryTaxExemptionsResponse proto.InternalMessageInfo
+
+func (m *QueryTaxExemptionsResponse) GetTaxExemptions() []TaxExemption {
+	if m != nil {
+		return m.TaxExemptions
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_Indicators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryIndicatorsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Indicators(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/terra.treasury.v1beta1.Query/Indicators",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Indicators(ctx, req.(*QueryIndicatorsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryParamsRequest is the request type for the Query/Params RPC method.
+type QueryParamsRequest struct {
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/terra.treasury.v1beta1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_699c8c29293c9a9b, []int{25}
+}
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+}
+func (m *QueryParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "terra.treasury.v1beta1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "TaxRate",
-			Handler:    _Query_TaxRate_Handler,
-		},
-		{
-			MethodName: "TaxCap",
-			Handler:    _Query_TaxCap_Handler,
-		},
-		{
-			MethodName: "TaxCaps",
-			Handler:    _Query_TaxCaps_Handler,
-		},
-		{
-			MethodName: "RewardWeight",
-			Handler:    _Query_RewardWeight_Handler,
-		},
-		{
-			MethodName: "SeigniorageProceeds",
-			Handler:    _Query_SeigniorageProceeds_Handler,
-		},
-		{
-			MethodName: "TaxProceeds",
-			Handler:    _Query_TaxProceeds_Handler,
-		},
-		{
-			MethodName: "Indicators",
-			Handler:    _Query_Indicators_Handler,
-		},
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "terra/treasury/v1beta1/query.proto",
+var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+
+// QueryParamsResponse is the response type for the Query/Params RPC method.
+type QueryParamsResponse struct {
+	// params defines the parameters of the module.
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 }
 
-func (m *QueryTaxRateRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_699c8c29293c9a9b, []int{26}
+}
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+}
+func (m *QueryParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryTaxRateRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+
+func (m *QueryParamsResponse) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
 }
 
-func (m *QueryTaxRateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func init() {
+	proto.RegisterType((*QueryTaxRateRequest)(nil), "terra.treasury.v1beta1.QueryTaxRateRequest")
+	proto.RegisterType((*QueryTaxRateResponse)(nil), "terra.treasury.v1beta1.QueryTaxRateResponse")
+	proto.RegisterType((*QueryTaxCapRequest)(nil), "terra.treasury.v1beta1.QueryTaxCapRequest")
+	proto.RegisterType((*QueryTaxCapResponse)(nil), "terra.treasury.v1beta1.QueryTaxCapResponse")
+	proto.RegisterType((*QueryTaxCapsRequest)(nil), "terra.treasury.v1beta1.QueryTaxCapsRequest")
+	proto.RegisterType((*QueryTaxCapsResponseItem)(nil), "terra.treasury.v1beta1.QueryTaxCapsResponseItem")
+	proto.RegisterType((*QueryTaxCapsResponse)(nil), "terra.treasury.v1beta1.QueryTaxCapsResponse")
+	proto.RegisterType((*QueryRewardWeightRequest)(nil), "terra.treasury.v1beta1.QueryRewardWeightRequest")
+	proto.RegisterType((*QueryRewardWeightResponse)(nil), "terra.treasury.v1beta1.QueryRewardWeightResponse")
+	proto.RegisterType((*QueryTaxProceedsRequest)(nil), "terra.treasury.v1beta1.QueryTaxProceedsRequest")
+	proto.RegisterType((*QueryTaxProceedsResponse)(nil), "terra.treasury.v1beta1.QueryTaxProceedsResponse")
+	proto.RegisterType((*QuerySeigniorageProceedsRequest)(nil), "terra.treasury.v1beta1.QuerySeigniorageProceedsRequest")
+	proto.RegisterType((*QuerySeigniorageProceedsResponse)(nil), "terra.treasury.v1beta1.QuerySeigniorageProceedsResponse")
+	proto.RegisterType((*QueryIndicatorsRequest)(nil), "terra.treasury.v1beta1.QueryIndicatorsRequest")
+	proto.RegisterType((*QueryIndicatorsResponse)(nil), "terra.treasury.v1beta1.QueryIndicatorsResponse")
+	proto.RegisterType((*QueryEpochStatesRequest)(nil), "terra.treasury.v1beta1.QueryEpochStatesRequest")
+	proto.RegisterType((*QueryEpochStatesResponse)(nil), "terra.treasury.v1beta1.QueryEpochStatesResponse")
+	proto.RegisterType((*QueryNextPolicyRequest)(nil), "terra.treasury.v1beta1.QueryNextPolicyRequest")
+	proto.RegisterType((*QueryNextPolicyResponse)(nil), "terra.treasury.v1beta1.QueryNextPolicyResponse")
+	proto.RegisterType((*QueryBurnedRequest)(nil), "terra.treasury.v1beta1.QueryBurnedRequest")
+	proto.RegisterType((*QueryBurnedResponse)(nil), "terra.treasury.v1beta1.QueryBurnedResponse")
+	proto.RegisterType((*QueryMinGasPricesRequest)(nil), "terra.treasury.v1beta1.QueryMinGasPricesRequest")
+	proto.RegisterType((*QueryMinGasPricesResponse)(nil), "terra.treasury.v1beta1.QueryMinGasPricesResponse")
+	proto.RegisterType((*QueryTaxExemptionsRequest)(nil), "terra.treasury.v1beta1.QueryTaxExemptionsRequest")
+	proto.RegisterType((*QueryTaxExemptionsResponse)(nil), "terra.treasury.v1beta1.QueryTaxExemptionsResponse")
+	proto.RegisterType((*QueryParamsRequest)(nil), "terra.treasury.v1beta1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "terra.treasury.v1beta1.QueryParamsResponse")
 }
 
-func (m *QueryTaxRateResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func init() {
+	proto.RegisterFile("terra/treasury/v1beta1/query.proto", fileDescriptor_699c8c29293c9a9b)
 }
 
-func (m *QueryTaxRateResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var fileDescriptor_699c8c29293c9a9b = []byte{
+	// 1494 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x98, 0xcd, 0x6f, 0x1b, 0x45,
+	0x14, 0xc0, 0xb3, 0x49, 0xea, 0xa4, 0xcf, 0xe9, 0xd7, 0x38, 0x6a, 0x9d, 0x6d, 0x65, 0x9b, 0x25,
+	0x4d, 0x4d, 0x9c, 0x7a, 0x93, 0xb4, 0x12, 0x50, 0x71, 0x4a, 0xbf, 0x14, 0xd1, 0x22, 0x77, 0x13,
+	0xa9, 0x02, 0x09, 0xac, 0xc9, 0x7a, 0xe4, 0xac, 0x6a, 0xef, 0x6c, 0x77, 0x27, 0xd4, 0x56, 0xc5,
+	0x85, 0x0b, 0x1f, 0x07, 0x84, 0x54, 0x2e, 0x70, 0x80, 0x0a, 0x24, 0x0e, 0x9c, 0xf8, 0x03, 0x10,
+	0xe7, 0x1e, 0x2b, 0x71, 0x41, 0x1c, 0x0a, 0x4a, 0x7b, 0x40, 0xfc, 0x15, 0x68, 0x67, 0x66, 0xd7,
+	0xbb, 0xb1, 0xd7, 0xde, 0xa4, 0xe9, 0xa9, 0xee, 0xbc, 0x37, 0xef, 0xfd, 0xe6, 0xbd, 0x99, 0xf7,
+	0xde, 0x06, 0x34, 0x46, 0x5c, 0x17, 0xeb, 0xcc, 0x25, 0xd8, 0xdb, 0x71, 0xbb, 0xfa, 0xc7, 0x2b,
+	0x5b, 0x84, 0xe1, 0x15, 0xfd, 0xfe, 0x0e, 0x71, 0xbb, 0x55, 0xc7, 0xa5, 0x8c, 0xa2, 0xd3, 0x5c,
+	0xa7, 0x1a, 0xe8, 0x54, 0xa5, 0x8e, 0x3a, 0xdb, 0xa4, 0x4d, 0xca, 0x55, 0x74, 0xff, 0x97, 0xd0,
+	0x56, 0xcf, 0x35, 0x29, 0x6d, 0xb6, 0x88, 0x8e, 0x1d, 0x4b, 0xc7, 0xb6, 0x4d, 0x19, 0x66, 0x16,
+	0xb5, 0x3d, 0x29, 0x3d, 0x9f, 0xe0, 0x2f, 0x34, 0x2e, 0xd4, 0xe6, 0x13, 0xd4, 0x9a, 0xc4, 0x26,
+	0x9e, 0x15, 0x18, 0x2b, 0x98, 0xd4, 0x6b, 0x53, 0x4f, 0xdf, 0xc2, 0x1e, 0x09, 0x55, 0x4c, 0x6a,
+	0xd9, 0x52, 0xbe, 0x18, 0x95, 0xf3, 0x13, 0x85, 0x5a, 0x0e, 0x6e, 0x5a, 0x36, 0x27, 0x13, 0xba,
+	0x5a, 0x05, 0x72, 0x77, 0x7c, 0x8d, 0x4d, 0xdc, 0x31, 0x30, 0x23, 0x06, 0xb9, 0xbf, 0x43, 0x3c,
+	0x86, 0x66, 0xe1, 0x48, 0x83, 0xd8, 0xb4, 0x9d, 0x57, 0x4a, 0x4a, 0xf9, 0xa8, 0x21, 0xfe, 0xa3,
+	0x61, 0x98, 0x8d, 0x2b, 0x7b, 0x0e, 0xb5, 0x3d, 0x82, 0xd6, 0x61, 0x9a, 0xe1, 0x4e, 0xdd, 0xc5,
+	0x8c, 0x88, 0x0d, 0x6b, 0xd5, 0x27, 0xcf, 0x8a, 0x63, 0x7f, 0x3d, 0x2b, 0x2e, 0x34, 0x2d, 0xb6,
+	0xbd, 0xb3, 0x55, 0x35, 0x69, 0x5b, 0x97, 0x54, 0xe2, 0x9f, 0x8b, 0x5e, 0xe3, 0x9e, 0xce, 0xba,
+	0x0e, 0xf1, 0xaa, 0xd7, 0x88, 0x69, 0x4c, 0x31, 0x61, 0x52, 0xbb, 0x0c, 0x28, 0x70, 0x71, 0x15,
+	0x3b, 0x43, 0x71, 0xae, 0x4c, 0x7f, 0xfe, 0xb8, 0x38, 0xf6, 0xef, 0xe3, 0xe2, 0x98, 0xf6, 0x11,
+	0xe4, 0x62, 0xbb, 0x24, 0xd7, 0x4d, 0xf0, 0xed, 0xd6, 0x4d, 0xec, 0x1c, 0x00, 0x6b, 0xdd, 0x66,
+	0x46, 0x86, 0x71, 0x83, 0x5a, 0x31, 0x66, 0xdf, 0x93, 0x58, 0x11, 0x80, 0x2e, 0xe4, 0xe3, 0x0a,
+	0x82, 0x60, 0x9d, 0x91, 0xf6, 0x60, 0xf8, 0x28, 0xdb, 0xf8, 0x4b, 0xb1, 0x59, 0x30, 0x3b, 0xc8,
+	0x35, 0xba, 0x23, 0x92, 0x62, 0x62, 0xc7, 0xcb, 0x2b, 0xa5, 0x89, 0x72, 0x76, 0x75, 0xb9, 0x3a,
+	0xf8, 0x46, 0x57, 0x93, 0xd0, 0xd7, 0x26, 0x7d, 0x26, 0x9e, 0x1c, 0x5f, 0xa4, 0xa9, 0xf2, 0x94,
+	0x06, 0x79, 0x80, 0xdd, 0xc6, 0x5d, 0x62, 0x35, 0xb7, 0x99, 0x8c, 0x85, 0xe6, 0xc0, 0xdc, 0x00,
+	0x99, 0x64, 0xd9, 0x80, 0x63, 0x2e, 0x5f, 0xaf, 0x3f, 0xe0, 0x82, 0x03, 0xde, 0x92, 0x19, 0x37,
+	0x62, 0x5c, 0x9b, 0x83, 0x33, 0x01, 0x78, 0xcd, 0xa5, 0x26, 0x21, 0x8d, 0x20, 0x31, 0xda, 0x37,
+	0x13, 0x90, 0xef, 0x97, 0x49, 0x18, 0x1b, 0x66, 0xfc, 0xc0, 0x38, 0x72, 0x5d, 0x06, 0x67, 0xae,
+	0x2a, 0x5c, 0x56, 0xfd, 0x57, 0x13, 0x46, 0xe6, 0x2a, 0xb5, 0xec, 0xb5, 0x65, 0x1f, 0xf3, 0x97,
+	0xbf, 0x8b, 0xe5, 0x14, 0x98, 0xfe, 0x06, 0xcf, 0xc8, 0xb2, 0x9e, 0x5f, 0xf4, 0x10, 0x72, 0x5b,
+	0x3b, 0xae, 0x4d, 0x1a, 0xf5, 0x98, 0xdb, 0xf1, 0xc3, 0x77, 0x7b, 0x4a, 0xf8, 0x89, 0x1c, 0x1a,
+	0x7d, 0xa9, 0xc0, 0x59, 0x93, 0xb6, 0xdb, 0x3b, 0xb6, 0xc5, 0xba, 0x75, 0x87, 0xd2, 0x56, 0x9c,
+	0x62, 0xe2, 0xf0, 0x29, 0xf2, 0xa1, 0xbf, 0x1a, 0xa5, 0xad, 0x08, 0x8c, 0xf6, 0x1a, 0x14, 0x79,
+	0x56, 0x36, 0x88, 0xd5, 0xb4, 0x2d, 0xea, 0xe2, 0x26, 0xd9, 0x9b, 0xb9, 0x17, 0x0a, 0x94, 0x92,
+	0x75, 0x64, 0x06, 0x31, 0xcc, 0x7a, 0x3d, 0x71, 0x34, 0x93, 0x07, 0x79, 0x48, 0x39, 0xaf, 0xdf,
+	0x15, 0xfa, 0x10, 0x50, 0xd4, 0x85, 0xb7, 0x8d, 0x5d, 0x12, 0xe4, 0xac, 0x9c, 0xf4, 0x8e, 0x22,
+	0xcc, 0x1b, 0xfe, 0x06, 0xf9, 0x7e, 0x4e, 0x79, 0x7b, 0xd6, 0x3d, 0x2d, 0x0f, 0xa7, 0xf9, 0x29,
+	0xd7, 0xed, 0x86, 0x65, 0x62, 0x46, 0xdd, 0x30, 0x00, 0x4f, 0x14, 0x38, 0xd3, 0x27, 0x92, 0xe7,
+	0xde, 0x84, 0x69, 0xe6, 0xb6, 0xea, 0x5d, 0x82, 0x5d, 0x79, 0xd6, 0xb7, 0xf7, 0xf7, 0x82, 0x76,
+	0x9f, 0x15, 0xa7, 0x36, 0x8d, 0x5b, 0xef, 0x13, 0xec, 0x1a, 0x53, 0xcc, 0x6d, 0xf9, 0x3f, 0xd0,
+	0x5d, 0x38, 0xea, 0x5b, 0x6d, 0x53, 0x9b, 0x6d, 0xcb, 0x5a, 0x74, 0x65, 0xdf, 0x66, 0xa7, 0x37,
+	0x8d, 0x5b, 0xb7, 0x7d, 0x0b, 0x86, 0x8f, 0xc8, 0x7f, 0x69, 0x3f, 0x04, 0x47, 0xb9, 0xee, 0x50,
+	0x73, 0x7b, 0x83, 0x61, 0x46, 0x82, 0x63, 0xa2, 0x22, 0x64, 0x3d, 0x86, 0x5d, 0x56, 0x27, 0xbe,
+	0x8c, 0x9f, 0x66, 0xd2, 0x00, 0xbe, 0xc4, 0xb5, 0xd1, 0x59, 0x38, 0x4a, 0xec, 0x86, 0x14, 0x8f,
+	0x73, 0xf1, 0x34, 0xb1, 0x1b, 0x42, 0x78, 0x03, 0xa0, 0xd7, 0xc9, 0xf2, 0x13, 0x25, 0xa5, 0x9c,
+	0x5d, 0x5d, 0x88, 0xdd, 0x61, 0xd1, 0xc8, 0x83, 0xc4, 0xd4, 0x70, 0x33, 0x68, 0x6d, 0x46, 0x64,
+	0xa7, 0xf6, 0xab, 0x22, 0xeb, 0x44, 0x8c, 0x50, 0x46, 0xfb, 0x5d, 0x98, 0xe1, 0xde, 0xeb, 0x1e,
+	0x5f, 0x97, 0x75, 0x42, 0x4b, 0x4a, 0x7e, 0xcf, 0x84, 0x4c, 0x7b, 0x96, 0xf4, 0x8c, 0xa2, 0x9b,
+	0x31, 0xe2, 0x71, 0x4e, 0x7c, 0x61, 0x24, 0xb1, 0x20, 0x89, 0x21, 0x07, 0x37, 0xe7, 0x3d, 0xd2,
+	0x61, 0x35, 0xda, 0xb2, 0xcc, 0x6e, 0x70, 0x73, 0x7e, 0x9b, 0x84, 0x33, 0x7d, 0xa2, 0x43, 0xef,
+	0xd0, 0xfd, 0xb5, 0x7c, 0xfc, 0xe5, 0x6b, 0x79, 0xac, 0x59, 0x4d, 0x1c, 0x4a, 0xb3, 0x42, 0x06,
+	0x9c, 0xe0, 0x9d, 0xb6, 0x1e, 0x1c, 0xdc, 0xcb, 0x4f, 0x72, 0xcb, 0xf3, 0x49, 0x96, 0xaf, 0xf9,
+	0xea, 0x72, 0xb6, 0x91, 0xd6, 0x8e, 0x35, 0x22, 0x6b, 0x1e, 0xaa, 0x41, 0x36, 0xf2, 0x96, 0xf3,
+	0x47, 0x0e, 0x54, 0x6f, 0xa2, 0x26, 0x12, 0xea, 0x4c, 0xe6, 0x90, 0xea, 0x0c, 0x7a, 0x03, 0x4e,
+	0xba, 0xa4, 0x8d, 0x2d, 0xdb, 0xb2, 0x9b, 0xf5, 0xad, 0x16, 0x35, 0xef, 0x79, 0xf9, 0xa9, 0x92,
+	0x52, 0x9e, 0x30, 0x4e, 0x84, 0xeb, 0x6b, 0x7c, 0x59, 0x9b, 0x95, 0x93, 0xd7, 0x1a, 0xef, 0x21,
+	0xc1, 0xa5, 0xfa, 0x4f, 0x81, 0x5c, 0x6c, 0x59, 0x5e, 0x28, 0x13, 0x32, 0xa2, 0xd9, 0xbc, 0x8a,
+	0xf6, 0x29, 0x4d, 0xfb, 0x9d, 0x5a, 0xbc, 0x40, 0xe9, 0xea, 0x15, 0xb4, 0x4c, 0xf1, 0x48, 0xc5,
+	0xe1, 0xc2, 0xf9, 0xe6, 0xb6, 0x65, 0xdf, 0xc4, 0x5e, 0xcd, 0xb5, 0x4c, 0xd2, 0x1b, 0x29, 0x14,
+	0x98, 0x1b, 0x20, 0x94, 0xe1, 0x78, 0x00, 0xc7, 0xdb, 0x96, 0x5d, 0x6f, 0x62, 0xaf, 0xee, 0x70,
+	0x89, 0x0c, 0xcb, 0xb9, 0x81, 0xac, 0xd7, 0x88, 0xc9, 0x71, 0x2f, 0x49, 0xdc, 0x4a, 0xba, 0x37,
+	0x23, 0x88, 0x67, 0xda, 0x11, 0x00, 0xed, 0xac, 0xa4, 0xda, 0xc4, 0x9d, 0xeb, 0x1d, 0xd2, 0x76,
+	0xf8, 0x47, 0x47, 0xc0, 0x4c, 0x41, 0x1d, 0x24, 0x0c, 0x07, 0xc4, 0xe3, 0xfe, 0xd3, 0x20, 0xa1,
+	0x24, 0xaf, 0x0c, 0x7f, 0x1f, 0x51, 0x33, 0xc1, 0xfb, 0x60, 0x51, 0xd3, 0xe1, 0x1d, 0xaa, 0x61,
+	0x17, 0xb7, 0x43, 0x8c, 0x0d, 0xc8, 0xc5, 0x56, 0xa5, 0xff, 0x77, 0x20, 0xe3, 0xf0, 0x15, 0x5e,
+	0x91, 0xb2, 0xab, 0x85, 0x24, 0xbf, 0x62, 0x9f, 0xf4, 0x28, 0xf7, 0xac, 0x7e, 0x76, 0x12, 0x8e,
+	0x70, 0xab, 0xe8, 0x2b, 0x05, 0xa6, 0xe4, 0x0b, 0x45, 0x95, 0x51, 0x55, 0x23, 0xf2, 0x91, 0xa3,
+	0x2e, 0xa5, 0x53, 0x16, 0xb8, 0x5a, 0xf9, 0xd3, 0x3f, 0x5e, 0x3c, 0x1a, 0xd7, 0x50, 0x49, 0x4f,
+	0xfa, 0x96, 0x93, 0x75, 0x06, 0x3d, 0x52, 0x20, 0x23, 0x0a, 0x14, 0x5a, 0x4c, 0x51, 0xc5, 0x02,
+	0x9c, 0x4a, 0x2a, 0x5d, 0x49, 0xb3, 0xcc, 0x69, 0x16, 0x51, 0x79, 0x18, 0x8d, 0x5f, 0x4e, 0xf5,
+	0x87, 0xbc, 0x84, 0x7d, 0x12, 0x84, 0x89, 0xd7, 0xc6, 0x4a, 0xba, 0xe2, 0x9a, 0x32, 0x4c, 0xd1,
+	0x4a, 0x9c, 0x2e, 0x4c, 0x3e, 0x18, 0xfa, 0x51, 0x81, 0x99, 0xe8, 0xd7, 0x02, 0x1a, 0x5e, 0xf2,
+	0x07, 0x7c, 0x74, 0xa8, 0x2b, 0xfb, 0xd8, 0x21, 0xf9, 0x2e, 0x72, 0xbe, 0x0b, 0xe8, 0x7c, 0x12,
+	0x5f, 0xac, 0xb9, 0xa1, 0xdf, 0x15, 0xc8, 0x0d, 0x18, 0x45, 0xd1, 0x9b, 0x43, 0x3d, 0x27, 0x0f,
+	0xb8, 0xea, 0x5b, 0xfb, 0xdf, 0x28, 0xc9, 0x2f, 0x73, 0xf2, 0x2a, 0x5a, 0x4a, 0x22, 0x1f, 0x34,
+	0x13, 0xa3, 0xef, 0x15, 0xc8, 0x46, 0x3f, 0x08, 0xf4, 0x51, 0xd9, 0xdc, 0x0b, 0xbc, 0x9c, 0x7e,
+	0x83, 0x04, 0x5d, 0xe2, 0xa0, 0x0b, 0x68, 0x7e, 0xd8, 0x15, 0x08, 0x01, 0xbf, 0x55, 0x00, 0x7a,
+	0xb3, 0x2e, 0xaa, 0x0e, 0x75, 0xd7, 0x37, 0x2f, 0xab, 0x7a, 0x6a, 0x7d, 0x49, 0xb7, 0xc8, 0xe9,
+	0xe6, 0x91, 0x96, 0x44, 0x67, 0xf5, 0x60, 0xfc, 0xe0, 0x45, 0x46, 0xc3, 0x11, 0xc1, 0xeb, 0x1f,
+	0x73, 0xd5, 0xe5, 0xf4, 0x1b, 0xd2, 0x06, 0x2f, 0x3a, 0x93, 0xa2, 0xef, 0x14, 0x80, 0xde, 0xb8,
+	0x37, 0x22, 0x78, 0x7d, 0x23, 0xa3, 0xaa, 0xa7, 0xd6, 0x97, 0x74, 0x15, 0x4e, 0x77, 0x1e, 0xbd,
+	0x9e, 0x44, 0x67, 0x93, 0x0e, 0xab, 0x3b, 0x82, 0xe6, 0x0b, 0x05, 0x32, 0xa2, 0xb3, 0x8e, 0xa8,
+	0x83, 0xb1, 0x91, 0x43, 0xad, 0xa4, 0xd2, 0x95, 0x40, 0x0b, 0x1c, 0xa8, 0x84, 0x0a, 0x49, 0x40,
+	0x72, 0x94, 0xf8, 0x49, 0x81, 0x99, 0x68, 0xe7, 0x1e, 0x51, 0x6c, 0x06, 0x4c, 0x00, 0xea, 0xca,
+	0x3e, 0x76, 0x48, 0xba, 0x2a, 0xa7, 0x2b, 0xa3, 0x85, 0x24, 0xba, 0xf8, 0xd0, 0x80, 0x7e, 0x56,
+	0xe0, 0x58, 0xac, 0x59, 0xa3, 0x95, 0x51, 0xaf, 0xaf, 0xaf, 0xeb, 0xab, 0xab, 0xfb, 0xd9, 0x92,
+	0x16, 0x34, 0x3e, 0x29, 0xf0, 0xd4, 0x8a, 0xb6, 0x3c, 0x22, 0xb5, 0xb1, 0x49, 0x40, 0xad, 0xa4,
+	0xd2, 0x4d, 0x9b, 0x5a, 0x31, 0x09, 0xac, 0xdd, 0x78, 0xb2, 0x5b, 0x50, 0x9e, 0xee, 0x16, 0x94,
+	0x7f, 0x76, 0x0b, 0xca, 0xd7, 0xcf, 0x0b, 0x63, 0x4f, 0x9f, 0x17, 0xc6, 0xfe, 0x7c, 0x5e, 0x18,
+	0xfb, 0x60, 0x29, 0x32, 0x57, 0x71, 0x1b, 0x17, 0xdb, 0xd4, 0x26, 0x5d, 0xdd, 0xa4, 0x2e, 0xd1,
+	0x3b, 0x3d, 0x83, 0x7c, 0xc2, 0xda, 0xca, 0xf0, 0xbf, 0x88, 0x5e, 0xfa, 0x7f, 0x00, 0x92, 0xd3,
+	0x95, 0x31, 0x1c, 0x16, 0x00, 0x00,
 }
 
-func (m *QueryTaxRateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.TaxRate.Size()
-		i -= size
-		if _, err := m.TaxRate.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// TaxRate return the current tax rate
+	TaxRate(ctx context.Context, in *QueryTaxRateRequest, opts ...grpc.CallOption) (*QueryTaxRateResponse, error)
+	// TaxCap returns the tax cap of a denom
+	TaxCap(ctx context.Context, in *QueryTaxCapRequest, opts ...grpc.CallOption) (*QueryTaxCapResponse, error)
+	// TaxCaps returns the all tax caps
+	TaxCaps(ctx context.Context, in *QueryTaxCapsRequest, opts ...grpc.CallOption) (*QueryTaxCapsResponse, error)
+	// RewardWeight return the current reward weight
+	RewardWeight(ctx context.Context, in *QueryRewardWeightRequest, opts ...grpc.CallOption) (*QueryRewardWeightResponse, error)
+	// SeigniorageProceeds return the current seigniorage proceeds
+	SeigniorageProceeds(ctx context.Context, in *QuerySeigniorageProceedsRequest, opts ...grpc.CallOption) (*QuerySeigniorageProceedsResponse, error)
+	// TaxProceeds return the current tax proceeds
+	TaxProceeds(ctx context.Context, in *QueryTaxProceedsRequest, opts ...grpc.CallOption) (*QueryTaxProceedsResponse, error)
+	// Indicators return the current trl informations
+	Indicators(ctx context.Context, in *QueryIndicatorsRequest, opts ...grpc.CallOption) (*QueryIndicatorsResponse, error)
+	// EpochStates returns the recorded indicators, tax rate and reward weight of the epochs in range
+	EpochStates(ctx context.Context, in *QueryEpochStatesRequest, opts ...grpc.CallOption) (*QueryEpochStatesResponse, error)
+	// NextPolicy returns the policy the treasury would apply at the end of the current epoch
+	NextPolicy(ctx context.Context, in *QueryNextPolicyRequest, opts ...grpc.CallOption) (*QueryNextPolicyResponse, error)
+	// Burned returns the amounts burned from the burn module account
+	Burned(ctx context.Context, in *QueryBurnedRequest, opts ...grpc.CallOption) (*QueryBurnedResponse, error)
+	// MinGasPrices returns the global minimum gas prices enforced by consensus
+	MinGasPrices(ctx context.Context, in *QueryMinGasPricesRequest, opts ...grpc.CallOption) (*QueryMinGasPricesResponse, error)
+	// TaxExemptions returns all tax exemption entries
+	TaxExemptions(ctx context.Context, in *QueryTaxExemptionsRequest, opts ...grpc.CallOption) (*QueryTaxExemptionsResponse, error)
+	// Params queries all parameters.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 }
 
-func (m *QueryTaxCapRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+type queryClient struct {
+	cc grpc1.ClientConn
 }
 
-func (m *QueryTaxCapRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
 }
 
-func (m *QueryTaxCapRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) TaxRate(ctx context.Context, in *QueryTaxRateRequest, opts ...grpc.CallOption) (*QueryTaxRateResponse, error) {
+	out := new(QueryTaxRateResponse)
+	err := c.cc.Invoke(ctx, "/terra.treasury.v1beta1.Query/TaxRate", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryTaxCapResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) TaxCap(ctx context.Context, in *QueryTaxCapRequest, opts ...grpc.CallOption) (*QueryTaxCapResponse, error) {
+	out := new(QueryTaxCapResponse)
+	err := c.cc.Invoke(ctx, "/terra.treasury.v1beta1.Query/TaxCap", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryTaxCapResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) TaxCaps(ctx context.Context, in *QueryTaxCapsRequest, opts ...grpc.CallOption) (*QueryTaxCapsResponse, error) {
+	out := new(QueryTaxCapsResponse)
+	err := c.cc.Invoke(ctx, "/terra.treasury.v1beta1.Query/TaxCaps", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryTaxCapResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.TaxCap.Size()
-		i -= size
-		if _, err := m.TaxCap.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func (c *queryClient) RewardWeight(ctx context.Context, in *QueryRewardWeightRequest, opts ...grpc.CallOption) (*QueryRewardWeightResponse, error) {
+	out := new(QueryRewardWeightResponse)
+	err := c.cc.Invoke(ctx, "/terra.treasury.v1beta1.Query/RewardWeight", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryTaxCapsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) SeigniorageProceeds(ctx context.Context, in *QuerySeigniorageProceedsRequest, opts ...grpc.CallOption) (*QuerySeigniorageProceedsResponse, error) {
+	out := new(QuerySeigniorageProceedsResponse)
+	err := c.cc.Invoke(ctx, "/terra.treasury.v1beta1.Query/SeigniorageProceeds", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryTaxCapsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryTaxCapsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
-}
-
-func (m *QueryTaxCapsResponseItem) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) TaxProceeds(ctx context.Context, in *QueryTaxProceedsRequest, opts ...grpc.CallOption) (*QueryTaxProceedsResponse, error) {
+	out := new(QueryTaxProceedsResponse)
+	err := c.cc.Invoke(ctx, "/terra.treasury.v1beta1.Query/TaxProceeds", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryTaxCapsResponseItem) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QueryTaxCapsResponseItem) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.TaxCap.Size()
-		i -= size
-		if _, err := m.TaxCap.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) Indicators(ctx context.Context, in *QueryIndicatorsRequest, opts ...grpc.CallOption) (*QueryIndicatorsResponse, error) {
+	out := new(QueryIndicatorsResponse)
+	err := c.cc.Invoke(ctx, "/terra.treasury.v1beta1.Query/Indicators", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryTaxCapsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) EpochStates(ctx context.Context, in *QueryEpochStatesRequest, opts ...grpc.CallOption) (*QueryEpochStatesResponse, error) {
+	out := new(QueryEpochStatesResponse)
+	err := c.cc.Invoke(ctx, "/terra.treasury.v1beta1.Query/EpochStates", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryTaxCapsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QueryTaxCapsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.TaxCaps) > 0 {
-		for iNdEx := len(m.TaxCaps) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.TaxCaps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (c *queryClient) NextPolicy(ctx context.Context, in *QueryNextPolicyRequest, opts ...grpc.CallOption) (*QueryNextPolicyResponse, error) {
+	out := new(QueryNextPolicyResponse)
+	err := c.cc.Invoke(ctx, "/terra.treasury.v1beta1.Query/NextPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryRewardWeightRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) Burned(ctx context.Context, in *QueryBurnedRequest, opts ...grpc.CallOption) (*QueryBurnedResponse, error) {
+	out := new(QueryBurnedResponse)
+	err := c.cc.Invoke(ctx, "/terra.treasury.v1beta1.Query/Burned", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryRewardWeightRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryRewardWeightRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryRewardWeightResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) MinGasPrices(ctx context.Context, in *QueryMinGasPricesRequest, opts ...grpc.CallOption) (*QueryMinGasPricesResponse, error) {
+	out := new(QueryMinGasPricesResponse)
+	err := c.cc.Invoke(ctx, "/terra.treasury.v1beta1.Query/MinGasPrices", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryRewardWeightResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QueryRewardWeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.RewardWeight.Size()
-		i -= size
-		if _, err := m.RewardWeight.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func (c *queryClient) TaxExemptions(ctx context.Context, in *QueryTaxExemptionsRequest, opts ...grpc.CallOption) (*QueryTaxExemptionsResponse, error) {
+	out := new(QueryTaxExemptionsResponse)
+	err := c.cc.Invoke(ctx, "/terra.treasury.v1beta1.Query/TaxExemptions", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryTaxProceedsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/terra.treasury.v1beta1.Query/Params", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryTaxProceedsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// TaxRate return the current tax rate
+	TaxRate(context.Context, *QueryTaxRateRequest) (*QueryTaxRateResponse, error)
+	// TaxCap returns the tax cap of a denom
+	TaxCap(context.Context, *QueryTaxCapRequest) (*QueryTaxCapResponse, error)
+	// TaxCaps returns the all tax caps
+	TaxCaps(context.Context, *QueryTaxCapsRequest) (*QueryTaxCapsResponse, error)
+	// RewardWeight return the current reward weight
+	RewardWeight(context.Context, *QueryRewardWeightRequest) (*QueryRewardWeightResponse, error)
+	// SeigniorageProceeds return the current seigniorage proceeds
+	SeigniorageProceeds(context.Context, *QuerySeigniorageProceedsRequest) (*QuerySeigniorageProceedsResponse, error)
+	// TaxProceeds return the current tax proceeds
+	TaxProceeds(context.Context, *QueryTaxProceedsRequest) (*QueryTaxProceedsResponse, error)
+	// Indicators return the current trl informations
+	Indicators(context.Context, *QueryIndicatorsRequest) (*QueryIndicatorsResponse, error)
+	// EpochStates returns the recorded indicators, tax rate and reward weight of the epochs in range
+	EpochStates(context.Context, *QueryEpochStatesRequest) (*QueryEpochStatesResponse, error)
+	// NextPolicy returns the policy the treasury would apply at the end of the current epoch
+	NextPolicy(context.Context, *QueryNextPolicyRequest) (*QueryNextPolicyResponse, error)
+	// Burned returns the amounts burned from the burn module account
+	Burned(context.Context, *QueryBurnedRequest) (*QueryBurnedResponse, error)
+	// MinGasPrices returns the global minimum gas prices enforced by consensus
+	MinGasPrices(context.Context, *QueryMinGasPricesRequest) (*QueryMinGasPricesResponse, error)
+	// TaxExemptions returns all tax exemption entries
+	TaxExemptions(context.Context, *QueryTaxExemptionsRequest) (*QueryTaxExemptionsResponse, error)
+	// Params queries all parameters.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 }
 
-func (m *QueryTaxProceedsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
 }
 
-func (m *QueryTaxProceedsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedQueryServer) TaxRate(ctx context.Context, req *QueryTaxRateRequest) (*QueryTaxRateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TaxRate not implemented")
 }
-
-func (m *QueryTaxProceedsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedQueryServer) TaxCap(ctx context.Context, req *QueryTaxCapRequest) (*QueryTaxCapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TaxCap not implemented")
+}
+func (*UnimplementedQueryServer) TaxCaps(ctx context.Context, req *QueryTaxCapsRequest) (*QueryTaxCapsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TaxCaps not implemented")
+}
+func (*UnimplementedQueryServer) RewardWeight(ctx context.Context, req *QueryRewardWeightRequest) (*QueryRewardWeightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RewardWeight not implemented")
+}
+func (*UnimplementedQueryServer) SeigniorageProceeds(ctx context.Context, req *QuerySeigniorageProceedsRequest) (*QuerySeigniorageProceedsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SeigniorageProceeds not implemented")
+}
+func (*UnimplementedQueryServer) TaxProceeds(ctx context.Context, req *QueryTaxProceedsRequest) (*QueryTaxProceedsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TaxProceeds not implemented")
+}
+func (*UnimplementedQueryServer) Indicators(ctx context.Context, req *QueryIndicatorsRequest) (*QueryIndicatorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Indicators not implemented")
+}
+func (*UnimplementedQueryServer) EpochStates(ctx context.Context, req *QueryEpochStatesRequest) (*QueryEpochStatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EpochStates not implemented")
+}
+func (*UnimplementedQueryServer) NextPolicy(ctx context.Context, req *QueryNextPolicyRequest) (*QueryNextPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NextPolicy not implemented")
+}
+func (*UnimplementedQueryServer) Burned(ctx context.Context, req *QueryBurnedRequest) (*QueryBurnedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Burned not implemented")
+}
+func (*UnimplementedQueryServer) MinGasPrices(ctx context.Context, req *QueryMinGasPricesRequest) (*QueryMinGasPricesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MinGasPrices not implemented")
+}
+func (*UnimplementedQueryServer) TaxExemptions(ctx context.Context, req *QueryTaxExemptionsRequest) (*QueryTaxExemptionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TaxExemptions not implemented")
+}
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
 
-func (m *QueryTaxProceedsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.TaxProceeds) > 0 {
-		for iNdEx := len(m.TaxProceeds) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.TaxProceeds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
 }
 
-func (m *QuerySeigniorageProceedsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_TaxRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTaxRateRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).TaxRate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/terra.treasury.v1beta1.Query/TaxRate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TaxRate(ctx, req.(*QueryTaxRateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QuerySeigniorageProceedsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QuerySeigniorageProceedsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
-}
-
-func (m *QuerySeigniorageProceedsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_TaxCap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTaxCapRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QuerySeigniorageProceedsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QuerySeigniorageProceedsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.SeigniorageProceeds.Size()
-		i -= size
-		if _, err := m.SeigniorageProceeds.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+	if interceptor == nil {
+		return srv.(QueryServer).TaxCap(ctx, in)
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/terra.treasury.v1beta1.Query/TaxCap",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TaxCap(ctx, req.(*QueryTaxCapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryIndicatorsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_TaxCaps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTaxCapsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryIndicatorsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(QueryServer).TaxCaps(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/terra.treasury.v1beta1.Query/TaxCaps",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TaxCaps(ctx, req.(*QueryTaxCapsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryIndicatorsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func _Query_RewardWeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRewardWeightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RewardWeight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/terra.treasury.v1beta1.Query/RewardWeight",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RewardWeight(ctx, req.(*QueryRewardWeightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryIndicatorsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_SeigniorageProceeds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySeigniorageProceedsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).SeigniorageProceeds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/terra.treasury.v1beta1.Query/SeigniorageProceeds",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SeigniorageProceeds(ctx, req.(*QuerySeigniorageProceedsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryIndicatorsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_TaxProceeds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTaxProceedsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TaxProceeds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/terra.treasury.v1beta1.Query/TaxProceeds",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TaxProceeds(ctx, req.(*QueryTaxProceedsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryIndicatorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.TRLMonth.Size()
-		i -= size
-		if _, err := m.TRLMonth.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func _Query_Indicators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryIndicatorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size := m.TRLYear.Size()
-		i -= size
-		if _, err := m.TRLYear.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+	if interceptor == nil {
+		return srv.(QueryServer).Indicators(ctx, in)
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/terra.treasury.v1beta1.Query/Indicators",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Indicators(ctx, req.(*QueryIndicatorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_EpochStates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEpochStatesRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).EpochStates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/terra.treasury.v1beta1.Query/EpochStates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EpochStates(ctx, req.(*QueryEpochStatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_NextPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNextPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).NextPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/terra.treasury.v1beta1.Query/NextPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).NextPolicy(ctx, req.(*QueryNextPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func _Query_Burned_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBurnedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Burned(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/terra.treasury.v1beta1.Query/Burned",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Burned(ctx, req.(*QueryBurnedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_MinGasPrices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMinGasPricesRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).MinGasPrices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/terra.treasury.v1beta1.Query/MinGasPrices",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MinGasPrices(ctx, req.(*QueryMinGasPricesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_TaxExemptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTaxExemptionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TaxExemptions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/terra.treasury.v1beta1.Query/TaxExemptions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TaxExemptions(ctx, req.(*QueryTaxExemptionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/terra.treasury.v1beta1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "terra.treasury.v1beta1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "TaxRate",
+			Handler:    _Query_TaxRate_Handler,
+		},
+		{
+			MethodName: "TaxCap",
+			Handler:    _Query_TaxCap_Handler,
+		},
+		{
+			MethodName: "TaxCaps",
+			Handler:    _Query_TaxCaps_Handler,
+		},
+		{
+			MethodName: "RewardWeight",
+			Handler:    _Query_RewardWeight_Handler,
+		},
+		{
+			MethodName: "SeigniorageProceeds",
+			Handler:    _Query_SeigniorageProceeds_Handler,
+		},
+		{
+			MethodName: "TaxProceeds",
+			Handler:    _Query_TaxProceeds_Handler,
+		},
+		{
+			MethodName: "Indicators",
+			Handler:    _Query_Indicators_Handler,
+		},
+		{
+			MethodName: "EpochStates",
+			Handler:    _Query_EpochStates_Handler,
+		},
+		{
+			MethodName: "NextPolicy",
+			Handler:    _Query_NextPolicy_Handler,
+		},
+		{
+			MethodName: "Burned",
+			Handler:    _Query_Burned_Handler,
+		},
+		{
+			MethodName: "MinGasPrices",
+			Handler:    _Query_MinGasPrices_Handler,
+		},
+		{
+			MethodName: "TaxExemptions",
+			Handler:    _Query_TaxExemptions_Handler,
+		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "terra/treasury/v1beta1/query.proto",
 }
-func (m *QueryTaxRateRequest) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *QueryTaxRateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryTaxRateResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.TaxRate.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryTaxRateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryTaxCapRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryTaxRateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryTaxCapResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryTaxRateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.TaxCap.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryTaxCapsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *QueryTaxRateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryTaxCapsResponseItem) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryTaxRateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	{
+		size := m.TaxRate.Size()
+		i -= size
+		if _, err := m.TaxRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	l = m.TaxCap.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryTaxCapsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.TaxCaps) > 0 {
-		for _, e := range m.TaxCaps {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+func (m *QueryTaxCapRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryRewardWeightRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *QueryTaxCapRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryRewardWeightResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryTaxCapRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.RewardWeight.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryTaxProceedsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryTaxCapResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryTaxProceedsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryTaxCapResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTaxCapResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.TaxProceeds) > 0 {
-		for _, e := range m.TaxProceeds {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	{
+		size := m.TaxCap.Size()
+		i -= size
+		if _, err := m.TaxCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QuerySeigniorageProceedsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryTaxCapsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTaxCapsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTaxCapsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QuerySeigniorageProceedsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryTaxCapsResponseItem) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTaxCapsResponseItem) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTaxCapsResponseItem) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.SeigniorageProceeds.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	{
+		size := m.TaxCap.Size()
+		i -= size
+		if _, err := m.TaxCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryIndicatorsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryTaxCapsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTaxCapsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTaxCapsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	if len(m.TaxCaps) > 0 {
+		for iNdEx := len(m.TaxCaps) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TaxCaps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryIndicatorsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryRewardWeightRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRewardWeightRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRewardWeightRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.TRLYear.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.TRLMonth.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryRewardWeightResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRewardWeightResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRewardWeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.RewardWeight.Size()
+		i -= size
+		if _, err := m.RewardWeight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTaxProceedsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTaxProceedsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTaxProceedsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTaxProceedsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTaxProceedsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTaxProceedsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CommunityPoolTaxProceeds) > 0 {
+		for iNdEx := len(m.CommunityPoolTaxProceeds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CommunityPoolTaxProceeds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.BurnedTaxProceeds) > 0 {
+		for iNdEx := len(m.BurnedTaxProceeds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BurnedTaxProceeds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.TaxProceeds) > 0 {
+		for iNdEx := len(m.TaxProceeds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TaxProceeds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySeigniorageProceedsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySeigniorageProceedsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySeigniorageProceedsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySeigniorageProceedsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySeigniorageProceedsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySeigniorageProceedsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SeigniorageShares) > 0 {
+		for iNdEx := len(m.SeigniorageShares) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SeigniorageShares[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size := m.SeigniorageProceeds.Size()
+		i -= size
+		if _, err := m.SeigniorageProceeds.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIndicatorsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIndicatorsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIndicatorsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIndicatorsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIndicatorsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIndicatorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TRLMonth.Size()
+		i -= size
+		if _, err := m.TRLMonth.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.TRLYear.Size()
+		i -= size
+		if _, err := m.TRLYear.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEpochStatesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEpochStatesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEpochStatesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.EndEpoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EndEpoch))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.StartEpoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StartEpoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEpochStatesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEpochStatesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEpochStatesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.EpochStates) > 0 {
+		for iNdEx := len(m.EpochStates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EpochStates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNextPolicyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNextPolicyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNextPolicyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNextPolicyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNextPolicyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNextPolicyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RemainingBlocks != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.RemainingBlocks))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.SeigniorageShares) > 0 {
+		for iNdEx := len(m.SeigniorageShares) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SeigniorageShares[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	{
+		size := m.Seigniorage.Size()
+		i -= size
+		if _, err := m.Seigniorage.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.DenomTaxRates) > 0 {
+		for iNdEx := len(m.DenomTaxRates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DenomTaxRates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.TaxCaps) > 0 {
+		for iNdEx := len(m.TaxCaps) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TaxCaps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size := m.RewardWeight.Size()
+		i -= size
+		if _, err := m.RewardWeight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.TaxRate.Size()
+		i -= size
+		if _, err := m.TaxRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBurnedRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBurnedRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBurnedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBurnedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBurnedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBurnedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EpochBurned) > 0 {
+		for iNdEx := len(m.EpochBurned) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EpochBurned[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Burned) > 0 {
+		for iNdEx := len(m.Burned) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Burned[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMinGasPricesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMinGasPricesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMinGasPricesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMinGasPricesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMinGasPricesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMinGasPricesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MinGasPrices) > 0 {
+		for iNdEx := len(m.MinGasPrices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinGasPrices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTaxExemptionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTaxExemptionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTaxExemptionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTaxExemptionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTaxExemptionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTaxExemptionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TaxExemptions) > 0 {
+		for iNdEx := len(m.TaxExemptions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TaxExemptions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryTaxRateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTaxRateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TaxRate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryTaxCapRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTaxCapResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TaxCap.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryTaxCapsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryTaxCapsResponseItem) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.TaxCap.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryTaxCapsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TaxCaps) > 0 {
+		for _, e := range m.TaxCaps {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryRewardWeightRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryRewardWeightResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.RewardWeight.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryTaxProceedsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryTaxProceedsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TaxProceeds) > 0 {
+		for _, e := range m.TaxProceeds {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.BurnedTaxProceeds) > 0 {
+		for _, e := range m.BurnedTaxProceeds {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.CommunityPoolTaxProceeds) > 0 {
+		for _, e := range m.CommunityPoolTaxProceeds {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QuerySeigniorageProceedsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QuerySeigniorageProceedsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SeigniorageProceeds.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.SeigniorageShares) > 0 {
+		for _, e := range m.SeigniorageShares {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryIndicatorsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryIndicatorsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TRLYear.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.TRLMonth.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryEpochStatesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartEpoch != 0 {
+		n += 1 + sovQuery(uint64(m.StartEpoch))
+	}
+	if m.EndEpoch != 0 {
+		n += 1 + sovQuery(uint64(m.EndEpoch))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEpochStatesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.EpochStates) > 0 {
+		for _, e := range m.EpochStates {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryNextPolicyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryNextPolicyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TaxRate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.RewardWeight.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.TaxCaps) > 0 {
+		for _, e := range m.TaxCaps {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.DenomTaxRates) > 0 {
+		for _, e := range m.DenomTaxRates {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.Seigniorage.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.SeigniorageShares) > 0 {
+		for _, e := range m.SeigniorageShares {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.RemainingBlocks != 0 {
+		n += 1 + sovQuery(uint64(m.RemainingBlocks))
+	}
+	return n
+}
+
+func (m *QueryBurnedRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryBurnedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Burned) > 0 {
+		for _, e := range m.Burned {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.EpochBurned) > 0 {
+		for _, e := range m.EpochBurned {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryMinGasPricesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryMinGasPricesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MinGasPrices) > 0 {
+		for _, e := range m.MinGasPrices {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryTaxExemptionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryTaxExemptionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TaxExemptions) > 0 {
+		for _, e := range m.TaxExemptions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryTaxRateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTaxRateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTaxRateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTaxRateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTaxRateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTaxRateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaxRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TaxRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTaxCapRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTaxCapRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTaxCapRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTaxCapResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTaxCapResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTaxCapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaxCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TaxCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTaxCapsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTaxCapsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTaxCapsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTaxCapsResponseItem) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTaxCapsResponseItem: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTaxCapsResponseItem: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaxCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TaxCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTaxCapsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTaxCapsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTaxCapsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaxCaps", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaxCaps = append(m.TaxCaps, QueryTaxCapsResponseItem{})
+			if err := m.TaxCaps[len(m.TaxCaps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRewardWeightRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRewardWeightRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRewardWeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRewardWeightResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRewardWeightResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRewardWeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardWeight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RewardWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTaxProceedsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTaxProceedsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTaxProceedsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTaxProceedsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTaxProceedsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTaxProceedsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaxProceeds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaxProceeds = append(m.TaxProceeds, types.Coin{})
+			if err := m.TaxProceeds[len(m.TaxProceeds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnedTaxProceeds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BurnedTaxProceeds = append(m.BurnedTaxProceeds, types.Coin{})
+			if err := m.BurnedTaxProceeds[len(m.BurnedTaxProceeds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPoolTaxProceeds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CommunityPoolTaxProceeds = append(m.CommunityPoolTaxProceeds, types.Coin{})
+			if err := m.CommunityPoolTaxProceeds[len(m.CommunityPoolTaxProceeds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySeigniorageProceedsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySeigniorageProceedsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySeigniorageProceedsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySeigniorageProceedsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySeigniorageProceedsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySeigniorageProceedsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SeigniorageProceeds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SeigniorageProceeds.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SeigniorageShares", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SeigniorageShares = append(m.SeigniorageShares, SeigniorageShare{})
+			if err := m.SeigniorageShares[len(m.SeigniorageShares)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	return n
-}
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryTaxRateRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryIndicatorsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1885,10 +4384,10 @@ func (m *QueryTaxRateRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTaxRateRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryIndicatorsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTaxRateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryIndicatorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1912,7 +4411,7 @@ func (m *QueryTaxRateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTaxRateResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryIndicatorsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1935,15 +4434,15 @@ func (m *QueryTaxRateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTaxRateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryIndicatorsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTaxRateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryIndicatorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaxRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TRLYear", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1971,7 +4470,41 @@ func (m *QueryTaxRateResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TaxRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TRLYear.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TRLMonth", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TRLMonth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1996,7 +4529,7 @@ func (m *QueryTaxRateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTaxCapRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryEpochStatesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2019,17 +4552,55 @@ func (m *QueryTaxCapRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTaxCapRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEpochStatesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTaxCapRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEpochStatesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartEpoch", wireType)
+			}
+			m.StartEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndEpoch", wireType)
+			}
+			m.EndEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2039,23 +4610,27 @@ func (m *QueryTaxCapRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2078,7 +4653,7 @@ func (m *QueryTaxCapRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTaxCapResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryEpochStatesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2101,17 +4676,17 @@ func (m *QueryTaxCapResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTaxCapResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEpochStatesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTaxCapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEpochStatesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaxCap", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochStates", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2121,23 +4696,59 @@ func (m *QueryTaxCapResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TaxCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.EpochStates = append(m.EpochStates, EpochState{})
+			if err := m.EpochStates[len(m.EpochStates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2162,7 +4773,7 @@ func (m *QueryTaxCapResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTaxCapsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryNextPolicyRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2185,10 +4796,10 @@ func (m *QueryTaxCapsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTaxCapsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryNextPolicyRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTaxCapsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryNextPolicyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2212,7 +4823,7 @@ func (m *QueryTaxCapsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTaxCapsResponseItem) Unmarshal(dAtA []byte) error {
+func (m *QueryNextPolicyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2235,15 +4846,15 @@ func (m *QueryTaxCapsResponseItem) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTaxCapsResponseItem: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryNextPolicyResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTaxCapsResponseItem: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryNextPolicyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TaxRate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2271,11 +4882,13 @@ func (m *QueryTaxCapsResponseItem) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			if err := m.TaxRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaxCap", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardWeight", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2303,63 +4916,47 @@ func (m *QueryTaxCapsResponseItem) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TaxCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RewardWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaxCaps", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryTaxCapsResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTaxCapsResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTaxCapsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.TaxCaps = append(m.TaxCaps, QueryTaxCapsResponseItem{})
+			if err := m.TaxCaps[len(m.TaxCaps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaxCaps", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomTaxRates", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2386,116 +4983,50 @@ func (m *QueryTaxCapsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TaxCaps = append(m.TaxCaps, QueryTaxCapsResponseItem{})
-			if err := m.TaxCaps[len(m.TaxCaps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.DenomTaxRates = append(m.DenomTaxRates, DenomTaxRate{})
+			if err := m.DenomTaxRates[len(m.DenomTaxRates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryRewardWeightRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seigniorage", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRewardWeightRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRewardWeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryRewardWeightResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.Seigniorage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRewardWeightResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRewardWeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RewardWeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SeigniorageShares", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2505,26 +5036,45 @@ func (m *QueryRewardWeightResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RewardWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.SeigniorageShares = append(m.SeigniorageShares, SeigniorageShare{})
+			if err := m.SeigniorageShares[len(m.SeigniorageShares)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingBlocks", wireType)
+			}
+			m.RemainingBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RemainingBlocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2546,7 +5096,7 @@ func (m *QueryRewardWeightResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTaxProceedsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryBurnedRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2569,10 +5119,10 @@ func (m *QueryTaxProceedsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTaxProceedsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBurnedRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTaxProceedsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBurnedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2596,7 +5146,7 @@ func (m *QueryTaxProceedsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTaxProceedsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryBurnedResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2619,15 +5169,15 @@ func (m *QueryTaxProceedsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTaxProceedsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBurnedResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTaxProceedsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBurnedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaxProceeds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Burned", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2654,8 +5204,42 @@ func (m *QueryTaxProceedsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TaxProceeds = append(m.TaxProceeds, types.Coin{})
-			if err := m.TaxProceeds[len(m.TaxProceeds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Burned = append(m.Burned, types.Coin{})
+			if err := m.Burned[len(m.Burned)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochBurned", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EpochBurned = append(m.EpochBurned, types.Coin{})
+			if err := m.EpochBurned[len(m.EpochBurned)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2680,7 +5264,7 @@ func (m *QueryTaxProceedsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySeigniorageProceedsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryMinGasPricesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2703,10 +5287,10 @@ func (m *QuerySeigniorageProceedsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySeigniorageProceedsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryMinGasPricesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySeigniorageProceedsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryMinGasPricesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2730,7 +5314,7 @@ func (m *QuerySeigniorageProceedsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySeigniorageProceedsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryMinGasPricesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2753,17 +5337,17 @@ func (m *QuerySeigniorageProceedsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySeigniorageProceedsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryMinGasPricesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySeigniorageProceedsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryMinGasPricesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SeigniorageProceeds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinGasPrices", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2773,23 +5357,23 @@ func (m *QuerySeigniorageProceedsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SeigniorageProceeds.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.MinGasPrices = append(m.MinGasPrices, types.DecCoin{})
+			if err := m.MinGasPrices[len(m.MinGasPrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2814,7 +5398,7 @@ func (m *QuerySeigniorageProceedsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryIndicatorsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryTaxExemptionsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2837,10 +5421,10 @@ func (m *QueryIndicatorsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryIndicatorsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTaxExemptionsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryIndicatorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTaxExemptionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2864,7 +5448,7 @@ func (m *QueryIndicatorsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryIndicatorsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTaxExemptionsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2887,51 +5471,17 @@ func (m *QueryIndicatorsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryIndicatorsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTaxExemptionsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryIndicatorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTaxExemptionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TRLYear", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.TRLYear.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TRLMonth", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TaxExemptions", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2941,23 +5491,23 @@ func (m *QueryIndicatorsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TRLMonth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TaxExemptions = append(m.TaxExemptions, TaxExemption{})
+			if err := m.TaxExemptions[len(m.TaxExemptions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
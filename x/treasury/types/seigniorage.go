@@ -0,0 +1,13 @@
+package types
+
+// Seigniorage destination types
+const (
+	// SeigniorageDestinationBurn burns the share
+	SeigniorageDestinationBurn = "burn"
+	// SeigniorageDestinationCommunityPool sends the share to the community pool
+	SeigniorageDestinationCommunityPool = "community_pool"
+	// SeigniorageDestinationOracle sends the share to the oracle reward pool
+	SeigniorageDestinationOracle = "oracle"
+	// SeigniorageDestinationModule sends the share to the module account of ModuleName
+	SeigniorageDestinationModule = "module"
+)
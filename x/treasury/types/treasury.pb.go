@@ -27,13 +27,21 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // Params defines the parameters for the oracle module.
 type Params struct {
-	TaxPolicy               PolicyConstraints                      `protobuf:"bytes,1,opt,name=tax_policy,json=taxPolicy,proto3" json:"tax_policy" yaml:"tax_policy"`
-	RewardPolicy            PolicyConstraints                      `protobuf:"bytes,2,opt,name=reward_policy,json=rewardPolicy,proto3" json:"reward_policy" yaml:"reward_policy"`
-	SeigniorageBurdenTarget github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=seigniorage_burden_target,json=seigniorageBurdenTarget,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"seigniorage_burden_target" yaml:"seigniorage_burden_target"`
-	MiningIncrement         github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=mining_increment,json=miningIncrement,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"mining_increment" yaml:"mining_increment"`
-	WindowShort             uint64                                 `protobuf:"varint,5,opt,name=window_short,json=windowShort,proto3" json:"window_short,omitempty" yaml:"window_short"`
-	WindowLong              uint64                                 `protobuf:"varint,6,opt,name=window_long,json=windowLong,proto3" json:"window_long,omitempty" yaml:"window_long"`
-	WindowProbation         uint64                                 `protobuf:"varint,7,opt,name=window_probation,json=windowProbation,proto3" json:"window_probation,omitempty" yaml:"window_probation"`
+	TaxPolicy                PolicyConstraints                           `protobuf:"bytes,1,opt,name=tax_policy,json=taxPolicy,proto3" json:"tax_policy" yaml:"tax_policy"`
+	RewardPolicy             PolicyConstraints                           `protobuf:"bytes,2,opt,name=reward_policy,json=rewardPolicy,proto3" json:"reward_policy" yaml:"reward_policy"`
+	SeigniorageBurdenTarget  github_com_cosmos_cosmos_sdk_types.Dec      `protobuf:"bytes,3,opt,name=seigniorage_burden_target,json=seigniorageBurdenTarget,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"seigniorage_burden_target" yaml:"seigniorage_burden_target"`
+	MiningIncrement          github_com_cosmos_cosmos_sdk_types.Dec      `protobuf:"bytes,4,opt,name=mining_increment,json=miningIncrement,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"mining_increment" yaml:"mining_increment"`
+	WindowShort              uint64                                      `protobuf:"varint,5,opt,name=window_short,json=windowShort,proto3" json:"window_short,omitempty" yaml:"window_short"`
+	WindowLong               uint64                                      `protobuf:"varint,6,opt,name=window_long,json=windowLong,proto3" json:"window_long,omitempty" yaml:"window_long"`
+	WindowProbation          uint64                                      `protobuf:"varint,7,opt,name=window_probation,json=windowProbation,proto3" json:"window_probation,omitempty" yaml:"window_probation"`
+	BurnTaxSplit             github_com_cosmos_cosmos_sdk_types.Dec      `protobuf:"bytes,8,opt,name=burn_tax_split,json=burnTaxSplit,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"burn_tax_split" yaml:"burn_tax_split"`
+	CommunityPoolTaxSplit    github_com_cosmos_cosmos_sdk_types.Dec      `protobuf:"bytes,9,opt,name=community_pool_tax_split,json=communityPoolTaxSplit,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"community_pool_tax_split" yaml:"community_pool_tax_split"`
+	DenomTaxPolicies         []DenomTaxPolicy                            `protobuf:"bytes,10,rep,name=denom_tax_policies,json=denomTaxPolicies,proto3" json:"denom_tax_policies" yaml:"denom_tax_policies"`
+	IndicatorRetentionMargin uint64                                      `protobuf:"varint,11,opt,name=indicator_retention_margin,json=indicatorRetentionMargin,proto3" json:"indicator_retention_margin,omitempty" yaml:"indicator_retention_margin"`
+	EpochLength              uint64                                      `protobuf:"varint,12,opt,name=epoch_length,json=epochLength,proto3" json:"epoch_length,omitempty" yaml:"epoch_length"`
+	SeigniorageDestinations  []SeigniorageDestination                    `protobuf:"bytes,13,rep,name=seigniorage_destinations,json=seigniorageDestinations,proto3" json:"seigniorage_destinations" yaml:"seigniorage_destinations"`
+	MinGasPrices             github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,14,rep,name=min_gas_prices,json=minGasPrices,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"min_gas_prices" yaml:"min_gas_prices"`
+	MsgFeeRequirements       []MsgFeeRequirement                         `protobuf:"bytes,15,rep,name=msg_fee_requirements,json=msgFeeRequirements,proto3" json:"msg_fee_requirements" yaml:"msg_fee_requirements"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -103,6 +111,261 @@ func (m *Params) GetWindowProbation() uint64 {
 	return 0
 }
 
+func (m *Params) GetDenomTaxPolicies() []DenomTaxPolicy {
+	if m != nil {
+		return m.DenomTaxPolicies
+	}
+	return nil
+}
+
+func (m *Params) GetIndicatorRetentionMargin() uint64 {
+	if m != nil {
+		return m.IndicatorRetentionMargin
+	}
+	return 0
+}
+
+func (m *Params) GetEpochLength() uint64 {
+	if m != nil {
+		return m.EpochLength
+	}
+	return 0
+}
+
+func (m *Params) GetSeigniorageDestinations() []SeigniorageDestination {
+	if m != nil {
+		return m.SeigniorageDestinations
+	}
+	return nil
+}
+
+func (m *Params) GetMinGasPrices() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.MinGasPrices
+	}
+	return nil
+}
+
+func (m *Params) GetMsgFeeRequirements() []MsgFeeRequirement {
+	if m != nil {
+		return m.MsgFeeRequirements
+	}
+	return nil
+}
+
+// MsgFeeRequirement - defines the fee requirement of a msg type
+type MsgFeeRequirement struct {
+	// msg_type_url is the type url of the msg, e.g. /terra.wasm.v1beta1.MsgStoreCode
+	MsgTypeUrl string `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty" yaml:"msg_type_url"`
+	// gas_price_multiplier scales the global min gas prices for the txs including the msg
+	GasPriceMultiplier github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=gas_price_multiplier,json=gasPriceMultiplier,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"gas_price_multiplier" yaml:"gas_price_multiplier"`
+	// flat_fee is charged on top of the gas fee for each msg of the type
+	FlatFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=flat_fee,json=flatFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"flat_fee" yaml:"flat_fee"`
+}
+
+func (m *MsgFeeRequirement) Reset()         { *m = MsgFeeRequirement{} }
+func (m *MsgFeeRequirement) String() string { return proto.CompactTextString(m) }
+func (*MsgFeeRequirement) ProtoMessage()    {}
+func (*MsgFeeRequirement) Descriptor() ([]byte, []int) {
+	return fileDescriptor_353bb3a9c554268e, []int{1}
+}
+func (m *MsgFeeRequirement) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFeeRequirement) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFeeRequirement.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgFeeRequirement) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFeeRequirement.Merge(m, src)
+}
+func (m *MsgFeeRequirement) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFeeRequirement) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFeeRequirement.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFeeRequirement proto.InternalMessageInfo
+
+func (m *MsgFeeRequirement) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
+
+func (m *MsgFeeRequirement) GetFlatFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.FlatFee
+	}
+	return nil
+}
+
+// SeigniorageDestination - defines a recipient of a share of the epoch seigniorage
+type SeigniorageDestination struct {
+	// type is one of burn, community_pool, oracle or module
+	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty" yaml:"type"`
+	// module_name is the recipient module account of the module type
+	ModuleName string                                 `protobuf:"bytes,2,opt,name=module_name,json=moduleName,proto3" json:"module_name,omitempty" yaml:"module_name"`
+	Weight     github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=weight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"weight" yaml:"weight"`
+}
+
+func (m *SeigniorageDestination) Reset()         { *m = SeigniorageDestination{} }
+func (m *SeigniorageDestination) String() string { return proto.CompactTextString(m) }
+func (*SeigniorageDestination) ProtoMessage()    {}
+func (*SeigniorageDestination) Descriptor() ([]byte, []int) {
+	return fileDescriptor_353bb3a9c554268e, []int{2}
+}
+func (m *SeigniorageDestination) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SeigniorageDestination) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SeigniorageDestination.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SeigniorageDestination) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SeigniorageDestination.Merge(m, src)
+}
+func (m *SeigniorageDestination) XXX_Size() int {
+	return m.Size()
+}
+func (m *SeigniorageDestination) XXX_DiscardUnknown() {
+	xxx_messageInfo_SeigniorageDestination.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SeigniorageDestination proto.InternalMessageInfo
+
+func (m *SeigniorageDestination) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *SeigniorageDestination) GetModuleName() string {
+	if m != nil {
+		return m.ModuleName
+	}
+	return ""
+}
+
+// SeigniorageShare - defines the seigniorage amount settled to a destination
+type SeigniorageShare struct {
+	Destination SeigniorageDestination                 `protobuf:"bytes,1,opt,name=destination,proto3" json:"destination" yaml:"destination"`
+	Amount      github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount" yaml:"amount"`
+}
+
+func (m *SeigniorageShare) Reset()         { *m = SeigniorageShare{} }
+func (m *SeigniorageShare) String() string { return proto.CompactTextString(m) }
+func (*SeigniorageShare) ProtoMessage()    {}
+func (*SeigniorageShare) Descriptor() ([]byte, []int) {
+	return fileDescriptor_353bb3a9c554268e, []int{3}
+}
+func (m *SeigniorageShare) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SeigniorageShare) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SeigniorageShare.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SeigniorageShare) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SeigniorageShare.Merge(m, src)
+}
+func (m *SeigniorageShare) XXX_Size() int {
+	return m.Size()
+}
+func (m *SeigniorageShare) XXX_DiscardUnknown() {
+	xxx_messageInfo_SeigniorageShare.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SeigniorageShare proto.InternalMessageInfo
+
+func (m *SeigniorageShare) GetDestination() SeigniorageDestination {
+	if m != nil {
+		return m.Destination
+	}
+	return SeigniorageDestination{}
+}
+
+// DenomTaxPolicy - defines the policy constraints of a denom whose tax rate
+// is overridden; the cap of the constraints is unused
+type DenomTaxPolicy struct {
+	Denom  string            `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	Policy PolicyConstraints `protobuf:"bytes,2,opt,name=policy,proto3" json:"policy" yaml:"policy"`
+}
+
+func (m *DenomTaxPolicy) Reset()         { *m = DenomTaxPolicy{} }
+func (m *DenomTaxPolicy) String() string { return proto.CompactTextString(m) }
+func (*DenomTaxPolicy) ProtoMessage()    {}
+func (*DenomTaxPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_353bb3a9c554268e, []int{4}
+}
+func (m *DenomTaxPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomTaxPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomTaxPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DenomTaxPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomTaxPolicy.Merge(m, src)
+}
+func (m *DenomTaxPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomTaxPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomTaxPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomTaxPolicy proto.InternalMessageInfo
+
+func (m *DenomTaxPolicy) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *DenomTaxPolicy) GetPolicy() PolicyConstraints {
+	if m != nil {
+		return m.Policy
+	}
+	return PolicyConstraints{}
+}
+
 // PolicyConstraints - defines policy constraints can be applied in tax & reward policies
 type PolicyConstraints struct {
 	RateMin       github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=rate_min,json=rateMin,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"rate_min" yaml:"rate_min"`
@@ -114,7 +377,7 @@ type PolicyConstraints struct {
 func (m *PolicyConstraints) Reset()      { *m = PolicyConstraints{} }
 func (*PolicyConstraints) ProtoMessage() {}
 func (*PolicyConstraints) Descriptor() ([]byte, []int) {
-	return fileDescriptor_353bb3a9c554268e, []int{1}
+	return fileDescriptor_353bb3a9c554268e, []int{5}
 }
 func (m *PolicyConstraints) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -160,7 +423,7 @@ func (m *EpochTaxProceeds) Reset()         { *m = EpochTaxProceeds{} }
 func (m *EpochTaxProceeds) String() string { return proto.CompactTextString(m) }
 func (*EpochTaxProceeds) ProtoMessage()    {}
 func (*EpochTaxProceeds) Descriptor() ([]byte, []int) {
-	return fileDescriptor_353bb3a9c554268e, []int{2}
+	return fileDescriptor_353bb3a9c554268e, []int{6}
 }
 func (m *EpochTaxProceeds) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -206,7 +469,7 @@ func (m *EpochInitialIssuance) Reset()         { *m = EpochInitialIssuance{} }
 func (m *EpochInitialIssuance) String() string { return proto.CompactTextString(m) }
 func (*EpochInitialIssuance) ProtoMessage()    {}
 func (*EpochInitialIssuance) Descriptor() ([]byte, []int) {
-	return fileDescriptor_353bb3a9c554268e, []int{3}
+	return fileDescriptor_353bb3a9c554268e, []int{7}
 }
 func (m *EpochInitialIssuance) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -242,11 +505,153 @@ func (m *EpochInitialIssuance) GetIssuance() github_com_cosmos_cosmos_sdk_types.
 	return nil
 }
 
+// TaxExemption assigns an address to a tax exemption zone; transfers whose
+// parties all belong to the same zone are not taxed
+type TaxExemption struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty" yaml:"address"`
+	Zone    string `protobuf:"bytes,2,opt,name=zone,proto3" json:"zone,omitempty" yaml:"zone"`
+}
+
+func (m *TaxExemption) Reset()         { *m = TaxExemption{} }
+func (m *TaxExemption) String() string { return proto.CompactTextString(m) }
+func (*TaxExemption) ProtoMessage()    {}
+func (*TaxExemption) Descriptor() ([]byte, []int) {
+	return fileDescriptor_353bb3a9c554268e, []int{8}
+}
+func (m *TaxExemption) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TaxExemption) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TaxExemption.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TaxExemption) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TaxExemption.Merge(m, src)
+}
+func (m *TaxExemption) XXX_Size() int {
+	return m.Size()
+}
+func (m *TaxExemption) XXX_DiscardUnknown() {
+	xxx_messageInfo_TaxExemption.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TaxExemption proto.InternalMessageInfo
+
+func (m *TaxExemption) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *TaxExemption) GetZone() string {
+	if m != nil {
+		return m.Zone
+	}
+	return ""
+}
+
+// AddTaxExemptionProposal is a gov Content type to add addresses
+// to a tax exemption zone
+type AddTaxExemptionProposal struct {
+	Title       string   `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty" yaml:"title"`
+	Description string   `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty" yaml:"description"`
+	Zone        string   `protobuf:"bytes,3,opt,name=zone,proto3" json:"zone,omitempty" yaml:"zone"`
+	Addresses   []string `protobuf:"bytes,4,rep,name=addresses,proto3" json:"addresses,omitempty" yaml:"addresses"`
+}
+
+func (m *AddTaxExemptionProposal) Reset()      { *m = AddTaxExemptionProposal{} }
+func (*AddTaxExemptionProposal) ProtoMessage() {}
+func (*AddTaxExemptionProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_353bb3a9c554268e, []int{9}
+}
+func (m *AddTaxExemptionProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AddTaxExemptionProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AddTaxExemptionProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AddTaxExemptionProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddTaxExemptionProposal.Merge(m, src)
+}
+func (m *AddTaxExemptionProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *AddTaxExemptionProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddTaxExemptionProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddTaxExemptionProposal proto.InternalMessageInfo
+
+// RemoveTaxExemptionProposal is a gov Content type to remove addresses
+// from their tax exemption zones
+type RemoveTaxExemptionProposal struct {
+	Title       string   `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty" yaml:"title"`
+	Description string   `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty" yaml:"description"`
+	Addresses   []string `protobuf:"bytes,3,rep,name=addresses,proto3" json:"addresses,omitempty" yaml:"addresses"`
+}
+
+func (m *RemoveTaxExemptionProposal) Reset()      { *m = RemoveTaxExemptionProposal{} }
+func (*RemoveTaxExemptionProposal) ProtoMessage() {}
+func (*RemoveTaxExemptionProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_353bb3a9c554268e, []int{10}
+}
+func (m *RemoveTaxExemptionProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RemoveTaxExemptionProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RemoveTaxExemptionProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RemoveTaxExemptionProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveTaxExemptionProposal.Merge(m, src)
+}
+func (m *RemoveTaxExemptionProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *RemoveTaxExemptionProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveTaxExemptionProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemoveTaxExemptionProposal proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*Params)(nil), "terra.treasury.v1beta1.Params")
+	proto.RegisterType((*MsgFeeRequirement)(nil), "terra.treasury.v1beta1.MsgFeeRequirement")
+	proto.RegisterType((*SeigniorageDestination)(nil), "terra.treasury.v1beta1.SeigniorageDestination")
+	proto.RegisterType((*SeigniorageShare)(nil), "terra.treasury.v1beta1.SeigniorageShare")
+	proto.RegisterType((*DenomTaxPolicy)(nil), "terra.treasury.v1beta1.DenomTaxPolicy")
 	proto.RegisterType((*PolicyConstraints)(nil), "terra.treasury.v1beta1.PolicyConstraints")
 	proto.RegisterType((*EpochTaxProceeds)(nil), "terra.treasury.v1beta1.EpochTaxProceeds")
 	proto.RegisterType((*EpochInitialIssuance)(nil), "terra.treasury.v1beta1.EpochInitialIssuance")
+	proto.RegisterType((*TaxExemption)(nil), "terra.treasury.v1beta1.TaxExemption")
+	proto.RegisterType((*AddTaxExemptionProposal)(nil), "terra.treasury.v1beta1.AddTaxExemptionProposal")
+	proto.RegisterType((*RemoveTaxExemptionProposal)(nil), "terra.treasury.v1beta1.RemoveTaxExemptionProposal")
 }
 
 func init() {
@@ -254,51 +659,97 @@ func init() {
 }
 
 var fileDescriptor_353bb3a9c554268e = []byte{
-	// 700 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x95, 0x4b, 0x6f, 0x13, 0x3b,
-	0x14, 0xc7, 0x33, 0x37, 0x7d, 0x3a, 0xad, 0xd2, 0x4e, 0xab, 0x36, 0xed, 0xbd, 0xca, 0x44, 0x96,
-	0xee, 0x55, 0xae, 0x44, 0x67, 0xd4, 0xb2, 0x40, 0xea, 0x06, 0x91, 0x42, 0x21, 0x12, 0x48, 0xd1,
-	0xd0, 0x15, 0x42, 0x1a, 0x39, 0x13, 0x6b, 0x62, 0x91, 0xb1, 0x47, 0xb6, 0x43, 0x27, 0xec, 0xd9,
-	0x21, 0x84, 0x58, 0x21, 0x56, 0xdd, 0xc2, 0x27, 0xe9, 0xb2, 0x4b, 0xc4, 0x22, 0xa0, 0x76, 0xc3,
-	0x3a, 0x9f, 0x00, 0x8d, 0xed, 0x3c, 0x5a, 0x9e, 0x11, 0xab, 0xf8, 0xbc, 0x7e, 0xe7, 0xef, 0xf1,
-	0xb1, 0x03, 0xfe, 0x95, 0x98, 0x73, 0xe4, 0x49, 0x8e, 0x91, 0xe8, 0xf2, 0x9e, 0xf7, 0x74, 0xb7,
-	0x89, 0x25, 0xda, 0x1d, 0x39, 0xdc, 0x84, 0x33, 0xc9, 0xec, 0x0d, 0x95, 0xe6, 0x8e, 0xbc, 0x26,
-	0x6d, 0x7b, 0x3d, 0x62, 0x11, 0x53, 0x29, 0x5e, 0xb6, 0xd2, 0xd9, 0xdb, 0xe5, 0x90, 0x89, 0x98,
-	0x09, 0xaf, 0x89, 0x04, 0x1e, 0x11, 0x43, 0x46, 0xa8, 0x8e, 0xc3, 0x77, 0xb3, 0x60, 0xae, 0x81,
-	0x38, 0x8a, 0x85, 0x1d, 0x02, 0x20, 0x51, 0x1a, 0x24, 0xac, 0x43, 0xc2, 0x5e, 0xc9, 0xaa, 0x58,
-	0xd5, 0xc2, 0xde, 0xff, 0xee, 0xf7, 0xbb, 0xb9, 0x0d, 0x95, 0x75, 0xc0, 0xa8, 0x90, 0x1c, 0x11,
-	0x2a, 0x45, 0x6d, 0xeb, 0xb4, 0xef, 0xe4, 0x06, 0x7d, 0x67, 0xb5, 0x87, 0xe2, 0xce, 0x3e, 0x1c,
-	0xa3, 0xa0, 0xbf, 0x28, 0x51, 0xaa, 0x0b, 0xec, 0x0e, 0x58, 0xe6, 0xf8, 0x18, 0xf1, 0xd6, 0xb0,
-	0xcf, 0x5f, 0xd3, 0xf6, 0xf9, 0xc7, 0xf4, 0x59, 0xd7, 0x7d, 0x2e, 0xd1, 0xa0, 0xbf, 0xa4, 0x6d,
-	0xd3, 0xed, 0xa5, 0x05, 0xb6, 0x04, 0x26, 0x11, 0x25, 0x8c, 0xa3, 0x08, 0x07, 0xcd, 0x2e, 0x6f,
-	0x61, 0x1a, 0x48, 0xc4, 0x23, 0x2c, 0x4b, 0xf9, 0x8a, 0x55, 0x5d, 0xac, 0xf9, 0x19, 0xef, 0x63,
-	0xdf, 0xf9, 0x2f, 0x22, 0xb2, 0xdd, 0x6d, 0xba, 0x21, 0x8b, 0x3d, 0xf3, 0xd1, 0xf4, 0xcf, 0x8e,
-	0x68, 0x3d, 0xf1, 0x64, 0x2f, 0xc1, 0xc2, 0xbd, 0x8d, 0xc3, 0x41, 0xdf, 0xa9, 0xe8, 0xce, 0x3f,
-	0x04, 0x43, 0x7f, 0x73, 0x22, 0x56, 0x53, 0xa1, 0x23, 0x15, 0xb1, 0x25, 0x58, 0x89, 0x09, 0x25,
-	0x34, 0x0a, 0x08, 0x0d, 0x39, 0x8e, 0x31, 0x95, 0xa5, 0x19, 0x25, 0xa3, 0x3e, 0xb5, 0x8c, 0x4d,
-	0x2d, 0xe3, 0x2a, 0x0f, 0xfa, 0x45, 0xed, 0xaa, 0x0f, 0x3d, 0xf6, 0x3e, 0x58, 0x3a, 0x26, 0xb4,
-	0xc5, 0x8e, 0x03, 0xd1, 0x66, 0x5c, 0x96, 0x66, 0x2b, 0x56, 0x75, 0xa6, 0xb6, 0x39, 0xe8, 0x3b,
-	0x6b, 0x9a, 0x31, 0x19, 0x85, 0x7e, 0x41, 0x9b, 0x0f, 0x33, 0xcb, 0xbe, 0x01, 0x8c, 0x19, 0x74,
-	0x18, 0x8d, 0x4a, 0x73, 0xaa, 0x74, 0x63, 0xd0, 0x77, 0xec, 0x4b, 0xa5, 0x59, 0x10, 0xfa, 0x40,
-	0x5b, 0xf7, 0x19, 0x8d, 0xec, 0x43, 0xb0, 0x62, 0x62, 0x09, 0x67, 0x4d, 0x24, 0x09, 0xa3, 0xa5,
-	0x79, 0x55, 0xfd, 0xf7, 0x58, 0xfc, 0xd5, 0x0c, 0xe8, 0x17, 0xb5, 0xab, 0x31, 0xf4, 0xec, 0x2f,
-	0xbc, 0x39, 0x71, 0x72, 0x5f, 0x4e, 0x1c, 0x0b, 0xbe, 0xc8, 0x83, 0xd5, 0x6f, 0xe6, 0xc1, 0x7e,
-	0x0c, 0x16, 0x38, 0x92, 0x38, 0x88, 0x09, 0x55, 0x43, 0xbb, 0x58, 0xbb, 0x35, 0xf5, 0xa7, 0x2c,
-	0x9a, 0x59, 0x32, 0x1c, 0xe8, 0xcf, 0x67, 0xcb, 0x07, 0x84, 0x8e, 0xe9, 0x28, 0x55, 0xa3, 0xfa,
-	0xc7, 0x74, 0x94, 0x0e, 0xe9, 0x28, 0xb5, 0x6f, 0x82, 0x7c, 0x88, 0x12, 0x35, 0x88, 0x85, 0xbd,
-	0x2d, 0x57, 0xd7, 0xbb, 0xd9, 0x5d, 0x1d, 0x5d, 0x80, 0x03, 0x46, 0x68, 0xcd, 0x36, 0x33, 0x0f,
-	0x34, 0x29, 0x44, 0x09, 0xf4, 0xb3, 0x4a, 0x3b, 0x01, 0xc5, 0xb0, 0x8d, 0x68, 0x84, 0x83, 0x91,
-	0x4a, 0x3d, 0x4e, 0xf7, 0xa6, 0x56, 0xb9, 0x61, 0xd8, 0x97, 0x71, 0xd0, 0x5f, 0xd6, 0x1e, 0x5f,
-	0x4b, 0x9e, 0x38, 0x8e, 0xb7, 0x16, 0x58, 0xb9, 0x93, 0xb0, 0xb0, 0x7d, 0x84, 0xd2, 0x06, 0x67,
-	0x21, 0xc6, 0x2d, 0x61, 0x3f, 0xb7, 0xc0, 0x92, 0xba, 0xfa, 0xc6, 0x51, 0xb2, 0x2a, 0xf9, 0x9f,
-	0xef, 0xed, 0xae, 0xd9, 0xdb, 0xda, 0xc4, 0xbb, 0x61, 0x8a, 0xe1, 0xfb, 0x4f, 0x4e, 0xf5, 0x37,
-	0x36, 0x90, 0x71, 0x84, 0x5f, 0x90, 0x63, 0x1d, 0xf0, 0xb5, 0x05, 0xd6, 0x95, 0xb8, 0x3a, 0x25,
-	0x92, 0xa0, 0x4e, 0x5d, 0x88, 0x2e, 0xa2, 0x21, 0xb6, 0x9f, 0x81, 0x05, 0x62, 0xd6, 0xbf, 0xd6,
-	0x76, 0x60, 0xb4, 0x99, 0x13, 0x1c, 0x16, 0x4e, 0xa7, 0x6b, 0xd4, 0xaf, 0x76, 0x78, 0x7a, 0x5e,
-	0xb6, 0xce, 0xce, 0xcb, 0xd6, 0xe7, 0xf3, 0xb2, 0xf5, 0xea, 0xa2, 0x9c, 0x3b, 0xbb, 0x28, 0xe7,
-	0x3e, 0x5c, 0x94, 0x73, 0x8f, 0xae, 0x4d, 0xd0, 0xd4, 0x4b, 0xb8, 0x13, 0x33, 0x8a, 0x7b, 0x5e,
-	0xc8, 0x38, 0xf6, 0xd2, 0xf1, 0x7f, 0x82, 0xe2, 0x36, 0xe7, 0xd4, 0xdb, 0x7d, 0xfd, 0x6b, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0x83, 0xdf, 0x3e, 0x57, 0x32, 0x06, 0x00, 0x00,
+	// 1435 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x57, 0xcd, 0x6f, 0x1b, 0x37,
+	0x16, 0xf7, 0x58, 0x8e, 0x3f, 0x28, 0xf9, 0x23, 0x8c, 0x3f, 0xc6, 0x4e, 0xe0, 0x71, 0x18, 0x24,
+	0xeb, 0xc5, 0x26, 0x12, 0x92, 0x3d, 0xec, 0xae, 0x2f, 0x41, 0x94, 0xc4, 0x59, 0x03, 0xf1, 0xc2,
+	0x4b, 0xbb, 0x68, 0x51, 0x14, 0x18, 0xd0, 0x23, 0x66, 0x4c, 0x74, 0x86, 0x54, 0x49, 0x2a, 0x96,
+	0x72, 0x69, 0x2f, 0x05, 0x8a, 0xa2, 0x28, 0x82, 0x9c, 0x82, 0x9e, 0x72, 0xee, 0x5f, 0xd1, 0x63,
+	0x8e, 0xb9, 0x14, 0x68, 0x7b, 0x50, 0x0b, 0xe7, 0x52, 0xf4, 0xa8, 0xbf, 0xa0, 0x20, 0x87, 0x92,
+	0x46, 0xfe, 0x48, 0xa3, 0xa0, 0x40, 0x4f, 0x1a, 0xbe, 0xc7, 0xf7, 0x7b, 0x1f, 0x7c, 0xef, 0x47,
+	0x0a, 0x5c, 0xd5, 0x54, 0x4a, 0x52, 0xd1, 0x92, 0x12, 0xd5, 0x90, 0xad, 0xca, 0xe3, 0x9b, 0xfb,
+	0x54, 0x93, 0x9b, 0x3d, 0x41, 0xb9, 0x2e, 0x85, 0x16, 0x70, 0xd1, 0x6e, 0x2b, 0xf7, 0xa4, 0x6e,
+	0xdb, 0xca, 0x7c, 0x2c, 0x62, 0x61, 0xb7, 0x54, 0xcc, 0x57, 0xb6, 0x7b, 0x65, 0x35, 0x12, 0x2a,
+	0x15, 0xaa, 0xb2, 0x4f, 0x14, 0xed, 0x21, 0x46, 0x82, 0xf1, 0x4c, 0x8f, 0x7e, 0x2b, 0x81, 0xf1,
+	0x1d, 0x22, 0x49, 0xaa, 0x60, 0x04, 0x80, 0x26, 0xcd, 0xb0, 0x2e, 0x12, 0x16, 0xb5, 0x7c, 0x6f,
+	0xcd, 0x5b, 0x2f, 0xde, 0xfa, 0x7b, 0xf9, 0x74, 0x6f, 0xe5, 0x1d, 0xbb, 0xeb, 0xae, 0xe0, 0x4a,
+	0x4b, 0xc2, 0xb8, 0x56, 0xd5, 0xe5, 0x97, 0xed, 0x60, 0xa4, 0xd3, 0x0e, 0xce, 0xb7, 0x48, 0x9a,
+	0x6c, 0xa0, 0x3e, 0x14, 0xc2, 0x53, 0x9a, 0x34, 0x33, 0x03, 0x98, 0x80, 0x69, 0x49, 0x0f, 0x89,
+	0xac, 0x75, 0xfd, 0x8c, 0x0e, 0xeb, 0xe7, 0x92, 0xf3, 0x33, 0x9f, 0xf9, 0x19, 0x40, 0x43, 0xb8,
+	0x94, 0xad, 0x9d, 0xb7, 0xaf, 0x3d, 0xb0, 0xac, 0x28, 0x8b, 0x39, 0x13, 0x92, 0xc4, 0x34, 0xdc,
+	0x6f, 0xc8, 0x1a, 0xe5, 0xa1, 0x26, 0x32, 0xa6, 0xda, 0x2f, 0xac, 0x79, 0xeb, 0x53, 0x55, 0x6c,
+	0xf0, 0x7e, 0x6a, 0x07, 0xd7, 0x62, 0xa6, 0x0f, 0x1a, 0xfb, 0xe5, 0x48, 0xa4, 0x15, 0x57, 0xb4,
+	0xec, 0xe7, 0x86, 0xaa, 0x7d, 0x5c, 0xd1, 0xad, 0x3a, 0x55, 0xe5, 0x7b, 0x34, 0xea, 0xb4, 0x83,
+	0xb5, 0xcc, 0xf3, 0x99, 0xc0, 0x08, 0x2f, 0xe5, 0x74, 0x55, 0xab, 0xda, 0xb3, 0x1a, 0xa8, 0xc1,
+	0x5c, 0xca, 0x38, 0xe3, 0x71, 0xc8, 0x78, 0x24, 0x69, 0x4a, 0xb9, 0xf6, 0xc7, 0x6c, 0x18, 0x5b,
+	0x43, 0x87, 0xb1, 0x94, 0x85, 0x71, 0x1c, 0x0f, 0xe1, 0xd9, 0x4c, 0xb4, 0xd5, 0x95, 0xc0, 0x0d,
+	0x50, 0x3a, 0x64, 0xbc, 0x26, 0x0e, 0x43, 0x75, 0x20, 0xa4, 0xf6, 0xcf, 0xad, 0x79, 0xeb, 0x63,
+	0xd5, 0xa5, 0x4e, 0x3b, 0xb8, 0x90, 0x61, 0xe4, 0xb5, 0x08, 0x17, 0xb3, 0xe5, 0xae, 0x59, 0xc1,
+	0x7f, 0x01, 0xb7, 0x0c, 0x13, 0xc1, 0x63, 0x7f, 0xdc, 0x9a, 0x2e, 0x76, 0xda, 0x01, 0x1c, 0x30,
+	0x35, 0x4a, 0x84, 0x41, 0xb6, 0x7a, 0x28, 0x78, 0x0c, 0x37, 0xc1, 0x9c, 0xd3, 0xd5, 0xa5, 0xd8,
+	0x27, 0x9a, 0x09, 0xee, 0x4f, 0x58, 0xeb, 0x8b, 0xfd, 0xe0, 0x8f, 0xef, 0x40, 0x78, 0x36, 0x13,
+	0xed, 0x74, 0x25, 0x30, 0x05, 0x33, 0xfb, 0x0d, 0x69, 0x6a, 0xdb, 0x0c, 0x55, 0x3d, 0x61, 0xda,
+	0x9f, 0xb4, 0x05, 0x7b, 0x30, 0x74, 0xc1, 0x16, 0x32, 0x9f, 0x83, 0x68, 0x08, 0x97, 0x8c, 0x60,
+	0x8f, 0x34, 0x77, 0xcd, 0x12, 0x7e, 0xe9, 0x01, 0x3f, 0x12, 0x69, 0xda, 0xe0, 0x4c, 0xb7, 0xc2,
+	0xba, 0x10, 0x49, 0xce, 0xf3, 0x94, 0xf5, 0xfc, 0xff, 0xa1, 0x3d, 0x07, 0x99, 0xe7, 0xb3, 0x70,
+	0x11, 0x5e, 0xe8, 0xa9, 0x76, 0x84, 0x48, 0x7a, 0xc1, 0x1c, 0x02, 0x58, 0xa3, 0x5c, 0xa4, 0x61,
+	0x6f, 0x9a, 0x18, 0x55, 0x3e, 0x58, 0x2b, 0xac, 0x17, 0x6f, 0x5d, 0x3b, 0x6b, 0x64, 0xee, 0x19,
+	0x8b, 0xbd, 0xee, 0xc4, 0x55, 0x2f, 0xbb, 0x79, 0x59, 0xce, 0x62, 0x38, 0x89, 0x87, 0xf0, 0x5c,
+	0x2d, 0x6f, 0xc2, 0xa8, 0xe1, 0x82, 0x15, 0xc6, 0x6b, 0x2c, 0x22, 0x5a, 0xc8, 0x50, 0x52, 0x4d,
+	0xb9, 0x39, 0x8b, 0x30, 0x25, 0x32, 0x66, 0xdc, 0x2f, 0xda, 0x63, 0xbc, 0xda, 0x69, 0x07, 0x97,
+	0x33, 0xd0, 0xb3, 0xf7, 0x22, 0xec, 0xf7, 0x94, 0xb8, 0xab, 0xdb, 0xb6, 0x2a, 0xd3, 0x96, 0xb4,
+	0x2e, 0xa2, 0x83, 0x30, 0xa1, 0x3c, 0xd6, 0x07, 0x7e, 0xe9, 0x78, 0x5b, 0xe6, 0xb5, 0x08, 0x17,
+	0xed, 0xf2, 0xa1, 0x5d, 0xc1, 0x67, 0x1e, 0xf0, 0xf3, 0x03, 0x58, 0xa3, 0x4a, 0x33, 0x6e, 0x3b,
+	0x46, 0xf9, 0xd3, 0xb6, 0x40, 0xe5, 0xb3, 0x0a, 0xb4, 0xdb, 0xb7, 0xbb, 0xd7, 0x37, 0xab, 0xfe,
+	0xcd, 0x15, 0x2a, 0x38, 0x39, 0xde, 0x79, 0xf4, 0xc1, 0xe9, 0xce, 0x01, 0x28, 0xf8, 0xd4, 0x03,
+	0x33, 0x29, 0xe3, 0x61, 0x4c, 0x54, 0x58, 0x97, 0x2c, 0xa2, 0xca, 0x9f, 0xb1, 0xa1, 0x5c, 0x2a,
+	0x67, 0x8d, 0x51, 0x36, 0x34, 0x9c, 0x3b, 0xa8, 0xe8, 0xae, 0x60, 0xbc, 0xfa, 0xd0, 0x39, 0x5e,
+	0xe8, 0x0d, 0x74, 0x0e, 0x01, 0x7d, 0xfb, 0x73, 0xf0, 0x8f, 0xb7, 0x6b, 0x34, 0x03, 0xa6, 0x70,
+	0x29, 0x65, 0xfc, 0x01, 0x51, 0x3b, 0xd6, 0x1a, 0x7e, 0xe6, 0x81, 0xf9, 0x54, 0xc5, 0xe1, 0x23,
+	0x4a, 0x43, 0x49, 0x3f, 0x69, 0xb0, 0x8c, 0x12, 0x94, 0x3f, 0xbb, 0x56, 0x78, 0x13, 0xef, 0x6e,
+	0xab, 0x78, 0x93, 0x52, 0xdc, 0xb7, 0xa8, 0x5e, 0x71, 0x51, 0x5e, 0x74, 0x51, 0x9e, 0x02, 0x8a,
+	0x30, 0x4c, 0x8f, 0xdb, 0xa9, 0x8d, 0xc9, 0xe7, 0x2f, 0x82, 0x91, 0x5f, 0x5f, 0x04, 0x1e, 0x7a,
+	0x35, 0x0a, 0xce, 0x9f, 0x00, 0x86, 0xff, 0x01, 0x25, 0x03, 0x66, 0xd2, 0x08, 0x1b, 0x32, 0xb1,
+	0x37, 0xcf, 0x54, 0xbe, 0x0d, 0xf2, 0x5a, 0x84, 0x41, 0xaa, 0xe2, 0xbd, 0x56, 0x9d, 0xbe, 0x27,
+	0x13, 0xf8, 0x29, 0x98, 0xef, 0x55, 0x2a, 0x4c, 0x1b, 0x89, 0x66, 0xf5, 0x84, 0x51, 0x69, 0x2f,
+	0x95, 0xa9, 0xea, 0xf6, 0xd0, 0x73, 0xea, 0x72, 0x3b, 0x0d, 0x13, 0x61, 0x18, 0xbb, 0xaa, 0x6e,
+	0xf7, 0x84, 0xb0, 0x05, 0x26, 0x1f, 0x25, 0x44, 0x9b, 0x4a, 0xf8, 0x05, 0x5b, 0xd1, 0xe5, 0x53,
+	0x8f, 0xda, 0x9e, 0xf3, 0x5d, 0x57, 0xc1, 0xd9, 0xcc, 0x4b, 0xd7, 0xd0, 0x9c, 0xf0, 0xfa, 0x5b,
+	0x84, 0x98, 0x1d, 0xef, 0x84, 0x31, 0xdb, 0xa4, 0x74, 0x63, 0xcc, 0x96, 0xf4, 0x7b, 0x0f, 0x2c,
+	0x9e, 0xde, 0xcf, 0xf0, 0x0a, 0x18, 0x33, 0x86, 0xae, 0x9e, 0xb3, 0x9d, 0x76, 0x50, 0xcc, 0x1c,
+	0x1b, 0x29, 0xc2, 0x56, 0x69, 0xe8, 0x3d, 0x15, 0xb5, 0x46, 0x42, 0x43, 0x4e, 0x52, 0xea, 0x0a,
+	0x97, 0xa3, 0xf7, 0x9c, 0xd2, 0x94, 0xde, 0xae, 0xfe, 0x47, 0x52, 0x0a, 0xdf, 0x07, 0xe3, 0x87,
+	0x94, 0xc5, 0x07, 0xdd, 0x6b, 0xf4, 0xf6, 0xd0, 0xc5, 0x9e, 0x76, 0x57, 0x80, 0x45, 0x41, 0xd8,
+	0xc1, 0xb9, 0xbc, 0x7e, 0xf4, 0xc0, 0x5c, 0x2e, 0xaf, 0xdd, 0x03, 0x22, 0x29, 0x4c, 0x40, 0x31,
+	0x37, 0x89, 0xee, 0x89, 0x32, 0xec, 0x98, 0xaf, 0xb8, 0x53, 0x80, 0x5d, 0x3e, 0xec, 0xa9, 0x10,
+	0xce, 0xc3, 0x9b, 0x0c, 0x49, 0x2a, 0x1a, 0x5c, 0xfb, 0xa3, 0x43, 0x67, 0xb8, 0xc5, 0x75, 0x3f,
+	0xc3, 0x0c, 0x05, 0x61, 0x07, 0x87, 0x9e, 0x7b, 0x60, 0x66, 0x90, 0xa4, 0xe1, 0x35, 0x70, 0xce,
+	0x72, 0xb0, 0x3b, 0xac, 0xb9, 0x4e, 0x3b, 0x28, 0xe5, 0xf8, 0x1a, 0xe1, 0x4c, 0x0d, 0x3f, 0x00,
+	0xe3, 0xef, 0xfa, 0x6e, 0x5a, 0x70, 0x79, 0xbb, 0xa0, 0xba, 0x0f, 0x26, 0x87, 0xe7, 0xca, 0xfe,
+	0x55, 0x01, 0x9c, 0x3f, 0x61, 0x0a, 0x3f, 0x02, 0x93, 0x92, 0x68, 0x1a, 0xa6, 0x8c, 0xbb, 0x00,
+	0xef, 0x0c, 0x7d, 0xda, 0xae, 0xe9, 0xbb, 0x38, 0x08, 0x4f, 0x98, 0xcf, 0x6d, 0xc6, 0xfb, 0xe8,
+	0xa4, 0xe9, 0x8f, 0xfe, 0x19, 0xe8, 0xa4, 0xd9, 0x45, 0x27, 0x4d, 0x78, 0x1b, 0x14, 0x22, 0x52,
+	0xb7, 0x4d, 0xfa, 0xc6, 0xe1, 0x84, 0xae, 0x3c, 0xc0, 0x5d, 0xd5, 0xa4, 0x8e, 0xb0, 0xb1, 0x84,
+	0x75, 0x30, 0x1b, 0x1d, 0x10, 0x1e, 0xd3, 0xb0, 0x17, 0x65, 0xf6, 0x62, 0xfb, 0xef, 0xd0, 0x51,
+	0x2e, 0x3a, 0xec, 0x41, 0x38, 0x84, 0xa7, 0x33, 0x09, 0xce, 0x42, 0xce, 0x11, 0xe6, 0x37, 0x1e,
+	0x98, 0xbb, 0x6f, 0x6e, 0x3d, 0xd3, 0x29, 0x52, 0x44, 0x94, 0xd6, 0x14, 0xfc, 0xdc, 0x03, 0x25,
+	0x7b, 0x7f, 0x3b, 0x81, 0xef, 0xfd, 0x11, 0xf1, 0x3c, 0x70, 0xb9, 0x5d, 0xc8, 0x3d, 0xcd, 0x9d,
+	0xf1, 0x70, 0xe4, 0x53, 0xd4, 0xfd, 0x38, 0xd0, 0x33, 0x0f, 0xcc, 0xdb, 0xe0, 0xb6, 0x38, 0xd3,
+	0x8c, 0x24, 0x5b, 0x4a, 0x35, 0x08, 0x8f, 0x28, 0x7c, 0x02, 0x26, 0x99, 0xfb, 0xf6, 0xbd, 0x21,
+	0x49, 0xb1, 0x6b, 0x38, 0x5c, 0x5c, 0x3d, 0x7f, 0x88, 0x80, 0xd2, 0x1e, 0x69, 0xde, 0x6f, 0xd2,
+	0xb4, 0x6e, 0x87, 0xf8, 0x3a, 0x98, 0x20, 0xb5, 0x9a, 0xa4, 0x4a, 0xb9, 0xce, 0x85, 0x9d, 0x76,
+	0x30, 0xe3, 0xe6, 0x32, 0x53, 0x20, 0xdc, 0xdd, 0x62, 0x28, 0xf3, 0x89, 0xe0, 0x5d, 0x1a, 0xcc,
+	0x51, 0xa6, 0x91, 0x22, 0x6c, 0x95, 0xe8, 0xc8, 0x03, 0x4b, 0x77, 0x6a, 0xb5, 0xbc, 0x9b, 0x1d,
+	0x29, 0xea, 0x42, 0x91, 0xc4, 0xcc, 0xb1, 0x66, 0x3a, 0xa1, 0x27, 0xe7, 0xd8, 0x8a, 0x11, 0xce,
+	0xd4, 0xf0, 0xdf, 0x96, 0xc9, 0x22, 0xc9, 0xac, 0xf9, 0x49, 0xda, 0xcd, 0x29, 0x33, 0x56, 0xea,
+	0xae, 0x7a, 0x21, 0x16, 0xde, 0x10, 0x22, 0xbc, 0x05, 0xa6, 0x5c, 0x4a, 0x54, 0xf9, 0x63, 0x6b,
+	0x85, 0xf5, 0xa9, 0xea, 0x7c, 0xa7, 0x1d, 0xcc, 0x0d, 0xe4, 0x6d, 0x5e, 0x7e, 0xfd, 0x6d, 0x1b,
+	0xa5, 0x2f, 0x5e, 0x04, 0x23, 0xae, 0xf3, 0x46, 0xd0, 0x77, 0x1e, 0x58, 0xc1, 0x34, 0x15, 0x8f,
+	0xe9, 0x5f, 0x94, 0xe7, 0x40, 0x0a, 0x85, 0x77, 0x48, 0xa1, 0xba, 0xf9, 0xf2, 0x68, 0xd5, 0x7b,
+	0x75, 0xb4, 0xea, 0xfd, 0x72, 0xb4, 0xea, 0x3d, 0x7d, 0xbd, 0x3a, 0xf2, 0xea, 0xf5, 0xea, 0xc8,
+	0x0f, 0xaf, 0x57, 0x47, 0x3e, 0xbc, 0x9e, 0x6b, 0x2c, 0xcb, 0x9f, 0x37, 0x52, 0xc1, 0x69, 0xab,
+	0x12, 0x09, 0x49, 0x2b, 0xcd, 0xfe, 0x3f, 0x70, 0xdb, 0x62, 0xfb, 0xe3, 0xf6, 0x9f, 0xf2, 0x3f,
+	0x7f, 0x1f, 0x00, 0xe8, 0x60, 0x06, 0xe4, 0xa0, 0x0f, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -341,16 +792,60 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.WindowProbation != that1.WindowProbation {
 		return false
 	}
+	if !this.BurnTaxSplit.Equal(that1.BurnTaxSplit) {
+		return false
+	}
+	if !this.CommunityPoolTaxSplit.Equal(that1.CommunityPoolTaxSplit) {
+		return false
+	}
+	if len(this.DenomTaxPolicies) != len(that1.DenomTaxPolicies) {
+		return false
+	}
+	for i := range this.DenomTaxPolicies {
+		if !this.DenomTaxPolicies[i].Equal(&that1.DenomTaxPolicies[i]) {
+			return false
+		}
+	}
+	if this.IndicatorRetentionMargin != that1.IndicatorRetentionMargin {
+		return false
+	}
+	if this.EpochLength != that1.EpochLength {
+		return false
+	}
+	if len(this.SeigniorageDestinations) != len(that1.SeigniorageDestinations) {
+		return false
+	}
+	for i := range this.SeigniorageDestinations {
+		if !this.SeigniorageDestinations[i].Equal(&that1.SeigniorageDestinations[i]) {
+			return false
+		}
+	}
+	if len(this.MinGasPrices) != len(that1.MinGasPrices) {
+		return false
+	}
+	for i := range this.MinGasPrices {
+		if !this.MinGasPrices[i].Equal(&that1.MinGasPrices[i]) {
+			return false
+		}
+	}
+	if len(this.MsgFeeRequirements) != len(that1.MsgFeeRequirements) {
+		return false
+	}
+	for i := range this.MsgFeeRequirements {
+		if !this.MsgFeeRequirements[i].Equal(&that1.MsgFeeRequirements[i]) {
+			return false
+		}
+	}
 	return true
 }
-func (this *PolicyConstraints) Equal(that interface{}) bool {
+func (this *MsgFeeRequirement) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*PolicyConstraints)
+	that1, ok := that.(*MsgFeeRequirement)
 	if !ok {
-		that2, ok := that.(PolicyConstraints)
+		that2, ok := that.(MsgFeeRequirement)
 		if ok {
 			that1 = &that2
 		} else {
@@ -362,16 +857,108 @@ func (this *PolicyConstraints) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.RateMin.Equal(that1.RateMin) {
+	if this.MsgTypeUrl != that1.MsgTypeUrl {
 		return false
 	}
-	if !this.RateMax.Equal(that1.RateMax) {
+	if !this.GasPriceMultiplier.Equal(that1.GasPriceMultiplier) {
 		return false
 	}
-	if !this.Cap.Equal(&that1.Cap) {
+	if len(this.FlatFee) != len(that1.FlatFee) {
 		return false
 	}
-	if !this.ChangeRateMax.Equal(that1.ChangeRateMax) {
+	for i := range this.FlatFee {
+		if !this.FlatFee[i].Equal(&that1.FlatFee[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *SeigniorageDestination) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SeigniorageDestination)
+	if !ok {
+		that2, ok := that.(SeigniorageDestination)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Type != that1.Type {
+		return false
+	}
+	if this.ModuleName != that1.ModuleName {
+		return false
+	}
+	if !this.Weight.Equal(that1.Weight) {
+		return false
+	}
+	return true
+}
+func (this *DenomTaxPolicy) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DenomTaxPolicy)
+	if !ok {
+		that2, ok := that.(DenomTaxPolicy)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Denom != that1.Denom {
+		return false
+	}
+	if !this.Policy.Equal(&that1.Policy) {
+		return false
+	}
+	return true
+}
+func (this *PolicyConstraints) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PolicyConstraints)
+	if !ok {
+		that2, ok := that.(PolicyConstraints)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.RateMin.Equal(that1.RateMin) {
+		return false
+	}
+	if !this.RateMax.Equal(that1.RateMax) {
+		return false
+	}
+	if !this.Cap.Equal(&that1.Cap) {
+		return false
+	}
+	if !this.ChangeRateMax.Equal(that1.ChangeRateMax) {
 		return false
 	}
 	return true
@@ -396,6 +983,92 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.MsgFeeRequirements) > 0 {
+		for iNdEx := len(m.MsgFeeRequirements) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MsgFeeRequirements[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTreasury(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if len(m.MinGasPrices) > 0 {
+		for iNdEx := len(m.MinGasPrices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinGasPrices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTreasury(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.SeigniorageDestinations) > 0 {
+		for iNdEx := len(m.SeigniorageDestinations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SeigniorageDestinations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTreasury(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if m.EpochLength != 0 {
+		i = encodeVarintTreasury(dAtA, i, uint64(m.EpochLength))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.IndicatorRetentionMargin != 0 {
+		i = encodeVarintTreasury(dAtA, i, uint64(m.IndicatorRetentionMargin))
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.DenomTaxPolicies) > 0 {
+		for iNdEx := len(m.DenomTaxPolicies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DenomTaxPolicies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTreasury(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	{
+		size := m.CommunityPoolTaxSplit.Size()
+		i -= size
+		if _, err := m.CommunityPoolTaxSplit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTreasury(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.BurnTaxSplit.Size()
+		i -= size
+		if _, err := m.BurnTaxSplit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTreasury(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
 	if m.WindowProbation != 0 {
 		i = encodeVarintTreasury(dAtA, i, uint64(m.WindowProbation))
 		i--
@@ -454,7 +1127,7 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *PolicyConstraints) Marshal() (dAtA []byte, err error) {
+func (m *MsgFeeRequirement) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -464,40 +1137,121 @@ func (m *PolicyConstraints) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PolicyConstraints) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgFeeRequirement) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PolicyConstraints) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgFeeRequirement) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.FlatFee) > 0 {
+		for iNdEx := len(m.FlatFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FlatFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTreasury(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	{
-		size := m.ChangeRateMax.Size()
+		size := m.GasPriceMultiplier.Size()
 		i -= size
-		if _, err := m.ChangeRateMax.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.GasPriceMultiplier.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintTreasury(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x22
+	dAtA[i] = 0x12
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintTreasury(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SeigniorageDestination) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SeigniorageDestination) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SeigniorageDestination) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
 	{
-		size, err := m.Cap.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
+		size := m.Weight.Size()
+		i -= size
+		if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
-		i -= size
 		i = encodeVarintTreasury(dAtA, i, uint64(size))
 	}
 	i--
 	dAtA[i] = 0x1a
+	if len(m.ModuleName) > 0 {
+		i -= len(m.ModuleName)
+		copy(dAtA[i:], m.ModuleName)
+		i = encodeVarintTreasury(dAtA, i, uint64(len(m.ModuleName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintTreasury(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SeigniorageShare) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SeigniorageShare) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SeigniorageShare) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
 	{
-		size := m.RateMax.Size()
+		size := m.Amount.Size()
 		i -= size
-		if _, err := m.RateMax.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintTreasury(dAtA, i, uint64(size))
@@ -505,11 +1259,11 @@ func (m *PolicyConstraints) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i--
 	dAtA[i] = 0x12
 	{
-		size := m.RateMin.Size()
-		i -= size
-		if _, err := m.RateMin.MarshalTo(dAtA[i:]); err != nil {
+		size, err := m.Destination.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
 			return 0, err
 		}
+		i -= size
 		i = encodeVarintTreasury(dAtA, i, uint64(size))
 	}
 	i--
@@ -517,7 +1271,7 @@ func (m *PolicyConstraints) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *EpochTaxProceeds) Marshal() (dAtA []byte, err error) {
+func (m *DenomTaxPolicy) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -527,34 +1281,37 @@ func (m *EpochTaxProceeds) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EpochTaxProceeds) MarshalTo(dAtA []byte) (int, error) {
+func (m *DenomTaxPolicy) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EpochTaxProceeds) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DenomTaxPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.TaxProceeds) > 0 {
-		for iNdEx := len(m.TaxProceeds) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.TaxProceeds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTreasury(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+	{
+		size, err := m.Policy.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintTreasury(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTreasury(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *EpochInitialIssuance) Marshal() (dAtA []byte, err error) {
+func (m *PolicyConstraints) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -564,30 +1321,266 @@ func (m *EpochInitialIssuance) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EpochInitialIssuance) MarshalTo(dAtA []byte) (int, error) {
+func (m *PolicyConstraints) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EpochInitialIssuance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PolicyConstraints) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Issuance) > 0 {
-		for iNdEx := len(m.Issuance) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Issuance[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTreasury(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
+	{
+		size := m.ChangeRateMax.Size()
+		i -= size
+		if _, err := m.ChangeRateMax.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTreasury(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.Cap.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTreasury(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.RateMax.Size()
+		i -= size
+		if _, err := m.RateMax.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTreasury(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.RateMin.Size()
+		i -= size
+		if _, err := m.RateMin.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTreasury(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *EpochTaxProceeds) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EpochTaxProceeds) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EpochTaxProceeds) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TaxProceeds) > 0 {
+		for iNdEx := len(m.TaxProceeds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TaxProceeds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTreasury(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EpochInitialIssuance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EpochInitialIssuance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EpochInitialIssuance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Issuance) > 0 {
+		for iNdEx := len(m.Issuance) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Issuance[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTreasury(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TaxExemption) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TaxExemption) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TaxExemption) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Zone) > 0 {
+		i -= len(m.Zone)
+		copy(dAtA[i:], m.Zone)
+		i = encodeVarintTreasury(dAtA, i, uint64(len(m.Zone)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTreasury(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AddTaxExemptionProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AddTaxExemptionProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AddTaxExemptionProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Addresses) > 0 {
+		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Addresses[iNdEx])
+			copy(dAtA[i:], m.Addresses[iNdEx])
+			i = encodeVarintTreasury(dAtA, i, uint64(len(m.Addresses[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Zone) > 0 {
+		i -= len(m.Zone)
+		copy(dAtA[i:], m.Zone)
+		i = encodeVarintTreasury(dAtA, i, uint64(len(m.Zone)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintTreasury(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintTreasury(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RemoveTaxExemptionProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RemoveTaxExemptionProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RemoveTaxExemptionProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Addresses) > 0 {
+		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Addresses[iNdEx])
+			copy(dAtA[i:], m.Addresses[iNdEx])
+			i = encodeVarintTreasury(dAtA, i, uint64(len(m.Addresses[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintTreasury(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintTreasury(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -625,61 +1618,230 @@ func (m *Params) Size() (n int) {
 	if m.WindowProbation != 0 {
 		n += 1 + sovTreasury(uint64(m.WindowProbation))
 	}
+	l = m.BurnTaxSplit.Size()
+	n += 1 + l + sovTreasury(uint64(l))
+	l = m.CommunityPoolTaxSplit.Size()
+	n += 1 + l + sovTreasury(uint64(l))
+	if len(m.DenomTaxPolicies) > 0 {
+		for _, e := range m.DenomTaxPolicies {
+			l = e.Size()
+			n += 1 + l + sovTreasury(uint64(l))
+		}
+	}
+	if m.IndicatorRetentionMargin != 0 {
+		n += 1 + sovTreasury(uint64(m.IndicatorRetentionMargin))
+	}
+	if m.EpochLength != 0 {
+		n += 1 + sovTreasury(uint64(m.EpochLength))
+	}
+	if len(m.SeigniorageDestinations) > 0 {
+		for _, e := range m.SeigniorageDestinations {
+			l = e.Size()
+			n += 1 + l + sovTreasury(uint64(l))
+		}
+	}
+	if len(m.MinGasPrices) > 0 {
+		for _, e := range m.MinGasPrices {
+			l = e.Size()
+			n += 1 + l + sovTreasury(uint64(l))
+		}
+	}
+	if len(m.MsgFeeRequirements) > 0 {
+		for _, e := range m.MsgFeeRequirements {
+			l = e.Size()
+			n += 1 + l + sovTreasury(uint64(l))
+		}
+	}
 	return n
 }
 
-func (m *PolicyConstraints) Size() (n int) {
+func (m *MsgFeeRequirement) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.RateMin.Size()
-	n += 1 + l + sovTreasury(uint64(l))
-	l = m.RateMax.Size()
-	n += 1 + l + sovTreasury(uint64(l))
-	l = m.Cap.Size()
-	n += 1 + l + sovTreasury(uint64(l))
-	l = m.ChangeRateMax.Size()
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovTreasury(uint64(l))
+	}
+	l = m.GasPriceMultiplier.Size()
 	n += 1 + l + sovTreasury(uint64(l))
+	if len(m.FlatFee) > 0 {
+		for _, e := range m.FlatFee {
+			l = e.Size()
+			n += 1 + l + sovTreasury(uint64(l))
+		}
+	}
 	return n
 }
 
-func (m *EpochTaxProceeds) Size() (n int) {
+func (m *SeigniorageDestination) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.TaxProceeds) > 0 {
-		for _, e := range m.TaxProceeds {
-			l = e.Size()
-			n += 1 + l + sovTreasury(uint64(l))
-		}
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovTreasury(uint64(l))
+	}
+	l = len(m.ModuleName)
+	if l > 0 {
+		n += 1 + l + sovTreasury(uint64(l))
 	}
+	l = m.Weight.Size()
+	n += 1 + l + sovTreasury(uint64(l))
 	return n
 }
 
-func (m *EpochInitialIssuance) Size() (n int) {
+func (m *SeigniorageShare) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Issuance) > 0 {
-		for _, e := range m.Issuance {
-			l = e.Size()
-			n += 1 + l + sovTreasury(uint64(l))
-		}
-	}
+	l = m.Destination.Size()
+	n += 1 + l + sovTreasury(uint64(l))
+	l = m.Amount.Size()
+	n += 1 + l + sovTreasury(uint64(l))
 	return n
 }
 
-func sovTreasury(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *DenomTaxPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTreasury(uint64(l))
+	}
+	l = m.Policy.Size()
+	n += 1 + l + sovTreasury(uint64(l))
+	return n
 }
-func sozTreasury(x uint64) (n int) {
-	return sovTreasury(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *PolicyConstraints) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.RateMin.Size()
+	n += 1 + l + sovTreasury(uint64(l))
+	l = m.RateMax.Size()
+	n += 1 + l + sovTreasury(uint64(l))
+	l = m.Cap.Size()
+	n += 1 + l + sovTreasury(uint64(l))
+	l = m.ChangeRateMax.Size()
+	n += 1 + l + sovTreasury(uint64(l))
+	return n
+}
+
+func (m *EpochTaxProceeds) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TaxProceeds) > 0 {
+		for _, e := range m.TaxProceeds {
+			l = e.Size()
+			n += 1 + l + sovTreasury(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EpochInitialIssuance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Issuance) > 0 {
+		for _, e := range m.Issuance {
+			l = e.Size()
+			n += 1 + l + sovTreasury(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TaxExemption) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTreasury(uint64(l))
+	}
+	l = len(m.Zone)
+	if l > 0 {
+		n += 1 + l + sovTreasury(uint64(l))
+	}
+	return n
+}
+
+func (m *AddTaxExemptionProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovTreasury(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovTreasury(uint64(l))
+	}
+	l = len(m.Zone)
+	if l > 0 {
+		n += 1 + l + sovTreasury(uint64(l))
+	}
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			l = len(s)
+			n += 1 + l + sovTreasury(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RemoveTaxExemptionProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovTreasury(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovTreasury(uint64(l))
+	}
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			l = len(s)
+			n += 1 + l + sovTreasury(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovTreasury(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTreasury(x uint64) (n int) {
+	return sovTreasury(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
 func (m *Params) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
@@ -745,7 +1907,1256 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RewardPolicy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTreasury
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RewardPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SeigniorageBurdenTarget", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTreasury
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SeigniorageBurdenTarget.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MiningIncrement", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTreasury
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MiningIncrement.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowShort", wireType)
+			}
+			m.WindowShort = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTreasury
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowShort |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowLong", wireType)
+			}
+			m.WindowLong = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTreasury
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowLong |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowProbation", wireType)
+			}
+			m.WindowProbation = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTreasury
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowProbation |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnTaxSplit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTreasury
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BurnTaxSplit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPoolTaxSplit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTreasury
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CommunityPoolTaxSplit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomTaxPolicies", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTreasury
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomTaxPolicies = append(m.DenomTaxPolicies, DenomTaxPolicy{})
+			if err := m.DenomTaxPolicies[len(m.DenomTaxPolicies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndicatorRetentionMargin", wireType)
+			}
+			m.IndicatorRetentionMargin = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTreasury
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IndicatorRetentionMargin |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochLength", wireType)
+			}
+			m.EpochLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTreasury
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochLength |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SeigniorageDestinations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTreasury
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SeigniorageDestinations = append(m.SeigniorageDestinations, SeigniorageDestination{})
+			if err := m.SeigniorageDestinations[len(m.SeigniorageDestinations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinGasPrices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTreasury
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinGasPrices = append(m.MinGasPrices, types.DecCoin{})
+			if err := m.MinGasPrices[len(m.MinGasPrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgFeeRequirements", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTreasury
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgFeeRequirements = append(m.MsgFeeRequirements, MsgFeeRequirement{})
+			if err := m.MsgFeeRequirements[len(m.MsgFeeRequirements)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTreasury(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFeeRequirement) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTreasury
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFeeRequirement: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFeeRequirement: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTreasury
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasPriceMultiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTreasury
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GasPriceMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FlatFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTreasury
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FlatFee = append(m.FlatFee, types.Coin{})
+			if err := m.FlatFee[len(m.FlatFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTreasury(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SeigniorageDestination) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTreasury
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SeigniorageDestination: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SeigniorageDestination: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTreasury
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTreasury
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ModuleName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTreasury
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTreasury(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SeigniorageShare) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTreasury
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SeigniorageShare: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SeigniorageShare: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTreasury
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Destination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTreasury
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTreasury(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DenomTaxPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTreasury
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomTaxPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomTaxPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTreasury
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTreasury
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Policy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTreasury(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PolicyConstraints) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTreasury
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PolicyConstraints: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PolicyConstraints: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateMin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTreasury
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RateMin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateMax", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTreasury
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RateMax.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cap", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTreasury
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Cap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChangeRateMax", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTreasury
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ChangeRateMax.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTreasury(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EpochTaxProceeds) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTreasury
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EpochTaxProceeds: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EpochTaxProceeds: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaxProceeds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTreasury
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaxProceeds = append(m.TaxProceeds, types.Coin{})
+			if err := m.TaxProceeds[len(m.TaxProceeds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTreasury(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EpochInitialIssuance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTreasury
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EpochInitialIssuance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EpochInitialIssuance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Issuance", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -772,13 +3183,64 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RewardPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Issuance = append(m.Issuance, types.Coin{})
+			if err := m.Issuance[len(m.Issuance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTreasury(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TaxExemption) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTreasury
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TaxExemption: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TaxExemption: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SeigniorageBurdenTarget", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -806,13 +3268,11 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SeigniorageBurdenTarget.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MiningIncrement", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Zone", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -840,67 +3300,8 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MiningIncrement.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Zone = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WindowShort", wireType)
-			}
-			m.WindowShort = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTreasury
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.WindowShort |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WindowLong", wireType)
-			}
-			m.WindowLong = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTreasury
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.WindowLong |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WindowProbation", wireType)
-			}
-			m.WindowProbation = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTreasury
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.WindowProbation |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTreasury(dAtA[iNdEx:])
@@ -922,7 +3323,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PolicyConstraints) Unmarshal(dAtA []byte) error {
+func (m *AddTaxExemptionProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -945,15 +3346,15 @@ func (m *PolicyConstraints) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PolicyConstraints: wiretype end group for non-group")
+			return fmt.Errorf("proto: AddTaxExemptionProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PolicyConstraints: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AddTaxExemptionProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RateMin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -981,13 +3382,11 @@ func (m *PolicyConstraints) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RateMin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Title = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RateMax", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1015,15 +3414,13 @@ func (m *PolicyConstraints) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RateMax.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cap", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Zone", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTreasury
@@ -1033,28 +3430,27 @@ func (m *PolicyConstraints) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTreasury
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTreasury
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Cap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Zone = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChangeRateMax", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1082,9 +3478,7 @@ func (m *PolicyConstraints) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ChangeRateMax.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1107,7 +3501,7 @@ func (m *PolicyConstraints) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EpochTaxProceeds) Unmarshal(dAtA []byte) error {
+func (m *RemoveTaxExemptionProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1130,17 +3524,17 @@ func (m *EpochTaxProceeds) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EpochTaxProceeds: wiretype end group for non-group")
+			return fmt.Errorf("proto: RemoveTaxExemptionProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EpochTaxProceeds: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RemoveTaxExemptionProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaxProceeds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTreasury
@@ -1150,81 +3544,61 @@ func (m *EpochTaxProceeds) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTreasury
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTreasury
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TaxProceeds = append(m.TaxProceeds, types.Coin{})
-			if err := m.TaxProceeds[len(m.TaxProceeds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Title = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTreasury(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTreasury
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTreasury
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTreasury
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *EpochInitialIssuance) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTreasury
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTreasury
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EpochInitialIssuance: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EpochInitialIssuance: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Issuance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTreasury
@@ -1234,25 +3608,23 @@ func (m *EpochInitialIssuance) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTreasury
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTreasury
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Issuance = append(m.Issuance, types.Coin{})
-			if err := m.Issuance[len(m.Issuance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -34,6 +34,7 @@ func (WasmQuerier) Query(_ sdk.Context, _ wasmvmtypes.QueryRequest) ([]byte, err
 type CosmosQuery struct {
 	TaxRate *struct{}                `json:"tax_rate,omitempty"`
 	TaxCap  *types.QueryTaxCapParams `json:"tax_cap,omitempty"`
+	Burned  *struct{}                `json:"burned,omitempty"`
 }
 
 // TaxRateQueryResponse - tax rate query response for wasm module
@@ -48,6 +49,14 @@ type TaxCapQueryResponse struct {
 	Cap string `json:"cap"`
 }
 
+// BurnedQueryResponse - burned query response for wasm module
+type BurnedQueryResponse struct {
+	// cumulative amount burned from the burn module account
+	Burned wasmvmtypes.Coins `json:"burned"`
+	// amount burned during the current epoch
+	EpochBurned wasmvmtypes.Coins `json:"epoch_burned"`
+}
+
 // QueryCustom implements custom query interface
 func (querier WasmQuerier) QueryCustom(ctx sdk.Context, data json.RawMessage) ([]byte, error) {
 	var query CosmosQuery
@@ -65,6 +74,11 @@ func (querier WasmQuerier) QueryCustom(ctx sdk.Context, data json.RawMessage) ([
 	} else if query.TaxCap != nil {
 		cap := querier.keeper.GetTaxCap(ctx, query.TaxCap.Denom)
 		bz, err = json.Marshal(TaxCapQueryResponse{Cap: cap.String()})
+	} else if query.Burned != nil {
+		bz, err = json.Marshal(BurnedQueryResponse{
+			Burned:      wasm.EncodeSdkCoins(querier.keeper.GetBurned(ctx)),
+			EpochBurned: wasm.EncodeSdkCoins(querier.keeper.GetEpochBurned(ctx, querier.keeper.GetEpoch(ctx))),
+		})
 	} else {
 		return nil, sdkerrors.ErrInvalidRequest
 	}
@@ -11,6 +11,7 @@ import (
 	core "github.com/terra-money/core/types"
 	"github.com/terra-money/core/x/treasury/keeper"
 	"github.com/terra-money/core/x/treasury/types"
+	wasm "github.com/terra-money/core/x/wasm/exported"
 )
 
 func TestQueryTaxRate(t *testing.T) {
@@ -70,3 +71,22 @@ func TestQueryTaxCap(t *testing.T) {
 	require.NoError(t, json.Unmarshal(res, &taxCapResponse))
 	require.Equal(t, cap.String(), taxCapResponse.Cap)
 }
+
+func TestQueryBurned(t *testing.T) {
+	input := keeper.CreateTestInput(t)
+	input.TreasuryKeeper.BurnCoinsFromBurnAccount(input.Ctx)
+
+	querier := NewWasmQuerier(input.TreasuryKeeper)
+	bz, err := json.Marshal(CosmosQuery{
+		Burned: &struct{}{},
+	})
+	require.NoError(t, err)
+
+	res, err := querier.QueryCustom(input.Ctx, bz)
+	require.NoError(t, err)
+
+	var burnedResponse BurnedQueryResponse
+	require.NoError(t, json.Unmarshal(res, &burnedResponse))
+	require.Equal(t, wasm.EncodeSdkCoins(keeper.InitCoins), burnedResponse.Burned)
+	require.Equal(t, wasm.EncodeSdkCoins(keeper.InitCoins), burnedResponse.EpochBurned)
+}
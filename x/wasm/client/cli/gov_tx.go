@@ -0,0 +1,418 @@
+package cli
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/terra-money/core/x/wasm/types"
+)
+
+const flagRunAs = "run-as"
+
+// GetCmdSubmitPinCodesProposal implements the command to submit a pin-codes proposal
+func GetCmdSubmitPinCodesProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pin-codes [code-id1] [code-id2] ...",
+		Args:  cobra.MinimumNArgs(1),
+		Short: "Submit a proposal to pin codes to the wasm vm cache",
+		Long: strings.TrimSpace(`
+Submit a proposal to pin codes to the wasm vm cache along with an initial deposit.
+Pinned codes are always kept in memory, so they are charged reduced gas to load.
+
+$ terrad tx gov submit-proposal pin-codes 1 2 --title="..." --description="..." --deposit="1000000uluna" --from=mykey
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			title, description, deposit, err := parseProposalFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			codeIDs, err := parseCodeIDs(args)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewPinCodesProposal(title, description, codeIDs)
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	addProposalFlags(cmd)
+	return cmd
+}
+
+// GetCmdSubmitUnpinCodesProposal implements the command to submit an unpin-codes proposal
+func GetCmdSubmitUnpinCodesProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unpin-codes [code-id1] [code-id2] ...",
+		Args:  cobra.MinimumNArgs(1),
+		Short: "Submit a proposal to unpin codes from the wasm vm cache",
+		Long: strings.TrimSpace(`
+Submit a proposal to unpin codes from the wasm vm cache along with an initial deposit.
+
+$ terrad tx gov submit-proposal unpin-codes 1 2 --title="..." --description="..." --deposit="1000000uluna" --from=mykey
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			title, description, deposit, err := parseProposalFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			codeIDs, err := parseCodeIDs(args)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewUnpinCodesProposal(title, description, codeIDs)
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	addProposalFlags(cmd)
+	return cmd
+}
+
+// GetCmdSubmitStoreCodeProposal implements the command to submit a store-code proposal
+func GetCmdSubmitStoreCodeProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "store-code [wasm-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to upload a wasm binary",
+		Long: strings.TrimSpace(`
+Submit a proposal to upload a wasm binary along with an initial deposit.
+The code upload access of the params is not applied to the proposal, and the
+run as address is recorded as the code creator.
+
+$ terrad tx gov submit-proposal store-code ./path-to-binary --run-as=terra1... --instantiate-nobody --title="..." --description="..." --deposit="1000000uluna" --from=mykey
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			title, description, deposit, err := parseProposalFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			runAsAddr, err := parseRunAsFlag(cmd)
+			if err != nil {
+				return err
+			}
+
+			wasmBytes, err := parseWasmFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			instantiatePermission, err := parseInstantiatePermissionFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			metadata, err := parseCodeMetadataFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewStoreCodeProposal(title, description, runAsAddr, wasmBytes, instantiatePermission, metadata)
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(flagRunAs, "", "the address which is recorded as the code creator")
+	cmd.MarkFlagRequired(flagRunAs)
+	addInstantiatePermissionFlags(cmd)
+	addCodeMetadataFlags(cmd)
+	addProposalFlags(cmd)
+	return cmd
+}
+
+// GetCmdSubmitInstantiateContractProposal implements the command to submit an instantiate-contract proposal
+func GetCmdSubmitInstantiateContractProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "instantiate-contract [code-id] [json-encoded-args] [coins]",
+		Args:  cobra.RangeArgs(2, 3),
+		Short: "Submit a proposal to instantiate a wasm contract",
+		Long: strings.TrimSpace(`
+Submit a proposal to instantiate a wasm contract of the code which has the given id
+along with an initial deposit. The instantiate permission of the code is not applied
+to the proposal; the run as address is recorded as the contract creator and pays the coins.
+
+$ terrad tx gov submit-proposal instantiate-contract 1 '{"arbiter": "terra..."}' --run-as=terra1... --admin=terra1... --title="..." --description="..." --deposit="1000000uluna" --from=mykey
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			title, description, deposit, err := parseProposalFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			runAsAddr, err := parseRunAsFlag(cmd)
+			if err != nil {
+				return err
+			}
+
+			admin, err := cmd.Flags().GetString(flagAdmin)
+			if err != nil {
+				return err
+			}
+
+			var adminAddr sdk.AccAddress
+			if len(admin) != 0 {
+				adminAddr, err = sdk.AccAddressFromBech32(admin)
+				if err != nil {
+					return err
+				}
+			}
+
+			codeID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			initMsgBz := []byte(args[1])
+			if !json.Valid(initMsgBz) {
+				return errors.New("msg must be a json string format")
+			}
+
+			var coins sdk.Coins
+			if len(args) == 3 {
+				coins, err = sdk.ParseCoinsNormalized(args[2])
+				if err != nil {
+					return err
+				}
+			}
+
+			label, err := cmd.Flags().GetString(flagLabel)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewInstantiateContractProposal(title, description, runAsAddr, adminAddr, codeID, initMsgBz, coins, label)
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(flagRunAs, "", "the address which is recorded as the contract creator and pays the coins")
+	cmd.Flags().String(flagAdmin, "", "the contract admin address which is previlaged to migrate contract")
+	cmd.Flags().String(flagLabel, "", "the human-readable name of the contract")
+	cmd.MarkFlagRequired(flagRunAs)
+	addProposalFlags(cmd)
+	return cmd
+}
+
+// GetCmdSubmitMigrateContractProposal implements the command to submit a migrate-contract proposal
+func GetCmdSubmitMigrateContractProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "migrate-contract [contract-addr-bech32] [new-code-id] [json-encoded-args]",
+		Args:  cobra.ExactArgs(3),
+		Short: "Submit a proposal to migrate a contract to new code base",
+		Long: strings.TrimSpace(`
+Submit a proposal to migrate a contract to new code along with an initial deposit.
+The contract is migrated regardless of its admin, but it must have an admin to be migratable.
+
+$ terrad tx gov submit-proposal migrate-contract terra... 10 '{"verifier": "terra..."}' --title="..." --description="..." --deposit="1000000uluna" --from=mykey
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			title, description, deposit, err := parseProposalFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			contractAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			newCodeID, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewMigrateContractProposal(title, description, contractAddr, newCodeID, []byte(args[2]))
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	addProposalFlags(cmd)
+	return cmd
+}
+
+func parseRunAsFlag(cmd *cobra.Command) (sdk.AccAddress, error) {
+	runAs, err := cmd.Flags().GetString(flagRunAs)
+	if err != nil {
+		return nil, err
+	}
+
+	return sdk.AccAddressFromBech32(runAs)
+}
+
+func parseCodeIDs(args []string) ([]uint64, error) {
+	codeIDs := make([]uint64, len(args))
+	for i, arg := range args {
+		codeID, err := strconv.ParseUint(arg, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid code id %s: %w", arg, err)
+		}
+
+		codeIDs[i] = codeID
+	}
+
+	return codeIDs, nil
+}
+
+func addProposalFlags(cmd *cobra.Command) {
+	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+	cmd.MarkFlagRequired(govcli.FlagTitle)
+	cmd.MarkFlagRequired(govcli.FlagDescription)
+}
+
+func parseProposalFlags(cmd *cobra.Command) (title, description string, deposit sdk.Coins, err error) {
+	title, err = cmd.Flags().GetString(govcli.FlagTitle)
+	if err != nil {
+		return
+	}
+
+	description, err = cmd.Flags().GetString(govcli.FlagDescription)
+	if err != nil {
+		return
+	}
+
+	depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+	if err != nil {
+		return
+	}
+
+	deposit, err = sdk.ParseCoinsNormalized(depositStr)
+	return
+}
+
+// GetCmdSubmitSudoContractProposal implements the command to submit a sudo-contract proposal
+func GetCmdSubmitSudoContractProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sudo-contract [contract-addr-bech32] [json-encoded-msg]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Submit a proposal to call the sudo entry point of a contract",
+		Long: strings.TrimSpace(`
+Submit a proposal to call the sudo entry point of a contract along with an initial deposit.
+
+$ terrad tx gov submit-proposal sudo-contract terra... '{"update_config": {}}' --title="..." --description="..." --deposit="1000000uluna" --from=mykey
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			title, description, deposit, err := parseProposalFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			contractAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			content := types.NewSudoContractProposal(title, description, contractAddr, []byte(args[1]))
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	addProposalFlags(cmd)
+	return cmd
+}
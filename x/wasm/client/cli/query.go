@@ -32,7 +32,13 @@ func GetQueryCmd() *cobra.Command {
 	queryCmd.AddCommand(
 		GetCmdQueryByteCode(),
 		GetCmdQueryCodeInfo(),
+		GetCmdQueryCodes(),
 		GetCmdGetContractInfo(),
+		GetCmdQueryContractsByCode(),
+		GetCmdQueryContractsByCreator(),
+		GetCmdQueryContractsByAdmin(),
+		GetCmdQueryContractHistory(),
+		GetCmdQueryPredictContractAddress(),
 		GetCmdGetContractStore(),
 		GetCmdGetRawStore(),
 		GetCmdQueryParams(),
@@ -72,6 +78,39 @@ func GetCmdQueryCodeInfo() *cobra.Command {
 	return cmd
 }
 
+// GetCmdQueryCodes lists all stored codes
+func GetCmdQueryCodes() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "codes",
+		Short: "List all stored codes",
+		Long:  "List all stored codes",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.Codes(context.Background(), &types.QueryCodesRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "codes")
+	return cmd
+}
+
 // GetCmdQueryByteCode returns the bytecode for a given contract
 func GetCmdQueryByteCode() *cobra.Command {
 	cmd := &cobra.Command{
@@ -140,6 +179,213 @@ func GetCmdGetContractInfo() *cobra.Command {
 	return cmd
 }
 
+// GetCmdQueryContractsByCode lists all contracts instantiated from a given code
+func GetCmdQueryContractsByCode() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "contracts-by-code [code-id]",
+		Short: "List all contracts instantiated from a given code id",
+		Long:  "List all contracts instantiated from a given code id",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			codeID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ContractsByCode(context.Background(), &types.QueryContractsByCodeRequest{
+				CodeId:     codeID,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "contracts by code")
+	return cmd
+}
+
+// GetCmdQueryContractsByCreator lists all contracts instantiated by a given creator
+func GetCmdQueryContractsByCreator() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "contracts-by-creator [creator-address]",
+		Short: "List all contracts instantiated by a given creator",
+		Long:  "List all contracts instantiated by a given creator",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			if _, err := sdk.AccAddressFromBech32(args[0]); err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ContractsByCreator(context.Background(), &types.QueryContractsByCreatorRequest{
+				CreatorAddress: args[0],
+				Pagination:     pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "contracts by creator")
+	return cmd
+}
+
+// GetCmdQueryContractsByAdmin lists all contracts administered by a given admin
+func GetCmdQueryContractsByAdmin() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "contracts-by-admin [admin-address]",
+		Short: "List all contracts administered by a given admin",
+		Long:  "List all contracts administered by a given admin",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			if _, err := sdk.AccAddressFromBech32(args[0]); err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ContractsByAdmin(context.Background(), &types.QueryContractsByAdminRequest{
+				AdminAddress: args[0],
+				Pagination:   pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "contracts by admin")
+	return cmd
+}
+
+// GetCmdQueryContractHistory prints the code history of a given contract
+func GetCmdQueryContractHistory() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "contract-history [contract-address]",
+		Short: "Prints out the code history of a contract given its address",
+		Long:  "Prints out the code history of a contract given its address",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			if _, err := sdk.AccAddressFromBech32(args[0]); err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ContractHistory(context.Background(), &types.QueryContractHistoryRequest{
+				ContractAddress: args[0],
+				Pagination:      pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "contract history")
+	return cmd
+}
+
+// GetCmdQueryPredictContractAddress prints the address of the contract instantiated with a given salt
+func GetCmdQueryPredictContractAddress() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "predict-address [code-id] [creator-address] [salt]",
+		Short: "Prints out the address of the contract instantiated by instantiate2",
+		Long:  "Prints out the address of the contract instantiated from the code by the creator with the salt",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			codeID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			if _, err := sdk.AccAddressFromBech32(args[1]); err != nil {
+				return err
+			}
+
+			salt, err := parseSaltArg(cmd, args[2])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.PredictContractAddress(context.Background(), &types.QueryPredictContractAddressRequest{
+				CodeId:         codeID,
+				CreatorAddress: args[1],
+				Salt:           salt,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Bool(flagHexSalt, false, "decode the salt from hex")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 // GetCmdGetContractStore send query msg to a given contract
 func GetCmdGetContractStore() *cobra.Command {
 	cmd := &cobra.Command{
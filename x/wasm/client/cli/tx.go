@@ -1,6 +1,7 @@
 package cli
 
 import (
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -25,6 +26,15 @@ const (
 	flagAmount        = "amount"
 	flagAdmin         = "admin"
 	flagMigrateCodeID = "migrate-code-id"
+	flagHexSalt       = "hex"
+	flagLabel         = "label"
+	flagSource        = "source"
+	flagBuilder       = "builder"
+	flagSchema        = "schema"
+
+	flagInstantiateEverybody = "instantiate-everybody"
+	flagInstantiateNobody    = "instantiate-nobody"
+	flagInstantiateAllowlist = "instantiate-allowlist"
 )
 
 // GetTxCmd returns the transaction commands for this module
@@ -39,10 +49,13 @@ func GetTxCmd() *cobra.Command {
 	txCmd.AddCommand(
 		StoreCodeCmd(),
 		InstantiateContractCmd(),
+		InstantiateContract2Cmd(),
 		ExecuteContractCmd(),
 		MigrateContractCmd(),
 		UpdateContractAdminCmd(),
 		ClearContractAdminCmd(),
+		UpdateContractLabelCmd(),
+		UpdateCodeMetadataCmd(),
 	)
 	return txCmd
 }
@@ -56,6 +69,12 @@ func StoreCodeCmd() *cobra.Command {
 Contract developers can use store cmd to upload new wasm binary
 $ terrad tx store ./path-to-binary 
 
+Or to restrict who can instantiate the code
+$ terrad tx store ./path-to-binary --instantiate-allowlist terra1...,terra1...
+
+Or to describe the code
+$ terrad tx store ./path-to-binary --source https://github.com/... --builder cosmwasm/rust-optimizer:0.12.4
+
 Or to migrate columbus-4 code to columbus-5 code
 $ terrad tx store ./path-to-binary --migrate-code-id 3
 `,
@@ -71,26 +90,19 @@ $ terrad tx store ./path-to-binary --migrate-code-id 3
 				return fmt.Errorf("must specify flag --from")
 			}
 
-			// parse coins trying to be sent
-			wasmBytes, err := ioutil.ReadFile(args[0])
+			wasmBytes, err := parseWasmFile(args[0])
 			if err != nil {
 				return err
 			}
 
-			// limit the input size
-			if wasmLen := uint64(len(wasmBytes)); wasmLen > types.EnforcedMaxContractSize {
-				return fmt.Errorf("wasm code size exceeds the max size hard-cap (allowed:%d, actual: %d)",
-					types.EnforcedMaxContractSize, wasmLen)
+			instantiatePermission, err := parseInstantiatePermissionFlags(cmd)
+			if err != nil {
+				return err
 			}
 
-			// gzip the wasm file
-			if wasmUtils.IsWasm(wasmBytes) {
-				wasmBytes, err = wasmUtils.GzipIt(wasmBytes)
-				if err != nil {
-					return err
-				}
-			} else if !wasmUtils.IsGzip(wasmBytes) {
-				return fmt.Errorf("invalid input file. Use wasm binary or gzip")
+			metadata, err := parseCodeMetadataFlags(cmd)
+			if err != nil {
+				return err
 			}
 
 			var msg sdk.Msg
@@ -99,7 +111,10 @@ $ terrad tx store ./path-to-binary --migrate-code-id 3
 			} else if codeID != 0 {
 				msg = types.NewMsgMigrateCode(codeID, fromAddr, wasmBytes)
 			} else {
-				msg = types.NewMsgStoreCode(fromAddr, wasmBytes)
+				storeMsg := types.NewMsgStoreCode(fromAddr, wasmBytes)
+				storeMsg.InstantiatePermission = instantiatePermission
+				storeMsg.Metadata = metadata
+				msg = storeMsg
 			}
 
 			// build and sign the transaction, then broadcast to Tendermint
@@ -112,11 +127,114 @@ $ terrad tx store ./path-to-binary --migrate-code-id 3
 	}
 
 	cmd.Flags().Uint64(flagMigrateCodeID, 0, "specifies the code ID to be migrated")
+	addInstantiatePermissionFlags(cmd)
+	addCodeMetadataFlags(cmd)
 
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
 
+// parseWasmFile reads the wasm binary or gzip file and returns gzipped bytes
+func parseWasmFile(path string) ([]byte, error) {
+	wasmBytes, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	// limit the input size
+	if wasmLen := uint64(len(wasmBytes)); wasmLen > types.EnforcedMaxContractSize {
+		return nil, fmt.Errorf("wasm code size exceeds the max size hard-cap (allowed:%d, actual: %d)",
+			types.EnforcedMaxContractSize, wasmLen)
+	}
+
+	// gzip the wasm file
+	if wasmUtils.IsWasm(wasmBytes) {
+		return wasmUtils.GzipIt(wasmBytes)
+	} else if !wasmUtils.IsGzip(wasmBytes) {
+		return nil, fmt.Errorf("invalid input file. Use wasm binary or gzip")
+	}
+
+	return wasmBytes, nil
+}
+
+func addCodeMetadataFlags(cmd *cobra.Command) {
+	cmd.Flags().String(flagSource, "", "the url of the source code")
+	cmd.Flags().String(flagBuilder, "", "the docker image used to build the code")
+	cmd.Flags().String(flagSchema, "", "the url or the content of the json schema of the contract messages")
+}
+
+func parseCodeMetadataFlags(cmd *cobra.Command) (types.CodeMetadata, error) {
+	source, err := cmd.Flags().GetString(flagSource)
+	if err != nil {
+		return types.CodeMetadata{}, err
+	}
+
+	builder, err := cmd.Flags().GetString(flagBuilder)
+	if err != nil {
+		return types.CodeMetadata{}, err
+	}
+
+	schema, err := cmd.Flags().GetString(flagSchema)
+	if err != nil {
+		return types.CodeMetadata{}, err
+	}
+
+	metadata := types.CodeMetadata{Source: source, Builder: builder, Schema: schema}
+	return metadata, metadata.ValidateBasic()
+}
+
+func addInstantiatePermissionFlags(cmd *cobra.Command) {
+	cmd.Flags().Bool(flagInstantiateEverybody, false, "everybody can instantiate the code")
+	cmd.Flags().Bool(flagInstantiateNobody, false, "nobody can instantiate the code")
+	cmd.Flags().StringSlice(flagInstantiateAllowlist, nil, "comma separated addresses which can instantiate the code")
+}
+
+// parseInstantiatePermissionFlags returns nil when no flag is given,
+// so the default instantiate permission of the params is applied
+func parseInstantiatePermissionFlags(cmd *cobra.Command) (*types.AccessConfig, error) {
+	everybody, err := cmd.Flags().GetBool(flagInstantiateEverybody)
+	if err != nil {
+		return nil, err
+	}
+
+	nobody, err := cmd.Flags().GetBool(flagInstantiateNobody)
+	if err != nil {
+		return nil, err
+	}
+
+	allowlist, err := cmd.Flags().GetStringSlice(flagInstantiateAllowlist)
+	if err != nil {
+		return nil, err
+	}
+
+	var configs []types.AccessConfig
+	if everybody {
+		configs = append(configs, types.AllowEverybody)
+	}
+
+	if nobody {
+		configs = append(configs, types.AllowNobody)
+	}
+
+	if len(allowlist) != 0 {
+		configs = append(configs, types.AccessConfig{Permission: types.AccessTypeAllowlist, Addresses: allowlist})
+	}
+
+	switch len(configs) {
+	case 0:
+		return nil, nil
+	case 1:
+		if err := configs[0].ValidateBasic(); err != nil {
+			return nil, err
+		}
+
+		return &configs[0], nil
+	default:
+		return nil, fmt.Errorf("only one of --%s, --%s and --%s can be given",
+			flagInstantiateEverybody, flagInstantiateNobody, flagInstantiateAllowlist)
+	}
+}
+
 // InstantiateContractCmd will instantiate a contract from previously uploaded code.
 func InstantiateContractCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -185,7 +303,13 @@ $ terrad instantiate 1 '{"arbiter": "terra~~"}' "1000000uluna"
 			}
 
 			// build and sign the transaction, then broadcast to Tendermint
+			label, err := cmd.Flags().GetString(flagLabel)
+			if err != nil {
+				return err
+			}
+
 			msg := types.NewMsgInstantiateContract(fromAddr, adminAddr, codeID, initMsgBz, coins)
+			msg.Label = label
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -211,10 +335,146 @@ $ terrad instantiate 1 '{"arbiter": "terra~~"}' "1000000uluna"
 	}
 
 	cmd.Flags().String(flagAdmin, "", "the contract admin address which is previlaged to migrate contract")
+	cmd.Flags().String(flagLabel, "", "the human-readable name of the contract")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// InstantiateContract2Cmd will instantiate a contract at the predictable address from previously uploaded code.
+func InstantiateContract2Cmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "instantiate2 [code-id-int64] [json-encoded-args] [salt] [coins]",
+		Short: "Instantiate a wasm contract at the predictable address",
+		Long: `
+Instantiate a wasm contract of the code which has the given id
+at the address derived from the code hash, the sender and the salt
+
+$ terrad instantiate2 1 '{"arbiter": "terra~~"}' "my-salt"
+
+The salt can be given in hex encoding
+
+$ terrad instantiate2 1 '{"arbiter": "terra~~"}' "6d792d73616c74" --hex
+
+You can also instantiate it with funds
+
+$ terrad instantiate2 1 '{"arbiter": "terra~~"}' "my-salt" "1000000uluna"
+`,
+		Args: cobra.RangeArgs(3, 4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// Generate transaction factory for gas simulation
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+
+			fromAddr := clientCtx.GetFromAddress()
+			if fromAddr.Empty() {
+				return fmt.Errorf("must specify flag --from")
+			}
+
+			admin, err := cmd.Flags().GetString(flagAdmin)
+			if err != nil {
+				return err
+			}
+
+			var adminAddr sdk.AccAddress
+			if len(admin) != 0 {
+				adminAddr, err = sdk.AccAddressFromBech32(admin)
+				if err != nil {
+					return err
+				}
+			}
+
+			// get the id of the code to instantiate
+			codeID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			initMsgBz := []byte(args[1])
+			if !json.Valid(initMsgBz) {
+				return errors.New("msg must be a json string format")
+			}
+
+			// limit the input size
+			if initMsgLen := uint64(len(initMsgBz)); initMsgLen > types.EnforcedMaxContractMsgSize {
+				return fmt.Errorf("init msg size exceeds the max size hard-cap (allowed:%d, actual: %d)",
+					types.EnforcedMaxContractMsgSize, initMsgLen)
+			}
+
+			salt, err := parseSaltArg(cmd, args[2])
+			if err != nil {
+				return err
+			}
+
+			var coins sdk.Coins
+			if len(args) == 4 {
+				coins, err = sdk.ParseCoinsNormalized(args[3])
+				if err != nil {
+					return err
+				}
+			}
+
+			// build and sign the transaction, then broadcast to Tendermint
+			label, err := cmd.Flags().GetString(flagLabel)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgInstantiateContract2(fromAddr, adminAddr, codeID, initMsgBz, coins, salt)
+			msg.Label = label
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			if len(args) == 4 && !clientCtx.GenerateOnly && txf.Fees().IsZero() {
+				// estimate tax and gas
+				stdFee, err := feeutils.ComputeFeesWithCmd(clientCtx, cmd.Flags(), msg)
+
+				if err != nil {
+					return err
+				}
+
+				// override gas and fees
+				txf = txf.
+					WithFees(stdFee.Amount.String()).
+					WithGas(stdFee.Gas).
+					WithSimulateAndExecute(false).
+					WithGasPrices("")
+			}
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	cmd.Flags().String(flagAdmin, "", "the contract admin address which is previlaged to migrate contract")
+	cmd.Flags().String(flagLabel, "", "the human-readable name of the contract")
+	cmd.Flags().Bool(flagHexSalt, false, "decode the salt from hex")
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
 
+// parseSaltArg returns the salt bytes of the argument, hex decoded when --hex is given
+func parseSaltArg(cmd *cobra.Command, arg string) ([]byte, error) {
+	isHex, err := cmd.Flags().GetBool(flagHexSalt)
+	if err != nil {
+		return nil, err
+	}
+
+	if !isHex {
+		return []byte(arg), nil
+	}
+
+	salt, err := hex.DecodeString(arg)
+	if err != nil {
+		return nil, fmt.Errorf("invalid hex salt: %w", err)
+	}
+
+	return salt, nil
+}
+
 // ExecuteContractCmd will instantiate a contract from previously uploaded code.
 func ExecuteContractCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -432,3 +692,93 @@ $ terrad tx wasm clear-admin terra...
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+// UpdateContractLabelCmd sets a new label for a contract
+func UpdateContractLabelCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-label [contract-addr-bech32] [new-label]",
+		Short: "set a new label for a contract",
+		Long: strings.TrimSpace(`
+Set a new human-readable label for a contract; only the contract admin,
+or the contract creator when the contract has no admin, can update it
+
+$ terrad tx wasm update-label terra... "my contract"
+		`),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			fromAddr := clientCtx.GetFromAddress()
+			if fromAddr.Empty() {
+				return fmt.Errorf("must specify flag --from")
+			}
+
+			contractAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			// build and sign the transaction, then broadcast to Tendermint
+			msg := types.NewMsgUpdateContractLabel(fromAddr, contractAddr, args[1])
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// UpdateCodeMetadataCmd sets new metadata for a stored code
+func UpdateCodeMetadataCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-code-metadata [code-id]",
+		Short: "set new metadata for a stored code",
+		Long: strings.TrimSpace(`
+Set new human-readable metadata for a stored code; only the code creator
+can update it, and the flags not given are cleared
+
+$ terrad tx wasm update-code-metadata 1 --source https://github.com/... --builder cosmwasm/rust-optimizer:0.12.4
+		`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			fromAddr := clientCtx.GetFromAddress()
+			if fromAddr.Empty() {
+				return fmt.Errorf("must specify flag --from")
+			}
+
+			codeID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			metadata, err := parseCodeMetadataFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			// build and sign the transaction, then broadcast to Tendermint
+			msg := types.NewMsgUpdateCodeMetadata(fromAddr, codeID, metadata)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	addCodeMetadataFlags(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
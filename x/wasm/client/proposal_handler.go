@@ -0,0 +1,22 @@
+package client
+
+import (
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+
+	"github.com/terra-money/core/x/wasm/client/cli"
+	"github.com/terra-money/core/x/wasm/client/rest"
+)
+
+// Code pinning proposal handlers.
+var (
+	PinCodesProposalHandler   = govclient.NewProposalHandler(cli.GetCmdSubmitPinCodesProposal, rest.PinCodesProposalRESTHandler)
+	UnpinCodesProposalHandler = govclient.NewProposalHandler(cli.GetCmdSubmitUnpinCodesProposal, rest.UnpinCodesProposalRESTHandler)
+)
+
+// Governance-gated deployment proposal handlers.
+var (
+	StoreCodeProposalHandler           = govclient.NewProposalHandler(cli.GetCmdSubmitStoreCodeProposal, rest.StoreCodeProposalRESTHandler)
+	InstantiateContractProposalHandler = govclient.NewProposalHandler(cli.GetCmdSubmitInstantiateContractProposal, rest.InstantiateContractProposalRESTHandler)
+	MigrateContractProposalHandler     = govclient.NewProposalHandler(cli.GetCmdSubmitMigrateContractProposal, rest.MigrateContractProposalRESTHandler)
+	SudoContractProposalHandler        = govclient.NewProposalHandler(cli.GetCmdSubmitSudoContractProposal, rest.SudoContractProposalRESTHandler)
+)
@@ -0,0 +1,305 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	govrest "github.com/cosmos/cosmos-sdk/x/gov/client/rest"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/terra-money/core/x/wasm/types"
+)
+
+type (
+	// PinCodesProposalReq defines a pin-codes proposal request body.
+	PinCodesProposalReq struct {
+		BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+		Title       string         `json:"title" yaml:"title"`
+		Description string         `json:"description" yaml:"description"`
+		CodeIDs     []uint64       `json:"code_ids" yaml:"code_ids"`
+		Proposer    sdk.AccAddress `json:"proposer" yaml:"proposer"`
+		Deposit     sdk.Coins      `json:"deposit" yaml:"deposit"`
+	}
+
+	// UnpinCodesProposalReq defines an unpin-codes proposal request body.
+	UnpinCodesProposalReq struct {
+		BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+		Title       string         `json:"title" yaml:"title"`
+		Description string         `json:"description" yaml:"description"`
+		CodeIDs     []uint64       `json:"code_ids" yaml:"code_ids"`
+		Proposer    sdk.AccAddress `json:"proposer" yaml:"proposer"`
+		Deposit     sdk.Coins      `json:"deposit" yaml:"deposit"`
+	}
+
+	// StoreCodeProposalReq defines a store-code proposal request body.
+	StoreCodeProposalReq struct {
+		BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+		Title                 string              `json:"title" yaml:"title"`
+		Description           string              `json:"description" yaml:"description"`
+		RunAs                 sdk.AccAddress      `json:"run_as" yaml:"run_as"`
+		WasmBytes             []byte              `json:"wasm_bytes" yaml:"wasm_bytes"`
+		InstantiatePermission *types.AccessConfig `json:"instantiate_permission" yaml:"instantiate_permission"`
+		Metadata              types.CodeMetadata  `json:"metadata" yaml:"metadata"`
+		Proposer              sdk.AccAddress      `json:"proposer" yaml:"proposer"`
+		Deposit               sdk.Coins           `json:"deposit" yaml:"deposit"`
+	}
+
+	// InstantiateContractProposalReq defines an instantiate-contract proposal request body.
+	InstantiateContractProposalReq struct {
+		BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+		Title       string         `json:"title" yaml:"title"`
+		Description string         `json:"description" yaml:"description"`
+		RunAs       sdk.AccAddress `json:"run_as" yaml:"run_as"`
+		Admin       sdk.AccAddress `json:"admin" yaml:"admin"`
+		CodeID      uint64         `json:"code_id" yaml:"code_id"`
+		InitMsg     string         `json:"init_msg" yaml:"init_msg"`
+		InitCoins   sdk.Coins      `json:"init_coins" yaml:"init_coins"`
+		Label       string         `json:"label" yaml:"label"`
+		Proposer    sdk.AccAddress `json:"proposer" yaml:"proposer"`
+		Deposit     sdk.Coins      `json:"deposit" yaml:"deposit"`
+	}
+
+	// MigrateContractProposalReq defines a migrate-contract proposal request body.
+	MigrateContractProposalReq struct {
+		BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+		Title       string         `json:"title" yaml:"title"`
+		Description string         `json:"description" yaml:"description"`
+		Contract    sdk.AccAddress `json:"contract" yaml:"contract"`
+		NewCodeID   uint64         `json:"new_code_id" yaml:"new_code_id"`
+		MigrateMsg  string         `json:"migrate_msg" yaml:"migrate_msg"`
+		Proposer    sdk.AccAddress `json:"proposer" yaml:"proposer"`
+		Deposit     sdk.Coins      `json:"deposit" yaml:"deposit"`
+	}
+
+	// SudoContractProposalReq defines a sudo-contract proposal request body.
+	SudoContractProposalReq struct {
+		BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+		Title       string         `json:"title" yaml:"title"`
+		Description string         `json:"description" yaml:"description"`
+		Contract    sdk.AccAddress `json:"contract" yaml:"contract"`
+		Msg         string         `json:"msg" yaml:"msg"`
+		Proposer    sdk.AccAddress `json:"proposer" yaml:"proposer"`
+		Deposit     sdk.Coins      `json:"deposit" yaml:"deposit"`
+	}
+)
+
+// PinCodesProposalRESTHandler returns a ProposalRESTHandler that exposes the pin codes REST handler with a given sub-route.
+func PinCodesProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "pin_codes",
+		Handler:  postPinCodesProposalHandlerFn(clientCtx),
+	}
+}
+
+// UnpinCodesProposalRESTHandler returns a ProposalRESTHandler that exposes the unpin codes REST handler with a given sub-route.
+func UnpinCodesProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "unpin_codes",
+		Handler:  postUnpinCodesProposalHandlerFn(clientCtx),
+	}
+}
+
+// StoreCodeProposalRESTHandler returns a ProposalRESTHandler that exposes the store code REST handler with a given sub-route.
+func StoreCodeProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "store_code",
+		Handler:  postStoreCodeProposalHandlerFn(clientCtx),
+	}
+}
+
+// InstantiateContractProposalRESTHandler returns a ProposalRESTHandler that exposes the instantiate contract REST handler with a given sub-route.
+func InstantiateContractProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "instantiate_contract",
+		Handler:  postInstantiateContractProposalHandlerFn(clientCtx),
+	}
+}
+
+// MigrateContractProposalRESTHandler returns a ProposalRESTHandler that exposes the migrate contract REST handler with a given sub-route.
+func MigrateContractProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "migrate_contract",
+		Handler:  postMigrateContractProposalHandlerFn(clientCtx),
+	}
+}
+
+// SudoContractProposalRESTHandler returns a ProposalRESTHandler that exposes the sudo contract REST handler with a given sub-route.
+func SudoContractProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "sudo_contract",
+		Handler:  postSudoContractProposalHandlerFn(clientCtx),
+	}
+}
+
+func postPinCodesProposalHandlerFn(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req PinCodesProposalReq
+		if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		content := types.NewPinCodesProposal(req.Title, req.Description, req.CodeIDs)
+
+		msg, err := govtypes.NewMsgSubmitProposal(content, req.Deposit, req.Proposer)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+			return
+		}
+
+		tx.WriteGeneratedTxResponse(clientCtx, w, req.BaseReq, msg)
+	}
+}
+
+func postUnpinCodesProposalHandlerFn(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req UnpinCodesProposalReq
+		if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		content := types.NewUnpinCodesProposal(req.Title, req.Description, req.CodeIDs)
+
+		msg, err := govtypes.NewMsgSubmitProposal(content, req.Deposit, req.Proposer)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+			return
+		}
+
+		tx.WriteGeneratedTxResponse(clientCtx, w, req.BaseReq, msg)
+	}
+}
+
+func postStoreCodeProposalHandlerFn(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req StoreCodeProposalReq
+		if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		wasmBytes, ok := parseWasmBytes(w, req.WasmBytes)
+		if !ok {
+			return
+		}
+
+		content := types.NewStoreCodeProposal(req.Title, req.Description, req.RunAs, wasmBytes, req.InstantiatePermission, req.Metadata)
+
+		msg, err := govtypes.NewMsgSubmitProposal(content, req.Deposit, req.Proposer)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+			return
+		}
+
+		tx.WriteGeneratedTxResponse(clientCtx, w, req.BaseReq, msg)
+	}
+}
+
+func postInstantiateContractProposalHandlerFn(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req InstantiateContractProposalReq
+		if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		content := types.NewInstantiateContractProposal(
+			req.Title, req.Description, req.RunAs, req.Admin,
+			req.CodeID, []byte(req.InitMsg), req.InitCoins, req.Label,
+		)
+
+		msg, err := govtypes.NewMsgSubmitProposal(content, req.Deposit, req.Proposer)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+			return
+		}
+
+		tx.WriteGeneratedTxResponse(clientCtx, w, req.BaseReq, msg)
+	}
+}
+
+func postMigrateContractProposalHandlerFn(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req MigrateContractProposalReq
+		if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		content := types.NewMigrateContractProposal(req.Title, req.Description, req.Contract, req.NewCodeID, []byte(req.MigrateMsg))
+
+		msg, err := govtypes.NewMsgSubmitProposal(content, req.Deposit, req.Proposer)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+			return
+		}
+
+		tx.WriteGeneratedTxResponse(clientCtx, w, req.BaseReq, msg)
+	}
+}
+
+func postSudoContractProposalHandlerFn(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req SudoContractProposalReq
+		if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		content := types.NewSudoContractProposal(req.Title, req.Description, req.Contract, []byte(req.Msg))
+
+		msg, err := govtypes.NewMsgSubmitProposal(content, req.Deposit, req.Proposer)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+			return
+		}
+
+		tx.WriteGeneratedTxResponse(clientCtx, w, req.BaseReq, msg)
+	}
+}
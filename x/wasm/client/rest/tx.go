@@ -29,8 +29,10 @@ func registerTxRoutes(clientCtx client.Context, r *mux.Router) {
 }
 
 type storeCodeReq struct {
-	BaseReq   rest.BaseReq `json:"base_req" yaml:"base_req"`
-	WasmBytes []byte       `json:"wasm_bytes"`
+	BaseReq               rest.BaseReq        `json:"base_req" yaml:"base_req"`
+	WasmBytes             []byte              `json:"wasm_bytes"`
+	InstantiatePermission *types.AccessConfig `json:"instantiate_permission" yaml:"instantiate_permission"`
+	Metadata              types.CodeMetadata  `json:"metadata" yaml:"metadata"`
 }
 
 type migrateCodeReq struct {
@@ -43,6 +45,7 @@ type instantiateContractReq struct {
 	InitCoins sdk.Coins    `json:"init_coins" yaml:"init_coins"`
 	InitMsg   string       `json:"init_msg" yaml:"init_msg"`
 	Admin     string       `json:"admin" yaml:"admin"`
+	Label     string       `json:"label" yaml:"label"`
 }
 
 type executeContractReq struct {
@@ -66,6 +69,30 @@ type clearContractAdminReq struct {
 	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
 }
 
+// parseWasmBytes checks the size of the wasm bytes and returns them gzipped;
+// an error response is written when the bytes are invalid
+func parseWasmBytes(w http.ResponseWriter, wasmBytes []byte) ([]byte, bool) {
+	if wasmBytesLen := uint64(len(wasmBytes)); wasmBytesLen > types.EnforcedMaxContractSize {
+		rest.WriteErrorResponse(w, http.StatusBadRequest, "Binary size exceeds maximum limit")
+		return nil, false
+	}
+
+	// gzip the wasm file
+	if wasmUtils.IsWasm(wasmBytes) {
+		wasmBytes, err := wasmUtils.GzipIt(wasmBytes)
+		if rest.CheckBadRequestError(w, err) {
+			return nil, false
+		}
+
+		return wasmBytes, true
+	} else if !wasmUtils.IsGzip(wasmBytes) {
+		rest.WriteErrorResponse(w, http.StatusBadRequest, "Invalid input file, use wasm binary or zip")
+		return nil, false
+	}
+
+	return wasmBytes, true
+}
+
 func storeCodeHandlerFn(clientCtx client.Context) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		var req storeCodeReq
@@ -78,21 +105,8 @@ func storeCodeHandlerFn(clientCtx client.Context) http.HandlerFunc {
 			return
 		}
 
-		var err error
-		wasmBytes := req.WasmBytes
-		if wasmBytesLen := uint64(len(wasmBytes)); wasmBytesLen > types.EnforcedMaxContractSize {
-			rest.WriteErrorResponse(w, http.StatusBadRequest, "Binary size exceeds maximum limit")
-			return
-		}
-
-		// gzip the wasm file
-		if wasmUtils.IsWasm(wasmBytes) {
-			wasmBytes, err = wasmUtils.GzipIt(wasmBytes)
-			if rest.CheckBadRequestError(w, err) {
-				return
-			}
-		} else if !wasmUtils.IsGzip(wasmBytes) {
-			rest.WriteErrorResponse(w, http.StatusBadRequest, "Invalid input file, use wasm binary or zip")
+		wasmBytes, ok := parseWasmBytes(w, req.WasmBytes)
+		if !ok {
 			return
 		}
 
@@ -103,6 +117,8 @@ func storeCodeHandlerFn(clientCtx client.Context) http.HandlerFunc {
 
 		// build and sign the transaction, then broadcast to Tendermint
 		msg := types.NewMsgStoreCode(fromAddr, wasmBytes)
+		msg.InstantiatePermission = req.InstantiatePermission
+		msg.Metadata = req.Metadata
 		if err = msg.ValidateBasic(); err != nil {
 			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 			return
@@ -216,6 +232,7 @@ func instantiateContractHandlerFn(clientCtx client.Context) http.HandlerFunc {
 		}
 
 		msg := types.NewMsgInstantiateContract(fromAddr, adminAddr, codeID, initMsgBz, req.InitCoins)
+		msg.Label = req.Label
 		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
 			return
 		}
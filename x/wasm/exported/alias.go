@@ -13,9 +13,10 @@ var (
 )
 
 type (
-	WasmMsgParserInterface = types.WasmMsgParserInterface
-	WasmQuerierInterface   = types.WasmQuerierInterface
-	MsgInstantiateContract = types.MsgInstantiateContract
-	MsgExecuteContract     = types.MsgExecuteContract
-	MsgStoreCode           = types.MsgStoreCode
+	WasmMsgParserInterface  = types.WasmMsgParserInterface
+	WasmQuerierInterface    = types.WasmQuerierInterface
+	MsgInstantiateContract  = types.MsgInstantiateContract
+	MsgInstantiateContract2 = types.MsgInstantiateContract2
+	MsgExecuteContract      = types.MsgExecuteContract
+	MsgStoreCode            = types.MsgStoreCode
 )
@@ -33,6 +33,12 @@ func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, data *types.GenesisState
 		}
 
 		keeper.SetCodeInfo(ctx, code.CodeInfo.CodeID, code.CodeInfo)
+
+		if code.Pinned {
+			if err := keeper.PinCode(ctx, code.CodeInfo.CodeID); err != nil {
+				panic(err)
+			}
+		}
 	}
 
 	for _, contract := range data.Contracts {
@@ -43,6 +49,7 @@ func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, data *types.GenesisState
 
 		keeper.SetContractInfo(ctx, contractAddr, contract.ContractInfo)
 		keeper.SetContractStore(ctx, contractAddr, contract.ContractStore)
+		keeper.AppendContractHistory(ctx, contractAddr, contract.ContractCodeHistory...)
 	}
 }
 
@@ -78,6 +85,7 @@ func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) *types.GenesisState {
 		codes = append(codes, types.Code{
 			CodeInfo:  codeInfo,
 			CodeBytes: bytecode,
+			Pinned:    keeper.IsPinnedCode(ctx, i),
 		})
 	}
 
@@ -98,8 +106,9 @@ func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) *types.GenesisState {
 		}
 
 		contracts = append(contracts, types.Contract{
-			ContractInfo:  contract,
-			ContractStore: models,
+			ContractInfo:        contract,
+			ContractStore:       models,
+			ContractCodeHistory: keeper.GetContractHistory(ctx, contractAddr),
 		})
 
 		return false
@@ -96,6 +96,11 @@ func TestInitGenesis(t *testing.T) {
 
 	assertContractStore(t, models, expectedConfigState)
 
+	expectedHistory := []types.ContractCodeHistoryEntry{
+		types.NewContractCodeHistoryEntry(types.ContractCodeHistoryOperationTypeInit, 1, input.Ctx.BlockHeight(), creator, initMsgBz),
+	}
+	require.Equal(t, expectedHistory, input.WasmKeeper.GetContractHistory(input.Ctx, contractAddr))
+
 	// export into genstate
 	genState := wasm.ExportGenesis(input.Ctx, input.WasmKeeper)
 
@@ -121,4 +126,5 @@ func TestInitGenesis(t *testing.T) {
 	}
 
 	assertContractStore(t, models, expectedConfigState)
+	require.Equal(t, expectedHistory, newInput.WasmKeeper.GetContractHistory(newInput.Ctx, contractAddr))
 }
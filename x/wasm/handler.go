@@ -31,6 +31,8 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 			res, err = msgServer.MigrateCode(sdk.WrapSDKContext(ctx), msg)
 		case *types.MsgInstantiateContract:
 			res, err = msgServer.InstantiateContract(sdk.WrapSDKContext(ctx), msg)
+		case *types.MsgInstantiateContract2:
+			res, err = msgServer.InstantiateContract2(sdk.WrapSDKContext(ctx), msg)
 		case *types.MsgExecuteContract:
 			res, err = msgServer.ExecuteContract(sdk.WrapSDKContext(ctx), msg)
 		case *types.MsgMigrateContract:
@@ -39,6 +41,10 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 			res, err = msgServer.UpdateContractAdmin(sdk.WrapSDKContext(ctx), msg)
 		case *types.MsgClearContractAdmin:
 			res, err = msgServer.ClearContractAdmin(sdk.WrapSDKContext(ctx), msg)
+		case *types.MsgUpdateContractLabel:
+			res, err = msgServer.UpdateContractLabel(sdk.WrapSDKContext(ctx), msg)
+		case *types.MsgUpdateCodeMetadata:
+			res, err = msgServer.UpdateCodeMetadata(sdk.WrapSDKContext(ctx), msg)
 
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized wasm message type: %T", msg)
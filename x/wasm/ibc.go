@@ -0,0 +1,339 @@
+package wasm
+
+import (
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	channeltypes "github.com/cosmos/ibc-go/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/modules/core/05-port/types"
+	host "github.com/cosmos/ibc-go/modules/core/24-host"
+	ibcexported "github.com/cosmos/ibc-go/modules/core/exported"
+
+	"github.com/terra-money/core/x/wasm/keeper"
+	"github.com/terra-money/core/x/wasm/types"
+)
+
+var _ porttypes.IBCModule = IBCHandler{}
+
+// IBCHandler routes the ibc callbacks of the contract ports to the contract IBC entry points
+type IBCHandler struct {
+	keeper        keeper.Keeper
+	channelKeeper types.ChannelKeeper
+}
+
+// NewIBCHandler returns the ibc handler of the wasm module
+func NewIBCHandler(keeper keeper.Keeper, channelKeeper types.ChannelKeeper) IBCHandler {
+	return IBCHandler{keeper: keeper, channelKeeper: channelKeeper}
+}
+
+// OnChanOpenInit implements the IBCModule interface
+func (i IBCHandler) OnChanOpenInit(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	connectionHops []string,
+	portID string,
+	channelID string,
+	chanCap *capabilitytypes.Capability,
+	counterParty channeltypes.Counterparty,
+	version string,
+) error {
+	// ensure the channel identifier
+	if err := ValidateChannelParams(channelID); err != nil {
+		return err
+	}
+
+	contractAddr, err := types.ContractFromPortID(portID)
+	if err != nil {
+		return sdkerrors.Wrapf(err, "contract port id")
+	}
+
+	msg := wasmvmtypes.IBCChannelOpenMsg{
+		OpenInit: &wasmvmtypes.IBCOpenInit{
+			Channel: wasmvmtypes.IBCChannel{
+				Endpoint:             wasmvmtypes.IBCEndpoint{PortID: portID, ChannelID: channelID},
+				CounterpartyEndpoint: wasmvmtypes.IBCEndpoint{PortID: counterParty.PortId, ChannelID: counterParty.ChannelId},
+				Order:                order.String(),
+				Version:              version,
+				ConnectionID:         connectionHops[0], // At the moment this list must be of length 1. In the future multi-hop channels may be supported.
+			},
+		},
+	}
+
+	if err := i.keeper.OnOpenChannel(ctx, contractAddr, msg); err != nil {
+		return err
+	}
+
+	// Claim channel capability passed back by IBC module
+	if err := i.keeper.ClaimCapability(ctx, chanCap, host.ChannelCapabilityPath(portID, channelID)); err != nil {
+		return sdkerrors.Wrap(err, "claim capability")
+	}
+
+	return nil
+}
+
+// OnChanOpenTry implements the IBCModule interface
+func (i IBCHandler) OnChanOpenTry(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	connectionHops []string,
+	portID, channelID string,
+	chanCap *capabilitytypes.Capability,
+	counterParty channeltypes.Counterparty,
+	version, counterpartyVersion string,
+) error {
+	// ensure the channel identifier
+	if err := ValidateChannelParams(channelID); err != nil {
+		return err
+	}
+
+	contractAddr, err := types.ContractFromPortID(portID)
+	if err != nil {
+		return sdkerrors.Wrapf(err, "contract port id")
+	}
+
+	msg := wasmvmtypes.IBCChannelOpenMsg{
+		OpenTry: &wasmvmtypes.IBCOpenTry{
+			Channel: wasmvmtypes.IBCChannel{
+				Endpoint:             wasmvmtypes.IBCEndpoint{PortID: portID, ChannelID: channelID},
+				CounterpartyEndpoint: wasmvmtypes.IBCEndpoint{PortID: counterParty.PortId, ChannelID: counterParty.ChannelId},
+				Order:                order.String(),
+				Version:              version,
+				ConnectionID:         connectionHops[0], // At the moment this list must be of length 1. In the future multi-hop channels may be supported.
+			},
+			CounterpartyVersion: counterpartyVersion,
+		},
+	}
+
+	if err := i.keeper.OnOpenChannel(ctx, contractAddr, msg); err != nil {
+		return err
+	}
+
+	// Module may have already claimed capability in OnChanOpenInit in the case of crossing hellos
+	// (ie chainA and chainB both call ChanOpenInit before one of them calls ChanOpenTry)
+	// If module can already authenticate the capability then module already owns it so we don't need to claim
+	// Otherwise, module does not have channel capability and we must claim it from IBC
+	if !i.keeper.AuthenticateCapability(ctx, chanCap, host.ChannelCapabilityPath(portID, channelID)) {
+		// Only claim channel capability passed back by IBC module if we do not already own it
+		if err := i.keeper.ClaimCapability(ctx, chanCap, host.ChannelCapabilityPath(portID, channelID)); err != nil {
+			return sdkerrors.Wrap(err, "claim capability")
+		}
+	}
+
+	return nil
+}
+
+// OnChanOpenAck implements the IBCModule interface
+func (i IBCHandler) OnChanOpenAck(
+	ctx sdk.Context,
+	portID, channelID string,
+	counterpartyVersion string,
+) error {
+	contractAddr, err := types.ContractFromPortID(portID)
+	if err != nil {
+		return sdkerrors.Wrapf(err, "contract port id")
+	}
+
+	channelInfo, ok := i.channelKeeper.GetChannel(ctx, portID, channelID)
+	if !ok {
+		return sdkerrors.Wrapf(channeltypes.ErrChannelNotFound, "port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	msg := wasmvmtypes.IBCChannelConnectMsg{
+		OpenAck: &wasmvmtypes.IBCOpenAck{
+			Channel:             toWasmVMChannel(portID, channelID, channelInfo),
+			CounterpartyVersion: counterpartyVersion,
+		},
+	}
+
+	return i.keeper.OnConnectChannel(ctx, contractAddr, msg)
+}
+
+// OnChanOpenConfirm implements the IBCModule interface
+func (i IBCHandler) OnChanOpenConfirm(ctx sdk.Context, portID, channelID string) error {
+	contractAddr, err := types.ContractFromPortID(portID)
+	if err != nil {
+		return sdkerrors.Wrapf(err, "contract port id")
+	}
+
+	channelInfo, ok := i.channelKeeper.GetChannel(ctx, portID, channelID)
+	if !ok {
+		return sdkerrors.Wrapf(channeltypes.ErrChannelNotFound, "port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	msg := wasmvmtypes.IBCChannelConnectMsg{
+		OpenConfirm: &wasmvmtypes.IBCOpenConfirm{
+			Channel: toWasmVMChannel(portID, channelID, channelInfo),
+		},
+	}
+
+	return i.keeper.OnConnectChannel(ctx, contractAddr, msg)
+}
+
+// OnChanCloseInit implements the IBCModule interface
+func (i IBCHandler) OnChanCloseInit(ctx sdk.Context, portID, channelID string) error {
+	contractAddr, err := types.ContractFromPortID(portID)
+	if err != nil {
+		return sdkerrors.Wrapf(err, "contract port id")
+	}
+
+	channelInfo, ok := i.channelKeeper.GetChannel(ctx, portID, channelID)
+	if !ok {
+		return sdkerrors.Wrapf(channeltypes.ErrChannelNotFound, "port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	msg := wasmvmtypes.IBCChannelCloseMsg{
+		CloseInit: &wasmvmtypes.IBCCloseInit{
+			Channel: toWasmVMChannel(portID, channelID, channelInfo),
+		},
+	}
+
+	return i.keeper.OnCloseChannel(ctx, contractAddr, msg)
+}
+
+// OnChanCloseConfirm implements the IBCModule interface
+func (i IBCHandler) OnChanCloseConfirm(ctx sdk.Context, portID, channelID string) error {
+	// counterparty has closed the channel
+	contractAddr, err := types.ContractFromPortID(portID)
+	if err != nil {
+		return sdkerrors.Wrapf(err, "contract port id")
+	}
+
+	channelInfo, ok := i.channelKeeper.GetChannel(ctx, portID, channelID)
+	if !ok {
+		return sdkerrors.Wrapf(channeltypes.ErrChannelNotFound, "port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	msg := wasmvmtypes.IBCChannelCloseMsg{
+		CloseConfirm: &wasmvmtypes.IBCCloseConfirm{
+			Channel: toWasmVMChannel(portID, channelID, channelInfo),
+		},
+	}
+
+	return i.keeper.OnCloseChannel(ctx, contractAddr, msg)
+}
+
+// OnRecvPacket implements the IBCModule interface. A contract error is returned
+// as error acknowledgement, so that the state changes of the contract are discarded.
+func (i IBCHandler) OnRecvPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) ibcexported.Acknowledgement {
+	contractAddr, err := types.ContractFromPortID(packet.DestinationPort)
+	if err != nil {
+		return channeltypes.NewErrorAcknowledgement(sdkerrors.Wrapf(err, "contract port id").Error())
+	}
+
+	msg := wasmvmtypes.IBCPacketReceiveMsg{Packet: newIBCPacket(packet)}
+	ack, err := i.keeper.OnRecvPacket(ctx, contractAddr, msg)
+	if err != nil {
+		return channeltypes.NewErrorAcknowledgement(err.Error())
+	}
+
+	return ContractAcknowledgement(ack)
+}
+
+// OnAcknowledgementPacket implements the IBCModule interface
+func (i IBCHandler) OnAcknowledgementPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	acknowledgement []byte,
+	relayer sdk.AccAddress,
+) (*sdk.Result, error) {
+	contractAddr, err := types.ContractFromPortID(packet.SourcePort)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "contract port id")
+	}
+
+	msg := wasmvmtypes.IBCPacketAckMsg{
+		Acknowledgement: wasmvmtypes.IBCAcknowledgement{Data: acknowledgement},
+		OriginalPacket:  newIBCPacket(packet),
+	}
+
+	if err := i.keeper.OnAckPacket(ctx, contractAddr, msg); err != nil {
+		return nil, sdkerrors.Wrap(err, "on ack")
+	}
+
+	return &sdk.Result{
+		Events: ctx.EventManager().Events().ToABCIEvents(),
+	}, nil
+}
+
+// OnTimeoutPacket implements the IBCModule interface
+func (i IBCHandler) OnTimeoutPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) (*sdk.Result, error) {
+	contractAddr, err := types.ContractFromPortID(packet.SourcePort)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "contract port id")
+	}
+
+	msg := wasmvmtypes.IBCPacketTimeoutMsg{Packet: newIBCPacket(packet)}
+	if err := i.keeper.OnTimeoutPacket(ctx, contractAddr, msg); err != nil {
+		return nil, sdkerrors.Wrap(err, "on timeout")
+	}
+
+	return &sdk.Result{
+		Events: ctx.EventManager().Events().ToABCIEvents(),
+	}, nil
+}
+
+// ValidateChannelParams checks the channel identifier, as the channel
+// sequence is the source of the channel capability name
+func ValidateChannelParams(channelID string) error {
+	if _, err := channeltypes.ParseChannelSequence(channelID); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContractAcknowledgement is the successful acknowledgement of the contract, carrying the
+// raw ack data of the contract. Contract level failures are encoded in the ack data.
+type ContractAcknowledgement []byte
+
+var _ ibcexported.Acknowledgement = ContractAcknowledgement{}
+
+// Success implements the Acknowledgement interface
+func (a ContractAcknowledgement) Success() bool {
+	return true
+}
+
+// Acknowledgement implements the Acknowledgement interface
+func (a ContractAcknowledgement) Acknowledgement() []byte {
+	return a
+}
+
+func toWasmVMChannel(portID, channelID string, channelInfo channeltypes.Channel) wasmvmtypes.IBCChannel {
+	return wasmvmtypes.IBCChannel{
+		Endpoint:             wasmvmtypes.IBCEndpoint{PortID: portID, ChannelID: channelID},
+		CounterpartyEndpoint: wasmvmtypes.IBCEndpoint{PortID: channelInfo.Counterparty.PortId, ChannelID: channelInfo.Counterparty.ChannelId},
+		Order:                channelInfo.Ordering.String(),
+		Version:              channelInfo.Version,
+		ConnectionID:         channelInfo.ConnectionHops[0], // At the moment this list must be of length 1. In the future multi-hop channels may be supported.
+	}
+}
+
+func newIBCPacket(packet channeltypes.Packet) wasmvmtypes.IBCPacket {
+	timeout := wasmvmtypes.IBCTimeout{
+		Timestamp: packet.TimeoutTimestamp,
+	}
+	if !packet.TimeoutHeight.IsZero() {
+		timeout.Block = &wasmvmtypes.IBCTimeoutBlock{
+			Height:   packet.TimeoutHeight.RevisionHeight,
+			Revision: packet.TimeoutHeight.RevisionNumber,
+		}
+	}
+
+	return wasmvmtypes.IBCPacket{
+		Data:     packet.Data,
+		Src:      wasmvmtypes.IBCEndpoint{ChannelID: packet.SourceChannel, PortID: packet.SourcePort},
+		Dest:     wasmvmtypes.IBCEndpoint{ChannelID: packet.DestinationChannel, PortID: packet.DestinationPort},
+		Sequence: packet.Sequence,
+		Timeout:  timeout,
+	}
+}
@@ -0,0 +1,239 @@
+package wasm_test
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/modules/core/24-host"
+	ibctesting "github.com/cosmos/ibc-go/testing"
+
+	terraapp "github.com/terra-money/core/app"
+	wasmconfig "github.com/terra-money/core/x/wasm/config"
+	"github.com/terra-money/core/x/wasm/types"
+)
+
+var _ ibctesting.TestingApp = (*terraapp.TerraApp)(nil)
+
+// setupIBCTestingApp replaces the ibc testing app with the terra app
+// for the duration of the test
+func setupIBCTestingApp(t *testing.T) {
+	defaultTestingAppInit := ibctesting.DefaultTestingAppInit
+	t.Cleanup(func() { ibctesting.DefaultTestingAppInit = defaultTestingAppInit })
+
+	ibctesting.DefaultTestingAppInit = func() (ibctesting.TestingApp, map[string]json.RawMessage) {
+		app := terraapp.NewTerraApp(
+			log.NewNopLogger(), dbm.NewMemDB(), nil, true, map[int64]bool{},
+			t.TempDir(), 5, terraapp.MakeEncodingConfig(), simapp.EmptyAppOptions{},
+			wasmconfig.DefaultConfig(),
+		)
+
+		return app, terraapp.NewDefaultGenesisState()
+	}
+}
+
+// storeAndInstantiate uploads the code and instantiates it on the chain with
+// the deposit, returning the address of the new contract
+func storeAndInstantiate(t *testing.T, coordinator *ibctesting.Coordinator, chain *ibctesting.TestChain, code []byte, initMsg []byte, deposit sdk.Coins) sdk.AccAddress {
+	sender := chain.SenderAccount.GetAddress()
+	wasmKeeper := chain.App.(*terraapp.TerraApp).WasmKeeper
+	ctx := chain.GetContext()
+
+	codeID, err := wasmKeeper.StoreCode(ctx, sender, code)
+	require.NoError(t, err)
+
+	contractAddr, _, err := wasmKeeper.InstantiateContract(ctx, codeID, sender, nil, initMsg, deposit)
+	require.NoError(t, err)
+
+	coordinator.CommitBlock(chain)
+
+	return contractAddr
+}
+
+// executeContract has the ibc tester contract dispatch the msg in a tx on the chain
+func executeContract(t *testing.T, chain *ibctesting.TestChain, contract sdk.AccAddress, msg string) {
+	_, err := chain.SendMsgs(types.NewMsgExecuteContract(chain.SenderAccount.GetAddress(), contract, []byte(msg), nil))
+	require.NoError(t, err)
+}
+
+// contractCalls returns the msgs the ibc tester contract was last called with,
+// by the name of its IBC entry points
+func contractCalls(chain *ibctesting.TestChain, contract sdk.AccAddress) map[string]json.RawMessage {
+	iter := chain.App.(*terraapp.TerraApp).WasmKeeper.GetContractStoreIterator(chain.GetContext(), contract)
+	defer iter.Close()
+
+	calls := map[string]json.RawMessage{}
+	for ; iter.Valid(); iter.Next() {
+		calls[string(iter.Key())] = iter.Value()
+	}
+
+	return calls
+}
+
+func TestIBCContract(t *testing.T) {
+	setupIBCTestingApp(t)
+
+	coordinator := ibctesting.NewCoordinator(t, 2)
+	chainA := coordinator.GetChain(ibctesting.GetChainID(0))
+	chainB := coordinator.GetChain(ibctesting.GetChainID(1))
+
+	ibcTesterContract := mustLoad("./keeper/testdata/ibc_tester.wasm")
+	deposit := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000))
+	contractA := storeAndInstantiate(t, coordinator, chainA, ibcTesterContract, []byte("{}"), deposit)
+	contractB := storeAndInstantiate(t, coordinator, chainB, ibcTesterContract, []byte("{}"), nil)
+
+	// ibc enabled contracts are bound to their own port
+	for _, c := range []struct {
+		chain    *ibctesting.TestChain
+		contract sdk.AccAddress
+	}{{chainA, contractA}, {chainB, contractB}} {
+		app := c.chain.App.(*terraapp.TerraApp)
+		ctx := c.chain.GetContext()
+
+		contractInfo, err := app.WasmKeeper.GetContractInfo(ctx, c.contract)
+		require.NoError(t, err)
+		require.Equal(t, types.PortIDForContract(c.contract), contractInfo.IBCPortID)
+
+		portContract, err := types.ContractFromPortID(contractInfo.IBCPortID)
+		require.NoError(t, err)
+		require.Equal(t, c.contract, portContract)
+
+		_, found := app.ScopedWasmKeeper.GetCapability(ctx, host.PortPath(contractInfo.IBCPortID))
+		require.True(t, found)
+	}
+
+	// the channel handshake goes through the contracts on both ends
+	path := ibctesting.NewPath(chainA, chainB)
+	path.EndpointA.ChannelConfig.PortID = types.PortIDForContract(contractA)
+	path.EndpointA.ChannelConfig.Version = "ibc-tester-v1"
+	path.EndpointB.ChannelConfig.PortID = types.PortIDForContract(contractB)
+	path.EndpointB.ChannelConfig.Version = "ibc-tester-v1"
+	coordinator.Setup(path)
+
+	for _, endpoint := range []*ibctesting.Endpoint{path.EndpointA, path.EndpointB} {
+		app := endpoint.Chain.App.(*terraapp.TerraApp)
+		_, found := app.ScopedWasmKeeper.GetCapability(
+			endpoint.Chain.GetContext(),
+			host.ChannelCapabilityPath(endpoint.ChannelConfig.PortID, endpoint.ChannelID),
+		)
+		require.True(t, found)
+		require.Equal(t, channeltypes.OPEN, endpoint.GetChannel().State)
+	}
+
+	var openMsg wasmvmtypes.IBCChannelOpenMsg
+	require.NoError(t, json.Unmarshal(contractCalls(chainA, contractA)["ibc_channel_open"], &openMsg))
+	require.NotNil(t, openMsg.OpenInit)
+	require.NoError(t, json.Unmarshal(contractCalls(chainB, contractB)["ibc_channel_open"], &openMsg))
+	require.NotNil(t, openMsg.OpenTry)
+
+	var connectMsg wasmvmtypes.IBCChannelConnectMsg
+	require.NoError(t, json.Unmarshal(contractCalls(chainA, contractA)["ibc_channel_connect"], &connectMsg))
+	require.NotNil(t, connectMsg.OpenAck)
+	require.Equal(t, path.EndpointB.ChannelID, connectMsg.OpenAck.Channel.CounterpartyEndpoint.ChannelID)
+	require.NoError(t, json.Unmarshal(contractCalls(chainB, contractB)["ibc_channel_connect"], &connectMsg))
+	require.NotNil(t, connectMsg.OpenConfirm)
+	require.Equal(t, path.EndpointA.ChannelID, connectMsg.OpenConfirm.Channel.CounterpartyEndpoint.ChannelID)
+
+	// the contract on chain A sends packets from its port
+	sendPacket := func(sequence uint64, timeoutHeight clienttypes.Height) channeltypes.Packet {
+		data := []byte(`{"ping":{}}`)
+		executeContract(t, chainA, contractA, fmt.Sprintf(
+			`{"ibc":{"send_packet":{"channel_id":%q,"data":%q,"timeout":{"block":{"revision":%d,"height":%d}}}}}`,
+			path.EndpointA.ChannelID, base64.StdEncoding.EncodeToString(data),
+			timeoutHeight.RevisionNumber, timeoutHeight.RevisionHeight,
+		))
+		require.NoError(t, path.EndpointB.UpdateClient())
+
+		return channeltypes.NewPacket(
+			data, sequence,
+			path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID,
+			path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID,
+			timeoutHeight, 0,
+		)
+	}
+
+	// the contract on chain B receives the packet and the contract on chain A its acknowledgement
+	packet := sendPacket(1, clienttypes.NewHeight(0, uint64(chainB.GetContext().BlockHeight()+100)))
+	require.NoError(t, path.RelayPacket(packet, []byte("ok")))
+
+	var receiveMsg wasmvmtypes.IBCPacketReceiveMsg
+	require.NoError(t, json.Unmarshal(contractCalls(chainB, contractB)["ibc_packet_receive"], &receiveMsg))
+	require.Equal(t, packet.Data, []byte(receiveMsg.Packet.Data))
+
+	var ackMsg wasmvmtypes.IBCPacketAckMsg
+	require.NoError(t, json.Unmarshal(contractCalls(chainA, contractA)["ibc_packet_ack"], &ackMsg))
+	require.Equal(t, []byte("ok"), []byte(ackMsg.Acknowledgement.Data))
+	require.Equal(t, packet.Sequence, ackMsg.OriginalPacket.Sequence)
+
+	// the contract on chain A handles the timeout
+	packet = sendPacket(2, clienttypes.NewHeight(0, uint64(chainB.GetContext().BlockHeight()+1)))
+	coordinator.CommitNBlocks(chainB, 2)
+	require.NoError(t, path.EndpointA.UpdateClient())
+	require.NoError(t, path.EndpointA.TimeoutPacket(packet))
+
+	var timeoutMsg wasmvmtypes.IBCPacketTimeoutMsg
+	require.NoError(t, json.Unmarshal(contractCalls(chainA, contractA)["ibc_packet_timeout"], &timeoutMsg))
+	require.Equal(t, packet.Sequence, timeoutMsg.Packet.Sequence)
+
+	// the contract on chain A transfers its coins to chain B through the transfer channel
+	transferPath := ibctesting.NewPath(chainA, chainB)
+	transferPath.EndpointA.ChannelConfig.PortID = ibctesting.TransferPort
+	transferPath.EndpointB.ChannelConfig.PortID = ibctesting.TransferPort
+	coordinator.Setup(transferPath)
+
+	receiver := chainB.SenderAccount.GetAddress()
+	timeoutHeight := clienttypes.NewHeight(0, uint64(chainB.GetContext().BlockHeight()+100))
+	executeContract(t, chainA, contractA, fmt.Sprintf(
+		`{"ibc":{"transfer":{"channel_id":%q,"to_address":%q,"amount":{"denom":%q,"amount":"100"},"timeout":{"block":{"revision":%d,"height":%d}}}}}`,
+		transferPath.EndpointA.ChannelID, receiver.String(), sdk.DefaultBondDenom,
+		timeoutHeight.RevisionNumber, timeoutHeight.RevisionHeight,
+	))
+	require.NoError(t, transferPath.EndpointB.UpdateClient())
+
+	transferData := ibctransfertypes.NewFungibleTokenPacketData(sdk.DefaultBondDenom, 100, contractA.String(), receiver.String())
+	transferPacket := channeltypes.NewPacket(
+		transferData.GetBytes(), 1,
+		transferPath.EndpointA.ChannelConfig.PortID, transferPath.EndpointA.ChannelID,
+		transferPath.EndpointB.ChannelConfig.PortID, transferPath.EndpointB.ChannelID,
+		timeoutHeight, 0,
+	)
+	require.NoError(t, transferPath.RelayPacket(transferPacket, channeltypes.NewResultAcknowledgement([]byte{byte(1)}).Acknowledgement()))
+
+	voucherDenom := ibctransfertypes.ParseDenomTrace(ibctransfertypes.GetPrefixedDenom(
+		transferPath.EndpointB.ChannelConfig.PortID, transferPath.EndpointB.ChannelID, sdk.DefaultBondDenom,
+	)).IBCDenom()
+	appB := chainB.App.(*terraapp.TerraApp)
+	require.Equal(t, sdk.NewInt64Coin(voucherDenom, 100), appB.BankKeeper.GetBalance(chainB.GetContext(), receiver, voucherDenom))
+
+	// the contract on chain A closes the channel, and the contract on chain B is notified
+	executeContract(t, chainA, contractA, fmt.Sprintf(`{"ibc":{"close_channel":{"channel_id":%q}}}`, path.EndpointA.ChannelID))
+	require.Equal(t, channeltypes.CLOSED, path.EndpointA.GetChannel().State)
+	require.NoError(t, path.EndpointB.UpdateClient())
+
+	proof, proofHeight := chainA.QueryProof(host.ChannelKey(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID))
+	_, err := chainB.SendMsgs(channeltypes.NewMsgChannelCloseConfirm(
+		path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID,
+		proof, proofHeight, chainB.SenderAccount.GetAddress().String(),
+	))
+	require.NoError(t, err)
+	require.Equal(t, channeltypes.CLOSED, path.EndpointB.GetChannel().State)
+
+	var closeMsg wasmvmtypes.IBCChannelCloseMsg
+	require.NoError(t, json.Unmarshal(contractCalls(chainA, contractA)["ibc_channel_close"], &closeMsg))
+	require.NotNil(t, closeMsg.CloseInit)
+	require.NoError(t, json.Unmarshal(contractCalls(chainB, contractB)["ibc_channel_close"], &closeMsg))
+	require.NotNil(t, closeMsg.CloseConfirm)
+}
@@ -0,0 +1,44 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/terra-money/core/x/wasm/types"
+)
+
+// authorizationPolicy decides whether an actor may perform a wasm code action
+type authorizationPolicy interface {
+	CanCreateCode(config types.AccessConfig, actor sdk.AccAddress) bool
+	CanInstantiateContract(config types.AccessConfig, actor sdk.AccAddress) bool
+	CanModifyContract(admin string, actor sdk.AccAddress) bool
+}
+
+// defaultAuthorizationPolicy enforces the access configs for the msgs sent by accounts
+type defaultAuthorizationPolicy struct{}
+
+func (defaultAuthorizationPolicy) CanCreateCode(config types.AccessConfig, actor sdk.AccAddress) bool {
+	return config.Allowed(actor)
+}
+
+func (defaultAuthorizationPolicy) CanInstantiateContract(config types.AccessConfig, actor sdk.AccAddress) bool {
+	return config.Allowed(actor)
+}
+
+func (defaultAuthorizationPolicy) CanModifyContract(admin string, actor sdk.AccAddress) bool {
+	return admin == actor.String()
+}
+
+// govAuthorizationPolicy allows every action of the passed governance proposals
+type govAuthorizationPolicy struct{}
+
+func (govAuthorizationPolicy) CanCreateCode(types.AccessConfig, sdk.AccAddress) bool {
+	return true
+}
+
+func (govAuthorizationPolicy) CanInstantiateContract(types.AccessConfig, sdk.AccAddress) bool {
+	return true
+}
+
+func (govAuthorizationPolicy) CanModifyContract(string, sdk.AccAddress) bool {
+	return true
+}
@@ -0,0 +1,49 @@
+package keeper
+
+import (
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	treasurytypes "github.com/terra-money/core/x/treasury/types"
+	"github.com/terra-money/core/x/wasm/types"
+)
+
+// burnContractCoins burns the coins from the contract balance by routing them
+// through the treasury burn module account. The coins leave the supply instead of
+// moving to another account, so no stability tax is charged, and the burned
+// amount is recorded in the treasury burn accounting.
+func (k Keeper) burnContractCoins(ctx sdk.Context, contractAddr sdk.AccAddress, msg *wasmvmtypes.BurnMsg) (sdk.Events, error) {
+	coins, err := types.ParseToCoins(msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	if !coins.IsValid() || coins.IsZero() {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, coins.String())
+	}
+
+	eventManager := sdk.NewEventManager()
+	ctx = ctx.WithEventManager(eventManager)
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, contractAddr, treasurytypes.BurnModuleName, coins); err != nil {
+		return nil, err
+	}
+
+	if err := k.bankKeeper.BurnCoins(ctx, treasurytypes.BurnModuleName, coins); err != nil {
+		return nil, err
+	}
+
+	k.treasuryKeeper.RecordBurned(ctx, coins)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeBurnContractCoins,
+			sdk.NewAttribute(types.AttributeKeyContractAddress, contractAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, coins.String()),
+		),
+	)
+
+	return eventManager.Events(), nil
+}
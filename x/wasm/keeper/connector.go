@@ -120,6 +120,18 @@ func (k Keeper) dispatchMessageWithGasLimit(ctx sdk.Context, contractAddr sdk.Ac
 
 // dispatchMessage does not emit events to prevent duplicate emission
 func (k Keeper) dispatchMessage(ctx sdk.Context, contractAddr sdk.AccAddress, msg wasmvmtypes.CosmosMsg) (events sdk.Events, data []byte, err error) {
+	// there is no sdk msg for raw ibc packets, so the keeper sends them itself
+	if msg.IBC != nil && msg.IBC.SendPacket != nil {
+		events, err = k.sendIBCPacket(ctx, contractAddr, msg.IBC.SendPacket)
+		return events, nil, err
+	}
+
+	// there is no sdk msg for burning coins from an account, so the keeper burns them itself
+	if msg.Bank != nil && msg.Bank.Burn != nil {
+		events, err = k.burnContractCoins(ctx, contractAddr, msg.Bank.Burn)
+		return events, nil, err
+	}
+
 	sdkMsg, err := k.msgParser.Parse(ctx, contractAddr, msg)
 	if err != nil {
 		return nil, nil, err
@@ -130,7 +142,11 @@ func (k Keeper) dispatchMessage(ctx sdk.Context, contractAddr sdk.AccAddress, ms
 	}
 
 	// Charge tax on result msg
-	taxes := ante.FilterMsgAndComputeTax(ctx, k.treasuryKeeper, sdkMsg)
+	taxes, err := ante.FilterMsgAndComputeTax(ctx, k.treasuryKeeper, k.taxableMsgRegistry, sdkMsg)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	if !taxes.IsZero() {
 		eventManager := sdk.NewEventManager()
 		contractAcc := k.accountKeeper.GetAccount(ctx, contractAddr)
@@ -12,6 +12,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
 // CompileCode uncompress the wasm code bytes and store the code to local file system
@@ -38,8 +39,23 @@ func (k Keeper) CompileCode(ctx sdk.Context, wasmCode []byte) (codeHash []byte,
 	return
 }
 
-// StoreCode uploads and compiles a WASM contract bytecode, returning a short identifier for the stored code
+// StoreCode uploads and compiles a WASM contract bytecode, returning a short identifier for the stored code.
+// The code gets the default instantiate permission of the params.
 func (k Keeper) StoreCode(ctx sdk.Context, creator sdk.AccAddress, wasmCode []byte) (codeID uint64, err error) {
+	return k.create(ctx, creator, wasmCode, nil, types.CodeMetadata{}, defaultAuthorizationPolicy{})
+}
+
+func (k Keeper) create(
+	ctx sdk.Context,
+	creator sdk.AccAddress,
+	wasmCode []byte,
+	instantiatePermission *types.AccessConfig,
+	metadata types.CodeMetadata,
+	authZ authorizationPolicy) (codeID uint64, err error) {
+	if !authZ.CanCreateCode(k.CodeUploadAccess(ctx), creator) {
+		return 0, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "can not create code")
+	}
+
 	codeHash, err := k.CompileCode(ctx, wasmCode)
 	if err != nil {
 		return 0, err
@@ -50,8 +66,14 @@ func (k Keeper) StoreCode(ctx sdk.Context, creator sdk.AccAddress, wasmCode []by
 		return 0, err
 	}
 
+	instantiateConfig := types.NewInstantiateAccessConfig(k.InstantiateDefaultPermission(ctx), creator)
+	if instantiatePermission != nil {
+		instantiateConfig = *instantiatePermission
+	}
+
 	codeID++
-	codeInfo := types.NewCodeInfo(codeID, codeHash, creator)
+	codeInfo := types.NewCodeInfo(codeID, codeHash, creator, instantiateConfig)
+	codeInfo.Metadata = metadata
 
 	k.SetLastCodeID(ctx, codeID)
 	k.SetCodeInfo(ctx, codeID, codeInfo)
@@ -95,14 +117,60 @@ func (k Keeper) InstantiateContract(
 	admin sdk.AccAddress,
 	initMsg []byte,
 	deposit sdk.Coins) (sdk.AccAddress, []byte, error) {
+	return k.instantiate(ctx, codeID, creator, admin, initMsg, deposit, "", nil, defaultAuthorizationPolicy{})
+}
+
+// InstantiateContract2 creates an instance of a WASM contract at the predictable
+// address derived from the code hash, the creator and the salt
+func (k Keeper) InstantiateContract2(
+	ctx sdk.Context,
+	codeID uint64,
+	creator sdk.AccAddress,
+	admin sdk.AccAddress,
+	initMsg []byte,
+	deposit sdk.Coins,
+	salt []byte) (sdk.AccAddress, []byte, error) {
+	if err := types.ValidateSalt(salt); err != nil {
+		return nil, nil, err
+	}
+
+	return k.instantiate(ctx, codeID, creator, admin, initMsg, deposit, "", salt, defaultAuthorizationPolicy{})
+}
+
+// instantiate derives the contract address from the code hash, the creator and the salt
+// when the salt is given, otherwise from the code id and the instance id
+func (k Keeper) instantiate(
+	ctx sdk.Context,
+	codeID uint64,
+	creator sdk.AccAddress,
+	admin sdk.AccAddress,
+	initMsg []byte,
+	deposit sdk.Coins,
+	label string,
+	salt []byte,
+	authZ authorizationPolicy) (sdk.AccAddress, []byte, error) {
 	defer telemetry.MeasureSince(time.Now(), "wasm", "contract", "instantiate")
 	ctx.GasMeter().ConsumeGas(types.RegisterContractCosts(), "Registering contract to the store")
-	ctx.GasMeter().ConsumeGas(types.InstantiateContractCosts(len(initMsg)), "Loading CosmWasm module: init")
+	ctx.GasMeter().ConsumeGas(types.InstantiateContractCosts(k.isPinnedCode(ctx, codeID), len(initMsg)), "Loading CosmWasm module: init")
 
 	if uint64(len(initMsg)) > k.MaxContractMsgSize(ctx) {
 		return nil, nil, sdkerrors.Wrap(types.ErrExceedMaxContractMsgSize, "init msg size is too huge")
 	}
 
+	// get code info
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetCodeInfoKey(codeID))
+	if bz == nil {
+		return nil, nil, sdkerrors.Wrapf(types.ErrNotFound, "codeID %d", codeID)
+	}
+
+	var codeInfo types.CodeInfo
+	k.cdc.MustUnmarshal(bz, &codeInfo)
+
+	if !authZ.CanInstantiateContract(codeInfo.InstantiatePermission, creator) {
+		return nil, nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "can not instantiate")
+	}
+
 	instanceID, err := k.GetLastInstanceID(ctx)
 	if err != nil {
 		return nil, nil, err
@@ -112,15 +180,20 @@ func (k Keeper) InstantiateContract(
 
 	// create contract address
 	contractAddress := types.GenerateContractAddress(codeID, instanceID)
+	if salt != nil {
+		contractAddress = types.GenerateContractAddress2(codeInfo.CodeHash, creator, salt)
+	}
 	existingAcct := k.accountKeeper.GetAccount(ctx, contractAddress)
-	if existingAcct != nil {
+	if existingAcct == nil {
+		// create contract account
+		contractAccount := k.accountKeeper.NewAccountWithAddress(ctx, contractAddress)
+		k.accountKeeper.SetAccount(ctx, contractAccount)
+	} else if salt == nil || !isUnusedBaseAccount(existingAcct) || store.Has(types.GetContractInfoKey(contractAddress)) {
+		// coins may be sent to a predicted address before the instantiation, so the
+		// plain accounts which never signed a tx and hold no contract are taken over
 		return nil, nil, sdkerrors.Wrap(types.ErrAccountExists, existingAcct.GetAddress().String())
 	}
 
-	// create contract account
-	contractAccount := k.accountKeeper.NewAccountWithAddress(ctx, contractAddress)
-	k.accountKeeper.SetAccount(ctx, contractAccount)
-
 	// deposit initial contract funds
 	if !deposit.IsZero() {
 		if err := k.bankKeeper.SendCoins(ctx, creator, contractAddress, deposit); err != nil {
@@ -128,16 +201,6 @@ func (k Keeper) InstantiateContract(
 		}
 	}
 
-	// get code info
-	store := ctx.KVStore(k.storeKey)
-	bz := store.Get(types.GetCodeInfoKey(codeID))
-	if bz == nil {
-		return nil, nil, sdkerrors.Wrapf(types.ErrNotFound, "codeID %d", codeID)
-	}
-
-	var codeInfo types.CodeInfo
-	k.cdc.MustUnmarshal(bz, &codeInfo)
-
 	// prepare env and info for contract instantiate call
 	env := types.NewEnv(ctx, contractAddress)
 	info := types.NewInfo(creator, deposit)
@@ -171,9 +234,24 @@ func (k Keeper) InstantiateContract(
 
 	// Must store contract info first, so last part can use it
 	contractInfo := types.NewContractInfo(codeID, contractAddress, creator, admin, initMsg)
+	contractInfo.Label = label
+
+	// bind the ibc port of the IBC-enabled contract
+	report, err := k.wasmVM.AnalyzeCode(codeInfo.CodeHash)
+	if err != nil {
+		return nil, nil, sdkerrors.Wrap(types.ErrInstantiateFailed, err.Error())
+	}
+
+	if report.HasIBCEntryPoints {
+		if contractInfo.IBCPortID, err = k.ensureIBCPort(ctx, contractAddress); err != nil {
+			return nil, nil, err
+		}
+	}
 
 	k.SetLastInstanceID(ctx, instanceID)
 	k.SetContractInfo(ctx, contractAddress, contractInfo)
+	k.AppendContractHistory(ctx, contractAddress, types.NewContractCodeHistoryEntry(
+		types.ContractCodeHistoryOperationTypeInit, codeID, ctx.BlockHeight(), creator, initMsg))
 
 	// parse wasm events to sdk events
 	events, err := types.ParseEvents(contractAddress, res.Attributes, res.Events)
@@ -203,7 +281,7 @@ func (k Keeper) ExecuteContract(
 	execMsg []byte,
 	coins sdk.Coins) ([]byte, error) {
 	defer telemetry.MeasureSince(time.Now(), "wasm", "contract", "execute")
-	ctx.GasMeter().ConsumeGas(types.InstantiateContractCosts(len(execMsg)), "Loading CosmWasm module: execute")
+	ctx.GasMeter().ConsumeGas(types.InstantiateContractCosts(false, len(execMsg)), "Loading CosmWasm module: execute")
 
 	if uint64(len(execMsg)) > k.MaxContractMsgSize(ctx) {
 		return nil, sdkerrors.Wrap(types.ErrExceedMaxContractMsgSize, "execute msg size is too huge")
@@ -214,6 +292,8 @@ func (k Keeper) ExecuteContract(
 		return nil, err
 	}
 
+	k.refundPinnedCodeCosts(ctx, codeInfo.CodeID, "Loading CosmWasm module: execute")
+
 	// add more funds
 	if !coins.IsZero() {
 		err = k.bankKeeper.SendCoins(ctx, sender, contractAddress, coins)
@@ -273,8 +353,18 @@ func (k Keeper) MigrateContract(
 	sender sdk.AccAddress,
 	newCodeID uint64,
 	migrateMsg []byte) ([]byte, error) {
+	return k.migrate(ctx, contractAddress, sender, newCodeID, migrateMsg, defaultAuthorizationPolicy{})
+}
+
+func (k Keeper) migrate(
+	ctx sdk.Context,
+	contractAddress sdk.AccAddress,
+	sender sdk.AccAddress,
+	newCodeID uint64,
+	migrateMsg []byte,
+	authZ authorizationPolicy) ([]byte, error) {
 	defer telemetry.MeasureSince(time.Now(), "wasm", "contract", "migrate")
-	ctx.GasMeter().ConsumeGas(types.InstantiateContractCosts(len(migrateMsg)), "Loading CosmWasm module: migrate")
+	ctx.GasMeter().ConsumeGas(types.InstantiateContractCosts(k.isPinnedCode(ctx, newCodeID), len(migrateMsg)), "Loading CosmWasm module: migrate")
 
 	if uint64(len(migrateMsg)) > k.MaxContractMsgSize(ctx) {
 		return nil, sdkerrors.Wrap(types.ErrExceedMaxContractMsgSize, "migrate msg size is too huge")
@@ -289,7 +379,7 @@ func (k Keeper) MigrateContract(
 		return nil, types.ErrNotMigratable
 	}
 
-	if contractInfo.Admin != sender.String() {
+	if !authZ.CanModifyContract(contractInfo.Admin, sender) {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "no permission")
 	}
 
@@ -298,6 +388,20 @@ func (k Keeper) MigrateContract(
 		return nil, err
 	}
 
+	if !authZ.CanInstantiateContract(newCodeInfo.InstantiatePermission, sender) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "to use new code")
+	}
+
+	// the open channels of the contract require the new code to keep the ibc entry points
+	report, err := k.wasmVM.AnalyzeCode(newCodeInfo.CodeHash)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrMigrationFailed, err.Error())
+	}
+
+	if contractInfo.IBCPortID != "" && !report.HasIBCEntryPoints {
+		return nil, sdkerrors.Wrap(types.ErrMigrationFailed, "requires ibc callbacks")
+	}
+
 	env := types.NewEnv(ctx, contractAddress)
 
 	// prepare necessary meta data
@@ -334,8 +438,79 @@ func (k Keeper) MigrateContract(
 	// emit events
 	ctx.EventManager().EmitEvents(events)
 
+	// bind the ibc port when the contract becomes IBC-enabled
+	if contractInfo.IBCPortID == "" && report.HasIBCEntryPoints {
+		if contractInfo.IBCPortID, err = k.ensureIBCPort(ctx, contractAddress); err != nil {
+			return nil, err
+		}
+	}
+
 	contractInfo.CodeID = newCodeID
 	k.SetContractInfo(ctx, contractAddress, contractInfo)
+	k.AppendContractHistory(ctx, contractAddress, types.NewContractCodeHistoryEntry(
+		types.ContractCodeHistoryOperationTypeMigrate, newCodeID, ctx.BlockHeight(), sender, migrateMsg))
+
+	// dispatch submessages and messages
+	respData := res.Data
+	if replyData, err := k.dispatchMessages(ctx, contractAddress, res.Messages...); err != nil {
+		return nil, sdkerrors.Wrap(err, "dispatch")
+	} else if replyData != nil {
+		respData = replyData
+	}
+
+	return respData, nil
+}
+
+// Sudo calls the sudo entry point of the contract; it is not exposed to any
+// transaction and only called by governance or other native modules
+func (k Keeper) Sudo(
+	ctx sdk.Context,
+	contractAddress sdk.AccAddress,
+	sudoMsg []byte) ([]byte, error) {
+	defer telemetry.MeasureSince(time.Now(), "wasm", "contract", "sudo")
+	ctx.GasMeter().ConsumeGas(types.InstantiateContractCosts(false, len(sudoMsg)), "Loading CosmWasm module: sudo")
+
+	if uint64(len(sudoMsg)) > k.MaxContractMsgSize(ctx) {
+		return nil, sdkerrors.Wrap(types.ErrExceedMaxContractMsgSize, "sudo msg size is too huge")
+	}
+
+	codeInfo, storePrefix, err := k.getContractDetails(ctx, contractAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	k.refundPinnedCodeCosts(ctx, codeInfo.CodeID, "Loading CosmWasm module: sudo")
+
+	env := types.NewEnv(ctx, contractAddress)
+	res, gasUsed, err := k.wasmVM.Sudo(
+		codeInfo.CodeHash,
+		env,
+		sudoMsg,
+		storePrefix,
+		k.getCosmWasmAPI(ctx),
+		k.querier.WithCtx(ctx),
+		k.getWasmVMGasMeter(ctx),
+		k.getWasmVMGasRemaining(ctx),
+		types.JSONDeserializationWasmGasCost,
+	)
+
+	// add types.GasMultiplier to occur out of gas panic
+	k.consumeWasmVMGas(ctx, gasUsed+types.GasMultiplier, "Contract Sudo")
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrSudoFailed, err.Error())
+	}
+
+	// consume gas for wasm events
+	ctx.GasMeter().ConsumeGas(types.EventCosts(res.Attributes, res.Events), "Event Cost")
+
+	// parse wasm events to sdk events
+	events, err := types.ParseEvents(contractAddress, res.Attributes, res.Events)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "event validation failed")
+	}
+
+	// emit events
+	ctx.EventManager().EmitEvents(events)
 
 	// dispatch submessages and messages
 	respData := res.Data
@@ -355,13 +530,15 @@ func (k Keeper) reply(
 	contractAddress sdk.AccAddress,
 	reply wasmvmtypes.Reply) ([]byte, error) {
 	defer telemetry.MeasureSince(time.Now(), "wasm", "contract", "reply")
-	ctx.GasMeter().ConsumeGas(types.ReplyCosts(reply), "Loading CosmWasm module: reply")
+	ctx.GasMeter().ConsumeGas(types.ReplyCosts(false, reply), "Loading CosmWasm module: reply")
 
 	codeInfo, storePrefix, err := k.getContractDetails(ctx, contractAddress)
 	if err != nil {
 		return nil, err
 	}
 
+	k.refundPinnedCodeCosts(ctx, codeInfo.CodeID, "Loading CosmWasm module: reply")
+
 	env := types.NewEnv(ctx, contractAddress)
 	res, gasUsed, err := k.wasmVM.Reply(
 		codeInfo.CodeHash,
@@ -418,13 +595,15 @@ func (k Keeper) queryToStore(ctx sdk.Context, contractAddress sdk.AccAddress, ke
 
 func (k Keeper) queryToContract(ctx sdk.Context, contractAddress sdk.AccAddress, queryMsg []byte) ([]byte, error) {
 	defer telemetry.MeasureSince(time.Now(), "wasm", "contract", "query-smart")
-	ctx.GasMeter().ConsumeGas(types.InstantiateContractCosts(len(queryMsg)), "Loading CosmWasm module: query")
+	ctx.GasMeter().ConsumeGas(types.InstantiateContractCosts(false, len(queryMsg)), "Loading CosmWasm module: query")
 
 	codeInfo, contractStorePrefix, err := k.getContractDetails(ctx, contractAddress)
 	if err != nil {
 		return nil, err
 	}
 
+	k.refundPinnedCodeCosts(ctx, codeInfo.CodeID, "Loading CosmWasm module: query")
+
 	env := types.NewEnv(ctx, contractAddress)
 
 	// assert and increase query depth
@@ -495,3 +674,9 @@ func (k Keeper) getContractDetails(ctx sdk.Context, contractAddress sdk.AccAddre
 	contractStorePrefix = prefix.NewStore(types.KVStore(ctx, k.storeKey), contractStoreKey)
 	return
 }
+
+// isUnusedBaseAccount returns true for a plain account which never signed a tx
+func isUnusedBaseAccount(account authtypes.AccountI) bool {
+	baseAccount, ok := account.(*authtypes.BaseAccount)
+	return ok && baseAccount.GetPubKey() == nil && baseAccount.GetSequence() == 0
+}
@@ -74,6 +74,49 @@ func TestMigrateCode(t *testing.T) {
 	require.Error(t, err)
 }
 
+func TestStoreCodeUploadAccess(t *testing.T) {
+	input := CreateTestInput(t)
+	ctx, accKeeper, bankKeeper, keeper := input.Ctx, input.AccKeeper, input.BankKeeper, input.WasmKeeper
+
+	deposit := sdk.NewCoins(sdk.NewInt64Coin(core.MicroLunaDenom, 100000))
+	creator := createFakeFundedAccount(ctx, accKeeper, bankKeeper, deposit)
+	fakeAccount := createFakeFundedAccount(ctx, accKeeper, bankKeeper, deposit)
+
+	wasmCode, err := ioutil.ReadFile("./testdata/hackatom.wasm")
+	require.NoError(t, err)
+
+	params := keeper.GetParams(ctx)
+	params.CodeUploadAccess = types.AllowNobody
+	keeper.SetParams(ctx, params)
+
+	_, err = keeper.StoreCode(ctx, creator, wasmCode)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	params.CodeUploadAccess = types.NewAllowlistAccessConfig(creator)
+	keeper.SetParams(ctx, params)
+
+	_, err = keeper.StoreCode(ctx, fakeAccount, wasmCode)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	codeID, err := keeper.StoreCode(ctx, creator, wasmCode)
+	require.NoError(t, err)
+
+	// the code gets the default instantiate permission of the params
+	codeInfo, err := keeper.GetCodeInfo(ctx, codeID)
+	require.NoError(t, err)
+	require.Equal(t, types.AllowEverybody, codeInfo.InstantiatePermission)
+
+	params.InstantiateDefaultPermission = types.AccessTypeAllowlist
+	keeper.SetParams(ctx, params)
+
+	codeID, err = keeper.StoreCode(ctx, creator, wasmCode)
+	require.NoError(t, err)
+
+	codeInfo, err = keeper.GetCodeInfo(ctx, codeID)
+	require.NoError(t, err)
+	require.Equal(t, types.NewAllowlistAccessConfig(creator), codeInfo.InstantiatePermission)
+}
+
 func TestStoreCodeWithHugeCode(t *testing.T) {
 	input := CreateTestInput(t)
 	ctx, keeper := input.Ctx, input.WasmKeeper
@@ -137,6 +180,150 @@ func TestInstantiate(t *testing.T) {
 	require.Equal(t, "cosmos18vd8fpwxzck93qlwghaj6arh4p7c5n89uzcee5", addr.String())
 }
 
+func TestInstantiateContract2(t *testing.T) {
+	input := CreateTestInput(t)
+	ctx, accKeeper, bankKeeper, keeper := input.Ctx, input.AccKeeper, input.BankKeeper, input.WasmKeeper
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	deposit := sdk.NewCoins(sdk.NewInt64Coin(core.MicroLunaDenom, 100000))
+	creator := createFakeFundedAccount(ctx, accKeeper, bankKeeper, deposit)
+
+	wasmCode, err := ioutil.ReadFile("./testdata/hackatom.wasm")
+	require.NoError(t, err)
+
+	codeID, err := keeper.StoreCode(ctx, creator, wasmCode)
+	require.NoError(t, err)
+
+	_, _, bob := keyPubAddr()
+	_, _, fred := keyPubAddr()
+
+	initMsgBz, err := json.Marshal(HackatomExampleInitMsg{
+		Verifier:    fred,
+		Beneficiary: bob,
+	})
+	require.NoError(t, err)
+
+	salt := []byte("salt")
+	predictRes, err := NewQuerier(keeper).PredictContractAddress(goCtx, &types.QueryPredictContractAddressRequest{
+		CodeId:         codeID,
+		CreatorAddress: creator.String(),
+		Salt:           salt,
+	})
+	require.NoError(t, err)
+
+	// the address does not depend on the instantiation order
+	_, _, err = keeper.InstantiateContract(ctx, codeID, creator, sdk.AccAddress{}, initMsgBz, nil)
+	require.NoError(t, err)
+
+	addr, _, err := keeper.InstantiateContract2(ctx, codeID, creator, sdk.AccAddress{}, initMsgBz, nil, salt)
+	require.NoError(t, err)
+	require.Equal(t, predictRes.ContractAddress, addr.String())
+
+	instanceID, err := keeper.GetLastInstanceID(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), instanceID)
+
+	// the same salt cannot be used twice
+	_, _, err = keeper.InstantiateContract2(ctx, codeID, creator, sdk.AccAddress{}, initMsgBz, nil, salt)
+	require.ErrorIs(t, err, types.ErrAccountExists)
+
+	otherAddr, _, err := keeper.InstantiateContract2(ctx, codeID, creator, sdk.AccAddress{}, initMsgBz, nil, []byte("other salt"))
+	require.NoError(t, err)
+	require.NotEqual(t, addr, otherAddr)
+
+	_, _, err = keeper.InstantiateContract2(ctx, codeID, creator, sdk.AccAddress{}, initMsgBz, nil, nil)
+	require.ErrorIs(t, err, types.ErrInvalidSalt)
+
+	// the account created by sending coins to the predicted address is taken over
+	dustSalt := []byte("dust salt")
+	predictRes, err = NewQuerier(keeper).PredictContractAddress(goCtx, &types.QueryPredictContractAddressRequest{
+		CodeId:         codeID,
+		CreatorAddress: creator.String(),
+		Salt:           dustSalt,
+	})
+	require.NoError(t, err)
+	predictedAddr, err := sdk.AccAddressFromBech32(predictRes.ContractAddress)
+	require.NoError(t, err)
+
+	dust := sdk.NewCoins(sdk.NewInt64Coin(core.MicroLunaDenom, 1))
+	require.NoError(t, bankKeeper.SendCoins(ctx, creator, predictedAddr, dust))
+	dustAccount := accKeeper.GetAccount(ctx, predictedAddr)
+	require.NotNil(t, dustAccount)
+
+	initDeposit := sdk.NewCoins(sdk.NewInt64Coin(core.MicroLunaDenom, 1000))
+	addr, _, err = keeper.InstantiateContract2(ctx, codeID, creator, sdk.AccAddress{}, initMsgBz, initDeposit, dustSalt)
+	require.NoError(t, err)
+	require.Equal(t, predictedAddr, addr)
+	require.Equal(t, dustAccount.GetAccountNumber(), accKeeper.GetAccount(ctx, addr).GetAccountNumber())
+	require.Equal(t, initDeposit.Add(dust...), bankKeeper.GetAllBalances(ctx, addr))
+
+	// the account which signed a tx is not taken over
+	usedSalt := []byte("used salt")
+	predictRes, err = NewQuerier(keeper).PredictContractAddress(goCtx, &types.QueryPredictContractAddressRequest{
+		CodeId:         codeID,
+		CreatorAddress: creator.String(),
+		Salt:           usedSalt,
+	})
+	require.NoError(t, err)
+	predictedAddr, err = sdk.AccAddressFromBech32(predictRes.ContractAddress)
+	require.NoError(t, err)
+
+	usedAccount := accKeeper.NewAccountWithAddress(ctx, predictedAddr)
+	require.NoError(t, usedAccount.SetSequence(1))
+	accKeeper.SetAccount(ctx, usedAccount)
+
+	_, _, err = keeper.InstantiateContract2(ctx, codeID, creator, sdk.AccAddress{}, initMsgBz, nil, usedSalt)
+	require.ErrorIs(t, err, types.ErrAccountExists)
+}
+
+func TestInstantiatePermission(t *testing.T) {
+	input := CreateTestInput(t)
+	ctx, accKeeper, bankKeeper, keeper := input.Ctx, input.AccKeeper, input.BankKeeper, input.WasmKeeper
+
+	deposit := sdk.NewCoins(sdk.NewInt64Coin(core.MicroLunaDenom, 100000))
+	creator := createFakeFundedAccount(ctx, accKeeper, bankKeeper, deposit)
+	fakeAccount := createFakeFundedAccount(ctx, accKeeper, bankKeeper, deposit)
+
+	wasmCode, err := ioutil.ReadFile("./testdata/hackatom.wasm")
+	require.NoError(t, err)
+
+	_, _, bob := keyPubAddr()
+	_, _, fred := keyPubAddr()
+
+	initMsgBz, err := json.Marshal(HackatomExampleInitMsg{
+		Verifier:    fred,
+		Beneficiary: bob,
+	})
+	require.NoError(t, err)
+
+	msgServer := NewMsgServerImpl(keeper)
+	storeMsg := types.NewMsgStoreCode(creator, wasmCode)
+	storeMsg.InstantiatePermission = &types.AccessConfig{
+		Permission: types.AccessTypeAllowlist,
+		Addresses:  []string{creator.String()},
+	}
+	res, err := msgServer.StoreCode(sdk.WrapSDKContext(ctx), storeMsg)
+	require.NoError(t, err)
+
+	codeInfo, err := keeper.GetCodeInfo(ctx, res.CodeID)
+	require.NoError(t, err)
+	require.Equal(t, *storeMsg.InstantiatePermission, codeInfo.InstantiatePermission)
+
+	_, _, err = keeper.InstantiateContract(ctx, res.CodeID, fakeAccount, sdk.AccAddress{}, initMsgBz, nil)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	_, _, err = keeper.InstantiateContract(ctx, res.CodeID, creator, sdk.AccAddress{}, initMsgBz, nil)
+	require.NoError(t, err)
+
+	// nobody can instantiate the code
+	storeMsg.InstantiatePermission = &types.AccessConfig{Permission: types.AccessTypeNobody}
+	res, err = msgServer.StoreCode(sdk.WrapSDKContext(ctx), storeMsg)
+	require.NoError(t, err)
+
+	_, _, err = keeper.InstantiateContract(ctx, res.CodeID, creator, sdk.AccAddress{}, initMsgBz, nil)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+}
+
 func TestInstantiateWithNonExistingCodeID(t *testing.T) {
 	input := CreateTestInput(t)
 	ctx, accKeeper, bankKeeper, keeper := input.Ctx, input.AccKeeper, input.BankKeeper, input.WasmKeeper
@@ -232,7 +419,7 @@ func TestExecute(t *testing.T) {
 
 	// make sure gas is properly deducted from ctx
 	gasAfter := ctx.GasMeter().GasConsumed()
-	require.True(t, gasAfter-gasBefore > types.InstantiateContractCosts(0))
+	require.True(t, gasAfter-gasBefore > types.InstantiateContractCosts(false, 0))
 
 	// ensure bob now exists and got both payments released
 	bobAcct = accKeeper.GetAccount(ctx, bob)
@@ -413,6 +600,8 @@ func TestMigrate(t *testing.T) {
 	newCodeID, err := keeper.StoreCode(ctx, creator, wasmCode)
 	require.NoError(t, err)
 	require.NotEqual(t, originalCodeID, newCodeID)
+	nobodyCodeID, err := keeper.create(ctx, creator, wasmCode, &types.AccessConfig{Permission: types.AccessTypeNobody}, types.CodeMetadata{}, defaultAuthorizationPolicy{})
+	require.NoError(t, err)
 
 	_, _, anyAddr := keyPubAddr()
 	_, _, newVerifierAddr := keyPubAddr()
@@ -470,6 +659,13 @@ func TestMigrate(t *testing.T) {
 			codeID: originalCodeID,
 			expErr: sdkerrors.ErrUnauthorized,
 		},
+		"prevent migration to code the caller can not instantiate": {
+			admin:      creator,
+			caller:     creator,
+			codeID:     nobodyCodeID,
+			migrateMsg: migMsgBz,
+			expErr:     sdkerrors.ErrUnauthorized,
+		},
 		"fail with non existing code id": {
 			admin:  creator,
 			caller: creator,
@@ -0,0 +1,85 @@
+package keeper
+
+import (
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	channeltypes "github.com/cosmos/ibc-go/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/modules/core/24-host"
+
+	"github.com/terra-money/core/x/wasm/types"
+)
+
+// ensureIBCPort binds the ibc port of the contract, unless it is already owned by the wasm module
+func (k Keeper) ensureIBCPort(ctx sdk.Context, contractAddr sdk.AccAddress) (string, error) {
+	portID := types.PortIDForContract(contractAddr)
+	if _, ok := k.capabilityKeeper.GetCapability(ctx, host.PortPath(portID)); ok {
+		return portID, nil
+	}
+
+	portCap := k.portKeeper.BindPort(ctx, portID)
+	if err := k.ClaimCapability(ctx, portCap, host.PortPath(portID)); err != nil {
+		return "", sdkerrors.Wrapf(err, "claim port capability %s", portID)
+	}
+
+	return portID, nil
+}
+
+// ClaimCapability allows the wasm module to claim a capability that the ibc module passes to it
+func (k Keeper) ClaimCapability(ctx sdk.Context, cap *capabilitytypes.Capability, name string) error {
+	return k.capabilityKeeper.ClaimCapability(ctx, cap, name)
+}
+
+// AuthenticateCapability wraps the scoped keeper's AuthenticateCapability function
+func (k Keeper) AuthenticateCapability(ctx sdk.Context, cap *capabilitytypes.Capability, name string) bool {
+	return k.capabilityKeeper.AuthenticateCapability(ctx, cap, name)
+}
+
+// sendIBCPacket sends a raw packet from the contract port. There is no sdk msg
+// for it, so the keeper sends it on behalf of the contract.
+func (k Keeper) sendIBCPacket(ctx sdk.Context, contractAddr sdk.AccAddress, msg *wasmvmtypes.SendPacketMsg) (sdk.Events, error) {
+	contractInfo, err := k.GetContractInfo(ctx, contractAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	sourcePort := contractInfo.IBCPortID
+	if sourcePort == "" {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidIBCPort, "contract %s has no ibc port", contractAddr)
+	}
+
+	channel, found := k.channelKeeper.GetChannel(ctx, sourcePort, msg.ChannelID)
+	if !found {
+		return nil, sdkerrors.Wrapf(channeltypes.ErrChannelNotFound, "port ID (%s) channel ID (%s)", sourcePort, msg.ChannelID)
+	}
+
+	sequence, found := k.channelKeeper.GetNextSequenceSend(ctx, sourcePort, msg.ChannelID)
+	if !found {
+		return nil, sdkerrors.Wrapf(channeltypes.ErrSequenceSendNotFound, "source port: %s, source channel: %s", sourcePort, msg.ChannelID)
+	}
+
+	channelCap, ok := k.capabilityKeeper.GetCapability(ctx, host.ChannelCapabilityPath(sourcePort, msg.ChannelID))
+	if !ok {
+		return nil, sdkerrors.Wrap(channeltypes.ErrChannelCapabilityNotFound, "module does not own channel capability")
+	}
+
+	packet := channeltypes.NewPacket(
+		msg.Data,
+		sequence,
+		sourcePort,
+		msg.ChannelID,
+		channel.Counterparty.PortId,
+		channel.Counterparty.ChannelId,
+		types.NewIBCTimeoutHeight(msg.Timeout.Block),
+		msg.Timeout.Timestamp,
+	)
+
+	eventManager := sdk.NewEventManager()
+	if err := k.channelKeeper.SendPacket(ctx.WithEventManager(eventManager), channelCap, packet); err != nil {
+		return nil, err
+	}
+
+	return eventManager.Events(), nil
+}
@@ -0,0 +1,57 @@
+package keeper
+
+import (
+	"bytes"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/terra-money/core/x/wasm/types"
+)
+
+// contractIndexKeys returns the code, creator and admin index keys of the contract
+func contractIndexKeys(contractAddress sdk.AccAddress, contractInfo types.ContractInfo) [][]byte {
+	keys := [][]byte{types.GetContractByCodeIndexKey(contractInfo.CodeID, contractAddress)}
+
+	if creatorAddr, err := sdk.AccAddressFromBech32(contractInfo.Creator); err == nil {
+		keys = append(keys, types.GetContractByCreatorIndexKey(creatorAddr, contractAddress))
+	}
+
+	if adminAddr, err := sdk.AccAddressFromBech32(contractInfo.Admin); err == nil {
+		keys = append(keys, types.GetContractByAdminIndexKey(adminAddr, contractAddress))
+	}
+
+	return keys
+}
+
+// updateContractIndexes removes the stale index entries of the previous contract info
+// and adds the missing entries of the new one; prevContractInfo is nil for a new contract
+func updateContractIndexes(store sdk.KVStore, contractAddress sdk.AccAddress, prevContractInfo *types.ContractInfo, contractInfo types.ContractInfo) {
+	var prevKeys [][]byte
+	if prevContractInfo != nil {
+		prevKeys = contractIndexKeys(contractAddress, *prevContractInfo)
+	}
+
+	keys := contractIndexKeys(contractAddress, contractInfo)
+
+	for _, key := range prevKeys {
+		if !containsKey(keys, key) {
+			store.Delete(key)
+		}
+	}
+
+	for _, key := range keys {
+		if !containsKey(prevKeys, key) {
+			store.Set(key, []byte{1})
+		}
+	}
+}
+
+func containsKey(keys [][]byte, key []byte) bool {
+	for _, k := range keys {
+		if bytes.Equal(k, key) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -15,6 +15,7 @@ import (
 
 	wasmvm "github.com/CosmWasm/wasmvm"
 
+	customauthtypes "github.com/terra-money/core/custom/auth/types"
 	"github.com/terra-money/core/x/wasm/config"
 	"github.com/terra-money/core/x/wasm/types"
 )
@@ -29,6 +30,13 @@ type Keeper struct {
 	bankKeeper     types.BankKeeper
 	treasuryKeeper types.TreasuryKeeper
 
+	// taxableMsgRegistry reports the taxable principals of the dispatched msgs
+	taxableMsgRegistry customauthtypes.TaxableMsgRegistry
+
+	channelKeeper    types.ChannelKeeper
+	portKeeper       types.PortKeeper
+	capabilityKeeper types.CapabilityKeeper
+
 	serviceRouter types.MsgServiceRouter
 	queryRouter   types.GRPCQueryRouter
 
@@ -49,6 +57,10 @@ func NewKeeper(
 	accountKeeper types.AccountKeeper,
 	bankKeeper types.BankKeeper,
 	treasuryKeeper types.TreasuryKeeper,
+	taxableMsgRegistry customauthtypes.TaxableMsgRegistry,
+	channelKeeper types.ChannelKeeper,
+	portKeeper types.PortKeeper,
+	capabilityKeeper types.CapabilityKeeper,
 	serviceRouter types.MsgServiceRouter,
 	queryRouter types.GRPCQueryRouter,
 	supportedFeatures string,
@@ -78,18 +90,22 @@ func NewKeeper(
 	}
 
 	return Keeper{
-		storeKey:       storeKey,
-		cdc:            cdc,
-		paramSpace:     paramspace,
-		wasmVM:         vm,
-		accountKeeper:  accountKeeper,
-		bankKeeper:     bankKeeper,
-		treasuryKeeper: treasuryKeeper,
-		serviceRouter:  serviceRouter,
-		queryRouter:    queryRouter,
-		wasmConfig:     wasmConfig,
-		msgParser:      types.NewWasmMsgParser(),
-		querier:        types.NewWasmQuerier(),
+		storeKey:           storeKey,
+		cdc:                cdc,
+		paramSpace:         paramspace,
+		wasmVM:             vm,
+		accountKeeper:      accountKeeper,
+		bankKeeper:         bankKeeper,
+		treasuryKeeper:     treasuryKeeper,
+		taxableMsgRegistry: taxableMsgRegistry,
+		channelKeeper:      channelKeeper,
+		portKeeper:         portKeeper,
+		capabilityKeeper:   capabilityKeeper,
+		serviceRouter:      serviceRouter,
+		queryRouter:        queryRouter,
+		wasmConfig:         wasmConfig,
+		msgParser:          types.NewWasmMsgParser(),
+		querier:            types.NewWasmQuerier(),
 	}
 }
 
@@ -152,6 +168,22 @@ func (k Keeper) SetCodeInfo(ctx sdk.Context, codeID uint64, codeInfo types.CodeI
 	store.Set(types.GetCodeInfoKey(codeID), bz)
 }
 
+// IterateCodeInfo iterates all code infos
+func (k Keeper) IterateCodeInfo(ctx sdk.Context, cb func(types.CodeInfo) bool) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.CodeKey)
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var codeInfo types.CodeInfo
+		k.cdc.MustUnmarshal(iter.Value(), &codeInfo)
+		// cb returns true to stop early
+		if cb(codeInfo) {
+			break
+		}
+	}
+}
+
 // GetContractInfo returns contract info of the given address
 func (k Keeper) GetContractInfo(ctx sdk.Context, contractAddress sdk.AccAddress) (contractInfo types.ContractInfo, err error) {
 	store := ctx.KVStore(k.storeKey)
@@ -164,10 +196,20 @@ func (k Keeper) GetContractInfo(ctx sdk.Context, contractAddress sdk.AccAddress)
 }
 
 // SetContractInfo stores ContractInfo for the given contractAddress
+// and keeps the secondary indexes of the contract up to date
 func (k Keeper) SetContractInfo(ctx sdk.Context, contractAddress sdk.AccAddress, codeInfo types.ContractInfo) {
 	store := ctx.KVStore(k.storeKey)
+	key := types.GetContractInfoKey(contractAddress)
+
+	var prevContractInfo *types.ContractInfo
+	if bz := store.Get(key); bz != nil {
+		prevContractInfo = &types.ContractInfo{}
+		k.cdc.MustUnmarshal(bz, prevContractInfo)
+	}
+
 	b := k.cdc.MustMarshal(&codeInfo)
-	store.Set(types.GetContractInfoKey(contractAddress), b)
+	store.Set(key, b)
+	updateContractIndexes(store, contractAddress, prevContractInfo, codeInfo)
 }
 
 // IterateContractInfo iterates all contract infos
@@ -184,6 +226,42 @@ func (k Keeper) IterateContractInfo(ctx sdk.Context, cb func(types.ContractInfo)
 	}
 }
 
+// AppendContractHistory appends the entries to the code history of the contract
+func (k Keeper) AppendContractHistory(ctx sdk.Context, contractAddress sdk.AccAddress, entries ...types.ContractCodeHistoryEntry) {
+	store := ctx.KVStore(k.storeKey)
+	prefixStore := prefix.NewStore(store, types.GetContractCodeHistoryPrefix(contractAddress))
+
+	// the next sequence follows the last stored entry
+	var sequence uint64
+	iter := prefixStore.ReverseIterator(nil, nil)
+	if iter.Valid() {
+		sequence = sdk.BigEndianToUint64(iter.Key()) + 1
+	}
+	iter.Close()
+
+	for _, entry := range entries {
+		bz := k.cdc.MustMarshal(&entry)
+		store.Set(types.GetContractCodeHistoryKey(contractAddress, sequence), bz)
+		sequence++
+	}
+}
+
+// GetContractHistory returns the code history of the contract in the recorded order
+func (k Keeper) GetContractHistory(ctx sdk.Context, contractAddress sdk.AccAddress) []types.ContractCodeHistoryEntry {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetContractCodeHistoryPrefix(contractAddress))
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	var entries []types.ContractCodeHistoryEntry
+	for ; iter.Valid(); iter.Next() {
+		var entry types.ContractCodeHistoryEntry
+		k.cdc.MustUnmarshal(iter.Value(), &entry)
+		entries = append(entries, entry)
+	}
+
+	return entries
+}
+
 // GetContractStoreIterator returns iterator for a contract store
 func (k Keeper) GetContractStoreIterator(ctx sdk.Context, contractAddress sdk.AccAddress) sdk.Iterator {
 	prefixStoreKey := types.GetContractStoreKey(contractAddress)
@@ -219,6 +297,7 @@ func (k Keeper) GetByteCode(ctx sdk.Context, codeID uint64) ([]byte, error) {
 func (k *Keeper) RegisterMsgParsers(
 	parsers map[string]types.WasmMsgParserInterface,
 	stargateWasmMsgParser types.StargateWasmMsgParserInterface,
+	ibcWasmMsgParser types.IBCWasmMsgParserInterface,
 ) {
 	for route, parser := range parsers {
 		k.msgParser.Parsers[route] = parser
@@ -227,6 +306,10 @@ func (k *Keeper) RegisterMsgParsers(
 	if stargateWasmMsgParser != nil {
 		k.msgParser.StargateParser = stargateWasmMsgParser
 	}
+
+	if ibcWasmMsgParser != nil {
+		k.msgParser.IBCParser = ibcWasmMsgParser
+	}
 }
 
 // RegisterQueriers register module queriers
@@ -22,7 +22,7 @@ func TestCodeInfo(t *testing.T) {
 
 	codeID := uint64(1)
 	_, _, creatorAddr := keyPubAddr()
-	expected := types.NewCodeInfo(codeID, []byte{1, 2, 3}, creatorAddr)
+	expected := types.NewCodeInfo(codeID, []byte{1, 2, 3}, creatorAddr, types.AllowEverybody)
 	keeper.SetCodeInfo(ctx, 1, expected)
 
 	as, err := keeper.GetCodeInfo(ctx, codeID)
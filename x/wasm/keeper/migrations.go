@@ -0,0 +1,108 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/terra-money/core/x/wasm/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates from version 1 to 2. The access config params are set to
+// their defaults and the codes stored before version 2 can be instantiated by everybody.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	defaultParams := types.DefaultParams()
+	for _, pair := range defaultParams.ParamSetPairs() {
+		if !m.keeper.paramSpace.Has(ctx, pair.Key) {
+			m.keeper.paramSpace.Set(ctx, pair.Key, pair.Value)
+		}
+	}
+
+	var codeInfos []types.CodeInfo
+	m.keeper.IterateCodeInfo(ctx, func(codeInfo types.CodeInfo) bool {
+		if codeInfo.InstantiatePermission.Permission == types.AccessTypeUnspecified {
+			codeInfos = append(codeInfos, codeInfo)
+		}
+
+		return false
+	})
+
+	for _, codeInfo := range codeInfos {
+		codeInfo.InstantiatePermission = types.AllowEverybody
+		m.keeper.SetCodeInfo(ctx, codeInfo.CodeID, codeInfo)
+	}
+
+	return nil
+}
+
+// Migrate2to3 migrates from version 2 to 3. The code, creator and admin indexes
+// are backfilled for the contracts instantiated before version 3.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	var contractInfos []types.ContractInfo
+	m.keeper.IterateContractInfo(ctx, func(contractInfo types.ContractInfo) bool {
+		contractInfos = append(contractInfos, contractInfo)
+		return false
+	})
+
+	store := ctx.KVStore(m.keeper.storeKey)
+	for _, contractInfo := range contractInfos {
+		contractAddr, err := sdk.AccAddressFromBech32(contractInfo.Address)
+		if err != nil {
+			return err
+		}
+
+		updateContractIndexes(store, contractAddr, nil, contractInfo)
+	}
+
+	return nil
+}
+
+// Migrate3to4 migrates from version 3 to 4. The stargate query allowlist is set
+// to its default, which replaces the path blacklist of the previous versions.
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	if !m.keeper.paramSpace.Has(ctx, types.KeyStargateQueryAllowlist) {
+		m.keeper.paramSpace.Set(ctx, types.KeyStargateQueryAllowlist, types.DefaultStargateQueryAllowlist)
+	}
+
+	return nil
+}
+
+// Migrate4to5 migrates from version 4 to 5. An init entry is recorded in the code
+// history of the contracts instantiated before version 5, from their current code
+// id, creator and init msg; the height of their instantiation is unknown.
+func (m Migrator) Migrate4to5(ctx sdk.Context) error {
+	var contractInfos []types.ContractInfo
+	m.keeper.IterateContractInfo(ctx, func(contractInfo types.ContractInfo) bool {
+		contractInfos = append(contractInfos, contractInfo)
+		return false
+	})
+
+	for _, contractInfo := range contractInfos {
+		contractAddr, err := sdk.AccAddressFromBech32(contractInfo.Address)
+		if err != nil {
+			return err
+		}
+
+		if len(m.keeper.GetContractHistory(ctx, contractAddr)) != 0 {
+			continue
+		}
+
+		creatorAddr, err := sdk.AccAddressFromBech32(contractInfo.Creator)
+		if err != nil {
+			return err
+		}
+
+		m.keeper.AppendContractHistory(ctx, contractAddr, types.NewContractCodeHistoryEntry(
+			types.ContractCodeHistoryOperationTypeInit, contractInfo.CodeID, 0, creatorAddr, contractInfo.InitMsg))
+	}
+
+	return nil
+}
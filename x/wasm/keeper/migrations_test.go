@@ -0,0 +1,146 @@
+package keeper
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/terra-money/core/x/wasm/types"
+)
+
+func TestMigrate1to2(t *testing.T) {
+	input := CreateTestInput(t)
+	ctx, keeper := input.Ctx, input.WasmKeeper
+
+	_, _, creator := keyPubAddr()
+	keeper.SetCodeInfo(ctx, 1, types.CodeInfo{CodeID: 1, CodeHash: []byte{1, 2, 3}, Creator: creator.String()})
+	keeper.SetCodeInfo(ctx, 2, types.NewCodeInfo(2, []byte{1, 2, 3}, creator, types.AllowNobody))
+
+	migrator := NewMigrator(keeper)
+	require.NoError(t, migrator.Migrate1to2(ctx))
+
+	codeInfo, err := keeper.GetCodeInfo(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, types.AllowEverybody, codeInfo.InstantiatePermission)
+
+	codeInfo, err = keeper.GetCodeInfo(ctx, 2)
+	require.NoError(t, err)
+	require.Equal(t, types.AllowNobody, codeInfo.InstantiatePermission)
+}
+
+func TestMigrate2to3(t *testing.T) {
+	input := CreateTestInput(t)
+	ctx, keeper := input.Ctx, input.WasmKeeper
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	_, _, creator := keyPubAddr()
+	_, _, admin := keyPubAddr()
+	_, _, contractAddr := keyPubAddr()
+
+	// store the contract info without indexes as version 2 did
+	contractInfo := types.NewContractInfo(1, contractAddr, creator, admin, []byte("{}"))
+	ctx.KVStore(keeper.storeKey).Set(types.GetContractInfoKey(contractAddr), keeper.cdc.MustMarshal(&contractInfo))
+
+	querier := NewQuerier(keeper)
+	res, err := querier.ContractsByCode(goCtx, &types.QueryContractsByCodeRequest{CodeId: 1})
+	require.NoError(t, err)
+	require.Empty(t, res.ContractAddresses)
+
+	migrator := NewMigrator(keeper)
+	require.NoError(t, migrator.Migrate2to3(ctx))
+
+	res, err = querier.ContractsByCode(goCtx, &types.QueryContractsByCodeRequest{CodeId: 1})
+	require.NoError(t, err)
+	require.Equal(t, []string{contractAddr.String()}, res.ContractAddresses)
+
+	creatorRes, err := querier.ContractsByCreator(goCtx, &types.QueryContractsByCreatorRequest{CreatorAddress: creator.String()})
+	require.NoError(t, err)
+	require.Equal(t, []string{contractAddr.String()}, creatorRes.ContractAddresses)
+
+	adminRes, err := querier.ContractsByAdmin(goCtx, &types.QueryContractsByAdminRequest{AdminAddress: admin.String()})
+	require.NoError(t, err)
+	require.Equal(t, []string{contractAddr.String()}, adminRes.ContractAddresses)
+}
+
+func TestMigrate3to4(t *testing.T) {
+	input := CreateTestInput(t)
+	ctx, keeper := input.Ctx, input.WasmKeeper
+
+	// the allowlist configured by governance is kept
+	allowlist := []types.StargateQuery{
+		{Path: "/cosmos.bank.v1beta1.Query/Balance", ResponseType: "cosmos.bank.v1beta1.QueryBalanceResponse"},
+	}
+	params := keeper.GetParams(ctx)
+	params.StargateQueryAllowlist = allowlist
+	keeper.SetParams(ctx, params)
+
+	migrator := NewMigrator(keeper)
+	require.NoError(t, migrator.Migrate3to4(ctx))
+	require.Equal(t, allowlist, keeper.StargateQueryAllowlist(ctx))
+
+	// the version 3 params store does not have the allowlist
+	encodingConfig := MakeEncodingConfig(t)
+	keyParams := sdk.NewKVStoreKey(paramstypes.StoreKey)
+	tKeyParams := sdk.NewTransientStoreKey(paramstypes.TStoreKey)
+	db := dbm.NewMemDB()
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(keyParams, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(tKeyParams, sdk.StoreTypeTransient, db)
+	require.NoError(t, ms.LoadLatestVersion())
+	ctx = sdk.NewContext(ms, tmproto.Header{}, false, log.NewNopLogger())
+
+	keeper.paramSpace = paramstypes.NewSubspace(encodingConfig.Marshaler, encodingConfig.Amino, keyParams, tKeyParams, types.ModuleName).
+		WithKeyTable(types.ParamKeyTable())
+
+	defaultParams := types.DefaultParams()
+	for _, pair := range defaultParams.ParamSetPairs() {
+		if !bytes.Equal(pair.Key, types.KeyStargateQueryAllowlist) {
+			keeper.paramSpace.Set(ctx, pair.Key, pair.Value)
+		}
+	}
+	require.False(t, keeper.paramSpace.Has(ctx, types.KeyStargateQueryAllowlist))
+
+	migrator = NewMigrator(keeper)
+	require.NoError(t, migrator.Migrate3to4(ctx))
+	require.Equal(t, types.DefaultStargateQueryAllowlist, keeper.StargateQueryAllowlist(ctx))
+	require.Equal(t, defaultParams, keeper.GetParams(ctx))
+}
+
+func TestMigrate4to5(t *testing.T) {
+	input := CreateTestInput(t)
+	ctx, keeper := input.Ctx, input.WasmKeeper
+
+	_, _, creator := keyPubAddr()
+	_, _, contractAddr := keyPubAddr()
+	_, _, otherContractAddr := keyPubAddr()
+
+	// the contract instantiated before version 5 has no code history
+	contractInfo := types.NewContractInfo(2, contractAddr, creator, nil, []byte(`{"verifier":"fred"}`))
+	keeper.SetContractInfo(ctx, contractAddr, contractInfo)
+
+	otherContractInfo := types.NewContractInfo(3, otherContractAddr, creator, nil, []byte("{}"))
+	keeper.SetContractInfo(ctx, otherContractAddr, otherContractInfo)
+	otherHistory := []types.ContractCodeHistoryEntry{
+		types.NewContractCodeHistoryEntry(types.ContractCodeHistoryOperationTypeInit, 3, 10, creator, []byte("{}")),
+	}
+	keeper.AppendContractHistory(ctx, otherContractAddr, otherHistory...)
+
+	migrator := NewMigrator(keeper)
+	require.NoError(t, migrator.Migrate4to5(ctx))
+
+	history := keeper.GetContractHistory(ctx, contractAddr)
+	require.Equal(t, []types.ContractCodeHistoryEntry{
+		types.NewContractCodeHistoryEntry(types.ContractCodeHistoryOperationTypeInit, 2, 0, creator, []byte(`{"verifier":"fred"}`)),
+	}, history)
+	require.Equal(t, creator.String(), history[0].Sender)
+	require.Equal(t, otherHistory, keeper.GetContractHistory(ctx, otherContractAddr))
+}
@@ -29,7 +29,7 @@ func (k msgServer) StoreCode(goCtx context.Context, msg *types.MsgStoreCode) (*t
 		return nil, err
 	}
 
-	codeID, err := k.Keeper.StoreCode(ctx, senderAddr, msg.WASMByteCode)
+	codeID, err := k.Keeper.create(ctx, senderAddr, msg.WASMByteCode, msg.InstantiatePermission, msg.Metadata, defaultAuthorizationPolicy{})
 	if err != nil {
 		return nil, err
 	}
@@ -104,13 +104,16 @@ func (k msgServer) InstantiateContract(goCtx context.Context, msg *types.MsgInst
 	}
 
 	subCtx := ctx.WithEventManager(sdk.NewEventManager()).WithGasMeter(sdk.NewGasMeter(remain))
-	contractAddr, data, err := k.Keeper.InstantiateContract(
+	contractAddr, data, err := k.Keeper.instantiate(
 		subCtx,
 		msg.CodeID,
 		senderAddr,
 		adminAddr,
 		msg.InitMsg,
 		msg.InitCoins,
+		msg.Label,
+		nil,
+		defaultAuthorizationPolicy{},
 	)
 	if err != nil {
 		return nil, err
@@ -143,6 +146,70 @@ func (k msgServer) InstantiateContract(goCtx context.Context, msg *types.MsgInst
 	}, nil
 }
 
+func (k msgServer) InstantiateContract2(goCtx context.Context, msg *types.MsgInstantiateContract2) (*types.MsgInstantiateContract2Response, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	adminAddr := sdk.AccAddress{}
+	if len(msg.Admin) != 0 {
+		adminAddr, err = sdk.AccAddressFromBech32(msg.Admin)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	maxGas := k.MaxContractGas(ctx)
+	remain := ctx.GasMeter().Limit() - ctx.GasMeter().GasConsumed()
+	if remain > maxGas {
+		remain = maxGas
+	}
+
+	subCtx := ctx.WithEventManager(sdk.NewEventManager()).WithGasMeter(sdk.NewGasMeter(remain))
+	contractAddr, data, err := k.Keeper.instantiate(
+		subCtx,
+		msg.CodeID,
+		senderAddr,
+		adminAddr,
+		msg.InitMsg,
+		msg.InitCoins,
+		msg.Label,
+		msg.Salt,
+		defaultAuthorizationPolicy{},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// consume gas used from wasm execution
+	ctx.GasMeter().ConsumeGas(subCtx.GasMeter().GasConsumed(), "wasm vm execute")
+
+	// prepend the event to keep the events order
+	ctx.EventManager().EmitEvents(
+		sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeInstantiateContract,
+				sdk.NewAttribute(types.AttributeKeyCreator, msg.Sender),
+				sdk.NewAttribute(types.AttributeKeyAdmin, msg.Admin),
+				sdk.NewAttribute(types.AttributeKeyCodeID, fmt.Sprintf("%d", msg.CodeID)),
+				sdk.NewAttribute(types.AttributeKeyContractAddress, contractAddr.String()),
+			),
+			sdk.NewEvent(
+				sdk.EventTypeMessage,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+				sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+			),
+		}.AppendEvents(subCtx.EventManager().Events()),
+	)
+
+	return &types.MsgInstantiateContract2Response{
+		ContractAddress: contractAddr.String(),
+		Data:            data,
+	}, nil
+}
+
 func (k msgServer) ExecuteContract(goCtx context.Context, msg *types.MsgExecuteContract) (*types.MsgExecuteContractResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
@@ -261,7 +328,7 @@ func (k msgServer) UpdateContractAdmin(goCtx context.Context, msg *types.MsgUpda
 		return nil, err
 	}
 
-	_, err = sdk.AccAddressFromBech32(msg.Admin)
+	adminAddr, err := sdk.AccAddressFromBech32(msg.Admin)
 	if err != nil {
 		return nil, err
 	}
@@ -282,6 +349,8 @@ func (k msgServer) UpdateContractAdmin(goCtx context.Context, msg *types.MsgUpda
 
 	contractInfo.Admin = msg.NewAdmin
 	k.SetContractInfo(ctx, contractAddr, contractInfo)
+	k.AppendContractHistory(ctx, contractAddr, types.NewContractCodeHistoryEntry(
+		types.ContractCodeHistoryOperationTypeUpdateAdmin, contractInfo.CodeID, ctx.BlockHeight(), adminAddr, nil))
 
 	ctx.EventManager().EmitEvents(
 		sdk.Events{
@@ -308,7 +377,7 @@ func (k msgServer) ClearContractAdmin(goCtx context.Context, msg *types.MsgClear
 		return nil, err
 	}
 
-	_, err = sdk.AccAddressFromBech32(msg.Admin)
+	adminAddr, err := sdk.AccAddressFromBech32(msg.Admin)
 	if err != nil {
 		return nil, err
 	}
@@ -324,6 +393,8 @@ func (k msgServer) ClearContractAdmin(goCtx context.Context, msg *types.MsgClear
 
 	contractInfo.Admin = ""
 	k.SetContractInfo(ctx, contractAddr, contractInfo)
+	k.AppendContractHistory(ctx, contractAddr, types.NewContractCodeHistoryEntry(
+		types.ContractCodeHistoryOperationTypeClearAdmin, contractInfo.CodeID, ctx.BlockHeight(), adminAddr, nil))
 
 	ctx.EventManager().EmitEvents(
 		sdk.Events{
@@ -340,3 +411,87 @@ func (k msgServer) ClearContractAdmin(goCtx context.Context, msg *types.MsgClear
 
 	return &types.MsgClearContractAdminResponse{}, nil
 }
+
+func (k msgServer) UpdateContractLabel(goCtx context.Context, msg *types.MsgUpdateContractLabel) (*types.MsgUpdateContractLabelResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	contractAddr, err := sdk.AccAddressFromBech32(msg.Contract)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	contractInfo, err := k.GetContractInfo(ctx, contractAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	// the creator keeps the label of the contract without admin
+	owner := contractInfo.Admin
+	if owner == "" {
+		owner = contractInfo.Creator
+	}
+
+	if owner != msg.Sender {
+		return nil, sdkerrors.ErrUnauthorized
+	}
+
+	contractInfo.Label = msg.NewLabel
+	k.SetContractInfo(ctx, contractAddr, contractInfo)
+
+	ctx.EventManager().EmitEvents(
+		sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeUpdateContractLabel,
+				sdk.NewAttribute(types.AttributeKeyLabel, msg.NewLabel),
+				sdk.NewAttribute(types.AttributeKeyContractAddress, msg.Contract),
+			),
+			sdk.NewEvent(
+				sdk.EventTypeMessage,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			),
+		},
+	)
+
+	return &types.MsgUpdateContractLabelResponse{}, nil
+}
+
+func (k msgServer) UpdateCodeMetadata(goCtx context.Context, msg *types.MsgUpdateCodeMetadata) (*types.MsgUpdateCodeMetadataResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	codeInfo, err := k.GetCodeInfo(ctx, msg.CodeID)
+	if err != nil {
+		return nil, err
+	}
+
+	if codeInfo.Creator != msg.Sender {
+		return nil, sdkerrors.ErrUnauthorized
+	}
+
+	codeInfo.Metadata = msg.Metadata
+	k.SetCodeInfo(ctx, msg.CodeID, codeInfo)
+
+	ctx.EventManager().EmitEvents(
+		sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeUpdateCodeMetadata,
+				sdk.NewAttribute(types.AttributeKeyCodeID, fmt.Sprintf("%d", msg.CodeID)),
+			),
+			sdk.NewEvent(
+				sdk.EventTypeMessage,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			),
+		},
+	)
+
+	return &types.MsgUpdateCodeMetadataResponse{}, nil
+}
@@ -10,6 +10,8 @@ import (
 	"github.com/terra-money/core/x/wasm/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
 )
 
 func TestInstantiateExceedMaxGas(t *testing.T) {
@@ -39,7 +41,7 @@ func TestInstantiateExceedMaxGas(t *testing.T) {
 	// must panic
 	require.Panics(t, func() {
 		params := keeper.GetParams(ctx)
-		params.MaxContractGas = types.InstantiateContractCosts(0) + 1
+		params.MaxContractGas = types.InstantiateContractCosts(false, 0) + 1
 		keeper.SetParams(ctx, params)
 		NewMsgServerImpl(keeper).InstantiateContract(ctx.Context(), types.NewMsgInstantiateContract(creator, sdk.AccAddress{}, codeID, initMsgBz, nil))
 	})
@@ -74,7 +76,7 @@ func TestExecuteExceedMaxGas(t *testing.T) {
 	// must panic
 	require.Panics(t, func() {
 		params := keeper.GetParams(ctx)
-		params.MaxContractGas = types.InstantiateContractCosts(0) + 1
+		params.MaxContractGas = types.InstantiateContractCosts(false, 0) + 1
 		keeper.SetParams(ctx, params)
 		NewMsgServerImpl(keeper).ExecuteContract(ctx.Context(), types.NewMsgExecuteContract(creator, addr, []byte(`{"release":{}}`), nil))
 	})
@@ -109,8 +111,158 @@ func TestMigrateExceedMaxGas(t *testing.T) {
 	// must panic
 	require.Panics(t, func() {
 		params := keeper.GetParams(ctx)
-		params.MaxContractGas = types.InstantiateContractCosts(0) + 1
+		params.MaxContractGas = types.InstantiateContractCosts(false, 0) + 1
 		keeper.SetParams(ctx, params)
 		NewMsgServerImpl(keeper).MigrateContract(ctx.Context(), types.NewMsgMigrateContract(creator, addr, codeID, []byte(`{"release":{}}`)))
 	})
 }
+
+func TestContractHistory(t *testing.T) {
+	input := CreateTestInput(t)
+	ctx, accKeeper, bankKeeper, keeper := input.Ctx, input.AccKeeper, input.BankKeeper, input.WasmKeeper
+
+	deposit := sdk.NewCoins(sdk.NewInt64Coin(core.MicroLunaDenom, 100000))
+	creator := createFakeFundedAccount(ctx, accKeeper, bankKeeper, deposit)
+	newAdmin := createFakeFundedAccount(ctx, accKeeper, bankKeeper, deposit)
+
+	wasmCode, err := ioutil.ReadFile("./testdata/hackatom.wasm")
+	require.NoError(t, err)
+
+	codeID, err := keeper.StoreCode(ctx, creator, wasmCode)
+	require.NoError(t, err)
+	newCodeID, err := keeper.StoreCode(ctx, creator, wasmCode)
+	require.NoError(t, err)
+
+	_, _, bob := keyPubAddr()
+	_, _, fred := keyPubAddr()
+
+	initMsgBz, err := json.Marshal(HackatomExampleInitMsg{
+		Verifier:    fred,
+		Beneficiary: bob,
+	})
+	require.NoError(t, err)
+
+	migrateMsgBz, err := json.Marshal(struct {
+		Verifier sdk.AccAddress `json:"verifier"`
+	}{Verifier: bob})
+	require.NoError(t, err)
+
+	msgServer := NewMsgServerImpl(keeper)
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	res, err := msgServer.InstantiateContract(goCtx, types.NewMsgInstantiateContract(creator, creator, codeID, initMsgBz, nil))
+	require.NoError(t, err)
+	contractAddr, err := sdk.AccAddressFromBech32(res.ContractAddress)
+	require.NoError(t, err)
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	goCtx = sdk.WrapSDKContext(ctx)
+	_, err = msgServer.MigrateContract(goCtx, types.NewMsgMigrateContract(creator, contractAddr, newCodeID, migrateMsgBz))
+	require.NoError(t, err)
+
+	_, err = msgServer.UpdateContractAdmin(goCtx, types.NewMsgUpdateContractAdmin(creator, newAdmin, contractAddr))
+	require.NoError(t, err)
+
+	_, err = msgServer.ClearContractAdmin(goCtx, types.NewMsgClearContractAdmin(newAdmin, contractAddr))
+	require.NoError(t, err)
+
+	height := ctx.BlockHeight()
+	require.Equal(t, []types.ContractCodeHistoryEntry{
+		types.NewContractCodeHistoryEntry(types.ContractCodeHistoryOperationTypeInit, codeID, height-1, creator, initMsgBz),
+		types.NewContractCodeHistoryEntry(types.ContractCodeHistoryOperationTypeMigrate, newCodeID, height, creator, migrateMsgBz),
+		types.NewContractCodeHistoryEntry(types.ContractCodeHistoryOperationTypeUpdateAdmin, newCodeID, height, creator, nil),
+		types.NewContractCodeHistoryEntry(types.ContractCodeHistoryOperationTypeClearAdmin, newCodeID, height, newAdmin, nil),
+	}, keeper.GetContractHistory(ctx, contractAddr))
+
+	// query the history with pagination
+	queryRes, err := NewQuerier(keeper).ContractHistory(goCtx, &types.QueryContractHistoryRequest{
+		ContractAddress: contractAddr.String(),
+		Pagination:      &query.PageRequest{Offset: 1, Limit: 2},
+	})
+	require.NoError(t, err)
+	require.Len(t, queryRes.Entries, 2)
+	require.Equal(t, types.ContractCodeHistoryOperationTypeMigrate, queryRes.Entries[0].Operation)
+	require.Equal(t, types.ContractCodeHistoryOperationTypeUpdateAdmin, queryRes.Entries[1].Operation)
+}
+
+func TestUpdateContractLabelAndCodeMetadata(t *testing.T) {
+	input := CreateTestInput(t)
+	ctx, accKeeper, bankKeeper, keeper := input.Ctx, input.AccKeeper, input.BankKeeper, input.WasmKeeper
+
+	deposit := sdk.NewCoins(sdk.NewInt64Coin(core.MicroLunaDenom, 100000))
+	creator := createFakeFundedAccount(ctx, accKeeper, bankKeeper, deposit)
+	admin := createFakeFundedAccount(ctx, accKeeper, bankKeeper, deposit)
+
+	wasmCode, err := ioutil.ReadFile("./testdata/hackatom.wasm")
+	require.NoError(t, err)
+
+	msgServer := NewMsgServerImpl(keeper)
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	storeMsg := types.NewMsgStoreCode(creator, wasmCode)
+	storeMsg.Metadata = types.CodeMetadata{Source: "https://github.com/CosmWasm/cosmwasm"}
+	storeRes, err := msgServer.StoreCode(goCtx, storeMsg)
+	require.NoError(t, err)
+	codeID := storeRes.CodeID
+
+	codeInfo, err := keeper.GetCodeInfo(ctx, codeID)
+	require.NoError(t, err)
+	require.Equal(t, storeMsg.Metadata, codeInfo.Metadata)
+
+	// only the code creator can update the metadata
+	newMetadata := types.CodeMetadata{Source: "https://github.com/CosmWasm/cosmwasm", Builder: "cosmwasm/rust-optimizer:0.12.4", Schema: `{"type":"object"}`}
+	_, err = msgServer.UpdateCodeMetadata(goCtx, types.NewMsgUpdateCodeMetadata(admin, codeID, newMetadata))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	_, err = msgServer.UpdateCodeMetadata(goCtx, types.NewMsgUpdateCodeMetadata(creator, codeID, newMetadata))
+	require.NoError(t, err)
+
+	codeInfo, err = keeper.GetCodeInfo(ctx, codeID)
+	require.NoError(t, err)
+	require.Equal(t, newMetadata, codeInfo.Metadata)
+
+	_, _, bob := keyPubAddr()
+	_, _, fred := keyPubAddr()
+
+	initMsgBz, err := json.Marshal(HackatomExampleInitMsg{
+		Verifier:    fred,
+		Beneficiary: bob,
+	})
+	require.NoError(t, err)
+
+	instantiateMsg := types.NewMsgInstantiateContract(creator, admin, codeID, initMsgBz, nil)
+	instantiateMsg.Label = "hackatom"
+	res, err := msgServer.InstantiateContract(goCtx, instantiateMsg)
+	require.NoError(t, err)
+	contractAddr, err := sdk.AccAddressFromBech32(res.ContractAddress)
+	require.NoError(t, err)
+
+	contractInfo, err := keeper.GetContractInfo(ctx, contractAddr)
+	require.NoError(t, err)
+	require.Equal(t, "hackatom", contractInfo.Label)
+
+	// the admin owns the label while the contract has one
+	_, err = msgServer.UpdateContractLabel(goCtx, types.NewMsgUpdateContractLabel(creator, contractAddr, "renamed"))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	_, err = msgServer.UpdateContractLabel(goCtx, types.NewMsgUpdateContractLabel(admin, contractAddr, "renamed"))
+	require.NoError(t, err)
+
+	contractInfo, err = keeper.GetContractInfo(ctx, contractAddr)
+	require.NoError(t, err)
+	require.Equal(t, "renamed", contractInfo.Label)
+
+	// the creator takes over once the admin is cleared
+	_, err = msgServer.ClearContractAdmin(goCtx, types.NewMsgClearContractAdmin(admin, contractAddr))
+	require.NoError(t, err)
+
+	_, err = msgServer.UpdateContractLabel(goCtx, types.NewMsgUpdateContractLabel(admin, contractAddr, "final"))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	_, err = msgServer.UpdateContractLabel(goCtx, types.NewMsgUpdateContractLabel(creator, contractAddr, "final"))
+	require.NoError(t, err)
+
+	contractInfo, err = keeper.GetContractInfo(ctx, contractAddr)
+	require.NoError(t, err)
+	require.Equal(t, "final", contractInfo.Label)
+}
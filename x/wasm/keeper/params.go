@@ -24,6 +24,25 @@ func (k Keeper) MaxContractMsgSize(ctx sdk.Context) (res uint64) {
 	return
 }
 
+// CodeUploadAccess defines who can upload a new code
+func (k Keeper) CodeUploadAccess(ctx sdk.Context) (res types.AccessConfig) {
+	k.paramSpace.Get(ctx, types.KeyCodeUploadAccess, &res)
+	return
+}
+
+// InstantiateDefaultPermission defines the instantiate permission of a new code
+// when the uploader does not specify one
+func (k Keeper) InstantiateDefaultPermission(ctx sdk.Context) (res types.AccessType) {
+	k.paramSpace.Get(ctx, types.KeyInstantiateDefaultPermission, &res)
+	return
+}
+
+// StargateQueryAllowlist defines the stargate queries the contracts are allowed to call
+func (k Keeper) StargateQueryAllowlist(ctx sdk.Context) (res []types.StargateQuery) {
+	k.paramSpace.Get(ctx, types.KeyStargateQueryAllowlist, &res)
+	return
+}
+
 // GetParams returns the total set of oracle parameters.
 func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
 	k.paramSpace.GetParamSet(ctx, &params)
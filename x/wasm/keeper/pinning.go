@@ -0,0 +1,142 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/terra-money/core/x/wasm/types"
+)
+
+// PinCode pins the code to the wasm vm cache and records it in the pinned code index
+func (k Keeper) PinCode(ctx sdk.Context, codeID uint64) error {
+	codeInfo, err := k.GetCodeInfo(ctx, codeID)
+	if err != nil {
+		return err
+	}
+
+	if err := k.wasmVM.Pin(codeInfo.CodeHash); err != nil {
+		return sdkerrors.Wrap(types.ErrPinContractFailed, err.Error())
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetPinnedCodeIndexKey(codeID), []byte{1})
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypePinCode,
+			sdk.NewAttribute(types.AttributeKeyCodeID, fmt.Sprintf("%d", codeID)),
+		),
+	)
+
+	return nil
+}
+
+// UnpinCode unpins the code from the wasm vm cache and removes it from the pinned code index
+func (k Keeper) UnpinCode(ctx sdk.Context, codeID uint64) error {
+	codeInfo, err := k.GetCodeInfo(ctx, codeID)
+	if err != nil {
+		return err
+	}
+
+	if err := k.wasmVM.Unpin(codeInfo.CodeHash); err != nil {
+		return sdkerrors.Wrap(types.ErrUnpinContractFailed, err.Error())
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetPinnedCodeIndexKey(codeID))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUnpinCode,
+			sdk.NewAttribute(types.AttributeKeyCodeID, fmt.Sprintf("%d", codeID)),
+		),
+	)
+
+	return nil
+}
+
+// IsPinnedCode returns true when the code is pinned to the wasm vm cache
+func (k Keeper) IsPinnedCode(ctx sdk.Context, codeID uint64) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.GetPinnedCodeIndexKey(codeID))
+}
+
+// isPinnedCode reads the pinned code index under an infinite gas meter, so
+// calls into unpinned code are charged the same gas as before pinning existed
+func (k Keeper) isPinnedCode(ctx sdk.Context, codeID uint64) bool {
+	return k.IsPinnedCode(ctx.WithGasMeter(sdk.NewInfiniteGasMeter()), codeID)
+}
+
+// refundPinnedCodeCosts refunds the pinned code discount for calls which charge
+// the module loading costs before the code id of the contract is known
+func (k Keeper) refundPinnedCodeCosts(ctx sdk.Context, codeID uint64, descriptor string) {
+	if k.isPinnedCode(ctx, codeID) {
+		ctx.GasMeter().RefundGas(types.InstantiateContractCosts(false, 0)-types.InstantiateContractCosts(true, 0), descriptor)
+	}
+}
+
+// IteratePinnedCodeIDs iterates all pinned code ids
+func (k Keeper) IteratePinnedCodeIDs(ctx sdk.Context, cb func(codeID uint64) bool) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.PinnedCodeIndexPrefix)
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		// cb returns true to stop early
+		if cb(binary.BigEndian.Uint64(iter.Key())) {
+			break
+		}
+	}
+}
+
+// InitializePinnedCodes pins all the codes of the pinned code index to the wasm vm cache.
+// The cache is not persisted, so this must be called on every startup.
+func (k Keeper) InitializePinnedCodes(ctx sdk.Context) error {
+	var err error
+	k.IteratePinnedCodeIDs(ctx, func(codeID uint64) bool {
+		var codeInfo types.CodeInfo
+		codeInfo, err = k.GetCodeInfo(ctx, codeID)
+		if err != nil {
+			return true
+		}
+
+		if err = k.wasmVM.Pin(codeInfo.CodeHash); err != nil {
+			err = sdkerrors.Wrapf(types.ErrPinContractFailed, "code %d: %s", codeID, err)
+			return true
+		}
+
+		return false
+	})
+
+	return err
+}
+
+// HandlePinCodesProposal is a handler for executing a passed pin codes proposal
+func HandlePinCodesProposal(ctx sdk.Context, k Keeper, p *types.PinCodesProposal) error {
+	for _, codeID := range p.CodeIDs {
+		if err := k.PinCode(ctx, codeID); err != nil {
+			return sdkerrors.Wrapf(err, "code %d", codeID)
+		}
+	}
+
+	k.Logger(ctx).Info("pinned codes", "code_ids", p.CodeIDs)
+
+	return nil
+}
+
+// HandleUnpinCodesProposal is a handler for executing a passed unpin codes proposal
+func HandleUnpinCodesProposal(ctx sdk.Context, k Keeper, p *types.UnpinCodesProposal) error {
+	for _, codeID := range p.CodeIDs {
+		if err := k.UnpinCode(ctx, codeID); err != nil {
+			return sdkerrors.Wrapf(err, "code %d", codeID)
+		}
+	}
+
+	k.Logger(ctx).Info("unpinned codes", "code_ids", p.CodeIDs)
+
+	return nil
+}
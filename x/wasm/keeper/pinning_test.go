@@ -0,0 +1,86 @@
+package keeper
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/stretchr/testify/require"
+
+	core "github.com/terra-money/core/types"
+	"github.com/terra-money/core/x/wasm/types"
+)
+
+func TestPinCode(t *testing.T) {
+	input := CreateTestInput(t)
+	ctx, accKeeper, bankKeeper, keeper := input.Ctx, input.AccKeeper, input.BankKeeper, input.WasmKeeper
+
+	deposit := sdk.NewCoins(sdk.NewInt64Coin(core.MicroLunaDenom, 100000))
+	creator := createFakeFundedAccount(ctx, accKeeper, bankKeeper, deposit)
+
+	wasmCode, err := ioutil.ReadFile("./testdata/hackatom.wasm")
+	require.NoError(t, err)
+
+	codeID, err := keeper.StoreCode(ctx, creator, wasmCode)
+	require.NoError(t, err)
+	require.False(t, keeper.IsPinnedCode(ctx, codeID))
+
+	// pinning a non-existing code fails
+	err = HandlePinCodesProposal(ctx, keeper, types.NewPinCodesProposal("title", "desc", []uint64{codeID + 1}))
+	require.Error(t, err)
+
+	err = HandlePinCodesProposal(ctx, keeper, types.NewPinCodesProposal("title", "desc", []uint64{codeID}))
+	require.NoError(t, err)
+	require.True(t, keeper.IsPinnedCode(ctx, codeID))
+	require.NoError(t, keeper.InitializePinnedCodes(ctx))
+
+	var pinnedCodeIDs []uint64
+	keeper.IteratePinnedCodeIDs(ctx, func(codeID uint64) bool {
+		pinnedCodeIDs = append(pinnedCodeIDs, codeID)
+		return false
+	})
+	require.Equal(t, []uint64{codeID}, pinnedCodeIDs)
+
+	err = HandleUnpinCodesProposal(ctx, keeper, types.NewUnpinCodesProposal("title", "desc", []uint64{codeID}))
+	require.NoError(t, err)
+	require.False(t, keeper.IsPinnedCode(ctx, codeID))
+}
+
+func TestInstantiatePinnedCode(t *testing.T) {
+	input := CreateTestInput(t)
+	ctx, accKeeper, bankKeeper, keeper := input.Ctx, input.AccKeeper, input.BankKeeper, input.WasmKeeper
+
+	deposit := sdk.NewCoins(sdk.NewInt64Coin(core.MicroLunaDenom, 100000))
+	creator := createFakeFundedAccount(ctx, accKeeper, bankKeeper, deposit)
+
+	wasmCode, err := ioutil.ReadFile("./testdata/hackatom.wasm")
+	require.NoError(t, err)
+
+	codeID, err := keeper.StoreCode(ctx, creator, wasmCode)
+	require.NoError(t, err)
+
+	_, _, bob := keyPubAddr()
+	_, _, fred := keyPubAddr()
+
+	initMsgBz, err := json.Marshal(HackatomExampleInitMsg{
+		Verifier:    fred,
+		Beneficiary: bob,
+	})
+	require.NoError(t, err)
+
+	instantiateGas := func() uint64 {
+		ctx := ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+		_, _, err := keeper.InstantiateContract(ctx, codeID, creator, sdk.AccAddress{}, initMsgBz, nil)
+		require.NoError(t, err)
+
+		return ctx.GasMeter().GasConsumed()
+	}
+
+	unpinnedGas := instantiateGas()
+	require.NoError(t, keeper.PinCode(ctx, codeID))
+	pinnedGas := instantiateGas()
+
+	require.Less(t, pinnedGas, unpinnedGas)
+}
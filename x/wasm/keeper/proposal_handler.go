@@ -0,0 +1,156 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/terra-money/core/x/wasm/types"
+)
+
+// HandleStoreCodeProposal is a handler for executing a passed store code proposal;
+// the code upload access of the params is not applied
+func HandleStoreCodeProposal(ctx sdk.Context, k Keeper, p *types.StoreCodeProposal) error {
+	runAsAddr, err := sdk.AccAddressFromBech32(p.RunAs)
+	if err != nil {
+		return err
+	}
+
+	codeID, err := k.create(ctx, runAsAddr, p.WASMByteCode, p.InstantiatePermission, p.Metadata, govAuthorizationPolicy{})
+	if err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeStoreCode,
+			sdk.NewAttribute(types.AttributeKeySender, p.RunAs),
+			sdk.NewAttribute(types.AttributeKeyCodeID, fmt.Sprintf("%d", codeID)),
+		),
+	)
+
+	k.Logger(ctx).Info("stored code", "code_id", codeID, "run_as", p.RunAs)
+
+	return nil
+}
+
+// HandleInstantiateContractProposal is a handler for executing a passed instantiate contract proposal;
+// the instantiate permission of the code is not applied
+func HandleInstantiateContractProposal(ctx sdk.Context, k Keeper, p *types.InstantiateContractProposal) error {
+	runAsAddr, err := sdk.AccAddressFromBech32(p.RunAs)
+	if err != nil {
+		return err
+	}
+
+	adminAddr := sdk.AccAddress{}
+	if len(p.Admin) != 0 {
+		adminAddr, err = sdk.AccAddressFromBech32(p.Admin)
+		if err != nil {
+			return err
+		}
+	}
+
+	var contractAddr sdk.AccAddress
+	if err := executeWithMaxContractGas(ctx, k, func(subCtx sdk.Context) (err error) {
+		contractAddr, _, err = k.instantiate(subCtx, p.CodeID, runAsAddr, adminAddr, p.InitMsg, p.InitCoins, p.Label, nil, govAuthorizationPolicy{})
+		return err
+	}); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeInstantiateContract,
+			sdk.NewAttribute(types.AttributeKeyCreator, p.RunAs),
+			sdk.NewAttribute(types.AttributeKeyAdmin, p.Admin),
+			sdk.NewAttribute(types.AttributeKeyCodeID, fmt.Sprintf("%d", p.CodeID)),
+			sdk.NewAttribute(types.AttributeKeyContractAddress, contractAddr.String()),
+		),
+	)
+
+	k.Logger(ctx).Info("instantiated contract", "code_id", p.CodeID, "contract_address", contractAddr.String())
+
+	return nil
+}
+
+// HandleMigrateContractProposal is a handler for executing a passed migrate contract proposal;
+// the contract is migrated regardless of its admin, but it must be migratable
+func HandleMigrateContractProposal(ctx sdk.Context, k Keeper, p *types.MigrateContractProposal) error {
+	contractAddr, err := sdk.AccAddressFromBech32(p.Contract)
+	if err != nil {
+		return err
+	}
+
+	if err := executeWithMaxContractGas(ctx, k, func(subCtx sdk.Context) error {
+		_, err := k.migrate(subCtx, contractAddr, nil, p.NewCodeID, p.MigrateMsg, govAuthorizationPolicy{})
+		return err
+	}); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeMigrateContract,
+			sdk.NewAttribute(types.AttributeKeyCodeID, fmt.Sprintf("%d", p.NewCodeID)),
+			sdk.NewAttribute(types.AttributeKeyContractAddress, p.Contract),
+		),
+	)
+
+	k.Logger(ctx).Info("migrated contract", "new_code_id", p.NewCodeID, "contract_address", p.Contract)
+
+	return nil
+}
+
+// HandleSudoContractProposal is a handler for executing a passed sudo contract proposal;
+// the sudo entry point of the contract is called with the proposal msg
+func HandleSudoContractProposal(ctx sdk.Context, k Keeper, p *types.SudoContractProposal) error {
+	contractAddr, err := sdk.AccAddressFromBech32(p.Contract)
+	if err != nil {
+		return err
+	}
+
+	if err := executeWithMaxContractGas(ctx, k, func(subCtx sdk.Context) error {
+		_, err := k.Sudo(subCtx, contractAddr, p.Msg)
+		return err
+	}); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSudoContract,
+			sdk.NewAttribute(types.AttributeKeyContractAddress, p.Contract),
+		),
+	)
+
+	k.Logger(ctx).Info("called sudo on contract", "contract_address", p.Contract)
+
+	return nil
+}
+
+// executeWithMaxContractGas limits the contract execution of a proposal to the max
+// contract gas, as gov runs the proposals without gas limit. Running out of gas
+// fails the proposal, since gov does not recover from the panic.
+func executeWithMaxContractGas(ctx sdk.Context, k Keeper, execute func(sdk.Context) error) (err error) {
+	subCtx := ctx.WithGasMeter(sdk.NewGasMeter(k.MaxContractGas(ctx)))
+
+	defer func() {
+		if r := recover(); r != nil {
+			// if it's not an OutOfGas error, raise it again
+			outOfGas, ok := r.(sdk.ErrorOutOfGas)
+			if !ok {
+				panic(r)
+			}
+
+			err = sdkerrors.Wrapf(sdkerrors.ErrOutOfGas, "out of gas in location: %v", outOfGas.Descriptor)
+		}
+	}()
+
+	err = execute(subCtx)
+
+	// make sure we charge the parent what was spent
+	ctx.GasMeter().ConsumeGas(subCtx.GasMeter().GasConsumed(), "Contract execution of the proposal")
+
+	return err
+}
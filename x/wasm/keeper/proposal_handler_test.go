@@ -0,0 +1,195 @@
+package keeper
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/stretchr/testify/require"
+
+	core "github.com/terra-money/core/types"
+	"github.com/terra-money/core/x/wasm/types"
+)
+
+func TestGovDeploymentProposals(t *testing.T) {
+	input := CreateTestInput(t)
+	ctx, accKeeper, bankKeeper, keeper := input.Ctx, input.AccKeeper, input.BankKeeper, input.WasmKeeper
+
+	deposit := sdk.NewCoins(sdk.NewInt64Coin(core.MicroLunaDenom, 100000))
+	creator := createFakeFundedAccount(ctx, accKeeper, bankKeeper, deposit)
+	admin := createFakeFundedAccount(ctx, accKeeper, bankKeeper, deposit)
+
+	wasmCode, err := ioutil.ReadFile("./testdata/hackatom.wasm")
+	require.NoError(t, err)
+
+	// nobody can upload and instantiate codes except governance
+	params := keeper.GetParams(ctx)
+	params.CodeUploadAccess = types.AllowNobody
+	params.InstantiateDefaultPermission = types.AccessTypeNobody
+	keeper.SetParams(ctx, params)
+
+	metadata := types.CodeMetadata{Source: "https://github.com/CosmWasm/cosmwasm", Builder: "cosmwasm/rust-optimizer:0.12.4"}
+	err = HandleStoreCodeProposal(ctx, keeper, types.NewStoreCodeProposal("title", "desc", creator, wasmCode, nil, metadata))
+	require.NoError(t, err)
+
+	codeID, err := keeper.GetLastCodeID(ctx)
+	require.NoError(t, err)
+
+	codeInfo, err := keeper.GetCodeInfo(ctx, codeID)
+	require.NoError(t, err)
+	require.Equal(t, creator.String(), codeInfo.Creator)
+	require.Equal(t, types.AllowNobody, codeInfo.InstantiatePermission)
+	require.Equal(t, metadata, codeInfo.Metadata)
+
+	_, _, bob := keyPubAddr()
+	_, _, fred := keyPubAddr()
+	initMsgBz, err := json.Marshal(HackatomExampleInitMsg{
+		Verifier:    fred,
+		Beneficiary: bob,
+	})
+	require.NoError(t, err)
+
+	err = HandleInstantiateContractProposal(ctx, keeper, types.NewInstantiateContractProposal("title", "desc", creator, admin, codeID, initMsgBz, deposit, "hackatom"))
+	require.NoError(t, err)
+
+	var contractInfos []types.ContractInfo
+	keeper.IterateContractInfo(ctx, func(contractInfo types.ContractInfo) bool {
+		contractInfos = append(contractInfos, contractInfo)
+		return false
+	})
+	require.Len(t, contractInfos, 1)
+	require.Equal(t, creator.String(), contractInfos[0].Creator)
+	require.Equal(t, admin.String(), contractInfos[0].Admin)
+	require.Equal(t, "hackatom", contractInfos[0].Label)
+
+	contractAddr, err := sdk.AccAddressFromBech32(contractInfos[0].Address)
+	require.NoError(t, err)
+	require.Equal(t, deposit, bankKeeper.GetAllBalances(ctx, contractAddr))
+
+	// governance migrates the contract regardless of its admin
+	err = HandleStoreCodeProposal(ctx, keeper, types.NewStoreCodeProposal("title", "desc", creator, wasmCode, nil, types.CodeMetadata{}))
+	require.NoError(t, err)
+
+	newCodeID, err := keeper.GetLastCodeID(ctx)
+	require.NoError(t, err)
+
+	migrateMsgBz, err := json.Marshal(struct {
+		Verifier sdk.AccAddress `json:"verifier"`
+	}{Verifier: bob})
+	require.NoError(t, err)
+
+	err = HandleMigrateContractProposal(ctx, keeper, types.NewMigrateContractProposal("title", "desc", contractAddr, newCodeID, migrateMsgBz))
+	require.NoError(t, err)
+
+	contractInfo, err := keeper.GetContractInfo(ctx, contractAddr)
+	require.NoError(t, err)
+	require.Equal(t, newCodeID, contractInfo.CodeID)
+
+	// the contract without admin is not migratable
+	contractInfo.Admin = ""
+	keeper.SetContractInfo(ctx, contractAddr, contractInfo)
+
+	err = HandleMigrateContractProposal(ctx, keeper, types.NewMigrateContractProposal("title", "desc", contractAddr, codeID, migrateMsgBz))
+	require.ErrorIs(t, err, types.ErrNotMigratable)
+}
+
+func TestSudoContractProposal(t *testing.T) {
+	input := CreateTestInput(t)
+	ctx, accKeeper, bankKeeper, keeper := input.Ctx, input.AccKeeper, input.BankKeeper, input.WasmKeeper
+
+	deposit := sdk.NewCoins(sdk.NewInt64Coin(core.MicroLunaDenom, 100000))
+	creator := createFakeFundedAccount(ctx, accKeeper, bankKeeper, deposit)
+
+	wasmCode, err := ioutil.ReadFile("./testdata/hackatom.wasm")
+	require.NoError(t, err)
+
+	codeID, err := keeper.StoreCode(ctx, creator, wasmCode)
+	require.NoError(t, err)
+
+	_, _, bob := keyPubAddr()
+	_, _, fred := keyPubAddr()
+	initMsgBz, err := json.Marshal(HackatomExampleInitMsg{
+		Verifier:    fred,
+		Beneficiary: bob,
+	})
+	require.NoError(t, err)
+
+	contractAddr, _, err := keeper.InstantiateContract(ctx, codeID, creator, sdk.AccAddress{}, initMsgBz, deposit)
+	require.NoError(t, err)
+
+	// governance steals the funds of the contract through the sudo entry point
+	_, _, community := keyPubAddr()
+	stolen := sdk.NewCoins(sdk.NewInt64Coin(core.MicroLunaDenom, 30000))
+	sudoMsgBz, err := json.Marshal(map[string]interface{}{
+		"steal_funds": map[string]interface{}{
+			"recipient": community.String(),
+			"amount":    stolen,
+		},
+	})
+	require.NoError(t, err)
+
+	err = HandleSudoContractProposal(ctx, keeper, types.NewSudoContractProposal("title", "desc", contractAddr, sudoMsgBz))
+	require.NoError(t, err)
+	require.Equal(t, stolen, bankKeeper.GetAllBalances(ctx, community))
+	require.Equal(t, deposit.Sub(stolen), bankKeeper.GetAllBalances(ctx, contractAddr))
+
+	// unknown sudo msg fails
+	err = HandleSudoContractProposal(ctx, keeper, types.NewSudoContractProposal("title", "desc", contractAddr, []byte(`{"unknown":{}}`)))
+	require.ErrorIs(t, err, types.ErrSudoFailed)
+}
+
+func TestContractProposalsExceedMaxGas(t *testing.T) {
+	input := CreateTestInput(t)
+	ctx, accKeeper, bankKeeper, keeper := input.Ctx, input.AccKeeper, input.BankKeeper, input.WasmKeeper
+
+	deposit := sdk.NewCoins(sdk.NewInt64Coin(core.MicroLunaDenom, 100000))
+	creator := createFakeFundedAccount(ctx, accKeeper, bankKeeper, deposit)
+
+	wasmCode, err := ioutil.ReadFile("./testdata/hackatom.wasm")
+	require.NoError(t, err)
+
+	codeID, err := keeper.StoreCode(ctx, creator, wasmCode)
+	require.NoError(t, err)
+
+	_, _, bob := keyPubAddr()
+	_, _, fred := keyPubAddr()
+	initMsgBz, err := json.Marshal(HackatomExampleInitMsg{
+		Verifier:    fred,
+		Beneficiary: bob,
+	})
+	require.NoError(t, err)
+
+	contractAddr, _, err := keeper.InstantiateContract(ctx, codeID, creator, creator, initMsgBz, deposit)
+	require.NoError(t, err)
+
+	// the contract executions of the proposals are limited to the max contract gas
+	params := keeper.GetParams(ctx)
+	params.MaxContractGas = types.InstantiateContractCosts(false, 0) + 1
+	keeper.SetParams(ctx, params)
+
+	err = HandleInstantiateContractProposal(ctx, keeper, types.NewInstantiateContractProposal("title", "desc", creator, nil, codeID, initMsgBz, nil, "hackatom"))
+	require.ErrorIs(t, err, sdkerrors.ErrOutOfGas)
+
+	migrateMsgBz, err := json.Marshal(struct {
+		Verifier sdk.AccAddress `json:"verifier"`
+	}{Verifier: bob})
+	require.NoError(t, err)
+
+	err = HandleMigrateContractProposal(ctx, keeper, types.NewMigrateContractProposal("title", "desc", contractAddr, codeID, migrateMsgBz))
+	require.ErrorIs(t, err, sdkerrors.ErrOutOfGas)
+
+	sudoMsgBz, err := json.Marshal(map[string]interface{}{
+		"steal_funds": map[string]interface{}{
+			"recipient": bob.String(),
+			"amount":    deposit,
+		},
+	})
+	require.NoError(t, err)
+
+	err = HandleSudoContractProposal(ctx, keeper, types.NewSudoContractProposal("title", "desc", contractAddr, sudoMsgBz))
+	require.ErrorIs(t, err, sdkerrors.ErrOutOfGas)
+	require.True(t, bankKeeper.GetAllBalances(ctx, bob).IsZero())
+}
@@ -9,8 +9,10 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
 )
 
 // querier is used as Keeper will have duplicate methods if used directly, and gRPC names take precedence over q
@@ -140,3 +142,157 @@ func (q querier) RawStore(c context.Context, req *types.QueryRawStoreRequest) (*
 		Data: res,
 	}, nil
 }
+
+// Codes returns the stored code infos
+func (q querier) Codes(c context.Context, req *types.QueryCodesRequest) (*types.QueryCodesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	prefixStore := prefix.NewStore(ctx.KVStore(q.storeKey), types.CodeKey)
+
+	var codeInfos []types.CodeInfo
+	pageRes, err := query.Paginate(prefixStore, req.Pagination, func(_, value []byte) error {
+		var codeInfo types.CodeInfo
+		if err := q.cdc.Unmarshal(value, &codeInfo); err != nil {
+			return err
+		}
+
+		codeInfos = append(codeInfos, codeInfo)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryCodesResponse{CodeInfos: codeInfos, Pagination: pageRes}, nil
+}
+
+// ContractsByCode returns the addresses of the contracts instantiated from the code
+func (q querier) ContractsByCode(c context.Context, req *types.QueryContractsByCodeRequest) (*types.QueryContractsByCodeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	contractAddrs, pageRes, err := q.paginateContractIndex(ctx, types.GetContractByCodeIndexPrefix(req.CodeId), req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryContractsByCodeResponse{ContractAddresses: contractAddrs, Pagination: pageRes}, nil
+}
+
+// ContractsByCreator returns the addresses of the contracts created by the creator
+func (q querier) ContractsByCreator(c context.Context, req *types.QueryContractsByCreatorRequest) (*types.QueryContractsByCreatorResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	creatorAddr, err := sdk.AccAddressFromBech32(req.CreatorAddress)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	contractAddrs, pageRes, err := q.paginateContractIndex(ctx, types.GetContractByCreatorIndexPrefix(creatorAddr), req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryContractsByCreatorResponse{ContractAddresses: contractAddrs, Pagination: pageRes}, nil
+}
+
+// ContractsByAdmin returns the addresses of the contracts administered by the admin
+func (q querier) ContractsByAdmin(c context.Context, req *types.QueryContractsByAdminRequest) (*types.QueryContractsByAdminResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	adminAddr, err := sdk.AccAddressFromBech32(req.AdminAddress)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	contractAddrs, pageRes, err := q.paginateContractIndex(ctx, types.GetContractByAdminIndexPrefix(adminAddr), req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryContractsByAdminResponse{ContractAddresses: contractAddrs, Pagination: pageRes}, nil
+}
+
+// ContractHistory returns the code history of the contract
+func (q querier) ContractHistory(c context.Context, req *types.QueryContractHistoryRequest) (*types.QueryContractHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	contractAddr, err := sdk.AccAddressFromBech32(req.ContractAddress)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	prefixStore := prefix.NewStore(ctx.KVStore(q.storeKey), types.GetContractCodeHistoryPrefix(contractAddr))
+
+	var entries []types.ContractCodeHistoryEntry
+	pageRes, err := query.Paginate(prefixStore, req.Pagination, func(_, value []byte) error {
+		var entry types.ContractCodeHistoryEntry
+		if err := q.cdc.Unmarshal(value, &entry); err != nil {
+			return err
+		}
+
+		entries = append(entries, entry)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryContractHistoryResponse{Entries: entries, Pagination: pageRes}, nil
+}
+
+// PredictContractAddress returns the address of the contract instantiated from the code by the creator with the salt
+func (q querier) PredictContractAddress(c context.Context, req *types.QueryPredictContractAddressRequest) (*types.QueryPredictContractAddressResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	creatorAddr, err := sdk.AccAddressFromBech32(req.CreatorAddress)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := types.ValidateSalt(req.Salt); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	codeInfo, err := q.GetCodeInfo(ctx, req.CodeId)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	contractAddr := types.GenerateContractAddress2(codeInfo.CodeHash, creatorAddr, req.Salt)
+	return &types.QueryPredictContractAddressResponse{ContractAddress: contractAddr.String()}, nil
+}
+
+// paginateContractIndex returns the contract addresses of a secondary index page;
+// the keys under the index prefix are the contract addresses
+func (q querier) paginateContractIndex(ctx sdk.Context, indexPrefix []byte, pageReq *query.PageRequest) ([]string, *query.PageResponse, error) {
+	prefixStore := prefix.NewStore(ctx.KVStore(q.storeKey), indexPrefix)
+
+	var contractAddrs []string
+	pageRes, err := query.Paginate(prefixStore, pageReq, func(key, _ []byte) error {
+		contractAddrs = append(contractAddrs, sdk.AccAddress(key).String())
+		return nil
+	})
+	if err != nil {
+		return nil, nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return contractAddrs, pageRes, nil
+}
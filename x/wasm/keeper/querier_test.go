@@ -8,6 +8,7 @@ import (
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/terra-money/core/x/wasm/types"
 
@@ -129,3 +130,90 @@ func TestQueryMultipleGoroutines(t *testing.T) {
 	}
 	wg.Wait()
 }
+
+func TestQueryCodesAndContractIndexes(t *testing.T) {
+	input := CreateTestInput(t)
+	goCtx := sdk.WrapSDKContext(input.Ctx)
+	ctx, accKeeper, bankKeeper, keeper := input.Ctx, input.AccKeeper, input.BankKeeper, input.WasmKeeper
+
+	deposit := sdk.NewCoins(sdk.NewInt64Coin("denom", 100000))
+	creator := createFakeFundedAccount(ctx, accKeeper, bankKeeper, deposit)
+	admin := createFakeFundedAccount(ctx, accKeeper, bankKeeper, deposit)
+	newAdmin := createFakeFundedAccount(ctx, accKeeper, bankKeeper, deposit)
+
+	wasmCode, err := ioutil.ReadFile("./testdata/hackatom.wasm")
+	require.NoError(t, err)
+
+	codeID, err := keeper.StoreCode(ctx, creator, wasmCode)
+	require.NoError(t, err)
+	otherCodeID, err := keeper.StoreCode(ctx, creator, wasmCode)
+	require.NoError(t, err)
+
+	_, _, bob := keyPubAddr()
+	initMsgBz, err := json.Marshal(HackatomExampleInitMsg{
+		Verifier:    creator,
+		Beneficiary: bob,
+	})
+	require.NoError(t, err)
+
+	var contractAddrs []string
+	for i := 0; i < 3; i++ {
+		addr, _, err := keeper.InstantiateContract(ctx, codeID, creator, admin, initMsgBz, nil)
+		require.NoError(t, err)
+		contractAddrs = append(contractAddrs, addr.String())
+	}
+
+	otherAddr, _, err := keeper.InstantiateContract(ctx, otherCodeID, creator, sdk.AccAddress{}, initMsgBz, nil)
+	require.NoError(t, err)
+
+	querier := NewQuerier(keeper)
+
+	codesRes, err := querier.Codes(goCtx, &types.QueryCodesRequest{})
+	require.NoError(t, err)
+	require.Len(t, codesRes.CodeInfos, 2)
+	require.Equal(t, codeID, codesRes.CodeInfos[0].CodeID)
+	require.Equal(t, otherCodeID, codesRes.CodeInfos[1].CodeID)
+
+	// paginate contracts by code
+	byCodeRes, err := querier.ContractsByCode(goCtx, &types.QueryContractsByCodeRequest{
+		CodeId:     codeID,
+		Pagination: &query.PageRequest{Limit: 2, CountTotal: true},
+	})
+	require.NoError(t, err)
+	require.Len(t, byCodeRes.ContractAddresses, 2)
+	require.Equal(t, uint64(3), byCodeRes.Pagination.Total)
+
+	nextRes, err := querier.ContractsByCode(goCtx, &types.QueryContractsByCodeRequest{
+		CodeId:     codeID,
+		Pagination: &query.PageRequest{Key: byCodeRes.Pagination.NextKey},
+	})
+	require.NoError(t, err)
+	require.ElementsMatch(t, contractAddrs, append(byCodeRes.ContractAddresses, nextRes.ContractAddresses...))
+
+	byCreatorRes, err := querier.ContractsByCreator(goCtx, &types.QueryContractsByCreatorRequest{CreatorAddress: creator.String()})
+	require.NoError(t, err)
+	require.ElementsMatch(t, append(contractAddrs, otherAddr.String()), byCreatorRes.ContractAddresses)
+
+	byAdminRes, err := querier.ContractsByAdmin(goCtx, &types.QueryContractsByAdminRequest{AdminAddress: admin.String()})
+	require.NoError(t, err)
+	require.ElementsMatch(t, contractAddrs, byAdminRes.ContractAddresses)
+
+	// the admin index follows the admin update
+	contractAddr, err := sdk.AccAddressFromBech32(contractAddrs[0])
+	require.NoError(t, err)
+	contractInfo, err := keeper.GetContractInfo(ctx, contractAddr)
+	require.NoError(t, err)
+	contractInfo.Admin = newAdmin.String()
+	keeper.SetContractInfo(ctx, contractAddr, contractInfo)
+
+	byAdminRes, err = querier.ContractsByAdmin(goCtx, &types.QueryContractsByAdminRequest{AdminAddress: admin.String()})
+	require.NoError(t, err)
+	require.ElementsMatch(t, contractAddrs[1:], byAdminRes.ContractAddresses)
+
+	byAdminRes, err = querier.ContractsByAdmin(goCtx, &types.QueryContractsByAdminRequest{AdminAddress: newAdmin.String()})
+	require.NoError(t, err)
+	require.Equal(t, []string{contractAddrs[0]}, byAdminRes.ContractAddresses)
+
+	_, err = querier.ContractsByCreator(goCtx, &types.QueryContractsByCreatorRequest{CreatorAddress: "invalid"})
+	require.Error(t, err)
+}
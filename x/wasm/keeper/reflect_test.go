@@ -10,6 +10,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	core "github.com/terra-money/core/types"
+	"github.com/terra-money/core/x/wasm/types"
 
 	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
 
@@ -165,6 +166,68 @@ func TestReflectReflectContractSend(t *testing.T) {
 
 }
 
+func TestReflectBurn(t *testing.T) {
+	input := CreateTestInput(t)
+	ctx, accKeeper, bankKeeper, treasuryKeeper, keeper := input.Ctx, input.AccKeeper, input.BankKeeper, input.TreasuryKeeper, input.WasmKeeper
+
+	deposit := sdk.NewCoins(sdk.NewInt64Coin(core.MicroLunaDenom, 100000))
+	creator := createFakeFundedAccount(ctx, accKeeper, bankKeeper, deposit)
+
+	// upload reflect code
+	reflectCode, err := ioutil.ReadFile("./testdata/reflect.wasm")
+	require.NoError(t, err)
+	reflectID, err := keeper.StoreCode(ctx, creator, reflectCode)
+	require.NoError(t, err)
+
+	// creator instantiates a contract and gives it tokens
+	reflectStart := sdk.NewCoins(sdk.NewInt64Coin(core.MicroLunaDenom, 40000))
+	reflectAddr, _, err := keeper.InstantiateContract(ctx, reflectID, creator, sdk.AccAddress{}, []byte("{}"), reflectStart)
+	require.NoError(t, err)
+
+	buildBurnMsg := func(amount string) []byte {
+		reflectBurn := ReflectHandleMsg{
+			Reflect: &reflectPayload{
+				Msgs: []wasmvmtypes.CosmosMsg{{
+					Bank: &wasmvmtypes.BankMsg{
+						Burn: &wasmvmtypes.BurnMsg{
+							Amount: []wasmvmtypes.Coin{{
+								Denom:  core.MicroLunaDenom,
+								Amount: amount,
+							}},
+						},
+					},
+				}},
+			},
+		}
+		reflectBurnBz, err := json.Marshal(reflectBurn)
+		require.NoError(t, err)
+		return reflectBurnBz
+	}
+
+	supplyBefore := bankKeeper.GetSupply(ctx, core.MicroLunaDenom)
+	burnedBefore := treasuryKeeper.GetBurned(ctx)
+
+	// burn more than the contract balance
+	_, err = keeper.ExecuteContract(ctx, reflectAddr, creator, buildBurnMsg("50000"), nil)
+	require.Error(t, err)
+
+	// burn 15k from the contract balance; no tax is charged
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	_, err = keeper.ExecuteContract(ctx, reflectAddr, creator, buildBurnMsg("15000"), nil)
+	require.NoError(t, err)
+
+	burnCoins := sdk.NewCoins(sdk.NewInt64Coin(core.MicroLunaDenom, 15000))
+	checkAccount(t, ctx, accKeeper, bankKeeper, reflectAddr, sdk.NewCoins(sdk.NewInt64Coin(core.MicroLunaDenom, 25000)))
+	require.Equal(t, supplyBefore.Sub(burnCoins[0]), bankKeeper.GetSupply(ctx, core.MicroLunaDenom))
+	require.Equal(t, burnedBefore.Add(burnCoins...), treasuryKeeper.GetBurned(ctx))
+
+	require.Contains(t, ctx.EventManager().Events(), sdk.NewEvent(
+		types.EventTypeBurnContractCoins,
+		sdk.NewAttribute(types.AttributeKeyContractAddress, reflectAddr.String()),
+		sdk.NewAttribute(types.AttributeKeyAmount, burnCoins.String()),
+	))
+}
+
 func TestReflectStargateQuery(t *testing.T) {
 	input := CreateTestInput(t)
 	ctx, accKeeper, keeper, bankKeeper := input.Ctx, input.AccKeeper, input.WasmKeeper, input.BankKeeper
@@ -237,6 +300,58 @@ func TestReflectStargateQuery(t *testing.T) {
 	assert.Equal(t, expectedBalance, protoResult.Balances)
 }
 
+func TestReflectStargateQueryAllowlist(t *testing.T) {
+	input := CreateTestInput(t)
+	ctx, accKeeper, keeper, bankKeeper := input.Ctx, input.AccKeeper, input.WasmKeeper, input.BankKeeper
+
+	funds := sdk.NewCoins(sdk.NewInt64Coin("denom", 320000))
+	creator := createFakeFundedAccount(ctx, accKeeper, bankKeeper, funds)
+
+	// upload code
+	reflectCode, err := ioutil.ReadFile("./testdata/reflect.wasm")
+	require.NoError(t, err)
+	codeID, err := keeper.StoreCode(ctx, creator, reflectCode)
+	require.NoError(t, err)
+
+	contractAddr, _, err := keeper.InstantiateContract(ctx, codeID, creator, sdk.AccAddress{}, []byte("{}"), nil)
+	require.NoError(t, err)
+
+	// the bank params query is routable, but not allowlisted by default
+	protoQueryBin, err := proto.Marshal(&banktypes.QueryParamsRequest{})
+	require.NoError(t, err)
+	protoQueryBz, err := json.Marshal(ReflectQueryMsg{
+		Chain: &ChainQuery{Request: &wasmvmtypes.QueryRequest{
+			Stargate: &wasmvmtypes.StargateQuery{
+				Path: "/cosmos.bank.v1beta1.Query/Params",
+				Data: protoQueryBin,
+			},
+		}},
+	})
+	require.NoError(t, err)
+
+	_, err = keeper.queryToContract(ctx, contractAddr, protoQueryBz)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "path is not allowed from the contract")
+
+	// governance extends the allowlist
+	params := keeper.GetParams(ctx)
+	params.StargateQueryAllowlist = append(params.StargateQueryAllowlist, types.StargateQuery{
+		Path:         "/cosmos.bank.v1beta1.Query/Params",
+		ResponseType: "cosmos.bank.v1beta1.QueryParamsResponse",
+	})
+	keeper.SetParams(ctx, params)
+
+	protoRes, err := keeper.queryToContract(ctx, contractAddr, protoQueryBz)
+	require.NoError(t, err)
+	var protoChain ChainResponse
+	mustParse(t, protoRes, &protoChain)
+
+	var protoResult banktypes.QueryParamsResponse
+	err = proto.Unmarshal(protoChain.Data, &protoResult)
+	require.NoError(t, err)
+	assert.Equal(t, bankKeeper.GetParams(ctx), protoResult.Params)
+}
+
 type reflectState struct {
 	Owner string `json:"owner"`
 }
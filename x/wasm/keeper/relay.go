@@ -0,0 +1,302 @@
+package keeper
+
+import (
+	"time"
+
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/terra-money/core/x/wasm/types"
+)
+
+// OnOpenChannel calls the contract to participate in the IBC channel handshake step.
+// In the IBC protocol this is either the `Channel Open Init` event on the initiating chain or
+// `Channel Open Try` on the counterparty chain.
+// The contract rejects the channel by returning an error.
+func (k Keeper) OnOpenChannel(
+	ctx sdk.Context,
+	contractAddr sdk.AccAddress,
+	msg wasmvmtypes.IBCChannelOpenMsg) error {
+	defer telemetry.MeasureSince(time.Now(), "wasm", "contract", "ibc-open-channel")
+	ctx.GasMeter().ConsumeGas(types.InstantiateContractCosts(false, 0), "Loading CosmWasm module: ibc-open-channel")
+
+	codeInfo, storePrefix, err := k.getContractDetails(ctx, contractAddr)
+	if err != nil {
+		return err
+	}
+
+	k.refundPinnedCodeCosts(ctx, codeInfo.CodeID, "Loading CosmWasm module: ibc-open-channel")
+
+	env := types.NewEnv(ctx, contractAddr)
+	gasUsed, err := k.wasmVM.IBCChannelOpen(
+		codeInfo.CodeHash,
+		env,
+		msg,
+		storePrefix,
+		k.getCosmWasmAPI(ctx),
+		k.querier.WithCtx(ctx),
+		k.getWasmVMGasMeter(ctx),
+		k.getWasmVMGasRemaining(ctx),
+		types.JSONDeserializationWasmGasCost,
+	)
+
+	// add types.GasMultiplier to occur out of gas panic
+	k.consumeWasmVMGas(ctx, gasUsed+types.GasMultiplier, "Contract IBC Open Channel")
+	if err != nil {
+		return sdkerrors.Wrap(types.ErrIBCCallbackFailed, err.Error())
+	}
+
+	return nil
+}
+
+// OnConnectChannel calls the contract to let it know the IBC channel was established.
+// In the IBC protocol this is either the `Channel Open Ack` event on the initiating chain or
+// `Channel Open Confirm` on the counterparty chain.
+func (k Keeper) OnConnectChannel(
+	ctx sdk.Context,
+	contractAddr sdk.AccAddress,
+	msg wasmvmtypes.IBCChannelConnectMsg) error {
+	defer telemetry.MeasureSince(time.Now(), "wasm", "contract", "ibc-connect-channel")
+	ctx.GasMeter().ConsumeGas(types.InstantiateContractCosts(false, 0), "Loading CosmWasm module: ibc-connect-channel")
+
+	codeInfo, storePrefix, err := k.getContractDetails(ctx, contractAddr)
+	if err != nil {
+		return err
+	}
+
+	k.refundPinnedCodeCosts(ctx, codeInfo.CodeID, "Loading CosmWasm module: ibc-connect-channel")
+
+	env := types.NewEnv(ctx, contractAddr)
+	res, gasUsed, err := k.wasmVM.IBCChannelConnect(
+		codeInfo.CodeHash,
+		env,
+		msg,
+		storePrefix,
+		k.getCosmWasmAPI(ctx),
+		k.querier.WithCtx(ctx),
+		k.getWasmVMGasMeter(ctx),
+		k.getWasmVMGasRemaining(ctx),
+		types.JSONDeserializationWasmGasCost,
+	)
+
+	// add types.GasMultiplier to occur out of gas panic
+	k.consumeWasmVMGas(ctx, gasUsed+types.GasMultiplier, "Contract IBC Connect Channel")
+	if err != nil {
+		return sdkerrors.Wrap(types.ErrIBCCallbackFailed, err.Error())
+	}
+
+	return k.handleIBCBasicContractResponse(ctx, contractAddr, res)
+}
+
+// OnCloseChannel calls the contract to let it know the IBC channel is closed.
+// Calling modules MAY atomically execute appropriate application logic in conjunction with calling chanCloseConfirm.
+//
+// Once closed, channels cannot be reopened and identifiers cannot be reused. Identifier reuse is prevented because
+// we want to prevent potential replay of previously sent packets.
+func (k Keeper) OnCloseChannel(
+	ctx sdk.Context,
+	contractAddr sdk.AccAddress,
+	msg wasmvmtypes.IBCChannelCloseMsg) error {
+	defer telemetry.MeasureSince(time.Now(), "wasm", "contract", "ibc-close-channel")
+	ctx.GasMeter().ConsumeGas(types.InstantiateContractCosts(false, 0), "Loading CosmWasm module: ibc-close-channel")
+
+	codeInfo, storePrefix, err := k.getContractDetails(ctx, contractAddr)
+	if err != nil {
+		return err
+	}
+
+	k.refundPinnedCodeCosts(ctx, codeInfo.CodeID, "Loading CosmWasm module: ibc-close-channel")
+
+	env := types.NewEnv(ctx, contractAddr)
+	res, gasUsed, err := k.wasmVM.IBCChannelClose(
+		codeInfo.CodeHash,
+		env,
+		msg,
+		storePrefix,
+		k.getCosmWasmAPI(ctx),
+		k.querier.WithCtx(ctx),
+		k.getWasmVMGasMeter(ctx),
+		k.getWasmVMGasRemaining(ctx),
+		types.JSONDeserializationWasmGasCost,
+	)
+
+	// add types.GasMultiplier to occur out of gas panic
+	k.consumeWasmVMGas(ctx, gasUsed+types.GasMultiplier, "Contract IBC Close Channel")
+	if err != nil {
+		return sdkerrors.Wrap(types.ErrIBCCallbackFailed, err.Error())
+	}
+
+	return k.handleIBCBasicContractResponse(ctx, contractAddr, res)
+}
+
+// OnRecvPacket calls the contract to process the incoming IBC packet. The contract fully owns the data processing and
+// returns the acknowledgement data for the chain level. This allows custom applications and protocols on top
+// of IBC. Although it is recommended to use the standard acknowledgement envelope defined in
+// https://github.com/cosmos/ics/tree/master/spec/ics-004-channel-and-packet-semantics#acknowledgement-envelope
+//
+// For more information see: https://github.com/cosmos/ics/tree/master/spec/ics-004-channel-and-packet-semantics#packet-flow--handling
+func (k Keeper) OnRecvPacket(
+	ctx sdk.Context,
+	contractAddr sdk.AccAddress,
+	msg wasmvmtypes.IBCPacketReceiveMsg) ([]byte, error) {
+	defer telemetry.MeasureSince(time.Now(), "wasm", "contract", "ibc-recv-packet")
+	ctx.GasMeter().ConsumeGas(types.InstantiateContractCosts(false, len(msg.Packet.Data)), "Loading CosmWasm module: ibc-recv-packet")
+
+	codeInfo, storePrefix, err := k.getContractDetails(ctx, contractAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	k.refundPinnedCodeCosts(ctx, codeInfo.CodeID, "Loading CosmWasm module: ibc-recv-packet")
+
+	env := types.NewEnv(ctx, contractAddr)
+	res, gasUsed, err := k.wasmVM.IBCPacketReceive(
+		codeInfo.CodeHash,
+		env,
+		msg,
+		storePrefix,
+		k.getCosmWasmAPI(ctx),
+		k.querier.WithCtx(ctx),
+		k.getWasmVMGasMeter(ctx),
+		k.getWasmVMGasRemaining(ctx),
+		types.JSONDeserializationWasmGasCost,
+	)
+
+	// add types.GasMultiplier to occur out of gas panic
+	k.consumeWasmVMGas(ctx, gasUsed+types.GasMultiplier, "Contract IBC Receive Packet")
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrIBCCallbackFailed, err.Error())
+	}
+
+	// consume gas for wasm events
+	ctx.GasMeter().ConsumeGas(types.EventCosts(res.Attributes, res.Events), "Event Cost")
+
+	// parse wasm events to sdk events
+	events, err := types.ParseEvents(contractAddr, res.Attributes, res.Events)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "event validation failed")
+	}
+
+	// emit events
+	ctx.EventManager().EmitEvents(events)
+
+	// dispatch submessages and messages; the reply data overrides the acknowledgement
+	ack := res.Acknowledgement
+	if replyData, err := k.dispatchMessages(ctx, contractAddr, res.Messages...); err != nil {
+		return nil, sdkerrors.Wrap(err, "dispatch")
+	} else if replyData != nil {
+		ack = replyData
+	}
+
+	return ack, nil
+}
+
+// OnAckPacket calls the contract to handle the "acknowledgement" data which can contain success or failure of a packet
+// acknowledgement written on the receiving chain for example. This is application level data and fully owned by the
+// contract. The use of the standard acknowledgement envelope is recommended: https://github.com/cosmos/ics/tree/master/spec/ics-004-channel-and-packet-semantics#acknowledgement-envelope
+//
+// On application errors the contract can revert an operation like returning tokens as in ibc-transfer.
+//
+// For more information see: https://github.com/cosmos/ics/tree/master/spec/ics-004-channel-and-packet-semantics#packet-flow--handling
+func (k Keeper) OnAckPacket(
+	ctx sdk.Context,
+	contractAddr sdk.AccAddress,
+	msg wasmvmtypes.IBCPacketAckMsg) error {
+	defer telemetry.MeasureSince(time.Now(), "wasm", "contract", "ibc-ack-packet")
+	ctx.GasMeter().ConsumeGas(types.InstantiateContractCosts(false, len(msg.Acknowledgement.Data)), "Loading CosmWasm module: ibc-ack-packet")
+
+	codeInfo, storePrefix, err := k.getContractDetails(ctx, contractAddr)
+	if err != nil {
+		return err
+	}
+
+	k.refundPinnedCodeCosts(ctx, codeInfo.CodeID, "Loading CosmWasm module: ibc-ack-packet")
+
+	env := types.NewEnv(ctx, contractAddr)
+	res, gasUsed, err := k.wasmVM.IBCPacketAck(
+		codeInfo.CodeHash,
+		env,
+		msg,
+		storePrefix,
+		k.getCosmWasmAPI(ctx),
+		k.querier.WithCtx(ctx),
+		k.getWasmVMGasMeter(ctx),
+		k.getWasmVMGasRemaining(ctx),
+		types.JSONDeserializationWasmGasCost,
+	)
+
+	// add types.GasMultiplier to occur out of gas panic
+	k.consumeWasmVMGas(ctx, gasUsed+types.GasMultiplier, "Contract IBC Ack Packet")
+	if err != nil {
+		return sdkerrors.Wrap(types.ErrIBCCallbackFailed, err.Error())
+	}
+
+	return k.handleIBCBasicContractResponse(ctx, contractAddr, res)
+}
+
+// OnTimeoutPacket calls the contract to let it know the packet was never received on the destination chain within
+// the timeout boundaries.
+// The contract should handle this on the application level and undo the original operation
+func (k Keeper) OnTimeoutPacket(
+	ctx sdk.Context,
+	contractAddr sdk.AccAddress,
+	msg wasmvmtypes.IBCPacketTimeoutMsg) error {
+	defer telemetry.MeasureSince(time.Now(), "wasm", "contract", "ibc-timeout-packet")
+	ctx.GasMeter().ConsumeGas(types.InstantiateContractCosts(false, len(msg.Packet.Data)), "Loading CosmWasm module: ibc-timeout-packet")
+
+	codeInfo, storePrefix, err := k.getContractDetails(ctx, contractAddr)
+	if err != nil {
+		return err
+	}
+
+	k.refundPinnedCodeCosts(ctx, codeInfo.CodeID, "Loading CosmWasm module: ibc-timeout-packet")
+
+	env := types.NewEnv(ctx, contractAddr)
+	res, gasUsed, err := k.wasmVM.IBCPacketTimeout(
+		codeInfo.CodeHash,
+		env,
+		msg,
+		storePrefix,
+		k.getCosmWasmAPI(ctx),
+		k.querier.WithCtx(ctx),
+		k.getWasmVMGasMeter(ctx),
+		k.getWasmVMGasRemaining(ctx),
+		types.JSONDeserializationWasmGasCost,
+	)
+
+	// add types.GasMultiplier to occur out of gas panic
+	k.consumeWasmVMGas(ctx, gasUsed+types.GasMultiplier, "Contract IBC Timeout Packet")
+	if err != nil {
+		return sdkerrors.Wrap(types.ErrIBCCallbackFailed, err.Error())
+	}
+
+	return k.handleIBCBasicContractResponse(ctx, contractAddr, res)
+}
+
+// handleIBCBasicContractResponse emits the events and dispatches the messages of the contract response
+func (k Keeper) handleIBCBasicContractResponse(
+	ctx sdk.Context,
+	contractAddr sdk.AccAddress,
+	res *wasmvmtypes.IBCBasicResponse) error {
+	// consume gas for wasm events
+	ctx.GasMeter().ConsumeGas(types.EventCosts(res.Attributes, res.Events), "Event Cost")
+
+	// parse wasm events to sdk events
+	events, err := types.ParseEvents(contractAddr, res.Attributes, res.Events)
+	if err != nil {
+		return sdkerrors.Wrap(err, "event validation failed")
+	}
+
+	// emit events
+	ctx.EventManager().EmitEvents(events)
+
+	// dispatch submessages and messages; ibc callbacks have no data to return
+	if _, err := k.dispatchMessages(ctx, contractAddr, res.Messages...); err != nil {
+		return sdkerrors.Wrap(err, "dispatch")
+	}
+
+	return nil
+}
@@ -17,7 +17,10 @@ import (
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
 	"github.com/cosmos/ibc-go/modules/apps/transfer"
+	ibctransfertypes "github.com/cosmos/ibc-go/modules/apps/transfer/types"
 	ibc "github.com/cosmos/ibc-go/modules/core"
+	ibchost "github.com/cosmos/ibc-go/modules/core/24-host"
+	ibckeeper "github.com/cosmos/ibc-go/modules/core/keeper"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -26,6 +29,7 @@ import (
 	simparams "github.com/cosmos/cosmos-sdk/simapp/params"
 	"github.com/cosmos/cosmos-sdk/std"
 	"github.com/cosmos/cosmos-sdk/store"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
@@ -34,15 +38,22 @@ import (
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/cosmos/cosmos-sdk/x/capability"
+	capabilitykeeper "github.com/cosmos/cosmos-sdk/x/capability/keeper"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
 	distrkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
 	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	paramskeeper "github.com/cosmos/cosmos-sdk/x/params/keeper"
 	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	upgradekeeper "github.com/cosmos/cosmos-sdk/x/upgrade/keeper"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 
 	customauth "github.com/terra-money/core/custom/auth"
+	customauthtypes "github.com/terra-money/core/custom/auth/types"
+	customauthztypes "github.com/terra-money/core/custom/authz/types"
 	custombank "github.com/terra-money/core/custom/bank"
+	custombanktypes "github.com/terra-money/core/custom/bank/types"
 	bankwasm "github.com/terra-money/core/custom/bank/wasm"
 	customdistr "github.com/terra-money/core/custom/distribution"
 	distrwasm "github.com/terra-money/core/custom/distribution/wasm"
@@ -148,6 +159,7 @@ type TestInput struct {
 	MarketKeeper       marketkeeper.Keeper
 	TreasuryKeeper     treasurykeeper.Keeper
 	WasmKeeper         Keeper
+	IBCKeeper          *ibckeeper.Keeper
 }
 
 // CreateTestInput nolint
@@ -164,6 +176,10 @@ func CreateTestInput(t *testing.T) TestInput {
 	keyOracle := sdk.NewKVStoreKey(oracletypes.StoreKey)
 	keyMarket := sdk.NewKVStoreKey(markettypes.StoreKey)
 	keyTreasury := sdk.NewKVStoreKey(treasurytypes.StoreKey)
+	keyCapability := sdk.NewKVStoreKey(capabilitytypes.StoreKey)
+	memKeyCapability := storetypes.NewMemoryStoreKey(capabilitytypes.MemStoreKey)
+	keyIBC := sdk.NewKVStoreKey(ibchost.StoreKey)
+	keyUpgrade := sdk.NewKVStoreKey(upgradetypes.StoreKey)
 
 	db := dbm.NewMemDB()
 	ms := store.NewCommitMultiStore(db)
@@ -181,6 +197,10 @@ func CreateTestInput(t *testing.T) TestInput {
 	ms.MountStoreWithDB(keyOracle, sdk.StoreTypeIAVL, db)
 	ms.MountStoreWithDB(keyMarket, sdk.StoreTypeIAVL, db)
 	ms.MountStoreWithDB(keyTreasury, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keyCapability, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(memKeyCapability, sdk.StoreTypeMemory, nil)
+	ms.MountStoreWithDB(keyIBC, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keyUpgrade, sdk.StoreTypeIAVL, db)
 
 	require.NoError(t, ms.LoadLatestVersion())
 
@@ -286,6 +306,7 @@ func CreateTestInput(t *testing.T) TestInput {
 		appCodec,
 		keyMarket, paramsKeeper.Subspace(markettypes.ModuleName),
 		accountKeeper, bankKeeper, oracleKeeper,
+		distrKeeper, distrtypes.ModuleName,
 	)
 	marketKeeper.SetParams(ctx, markettypes.DefaultParams())
 
@@ -300,12 +321,30 @@ func CreateTestInput(t *testing.T) TestInput {
 
 	treasuryKeeper.SetParams(ctx, treasurytypes.DefaultParams())
 
+	capabilityKeeper := capabilitykeeper.NewKeeper(appCodec, keyCapability, memKeyCapability)
+	scopedIBCKeeper := capabilityKeeper.ScopeToModule(ibchost.ModuleName)
+	scopedWasmKeeper := capabilityKeeper.ScopeToModule(types.ModuleName)
+	capabilityKeeper.Seal()
+
+	upgradeKeeper := upgradekeeper.NewKeeper(map[int64]bool{}, keyUpgrade, appCodec, tempDir, nil)
+	ibcKeeper := ibckeeper.NewKeeper(
+		appCodec, keyIBC, paramsKeeper.Subspace(ibchost.ModuleName),
+		stakingKeeper, upgradeKeeper, scopedIBCKeeper,
+	)
+
 	router := baseapp.NewMsgServiceRouter()
 	querier := baseapp.NewGRPCQueryRouter()
 	banktypes.RegisterQueryServer(querier, bankKeeper)
 	stakingtypes.RegisterQueryServer(querier, stakingkeeper.Querier{Keeper: stakingKeeper})
 	distrtypes.RegisterQueryServer(querier, distrKeeper)
 
+	taxableMsgRegistry := customauthtypes.NewTaxableMsgRegistry()
+	customauthtypes.RegisterTaxableMsgs(taxableMsgRegistry)
+	customauthztypes.RegisterTaxableMsgs(taxableMsgRegistry)
+	custombanktypes.RegisterTaxableMsgs(taxableMsgRegistry)
+	markettypes.RegisterTaxableMsgs(taxableMsgRegistry)
+	types.RegisterTaxableMsgs(taxableMsgRegistry)
+
 	keeper := NewKeeper(
 		appCodec,
 		keyContract,
@@ -313,6 +352,10 @@ func CreateTestInput(t *testing.T) TestInput {
 		accountKeeper,
 		bankKeeper,
 		treasuryKeeper,
+		taxableMsgRegistry,
+		ibcKeeper.ChannelKeeper,
+		&ibcKeeper.PortKeeper,
+		scopedWasmKeeper,
 		router,
 		querier,
 		types.DefaultFeatures,
@@ -349,7 +392,7 @@ func CreateTestInput(t *testing.T) TestInput {
 		types.WasmMsgParserRouteDistribution: distrwasm.NewWasmMsgParser(),
 		types.WasmMsgParserRouteGov:          govwasm.NewWasmMsgParser(),
 		types.WasmMsgParserRouteWasm:         NewWasmMsgParser(),
-	}, NewStargateWasmMsgParser(legacyAmino))
+	}, NewStargateWasmMsgParser(legacyAmino), NewIBCWasmMsgParser(mockICS20TransferPortSource{}))
 
 	keeper.SetLastCodeID(ctx, 0)
 	keeper.SetLastInstanceID(ctx, 0)
@@ -364,7 +407,14 @@ func CreateTestInput(t *testing.T) TestInput {
 		oracleKeeper,
 		marketKeeper,
 		treasuryKeeper,
-		keeper}
+		keeper,
+		ibcKeeper}
+}
+
+type mockICS20TransferPortSource struct{}
+
+func (mockICS20TransferPortSource) GetPort(ctx sdk.Context) string {
+	return ibctransfertypes.PortID
 }
 
 // FundAccount is a utility function that funds an account by minting and
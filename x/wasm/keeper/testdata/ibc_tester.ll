@@ -0,0 +1,202 @@
+; ibc_tester is a minimal IBC enabled contract for the wasm IBC tests.
+;
+; - instantiate succeeds with any msg;
+; - execute dispatches the msg it is given as a CosmosMsg, e.g. an IbcMsg;
+; - every IBC entry point stores the msg it is called with under its own name,
+;   accepts the channel and acknowledges the received packets with "ok".
+;
+; Build it with LLVM and the wasm linker of LLD:
+;
+;   llc -mtriple=wasm32-unknown-unknown -mcpu=mvp -O2 -filetype=obj ibc_tester.ll -o ibc_tester.o
+;   wasm-ld --no-entry --strip-all \
+;     --export=interface_version_7 --export=requires_stargate \
+;     --export=allocate --export=deallocate --export=instantiate --export=execute \
+;     --export=ibc_channel_open --export=ibc_channel_connect --export=ibc_channel_close \
+;     --export=ibc_packet_receive --export=ibc_packet_ack --export=ibc_packet_timeout \
+;     ibc_tester.o -o ibc_tester.wasm
+
+target datalayout = "e-m:e-p:32:32-i64:64-n32:64-S128"
+target triple = "wasm32-unknown-unknown"
+
+; Region is the memory region shared with the VM: offset, capacity and length
+%Region = type { i32, i32, i32 }
+
+@arena = internal global [1048576 x i8] zeroinitializer, align 8
+@arena_used = internal global i32 0
+
+@response = private constant [62 x i8] c"{\22ok\22:{\22messages\22:[],\22attributes\22:[],\22events\22:[],\22data\22:null}}"
+@execute_prefix = private constant [33 x i8] c"{\22ok\22:{\22messages\22:[{\22id\22:0,\22msg\22:"
+@execute_suffix = private constant [80 x i8] c",\22gas_limit\22:null,\22reply_on\22:\22never\22}],\22attributes\22:[],\22events\22:[],\22data\22:null}}"
+@open_response = private constant [11 x i8] c"{\22ok\22:null}"
+@basic_response = private constant [50 x i8] c"{\22ok\22:{\22messages\22:[],\22attributes\22:[],\22events\22:[]}}"
+@receive_response = private constant [75 x i8] c"{\22ok\22:{\22acknowledgement\22:\22b2s=\22,\22messages\22:[],\22attributes\22:[],\22events\22:[]}}"
+
+@key_channel_open = private constant [16 x i8] c"ibc_channel_open"
+@key_channel_connect = private constant [19 x i8] c"ibc_channel_connect"
+@key_channel_close = private constant [17 x i8] c"ibc_channel_close"
+@key_packet_receive = private constant [18 x i8] c"ibc_packet_receive"
+@key_packet_ack = private constant [14 x i8] c"ibc_packet_ack"
+@key_packet_timeout = private constant [18 x i8] c"ibc_packet_timeout"
+
+declare void @db_write(i32, i32) #0
+
+; alloc is a bump allocator over the arena; the VM uses a new instance per call
+define internal i8* @alloc(i32 %size) {
+  %used = load i32, i32* @arena_used
+  %padded = add i32 %size, 7
+  %aligned = and i32 %padded, -8
+  %next = add i32 %used, %aligned
+  store i32 %next, i32* @arena_used
+  %ptr = getelementptr [1048576 x i8], [1048576 x i8]* @arena, i32 0, i32 %used
+  ret i8* %ptr
+}
+
+define internal %Region* @new_region(i32 %capacity) {
+  %raw = call i8* @alloc(i32 12)
+  %region = bitcast i8* %raw to %Region*
+  %data = call i8* @alloc(i32 %capacity)
+  %offset = ptrtoint i8* %data to i32
+  %offset_field = getelementptr %Region, %Region* %region, i32 0, i32 0
+  store i32 %offset, i32* %offset_field
+  %capacity_field = getelementptr %Region, %Region* %region, i32 0, i32 1
+  store i32 %capacity, i32* %capacity_field
+  %length_field = getelementptr %Region, %Region* %region, i32 0, i32 2
+  store i32 0, i32* %length_field
+  ret %Region* %region
+}
+
+; append copies the bytes to the end of the region
+define internal void @append(%Region* %region, i8* %src, i32 %len) {
+entry:
+  %offset_field = getelementptr %Region, %Region* %region, i32 0, i32 0
+  %offset = load i32, i32* %offset_field
+  %length_field = getelementptr %Region, %Region* %region, i32 0, i32 2
+  %length = load i32, i32* %length_field
+  %end = add i32 %offset, %length
+  %dst = inttoptr i32 %end to i8*
+  %empty = icmp eq i32 %len, 0
+  br i1 %empty, label %done, label %loop
+
+loop:
+  %i = phi i32 [ 0, %entry ], [ %next, %loop ]
+  %from = getelementptr i8, i8* %src, i32 %i
+  %byte = load i8, i8* %from
+  %to = getelementptr i8, i8* %dst, i32 %i
+  store i8 %byte, i8* %to
+  %next = add i32 %i, 1
+  %finished = icmp eq i32 %next, %len
+  br i1 %finished, label %done, label %loop
+
+done:
+  %new_length = add i32 %length, %len
+  store i32 %new_length, i32* %length_field
+  ret void
+}
+
+define internal i32 @result(i8* %data, i32 %len) {
+  %region = call %Region* @new_region(i32 %len)
+  call void @append(%Region* %region, i8* %data, i32 %len)
+  %ptr = ptrtoint %Region* %region to i32
+  ret i32 %ptr
+}
+
+; record stores the msg region under the key
+define internal void @record(i8* %key, i32 %key_len, i32 %msg) {
+  %region = call %Region* @new_region(i32 %key_len)
+  call void @append(%Region* %region, i8* %key, i32 %key_len)
+  %ptr = ptrtoint %Region* %region to i32
+  call void @db_write(i32 %ptr, i32 %msg)
+  ret void
+}
+
+define void @interface_version_7() {
+  ret void
+}
+
+define void @requires_stargate() {
+  ret void
+}
+
+define i32 @allocate(i32 %size) {
+  %region = call %Region* @new_region(i32 %size)
+  %ptr = ptrtoint %Region* %region to i32
+  ret i32 %ptr
+}
+
+define void @deallocate(i32 %ptr) {
+  ret void
+}
+
+define i32 @instantiate(i32 %env, i32 %info, i32 %msg) {
+  %data = getelementptr [62 x i8], [62 x i8]* @response, i32 0, i32 0
+  %ptr = call i32 @result(i8* %data, i32 62)
+  ret i32 %ptr
+}
+
+define i32 @execute(i32 %env, i32 %info, i32 %msg) {
+  %msg_region = inttoptr i32 %msg to %Region*
+  %msg_offset_field = getelementptr %Region, %Region* %msg_region, i32 0, i32 0
+  %msg_offset = load i32, i32* %msg_offset_field
+  %msg_data = inttoptr i32 %msg_offset to i8*
+  %msg_length_field = getelementptr %Region, %Region* %msg_region, i32 0, i32 2
+  %msg_length = load i32, i32* %msg_length_field
+  %len = add i32 %msg_length, 113
+  %region = call %Region* @new_region(i32 %len)
+  %prefix = getelementptr [33 x i8], [33 x i8]* @execute_prefix, i32 0, i32 0
+  call void @append(%Region* %region, i8* %prefix, i32 33)
+  call void @append(%Region* %region, i8* %msg_data, i32 %msg_length)
+  %suffix = getelementptr [80 x i8], [80 x i8]* @execute_suffix, i32 0, i32 0
+  call void @append(%Region* %region, i8* %suffix, i32 80)
+  %ptr = ptrtoint %Region* %region to i32
+  ret i32 %ptr
+}
+
+define i32 @ibc_channel_open(i32 %env, i32 %msg) {
+  %key = getelementptr [16 x i8], [16 x i8]* @key_channel_open, i32 0, i32 0
+  call void @record(i8* %key, i32 16, i32 %msg)
+  %data = getelementptr [11 x i8], [11 x i8]* @open_response, i32 0, i32 0
+  %ptr = call i32 @result(i8* %data, i32 11)
+  ret i32 %ptr
+}
+
+define i32 @ibc_channel_connect(i32 %env, i32 %msg) {
+  %key = getelementptr [19 x i8], [19 x i8]* @key_channel_connect, i32 0, i32 0
+  call void @record(i8* %key, i32 19, i32 %msg)
+  %data = getelementptr [50 x i8], [50 x i8]* @basic_response, i32 0, i32 0
+  %ptr = call i32 @result(i8* %data, i32 50)
+  ret i32 %ptr
+}
+
+define i32 @ibc_channel_close(i32 %env, i32 %msg) {
+  %key = getelementptr [17 x i8], [17 x i8]* @key_channel_close, i32 0, i32 0
+  call void @record(i8* %key, i32 17, i32 %msg)
+  %data = getelementptr [50 x i8], [50 x i8]* @basic_response, i32 0, i32 0
+  %ptr = call i32 @result(i8* %data, i32 50)
+  ret i32 %ptr
+}
+
+define i32 @ibc_packet_receive(i32 %env, i32 %msg) {
+  %key = getelementptr [18 x i8], [18 x i8]* @key_packet_receive, i32 0, i32 0
+  call void @record(i8* %key, i32 18, i32 %msg)
+  %data = getelementptr [75 x i8], [75 x i8]* @receive_response, i32 0, i32 0
+  %ptr = call i32 @result(i8* %data, i32 75)
+  ret i32 %ptr
+}
+
+define i32 @ibc_packet_ack(i32 %env, i32 %msg) {
+  %key = getelementptr [14 x i8], [14 x i8]* @key_packet_ack, i32 0, i32 0
+  call void @record(i8* %key, i32 14, i32 %msg)
+  %data = getelementptr [50 x i8], [50 x i8]* @basic_response, i32 0, i32 0
+  %ptr = call i32 @result(i8* %data, i32 50)
+  ret i32 %ptr
+}
+
+define i32 @ibc_packet_timeout(i32 %env, i32 %msg) {
+  %key = getelementptr [18 x i8], [18 x i8]* @key_packet_timeout, i32 0, i32 0
+  call void @record(i8* %key, i32 18, i32 %msg)
+  %data = getelementptr [50 x i8], [50 x i8]* @basic_response, i32 0, i32 0
+  %ptr = call i32 @result(i8* %data, i32 50)
+  ret i32 %ptr
+}
+
+attributes #0 = { "wasm-import-module"="env" "wasm-import-name"="db_write" }
@@ -63,6 +63,7 @@ func (WasmMsgParser) Parse(contractAddr sdk.AccAddress, wasmMsg wasmvmtypes.Cosm
 			msg.Instantiate.Msg,
 			coins,
 		)
+		cosmosMsg.Label = msg.Instantiate.Label
 
 		return cosmosMsg, cosmosMsg.ValidateBasic()
 	}
@@ -106,9 +107,58 @@ func (WasmMsgParser) Parse(contractAddr sdk.AccAddress, wasmMsg wasmvmtypes.Cosm
 	return nil, sdkerrors.Wrap(types.ErrInvalidMsg, "Unknown variant of Wasm")
 }
 
+// Instantiate2Msg instantiates a contract at the address derived from
+// the code hash, the sender contract and the salt
+type Instantiate2Msg struct {
+	Admin  string            `json:"admin,omitempty"`
+	CodeID uint64            `json:"code_id"`
+	Msg    []byte            `json:"msg"`
+	Funds  wasmvmtypes.Coins `json:"funds"`
+	Salt   []byte            `json:"salt"`
+	Label  string            `json:"label,omitempty"`
+}
+
+// CosmosMsg custom msg interface for wasm msgs not supported by the wasmvm msg enum
+type CosmosMsg struct {
+	Instantiate2 *Instantiate2Msg `json:"instantiate2,omitempty"`
+}
+
 // ParseCustom implements custom parser
 func (parser WasmMsgParser) ParseCustom(contractAddr sdk.AccAddress, data json.RawMessage) (sdk.Msg, error) {
-	return nil, nil
+	var params CosmosMsg
+	err := json.Unmarshal(data, &params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "failed to parse wasm custom msg")
+	}
+
+	if params.Instantiate2 != nil {
+		coins, err := types.ParseToCoins(params.Instantiate2.Funds)
+		if err != nil {
+			return nil, err
+		}
+
+		adminAddr := sdk.AccAddress{}
+		if params.Instantiate2.Admin != "" {
+			adminAddr, err = sdk.AccAddressFromBech32(params.Instantiate2.Admin)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		cosmosMsg := types.NewMsgInstantiateContract2(
+			contractAddr,
+			adminAddr,
+			params.Instantiate2.CodeID,
+			params.Instantiate2.Msg,
+			coins,
+			params.Instantiate2.Salt,
+		)
+		cosmosMsg.Label = params.Instantiate2.Label
+
+		return cosmosMsg, cosmosMsg.ValidateBasic()
+	}
+
+	return nil, sdkerrors.Wrap(types.ErrInvalidMsg, "Unknown variant of Wasm")
 }
 
 // WasmQuerier - wasm query interface for wasm contract
@@ -160,6 +210,7 @@ type ContractInfoQueryResponse struct {
 	Creator string `json:"creator"`
 	Admin   string `json:"admin,omitempty"`
 	CodeID  uint64 `json:"code_id"`
+	Label   string `json:"label,omitempty"`
 }
 
 // QueryCustom implements custom query interface
@@ -187,6 +238,7 @@ func (querier WasmQuerier) QueryCustom(ctx sdk.Context, data json.RawMessage) ([
 			Creator: contractInfo.Creator,
 			Admin:   contractInfo.Admin,
 			CodeID:  contractInfo.CodeID,
+			Label:   contractInfo.Label,
 		})
 
 		if err != nil {
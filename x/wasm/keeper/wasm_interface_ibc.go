@@ -0,0 +1,61 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	ibctransfertypes "github.com/cosmos/ibc-go/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/modules/core/04-channel/types"
+
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+
+	"github.com/terra-money/core/x/wasm/types"
+)
+
+var _ types.IBCWasmMsgParserInterface = IBCWasmMsgParser{}
+
+// IBCWasmMsgParser - wasm msg parser for ibc msgs
+type IBCWasmMsgParser struct {
+	portSource types.ICS20TransferPortSource
+}
+
+// NewIBCWasmMsgParser returns ibc wasm msg parser
+func NewIBCWasmMsgParser(portSource types.ICS20TransferPortSource) IBCWasmMsgParser {
+	return IBCWasmMsgParser{portSource}
+}
+
+// Parse implements wasm ibc msg parser; raw packets are sent by the keeper
+// itself, so only transfer and channel close msgs are converted here
+func (parser IBCWasmMsgParser) Parse(ctx sdk.Context, contractAddr sdk.AccAddress, wasmMsg wasmvmtypes.CosmosMsg) (sdk.Msg, error) {
+	msg := wasmMsg.IBC
+
+	if msg.Transfer != nil {
+		amount, err := types.ParseToCoin(msg.Transfer.Amount)
+		if err != nil {
+			return nil, err
+		}
+
+		cosmosMsg := ibctransfertypes.NewMsgTransfer(
+			parser.portSource.GetPort(ctx),
+			msg.Transfer.ChannelID,
+			amount,
+			contractAddr.String(),
+			msg.Transfer.ToAddress,
+			types.NewIBCTimeoutHeight(msg.Transfer.Timeout.Block),
+			msg.Transfer.Timeout.Timestamp,
+		)
+
+		return cosmosMsg, cosmosMsg.ValidateBasic()
+	}
+
+	if msg.CloseChannel != nil {
+		cosmosMsg := channeltypes.NewMsgChannelCloseInit(
+			types.PortIDForContract(contractAddr),
+			msg.CloseChannel.ChannelID,
+			contractAddr.String(),
+		)
+
+		return cosmosMsg, cosmosMsg.ValidateBasic()
+	}
+
+	return nil, sdkerrors.Wrap(types.ErrInvalidMsg, "Unknown variant of IBC")
+}
@@ -2,10 +2,13 @@ package keeper
 
 import (
 	"fmt"
-	"strings"
+	"reflect"
+
+	"github.com/gogo/protobuf/proto"
 
 	abci "github.com/tendermint/tendermint/abci/types"
 
+	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -59,17 +62,13 @@ func NewStargateWasmQuerier(keeper Keeper) StargateWasmQuerier {
 	return StargateWasmQuerier{keeper}
 }
 
-var queryBlackList = []string{
-	"/cosmos.tx",
-	"/cosmos.base.tendermint",
-}
-
-// Query - implement query function
+// Query - implement query function; only the allowlisted paths are routed and
+// their responses are re-marshalled into the registered response type, so the
+// contracts receive canonical bytes regardless of the node version
 func (querier StargateWasmQuerier) Query(ctx sdk.Context, request wasmvmtypes.QueryRequest) ([]byte, error) {
-	for _, b := range queryBlackList {
-		if strings.Contains(request.Stargate.Path, b) {
-			return nil, wasmvmtypes.UnsupportedRequest{Kind: fmt.Sprintf("'%s' path is not allowed from the contract", request.Stargate.Path)}
-		}
+	responseType, found := querier.getResponseType(ctx, request.Stargate.Path)
+	if !found {
+		return nil, wasmvmtypes.UnsupportedRequest{Kind: fmt.Sprintf("'%s' path is not allowed from the contract", request.Stargate.Path)}
 	}
 
 	route := querier.keeper.queryRouter.Route(request.Stargate.Path)
@@ -86,5 +85,29 @@ func (querier StargateWasmQuerier) Query(ctx sdk.Context, request wasmvmtypes.Qu
 		return nil, err
 	}
 
-	return res.Value, nil
+	msgType := proto.MessageType(responseType)
+	if msgType == nil {
+		return nil, wasmvmtypes.UnsupportedRequest{Kind: fmt.Sprintf("Unknown response type '%s' of '%s'", responseType, request.Stargate.Path)}
+	}
+
+	msg, ok := reflect.New(msgType.Elem()).Interface().(codec.ProtoMarshaler)
+	if !ok {
+		return nil, wasmvmtypes.UnsupportedRequest{Kind: fmt.Sprintf("Invalid response type '%s' of '%s'", responseType, request.Stargate.Path)}
+	}
+
+	if err := querier.keeper.cdc.Unmarshal(res.Value, msg); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	return querier.keeper.cdc.Marshal(msg)
+}
+
+func (querier StargateWasmQuerier) getResponseType(ctx sdk.Context, path string) (string, bool) {
+	for _, query := range querier.keeper.StargateQueryAllowlist(ctx) {
+		if query.Path == path {
+			return query.ResponseType, true
+		}
+	}
+
+	return "", false
 }
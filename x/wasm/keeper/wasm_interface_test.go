@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"encoding/json"
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -12,6 +13,9 @@ import (
 	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/modules/core/04-channel/types"
 
 	core "github.com/terra-money/core/types"
 	"github.com/terra-money/core/x/wasm/types"
@@ -173,6 +177,158 @@ func TestEcoding(t *testing.T) {
 	}
 }
 
+func TestWasmCustomEncoding(t *testing.T) {
+	addrs := []sdk.AccAddress{
+		sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()),
+		sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()),
+	}
+
+	cases := map[string]struct {
+		sender sdk.AccAddress
+		input  json.RawMessage
+		// set if valid
+		output sdk.Msg
+		// set if invalid
+		isError bool
+	}{
+		"instantiate2": {
+			sender: addrs[0],
+			input: []byte(fmt.Sprintf(
+				`{"instantiate2":{"admin":"%s","code_id":7,"msg":"e30=","funds":[{"denom":"%s","amount":"1234"}],"salt":"c2FsdA=="}}`,
+				addrs[1], core.MicroLunaDenom)),
+			output: &types.MsgInstantiateContract2{
+				Sender:    addrs[0].String(),
+				Admin:     addrs[1].String(),
+				CodeID:    7,
+				InitMsg:   []byte("{}"),
+				InitCoins: sdk.NewCoins(sdk.NewInt64Coin(core.MicroLunaDenom, 1234)),
+				Salt:      []byte("salt"),
+			},
+		},
+		"instantiate2 without salt": {
+			sender:  addrs[0],
+			input:   []byte(`{"instantiate2":{"code_id":7,"msg":"e30=","funds":[]}}`),
+			isError: true,
+		},
+		"unknown variant": {
+			sender:  addrs[0],
+			input:   []byte(`{"instantiate3":{}}`),
+			isError: true,
+		},
+	}
+
+	parser := NewWasmMsgParser()
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			res, err := parser.ParseCustom(tc.sender, tc.input)
+			if tc.isError {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tc.output, res)
+			}
+		})
+	}
+}
+
+func TestIBCEncoding(t *testing.T) {
+	addrs := []sdk.AccAddress{
+		sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()),
+		sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()),
+	}
+
+	cases := map[string]struct {
+		sender sdk.AccAddress
+		input  wasmvmtypes.CosmosMsg
+		// set if valid
+		output sdk.Msg
+		// set if invalid
+		isError bool
+	}{
+		"simple transfer": {
+			sender: addrs[0],
+			input: wasmvmtypes.CosmosMsg{
+				IBC: &wasmvmtypes.IBCMsg{
+					Transfer: &wasmvmtypes.TransferMsg{
+						ChannelID: "channel-0",
+						ToAddress: addrs[1].String(),
+						Amount:    wasmvmtypes.NewCoin(1234, core.MicroLunaDenom),
+						Timeout: wasmvmtypes.IBCTimeout{
+							Block: &wasmvmtypes.IBCTimeoutBlock{Revision: 1, Height: 100},
+						},
+					},
+				},
+			},
+			output: &ibctransfertypes.MsgTransfer{
+				SourcePort:    ibctransfertypes.PortID,
+				SourceChannel: "channel-0",
+				Token:         sdk.NewInt64Coin(core.MicroLunaDenom, 1234),
+				Sender:        addrs[0].String(),
+				Receiver:      addrs[1].String(),
+				TimeoutHeight: clienttypes.NewHeight(1, 100),
+			},
+		},
+		"transfer of zero amount": {
+			sender: addrs[0],
+			input: wasmvmtypes.CosmosMsg{
+				IBC: &wasmvmtypes.IBCMsg{
+					Transfer: &wasmvmtypes.TransferMsg{
+						ChannelID: "channel-0",
+						ToAddress: addrs[1].String(),
+						Amount:    wasmvmtypes.NewCoin(0, core.MicroLunaDenom),
+						Timeout: wasmvmtypes.IBCTimeout{
+							Block: &wasmvmtypes.IBCTimeoutBlock{Revision: 1, Height: 100},
+						},
+					},
+				},
+			},
+			isError: true,
+		},
+		"simple close channel": {
+			sender: addrs[0],
+			input: wasmvmtypes.CosmosMsg{
+				IBC: &wasmvmtypes.IBCMsg{
+					CloseChannel: &wasmvmtypes.CloseChannelMsg{
+						ChannelID: "channel-0",
+					},
+				},
+			},
+			output: &channeltypes.MsgChannelCloseInit{
+				PortId:    types.PortIDForContract(addrs[0]),
+				ChannelId: "channel-0",
+				Signer:    addrs[0].String(),
+			},
+		},
+		"send packet": {
+			sender: addrs[0],
+			input: wasmvmtypes.CosmosMsg{
+				IBC: &wasmvmtypes.IBCMsg{
+					SendPacket: &wasmvmtypes.SendPacketMsg{
+						ChannelID: "channel-0",
+						Data:      []byte("{}"),
+					},
+				},
+			},
+			isError: true,
+		},
+	}
+
+	parser := NewIBCWasmMsgParser(mockICS20TransferPortSource{})
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			res, err := parser.Parse(sdk.Context{}, tc.sender, tc.input)
+			if tc.isError {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tc.output, res)
+			}
+		})
+	}
+}
+
 func TestQueryRaw(t *testing.T) {
 	input := CreateTestInput(t)
 
@@ -212,7 +368,9 @@ func TestQueryRaw(t *testing.T) {
 func TestQueryContractInfo(t *testing.T) {
 	input := CreateTestInput(t)
 
-	input.WasmKeeper.SetContractInfo(input.Ctx, Addrs[0], types.NewContractInfo(1, Addrs[0], Addrs[1], sdk.AccAddress{}, []byte{}))
+	contractInfo := types.NewContractInfo(1, Addrs[0], Addrs[1], sdk.AccAddress{}, []byte{})
+	contractInfo.Label = "my contract"
+	input.WasmKeeper.SetContractInfo(input.Ctx, Addrs[0], contractInfo)
 
 	bz, err := json.Marshal(CosmosQuery{
 		ContractInfo: &ContractInfoQueryParams{
@@ -234,5 +392,6 @@ func TestQueryContractInfo(t *testing.T) {
 		Creator: Addrs[1].String(),
 		Admin:   "",
 		CodeID:  1,
+		Label:   "my contract",
 	})
 }
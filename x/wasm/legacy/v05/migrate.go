@@ -22,6 +22,8 @@ func Migrate(
 				CodeID:   c.CodeInfo.CodeID,
 				CodeHash: []byte{},
 				Creator:  c.CodeInfo.Creator.String(),
+
+				InstantiatePermission: v05wasm.AllowEverybody,
 			},
 			CodeBytes: []byte{},
 		}
@@ -59,6 +61,11 @@ func Migrate(
 			MaxContractSize:    v05wasm.DefaultMaxContractSize,
 			MaxContractMsgSize: v05wasm.DefaultMaxContractMsgSize,
 			MaxContractGas:     v05wasm.DefaultMaxContractGas,
+
+			CodeUploadAccess:             v05wasm.AllowEverybody,
+			InstantiateDefaultPermission: v05wasm.DefaultInstantiateDefaultPermission,
+
+			StargateQueryAllowlist: v05wasm.DefaultStargateQueryAllowlist,
 		},
 		Codes:          codes,
 		Contracts:      contracts,
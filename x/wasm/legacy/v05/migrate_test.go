@@ -132,28 +132,51 @@ func TestMigrate(t *testing.T) {
 			"code_info": {
 				"code_hash": "",
 				"code_id": "1",
-				"creator": "terra1mx72uukvzqtzhc6gde7shrjqfu5srk22v7gmww"
-			}
+				"creator": "terra1mx72uukvzqtzhc6gde7shrjqfu5srk22v7gmww",
+				"instantiate_permission": {
+					"addresses": [],
+					"permission": "ACCESS_TYPE_EVERYBODY"
+				},
+				"metadata": {
+					"builder": "",
+					"schema": "",
+					"source": ""
+				}
+			},
+			"pinned": false
 		},
 		{
 			"code_bytes": "",
 			"code_info": {
 				"code_hash": "",
 				"code_id": "2",
-				"creator": "terra1mx72uukvzqtzhc6gde7shrjqfu5srk22v7gmww"
-			}
+				"creator": "terra1mx72uukvzqtzhc6gde7shrjqfu5srk22v7gmww",
+				"instantiate_permission": {
+					"addresses": [],
+					"permission": "ACCESS_TYPE_EVERYBODY"
+				},
+				"metadata": {
+					"builder": "",
+					"schema": "",
+					"source": ""
+				}
+			},
+			"pinned": false
 		}
 	],
 	"contracts": [
 		{
+			"contract_code_history": [],
 			"contract_info": {
 				"address": "terra13vs2znvhdcy948ejsh7p8p22j8l4n4y07062qq",
 				"admin": "terra1mx72uukvzqtzhc6gde7shrjqfu5srk22v7gmww",
 				"code_id": "1",
 				"creator": "terra1mx72uukvzqtzhc6gde7shrjqfu5srk22v7gmww",
+				"ibc_port_id": "",
 				"init_msg": {
 					"key": "value"
-				}
+				},
+				"label": ""
 			},
 			"contract_store": [
 				{
@@ -167,14 +190,17 @@ func TestMigrate(t *testing.T) {
 			]
 		},
 		{
+			"contract_code_history": [],
 			"contract_info": {
 				"address": "terra13vs2znvhdcy948ejsh7p8p22j8l4n4y07062qq",
 				"admin": "",
 				"code_id": "2",
 				"creator": "terra1mx72uukvzqtzhc6gde7shrjqfu5srk22v7gmww",
+				"ibc_port_id": "",
 				"init_msg": {
 					"key": "value"
-				}
+				},
+				"label": ""
 			},
 			"contract_store": [
 				{
@@ -191,9 +217,64 @@ func TestMigrate(t *testing.T) {
 	"last_code_id": "2",
 	"last_instance_id": "2",
 	"params": {
+		"code_upload_access": {
+			"addresses": [],
+			"permission": "ACCESS_TYPE_EVERYBODY"
+		},
+		"instantiate_default_permission": "ACCESS_TYPE_EVERYBODY",
 		"max_contract_gas": "20000000",
 		"max_contract_msg_size": "4096",
-		"max_contract_size": "614400"
+		"max_contract_size": "614400",
+		"stargate_query_allowlist": [
+			{
+				"path": "/cosmos.auth.v1beta1.Query/Account",
+				"response_type": "cosmos.auth.v1beta1.QueryAccountResponse"
+			},
+			{
+				"path": "/cosmos.bank.v1beta1.Query/Balance",
+				"response_type": "cosmos.bank.v1beta1.QueryBalanceResponse"
+			},
+			{
+				"path": "/cosmos.bank.v1beta1.Query/AllBalances",
+				"response_type": "cosmos.bank.v1beta1.QueryAllBalancesResponse"
+			},
+			{
+				"path": "/cosmos.bank.v1beta1.Query/SupplyOf",
+				"response_type": "cosmos.bank.v1beta1.QuerySupplyOfResponse"
+			},
+			{
+				"path": "/cosmos.distribution.v1beta1.Query/DelegationRewards",
+				"response_type": "cosmos.distribution.v1beta1.QueryDelegationRewardsResponse"
+			},
+			{
+				"path": "/cosmos.staking.v1beta1.Query/Validator",
+				"response_type": "cosmos.staking.v1beta1.QueryValidatorResponse"
+			},
+			{
+				"path": "/cosmos.staking.v1beta1.Query/Delegation",
+				"response_type": "cosmos.staking.v1beta1.QueryDelegationResponse"
+			},
+			{
+				"path": "/terra.market.v1beta1.Query/Swap",
+				"response_type": "terra.market.v1beta1.QuerySwapResponse"
+			},
+			{
+				"path": "/terra.oracle.v1beta1.Query/ExchangeRate",
+				"response_type": "terra.oracle.v1beta1.QueryExchangeRateResponse"
+			},
+			{
+				"path": "/terra.treasury.v1beta1.Query/TaxRate",
+				"response_type": "terra.treasury.v1beta1.QueryTaxRateResponse"
+			},
+			{
+				"path": "/terra.treasury.v1beta1.Query/TaxCap",
+				"response_type": "terra.treasury.v1beta1.QueryTaxCapResponse"
+			},
+			{
+				"path": "/terra.wasm.v1beta1.Query/ContractInfo",
+				"response_type": "terra.wasm.v1beta1.QueryContractInfoResponse"
+			}
+		]
 	}
 }`
 	assert.JSONEq(t, expected, string(indentedBz))
@@ -0,0 +1,38 @@
+package wasm
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/terra-money/core/x/wasm/keeper"
+	"github.com/terra-money/core/x/wasm/types"
+)
+
+// NewProposalHandler creates a new handler for wasm governance proposals
+func NewProposalHandler(k keeper.Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		switch c := content.(type) {
+		case *types.PinCodesProposal:
+			return keeper.HandlePinCodesProposal(ctx, k, c)
+
+		case *types.UnpinCodesProposal:
+			return keeper.HandleUnpinCodesProposal(ctx, k, c)
+
+		case *types.StoreCodeProposal:
+			return keeper.HandleStoreCodeProposal(ctx, k, c)
+
+		case *types.InstantiateContractProposal:
+			return keeper.HandleInstantiateContractProposal(ctx, k, c)
+
+		case *types.MigrateContractProposal:
+			return keeper.HandleMigrateContractProposal(ctx, k, c)
+
+		case *types.SudoContractProposal:
+			return keeper.HandleSudoContractProposal(ctx, k, c)
+
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized wasm proposal content type: %T", c)
+		}
+	}
+}
@@ -32,7 +32,7 @@ func TestDecodeDistributionStore(t *testing.T) {
 	binary.LittleEndian.PutUint64(lastCodeIDbz, 123)
 	binary.LittleEndian.PutUint64(lastInstanceIDbz, 456)
 
-	codeInfo := types.NewCodeInfo(1, []byte{1, 2, 3}, creatorAddr)
+	codeInfo := types.NewCodeInfo(1, []byte{1, 2, 3}, creatorAddr, types.AllowEverybody)
 	contractInfo := types.NewContractInfo(1, contractAddr, creatorAddr, creatorAddr, []byte{4, 5, 6})
 	emptyAdminContractInfo := types.NewContractInfo(1, contractAddr, creatorAddr, sdk.AccAddress{}, []byte{4, 5, 6})
 	contractStore := []byte{7, 8, 9}
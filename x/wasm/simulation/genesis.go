@@ -73,6 +73,12 @@ func RandomizedGenState(simState *module.SimulationState) {
 			MaxContractSize:    maxContractSize,
 			MaxContractGas:     maxContractGas,
 			MaxContractMsgSize: maxContractMsgSize,
+
+			// the simulated accounts store and instantiate the test contract
+			CodeUploadAccess:             types.AllowEverybody,
+			InstantiateDefaultPermission: types.AccessTypeEverybody,
+
+			StargateQueryAllowlist: types.DefaultStargateQueryAllowlist,
 		},
 		0,
 		0,
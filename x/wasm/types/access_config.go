@@ -0,0 +1,96 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var (
+	// AllowEverybody is the access config which allows the action for all accounts
+	AllowEverybody = AccessConfig{Permission: AccessTypeEverybody}
+
+	// AllowNobody is the access config which forbids the action for all accounts
+	AllowNobody = AccessConfig{Permission: AccessTypeNobody}
+)
+
+// NewAllowlistAccessConfig creates an access config which allows the action only for the given addresses
+func NewAllowlistAccessConfig(addrs ...sdk.AccAddress) AccessConfig {
+	addresses := make([]string, len(addrs))
+	for i, addr := range addrs {
+		addresses[i] = addr.String()
+	}
+
+	return AccessConfig{
+		Permission: AccessTypeAllowlist,
+		Addresses:  addresses,
+	}
+}
+
+// NewInstantiateAccessConfig returns the instantiate access config of a new code
+// for the given default permission; the allowlist only contains the code creator
+func NewInstantiateAccessConfig(permission AccessType, creator sdk.AccAddress) AccessConfig {
+	if permission == AccessTypeAllowlist {
+		return NewAllowlistAccessConfig(creator)
+	}
+
+	return AccessConfig{Permission: permission}
+}
+
+// Allowed returns true when the actor is allowed by the access config
+func (a AccessConfig) Allowed(actor sdk.AccAddress) bool {
+	switch a.Permission {
+	case AccessTypeEverybody:
+		return true
+	case AccessTypeAllowlist:
+		for _, addr := range a.Addresses {
+			if addr == actor.String() {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// ValidateBasic performs stateless validation of the access config
+func (a AccessConfig) ValidateBasic() error {
+	if err := validateAccessType(a.Permission); err != nil {
+		return err
+	}
+
+	if a.Permission != AccessTypeAllowlist {
+		if len(a.Addresses) != 0 {
+			return sdkerrors.Wrapf(ErrInvalidAccessConfig, "addresses are only allowed for %s", AccessTypeAllowlist)
+		}
+
+		return nil
+	}
+
+	if len(a.Addresses) == 0 {
+		return sdkerrors.Wrap(ErrInvalidAccessConfig, "empty allowlist")
+	}
+
+	seen := make(map[string]bool, len(a.Addresses))
+	for _, addr := range a.Addresses {
+		if _, err := sdk.AccAddressFromBech32(addr); err != nil {
+			return sdkerrors.Wrapf(ErrInvalidAccessConfig, "invalid address %s: %s", addr, err)
+		}
+
+		if seen[addr] {
+			return sdkerrors.Wrapf(ErrInvalidAccessConfig, "duplicate address %s", addr)
+		}
+
+		seen[addr] = true
+	}
+
+	return nil
+}
+
+func validateAccessType(permission AccessType) error {
+	switch permission {
+	case AccessTypeNobody, AccessTypeAllowlist, AccessTypeEverybody:
+		return nil
+	}
+
+	return sdkerrors.Wrapf(ErrInvalidAccessConfig, "unknown permission %s", permission)
+}
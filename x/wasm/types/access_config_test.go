@@ -0,0 +1,40 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestAccessConfigAllowed(t *testing.T) {
+	alice := sdk.AccAddress([]byte("addr1_______________"))
+	bob := sdk.AccAddress([]byte("addr2_______________"))
+
+	require.True(t, AllowEverybody.Allowed(alice))
+	require.False(t, AllowNobody.Allowed(alice))
+	require.False(t, AccessConfig{}.Allowed(alice))
+
+	allowlist := NewAllowlistAccessConfig(alice)
+	require.True(t, allowlist.Allowed(alice))
+	require.False(t, allowlist.Allowed(bob))
+
+	require.Equal(t, allowlist, NewInstantiateAccessConfig(AccessTypeAllowlist, alice))
+	require.Equal(t, AllowNobody, NewInstantiateAccessConfig(AccessTypeNobody, alice))
+}
+
+func TestAccessConfigValidateBasic(t *testing.T) {
+	alice := sdk.AccAddress([]byte("addr1_______________"))
+
+	require.NoError(t, AllowEverybody.ValidateBasic())
+	require.NoError(t, AllowNobody.ValidateBasic())
+	require.NoError(t, NewAllowlistAccessConfig(alice).ValidateBasic())
+
+	require.Error(t, AccessConfig{}.ValidateBasic())
+	require.Error(t, AccessConfig{Permission: AccessType(4)}.ValidateBasic())
+	require.Error(t, AccessConfig{Permission: AccessTypeAllowlist}.ValidateBasic())
+	require.Error(t, NewAllowlistAccessConfig(alice, alice).ValidateBasic())
+	require.Error(t, AccessConfig{Permission: AccessTypeAllowlist, Addresses: []string{"invalid"}}.ValidateBasic())
+	require.Error(t, AccessConfig{Permission: AccessTypeEverybody, Addresses: []string{alice.String()}}.ValidateBasic())
+}
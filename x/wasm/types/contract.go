@@ -7,14 +7,16 @@ import (
 	"github.com/tendermint/tendermint/crypto"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
 )
 
 // NewCodeInfo fills a new Contract struct
-func NewCodeInfo(codeID uint64, codeHash []byte, creator sdk.AccAddress) CodeInfo {
+func NewCodeInfo(codeID uint64, codeHash []byte, creator sdk.AccAddress, instantiatePermission AccessConfig) CodeInfo {
 	return CodeInfo{
-		CodeID:   codeID,
-		CodeHash: codeHash,
-		Creator:  creator.String(),
+		CodeID:                codeID,
+		CodeHash:              codeHash,
+		Creator:               creator.String(),
+		InstantiatePermission: instantiatePermission,
 	}
 }
 
@@ -34,6 +36,23 @@ func NewContractInfo(codeID uint64, address, creator, admin sdk.AccAddress, init
 	}
 }
 
+// NewContractCodeHistoryEntry creates a new contract code history entry;
+// an empty sender stands for governance
+func NewContractCodeHistoryEntry(operation ContractCodeHistoryOperationType, codeID uint64, height int64, sender sdk.AccAddress, msg []byte) ContractCodeHistoryEntry {
+	var senderAddr string
+	if !sender.Empty() {
+		senderAddr = sender.String()
+	}
+
+	return ContractCodeHistoryEntry{
+		Operation: operation,
+		CodeID:    codeID,
+		Height:    height,
+		Sender:    senderAddr,
+		Msg:       msg,
+	}
+}
+
 // NewEnv initializes the environment for a contract instance
 func NewEnv(ctx sdk.Context, contractAddr sdk.AccAddress) wasmvmtypes.Env {
 	env := wasmvmtypes.Env{
@@ -78,6 +97,20 @@ func GenerateContractAddress(codeID uint64, instanceID uint64) sdk.AccAddress {
 	return addrFromUint64(contractID)
 }
 
+// MaxSaltSize is the max byte length of the salt of a predictable contract address
+const MaxSaltSize = 64
+
+// GenerateContractAddress2 generates a predictable contract address from
+// the code hash, the creator and the salt; the result does not depend on
+// the instantiation order
+func GenerateContractAddress2(codeHash []byte, creator sdk.AccAddress, salt []byte) sdk.AccAddress {
+	key := []byte("wasm2")
+	key = append(key, address.MustLengthPrefix(codeHash)...)
+	key = append(key, address.MustLengthPrefix(creator)...)
+	key = append(key, address.MustLengthPrefix(salt)...)
+	return sdk.AccAddress(crypto.AddressHash(key))
+}
+
 func addrFromUint64(id uint64) sdk.AccAddress {
 	addr := make([]byte, 20)
 	addr[0] = 'C'
@@ -24,4 +24,15 @@ var (
 	ErrExceedMaxContractDataSize = sdkerrors.Register(ModuleName, 17, "exceeds max contract data size limit")
 	ErrReplyFailed               = sdkerrors.Register(ModuleName, 18, "reply wasm contract failed")
 	ErrExceedMaxQueryDepth       = sdkerrors.Register(ModuleName, 19, "exceed max query depth")
+	ErrIBCCallbackFailed         = sdkerrors.Register(ModuleName, 20, "ibc callback of wasm contract failed")
+	ErrInvalidIBCPort            = sdkerrors.Register(ModuleName, 21, "invalid contract ibc port")
+	ErrPinContractFailed         = sdkerrors.Register(ModuleName, 22, "pinning contract failed")
+	ErrUnpinContractFailed       = sdkerrors.Register(ModuleName, 23, "unpinning contract failed")
+	ErrEmptyCodeIDs              = sdkerrors.Register(ModuleName, 24, "code ids cannot be empty")
+	ErrDuplicateCodeID           = sdkerrors.Register(ModuleName, 25, "duplicate code id")
+	ErrInvalidAccessConfig       = sdkerrors.Register(ModuleName, 26, "invalid access config")
+	ErrInvalidSalt               = sdkerrors.Register(ModuleName, 27, "invalid salt")
+	ErrInvalidLabel              = sdkerrors.Register(ModuleName, 28, "invalid label")
+	ErrInvalidCodeMetadata       = sdkerrors.Register(ModuleName, 29, "invalid code metadata")
+	ErrSudoFailed                = sdkerrors.Register(ModuleName, 30, "sudo wasm contract failed")
 )
@@ -8,8 +8,14 @@ const (
 	EventTypeInstantiateContract = "instantiate_contract"
 	EventTypeExecuteContract     = "execute_contract"
 	EventTypeMigrateContract     = "migrate_contract"
+	EventTypeSudoContract        = "sudo_contract"
 	EventTypeUpdateContractAdmin = "update_contract_admin"
 	EventTypeClearContractAdmin  = "clear_contract_admin"
+	EventTypeUpdateContractLabel = "update_contract_label"
+	EventTypeUpdateCodeMetadata  = "update_code_metadata"
+	EventTypeBurnContractCoins   = "burn_contract_coins"
+	EventTypePinCode             = "pin_code"
+	EventTypeUnpinCode           = "unpin_code"
 	EventTypeWasmPrefix          = "wasm"
 
 	// Deprecated
@@ -21,6 +27,8 @@ const (
 	AttributeKeyContractID      = "contract_id"
 	AttributeKeyAdmin           = "admin"
 	AttributeKeyCreator         = "creator"
+	AttributeKeyLabel           = "label"
+	AttributeKeyAmount          = "amount"
 
 	AttributeValueCategory = ModuleName
 )
@@ -26,6 +26,9 @@ type BankKeeper interface {
 	// used to deduct tax
 	SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
 
+	// used to burn contract coins
+	BurnCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error
+
 	// used for simulation
 	SpendableCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
 	GetBalance(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin
@@ -38,7 +41,10 @@ type BankKeeper interface {
 type TreasuryKeeper interface {
 	RecordEpochTaxProceeds(ctx sdk.Context, delta sdk.Coins)
 	GetTaxRate(ctx sdk.Context) (taxRate sdk.Dec)
+	GetDenomTaxRateOverride(ctx sdk.Context, denom string) (taxRate sdk.Dec, found bool)
 	GetTaxCap(ctx sdk.Context, denom string) (taxCap sdk.Int)
+	IsTaxExempt(ctx sdk.Context, addresses ...string) bool
+	RecordBurned(ctx sdk.Context, coins sdk.Coins)
 }
 
 // GRPCQueryHandler defines a function type which handles ABCI Query requests
@@ -12,6 +12,7 @@ const (
 
 	compileCostPerByte             = uint64(2)       // sdk gas cost per bytes
 	instantiateCost                = uint64(40_000)  // sdk gas cost for executing wasmVM engine
+	instantiatePinnedCost          = uint64(4_000)   // sdk gas cost for executing wasmVM engine with pinned code
 	registerCost                   = uint64(160_000) // sdk gas cost for creating contract
 	humanizeCost                   = uint64(5)       // sdk gas cost to convert canonical address to human address
 	canonicalizeCost               = uint64(4)       // sdk gas cost to convert human address to canonical address
@@ -40,9 +41,14 @@ func CompileCosts(byteLength int) sdk.Gas {
 	return sdk.NewUint(compileCostPerByte).MulUint64(uint64(byteLength)).Uint64()
 }
 
-// InstantiateContractCosts costs when interacting with a wasm contract
-func InstantiateContractCosts(msgLen int) sdk.Gas {
+// InstantiateContractCosts costs when interacting with a wasm contract;
+// pinned code is always kept in the wasm vm cache, so it is cheaper to load
+func InstantiateContractCosts(pinned bool, msgLen int) sdk.Gas {
 	dataCosts := sdk.NewUint(sdk.Gas(msgLen)).MulUint64(contractMessageDataCostPerByte)
+	if pinned {
+		return dataCosts.AddUint64(instantiatePinnedCost).Uint64()
+	}
+
 	return dataCosts.AddUint64(instantiateCost).Uint64()
 }
 
@@ -52,7 +58,7 @@ func RegisterContractCosts() sdk.Gas {
 }
 
 // ReplyCosts costs to to handle a message reply
-func ReplyCosts(reply wasmvmtypes.Reply) sdk.Gas {
+func ReplyCosts(pinned bool, reply wasmvmtypes.Reply) sdk.Gas {
 	msgLen := len(reply.Result.Err)
 
 	eventGas := sdk.NewUint(0)
@@ -68,7 +74,7 @@ func ReplyCosts(reply wasmvmtypes.Reply) sdk.Gas {
 		eventGas = eventGas.AddUint64(eventAttributeCosts(attrs))
 	}
 
-	return eventGas.AddUint64(InstantiateContractCosts(msgLen)).Uint64()
+	return eventGas.AddUint64(InstantiateContractCosts(pinned, msgLen)).Uint64()
 }
 
 // EventCosts costs to persist an event
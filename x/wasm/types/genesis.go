@@ -41,6 +41,20 @@ func ValidateGenesis(data *GenesisState) error {
 		return sdkerrors.Wrap(ErrInvalidGenesis, "the number of contracts is not met with LastInstanceID")
 	}
 
+	for _, code := range data.Codes {
+		if err := code.CodeInfo.InstantiatePermission.ValidateBasic(); err != nil {
+			return sdkerrors.Wrapf(ErrInvalidGenesis, "code %d: %s", code.CodeInfo.CodeID, err)
+		}
+	}
+
+	for _, contract := range data.Contracts {
+		for _, entry := range contract.ContractCodeHistory {
+			if _, ok := ContractCodeHistoryOperationType_name[int32(entry.Operation)]; !ok || entry.Operation == ContractCodeHistoryOperationTypeUnspecified {
+				return sdkerrors.Wrapf(ErrInvalidGenesis, "contract %s: invalid code history operation %d", contract.ContractInfo.Address, entry.Operation)
+			}
+		}
+	}
+
 	return data.Params.Validate()
 }
 
@@ -154,10 +154,12 @@ func (m *Model) GetValue() []byte {
 	return nil
 }
 
-// Code struct encompasses CodeInfo and CodeBytes
+// Code struct encompasses CodeInfo, CodeBytes and the pinned flag
 type Code struct {
 	CodeInfo  CodeInfo `protobuf:"bytes,1,opt,name=code_info,json=codeInfo,proto3" json:"code_info"`
 	CodeBytes []byte   `protobuf:"bytes,2,opt,name=code_bytes,json=codeBytes,proto3" json:"code_bytes,omitempty"`
+	// Pinned to the wasm vm cache
+	Pinned bool `protobuf:"varint,3,opt,name=pinned,proto3" json:"pinned,omitempty"`
 }
 
 func (m *Code) Reset()         { *m = Code{} }
@@ -207,10 +209,18 @@ func (m *Code) GetCodeBytes() []byte {
 	return nil
 }
 
-// Contract struct encompasses ContractAddress, ContractInfo, and ContractState
+func (m *Code) GetPinned() bool {
+	if m != nil {
+		return m.Pinned
+	}
+	return false
+}
+
+// Contract struct encompasses ContractAddress, ContractInfo, ContractState and ContractCodeHistory
 type Contract struct {
-	ContractInfo  ContractInfo `protobuf:"bytes,1,opt,name=contract_info,json=contractInfo,proto3" json:"contract_info"`
-	ContractStore []Model      `protobuf:"bytes,2,rep,name=contract_store,json=contractStore,proto3" json:"contract_store"`
+	ContractInfo        ContractInfo               `protobuf:"bytes,1,opt,name=contract_info,json=contractInfo,proto3" json:"contract_info"`
+	ContractStore       []Model                    `protobuf:"bytes,2,rep,name=contract_store,json=contractStore,proto3" json:"contract_store"`
+	ContractCodeHistory []ContractCodeHistoryEntry `protobuf:"bytes,3,rep,name=contract_code_history,json=contractCodeHistory,proto3" json:"contract_code_history"`
 }
 
 func (m *Contract) Reset()         { *m = Contract{} }
@@ -260,6 +270,13 @@ func (m *Contract) GetContractStore() []Model {
 	return nil
 }
 
+func (m *Contract) GetContractCodeHistory() []ContractCodeHistoryEntry {
+	if m != nil {
+		return m.ContractCodeHistory
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "terra.wasm.v1beta1.GenesisState")
 	proto.RegisterType((*Model)(nil), "terra.wasm.v1beta1.Model")
@@ -270,37 +287,39 @@ func init() {
 func init() { proto.RegisterFile("terra/wasm/v1beta1/genesis.proto", fileDescriptor_bd15c5bc3571c951) }
 
 var fileDescriptor_bd15c5bc3571c951 = []byte{
-	// 467 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x52, 0xcd, 0x6e, 0xd3, 0x40,
-	0x10, 0x8e, 0x9b, 0xa4, 0x2a, 0x53, 0x13, 0x55, 0xab, 0x1e, 0x4c, 0xd4, 0x3a, 0x56, 0x4e, 0xb9,
-	0xe0, 0xa5, 0x85, 0x03, 0x07, 0x24, 0x90, 0xa9, 0x40, 0x11, 0x20, 0x21, 0xf7, 0xc6, 0xa5, 0x5a,
-	0xaf, 0x37, 0xc1, 0xc2, 0xf6, 0x44, 0xde, 0x6d, 0x21, 0x6f, 0xc1, 0x1b, 0xf0, 0x0a, 0x3c, 0x46,
-	0x8f, 0x3d, 0x72, 0x8a, 0x90, 0xf3, 0x22, 0x68, 0xd7, 0x9b, 0x10, 0x09, 0xd3, 0xdb, 0xcc, 0xce,
-	0xf7, 0x37, 0xa3, 0x85, 0x40, 0x89, 0xaa, 0x62, 0xf4, 0x2b, 0x93, 0x05, 0xbd, 0x39, 0x4b, 0x84,
-	0x62, 0x67, 0x74, 0x2e, 0x4a, 0x21, 0x33, 0x19, 0x2e, 0x2a, 0x54, 0x48, 0x88, 0x41, 0x84, 0x1a,
-	0x11, 0x5a, 0xc4, 0xf0, 0x78, 0x8e, 0x73, 0x34, 0x63, 0xaa, 0xab, 0x06, 0x39, 0x3c, 0x6d, 0xd1,
-	0x32, 0xb4, 0x66, 0xec, 0x73, 0x94, 0x05, 0x4a, 0x9a, 0x30, 0x29, 0xb6, 0x73, 0x8e, 0x59, 0xd9,
-	0xcc, 0xc7, 0x3f, 0xf7, 0xc0, 0x7d, 0xdb, 0x58, 0x5f, 0x2a, 0xa6, 0x04, 0x79, 0x0e, 0xfb, 0x0b,
-	0x56, 0xb1, 0x42, 0x7a, 0x4e, 0xe0, 0x4c, 0x0e, 0xcf, 0x87, 0xe1, 0xbf, 0x51, 0xc2, 0x8f, 0x06,
-	0x11, 0xf5, 0x6e, 0x57, 0xa3, 0x4e, 0x6c, 0xf1, 0xe4, 0x09, 0xb8, 0x39, 0x93, 0xea, 0x8a, 0x63,
-	0x2a, 0xae, 0xb2, 0xd4, 0xdb, 0x0b, 0x9c, 0x49, 0x2f, 0x1a, 0xd4, 0xab, 0x11, 0xbc, 0x67, 0x52,
-	0xbd, 0xc6, 0x54, 0x4c, 0x2f, 0x62, 0xc8, 0x37, 0x75, 0x4a, 0x5e, 0xc0, 0x91, 0x61, 0x64, 0xa5,
-	0x54, 0xac, 0xe4, 0x86, 0xd5, 0x35, 0x2c, 0x52, 0xaf, 0x46, 0x03, 0xcd, 0x9a, 0xda, 0xd1, 0xf4,
-	0x22, 0x1e, 0xe4, 0xbb, 0x7d, 0x4a, 0x9e, 0x41, 0x5f, 0x5b, 0x49, 0xaf, 0x17, 0x74, 0x27, 0x87,
-	0xe7, 0x5e, 0x5b, 0x50, 0x6d, 0x64, 0x63, 0x36, 0x60, 0xf2, 0x0a, 0x1e, 0x70, 0x2c, 0x55, 0xc5,
-	0xb8, 0x92, 0x5e, 0xdf, 0x30, 0x4f, 0xda, 0x99, 0x0d, 0xc8, 0xb2, 0xff, 0x92, 0xc6, 0x14, 0xfa,
-	0x1f, 0x30, 0x15, 0x39, 0x39, 0x82, 0xee, 0x17, 0xb1, 0x34, 0x77, 0x72, 0x63, 0x5d, 0x92, 0x63,
-	0xe8, 0xdf, 0xb0, 0xfc, 0x5a, 0x98, 0xdd, 0xdd, 0xb8, 0x69, 0xc6, 0x33, 0xe8, 0xe9, 0x1c, 0xe4,
-	0xa5, 0xb6, 0xd6, 0xb7, 0x29, 0x67, 0x68, 0xaf, 0x7b, 0xf2, 0xbf, 0xd0, 0xd3, 0x72, 0x86, 0xd6,
-	0xfa, 0x80, 0xdb, 0x9e, 0x9c, 0x02, 0x18, 0x81, 0x64, 0xa9, 0x84, 0xb4, 0x1e, 0x46, 0x32, 0xd2,
-	0x0f, 0xe3, 0x1f, 0x0e, 0x1c, 0x6c, 0x62, 0x93, 0x77, 0xf0, 0x70, 0x13, 0x79, 0xd7, 0x30, 0xb8,
-	0x6f, 0xd7, 0x1d, 0x53, 0x97, 0xef, 0xbc, 0x91, 0x37, 0x30, 0xd8, 0x8a, 0x49, 0x85, 0x95, 0x5e,
-	0x50, 0x5f, 0xee, 0x51, 0x9b, 0x9a, 0x39, 0x8e, 0x95, 0xd9, 0x66, 0xb8, 0xd4, 0xac, 0x28, 0xba,
-	0xad, 0x7d, 0xe7, 0xae, 0xf6, 0x9d, 0xdf, 0xb5, 0xef, 0x7c, 0x5f, 0xfb, 0x9d, 0xbb, 0xb5, 0xdf,
-	0xf9, 0xb5, 0xf6, 0x3b, 0x9f, 0x26, 0xf3, 0x4c, 0x7d, 0xbe, 0x4e, 0x42, 0x8e, 0x05, 0x35, 0x9a,
-	0x8f, 0x0b, 0x2c, 0xc5, 0x92, 0x72, 0xac, 0x04, 0xfd, 0xd6, 0x7c, 0x6f, 0xb5, 0x5c, 0x08, 0x99,
-	0xec, 0x9b, 0x8f, 0xfb, 0xf4, 0x4f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xcf, 0xd2, 0xf3, 0x3a, 0x45,
-	0x03, 0x00, 0x00,
+	// 508 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x53, 0x3d, 0x6f, 0xd3, 0x40,
+	0x18, 0x8e, 0xf3, 0xa5, 0xf4, 0x6d, 0x88, 0xaa, 0xa3, 0x20, 0x13, 0xb5, 0x4e, 0x94, 0x29, 0x03,
+	0xd8, 0xb4, 0x30, 0x30, 0x20, 0x81, 0x4c, 0xf9, 0x88, 0x00, 0x09, 0xb9, 0x1b, 0x4b, 0x75, 0x3e,
+	0x5f, 0x52, 0x0b, 0xfb, 0x2e, 0xf2, 0x5d, 0x0b, 0x5e, 0x58, 0x59, 0xf9, 0x29, 0xfc, 0x8c, 0x8e,
+	0x1d, 0x99, 0x22, 0xe4, 0xfc, 0x11, 0x74, 0x1f, 0x09, 0x91, 0x1a, 0xba, 0xdd, 0xeb, 0xf7, 0xf9,
+	0x7a, 0x1f, 0xc9, 0x30, 0x94, 0xb4, 0x28, 0x70, 0xf0, 0x15, 0x8b, 0x3c, 0xb8, 0x3c, 0x8a, 0xa9,
+	0xc4, 0x47, 0xc1, 0x8c, 0x32, 0x2a, 0x52, 0xe1, 0xcf, 0x0b, 0x2e, 0x39, 0x42, 0x1a, 0xe1, 0x2b,
+	0x84, 0x6f, 0x11, 0xfd, 0xfd, 0x19, 0x9f, 0x71, 0xbd, 0x0e, 0xd4, 0xcb, 0x20, 0xfb, 0x87, 0x5b,
+	0xb4, 0x34, 0xcd, 0xac, 0x3d, 0xc2, 0x45, 0xce, 0x45, 0x10, 0x63, 0x41, 0xd7, 0x7b, 0xc2, 0x53,
+	0x66, 0xf6, 0xa3, 0x5f, 0x75, 0xe8, 0xbe, 0x35, 0xd6, 0xa7, 0x12, 0x4b, 0x8a, 0x9e, 0x41, 0x7b,
+	0x8e, 0x0b, 0x9c, 0x0b, 0xd7, 0x19, 0x3a, 0xe3, 0xdd, 0xe3, 0xbe, 0x7f, 0x33, 0x8a, 0xff, 0x49,
+	0x23, 0xc2, 0xe6, 0xd5, 0x62, 0x50, 0x8b, 0x2c, 0x1e, 0x3d, 0x86, 0x6e, 0x86, 0x85, 0x3c, 0x23,
+	0x3c, 0xa1, 0x67, 0x69, 0xe2, 0xd6, 0x87, 0xce, 0xb8, 0x19, 0xf6, 0xaa, 0xc5, 0x00, 0x3e, 0x60,
+	0x21, 0x5f, 0xf1, 0x84, 0x4e, 0x4e, 0x22, 0xc8, 0x56, 0xef, 0x04, 0x3d, 0x87, 0x3d, 0xcd, 0x48,
+	0x99, 0x90, 0x98, 0x11, 0xcd, 0x6a, 0x68, 0x16, 0xaa, 0x16, 0x83, 0x9e, 0x62, 0x4d, 0xec, 0x6a,
+	0x72, 0x12, 0xf5, 0xb2, 0xcd, 0x39, 0x41, 0x4f, 0xa1, 0xa5, 0xac, 0x84, 0xdb, 0x1c, 0x36, 0xc6,
+	0xbb, 0xc7, 0xee, 0xb6, 0xa0, 0xca, 0xc8, 0xc6, 0x34, 0x60, 0xf4, 0x12, 0x76, 0x08, 0x67, 0xb2,
+	0xc0, 0x44, 0x0a, 0xb7, 0xa5, 0x99, 0x07, 0xdb, 0x99, 0x06, 0x64, 0xd9, 0xff, 0x48, 0xa3, 0x00,
+	0x5a, 0x1f, 0x79, 0x42, 0x33, 0xb4, 0x07, 0x8d, 0x2f, 0xb4, 0xd4, 0x3d, 0x75, 0x23, 0xf5, 0x44,
+	0xfb, 0xd0, 0xba, 0xc4, 0xd9, 0x05, 0xd5, 0xb7, 0x77, 0x23, 0x33, 0x8c, 0xbe, 0x43, 0x53, 0xe5,
+	0x40, 0x2f, 0x60, 0xc7, 0x74, 0xc3, 0xa6, 0xdc, 0xb6, 0x7b, 0xf0, 0xbf, 0xd0, 0x13, 0x36, 0xe5,
+	0xd6, 0xba, 0x43, 0xec, 0x8c, 0x0e, 0x01, 0xb4, 0x40, 0x5c, 0x4a, 0x2a, 0xac, 0x87, 0x96, 0x0c,
+	0xd5, 0x07, 0x74, 0x1f, 0xda, 0xf3, 0x94, 0x31, 0x6a, 0x4a, 0xec, 0x44, 0x76, 0x1a, 0xfd, 0xa8,
+	0x43, 0x67, 0x75, 0x0e, 0x7a, 0x0f, 0x77, 0x56, 0xa7, 0x6c, 0x06, 0x19, 0xde, 0xd6, 0xc1, 0x46,
+	0x98, 0x2e, 0xd9, 0xf8, 0x86, 0xde, 0x40, 0x6f, 0x2d, 0x26, 0x24, 0x2f, 0xd4, 0xe1, 0xaa, 0xd1,
+	0x07, 0xdb, 0xd4, 0x74, 0x69, 0x56, 0x66, 0x9d, 0xe1, 0x54, 0xb1, 0xd0, 0x14, 0xee, 0xad, 0x75,
+	0xf4, 0x85, 0xe7, 0xa9, 0x92, 0x2b, 0xdd, 0x86, 0x96, 0x7b, 0x78, 0x5b, 0x38, 0xd5, 0xd6, 0x3b,
+	0x03, 0x7f, 0xcd, 0x64, 0x51, 0x5a, 0x87, 0xbb, 0xe4, 0xe6, 0x3e, 0x0c, 0xaf, 0x2a, 0xcf, 0xb9,
+	0xae, 0x3c, 0xe7, 0x4f, 0xe5, 0x39, 0x3f, 0x97, 0x5e, 0xed, 0x7a, 0xe9, 0xd5, 0x7e, 0x2f, 0xbd,
+	0xda, 0xe7, 0xf1, 0x2c, 0x95, 0xe7, 0x17, 0xb1, 0x4f, 0x78, 0x1e, 0x68, 0xb3, 0x47, 0x39, 0x67,
+	0xb4, 0x0c, 0x08, 0x2f, 0x68, 0xf0, 0xcd, 0xfc, 0x5e, 0xb2, 0x9c, 0x53, 0x11, 0xb7, 0xf5, 0x8f,
+	0xf3, 0xe4, 0xef, 0x00, 0x91, 0x5d, 0xb5, 0xd6, 0xc5, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -431,6 +450,16 @@ func (m *Code) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Pinned {
+		i--
+		if m.Pinned {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
 	if len(m.CodeBytes) > 0 {
 		i -= len(m.CodeBytes)
 		copy(dAtA[i:], m.CodeBytes)
@@ -471,6 +500,20 @@ func (m *Contract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ContractCodeHistory) > 0 {
+		for iNdEx := len(m.ContractCodeHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ContractCodeHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.ContractStore) > 0 {
 		for iNdEx := len(m.ContractStore) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -567,6 +610,9 @@ func (m *Code) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovGenesis(uint64(l))
 	}
+	if m.Pinned {
+		n += 2
+	}
 	return n
 }
 
@@ -584,6 +630,12 @@ func (m *Contract) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.ContractCodeHistory) > 0 {
+		for _, e := range m.ContractCodeHistory {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -996,6 +1048,26 @@ func (m *Code) Unmarshal(dAtA []byte) error {
 				m.CodeBytes = []byte{}
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pinned", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Pinned = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1113,6 +1185,40 @@ func (m *Contract) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractCodeHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractCodeHistory = append(m.ContractCodeHistory, ContractCodeHistoryEntry{})
+			if err := m.ContractCodeHistory[len(m.ContractCodeHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
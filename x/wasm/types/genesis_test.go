@@ -22,13 +22,20 @@ func TestGenesisValidation(t *testing.T) {
 	require.Error(t, ValidateGenesis(genState))
 
 	genState = DefaultGenesisState()
-	genState.Codes = []Code{{}, {}}
+	genState.Codes = []Code{
+		{CodeInfo: CodeInfo{CodeID: 1, InstantiatePermission: AllowEverybody}},
+		{CodeInfo: CodeInfo{CodeID: 2, InstantiatePermission: AllowNobody}},
+	}
 	genState.LastCodeID = 2
 	require.NoError(t, ValidateGenesis(genState))
 
 	genState.LastCodeID = 1
 	require.Error(t, ValidateGenesis(genState))
 
+	genState.LastCodeID = 2
+	genState.Codes[1].CodeInfo.InstantiatePermission = AccessConfig{}
+	require.Error(t, ValidateGenesis(genState))
+
 	genState = DefaultGenesisState()
 	genState.Contracts = []Contract{{}, {}}
 	genState.LastInstanceID = 2
@@ -36,4 +43,13 @@ func TestGenesisValidation(t *testing.T) {
 
 	genState.LastInstanceID = 1
 	require.Error(t, ValidateGenesis(genState))
+
+	genState.LastInstanceID = 2
+	genState.Contracts[0].ContractCodeHistory = []ContractCodeHistoryEntry{
+		{Operation: ContractCodeHistoryOperationTypeInit, CodeID: 1},
+	}
+	require.NoError(t, ValidateGenesis(genState))
+
+	genState.Contracts[0].ContractCodeHistory[0].Operation = ContractCodeHistoryOperationTypeUnspecified
+	require.Error(t, ValidateGenesis(genState))
 }
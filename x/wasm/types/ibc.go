@@ -0,0 +1,39 @@
+package types
+
+import (
+	"strings"
+
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+
+	clienttypes "github.com/cosmos/ibc-go/modules/core/02-client/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// IBCPortIDPrefix is the prefix of the ibc ports bound to contracts
+const IBCPortIDPrefix = "wasm."
+
+// PortIDForContract returns the ibc port id of the contract
+func PortIDForContract(contractAddr sdk.AccAddress) string {
+	return IBCPortIDPrefix + contractAddr.String()
+}
+
+// ContractFromPortID returns the contract address bound to the ibc port id
+func ContractFromPortID(portID string) (sdk.AccAddress, error) {
+	if !strings.HasPrefix(portID, IBCPortIDPrefix) {
+		return nil, sdkerrors.Wrapf(ErrInvalidIBCPort, "without prefix: %s", portID)
+	}
+
+	return sdk.AccAddressFromBech32(portID[len(IBCPortIDPrefix):])
+}
+
+// NewIBCTimeoutHeight converts the wasm ibc timeout block to an ibc client height;
+// zero height disables the timeout
+func NewIBCTimeoutHeight(block *wasmvmtypes.IBCTimeoutBlock) clienttypes.Height {
+	if block == nil {
+		return clienttypes.NewHeight(0, 0)
+	}
+
+	return clienttypes.NewHeight(block.Revision, block.Height)
+}
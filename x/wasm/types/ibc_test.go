@@ -0,0 +1,32 @@
+package types
+
+import (
+	"testing"
+
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+	"github.com/stretchr/testify/require"
+
+	clienttypes "github.com/cosmos/ibc-go/modules/core/02-client/types"
+)
+
+func TestPortIDForContract(t *testing.T) {
+	_, _, addr := keyPubAddr()
+
+	portID := PortIDForContract(addr)
+	require.Equal(t, "wasm."+addr.String(), portID)
+
+	contractAddr, err := ContractFromPortID(portID)
+	require.NoError(t, err)
+	require.Equal(t, addr, contractAddr)
+
+	_, err = ContractFromPortID(addr.String())
+	require.ErrorIs(t, err, ErrInvalidIBCPort)
+
+	_, err = ContractFromPortID("wasm.invalid")
+	require.Error(t, err)
+}
+
+func TestNewIBCTimeoutHeight(t *testing.T) {
+	require.Equal(t, clienttypes.NewHeight(0, 0), NewIBCTimeoutHeight(nil))
+	require.Equal(t, clienttypes.NewHeight(1, 100), NewIBCTimeoutHeight(&wasmvmtypes.IBCTimeoutBlock{Revision: 1, Height: 100}))
+}
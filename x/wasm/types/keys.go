@@ -37,12 +37,27 @@ const (
 // - 0x04<accAddress_Bytes>: ContractInfo
 //
 // - 0x05<accAddress_Bytes>: KVStore for contract
+//
+// - 0x06<uint64>: []byte{1} for pinned code
+//
+// - 0x07<uint64><accAddress_Bytes>: []byte{1} for contract of the code
+//
+// - 0x08<creator_accAddress_Bytes><accAddress_Bytes>: []byte{1} for contract of the creator
+//
+// - 0x09<admin_accAddress_Bytes><accAddress_Bytes>: []byte{1} for contract of the admin
+//
+// - 0x0A<accAddress_Bytes><uint64>: ContractCodeHistoryEntry
 var (
-	LastCodeIDKey     = []byte{0x01}
-	LastInstanceIDKey = []byte{0x02}
-	CodeKey           = []byte{0x03}
-	ContractInfoKey   = []byte{0x04}
-	ContractStoreKey  = []byte{0x05}
+	LastCodeIDKey                = []byte{0x01}
+	LastInstanceIDKey            = []byte{0x02}
+	CodeKey                      = []byte{0x03}
+	ContractInfoKey              = []byte{0x04}
+	ContractStoreKey             = []byte{0x05}
+	PinnedCodeIndexPrefix        = []byte{0x06}
+	ContractByCodeIndexPrefix    = []byte{0x07}
+	ContractByCreatorIndexPrefix = []byte{0x08}
+	ContractByAdminIndexPrefix   = []byte{0x09}
+	ContractCodeHistoryPrefix    = []byte{0x0A}
 )
 
 // GetCodeInfoKey constructs the key of the WASM code info for the ID
@@ -60,3 +75,48 @@ func GetContractInfoKey(addr sdk.AccAddress) []byte {
 func GetContractStoreKey(addr sdk.AccAddress) []byte {
 	return append(ContractStoreKey, address.MustLengthPrefix(addr)...)
 }
+
+// GetPinnedCodeIndexKey returns the key of the pinned code index for the code ID
+func GetPinnedCodeIndexKey(codeID uint64) []byte {
+	return append(PinnedCodeIndexPrefix, sdk.Uint64ToBigEndian(codeID)...)
+}
+
+// GetContractByCodeIndexPrefix returns the prefix of the contract by code index for the code ID
+func GetContractByCodeIndexPrefix(codeID uint64) []byte {
+	return append(ContractByCodeIndexPrefix, sdk.Uint64ToBigEndian(codeID)...)
+}
+
+// GetContractByCodeIndexKey returns the key of the contract by code index for the code ID and the contract address
+func GetContractByCodeIndexKey(codeID uint64, contractAddr sdk.AccAddress) []byte {
+	return append(GetContractByCodeIndexPrefix(codeID), contractAddr...)
+}
+
+// GetContractByCreatorIndexPrefix returns the prefix of the contract by creator index for the creator address
+func GetContractByCreatorIndexPrefix(creatorAddr sdk.AccAddress) []byte {
+	return append(ContractByCreatorIndexPrefix, address.MustLengthPrefix(creatorAddr)...)
+}
+
+// GetContractByCreatorIndexKey returns the key of the contract by creator index for the creator and the contract address
+func GetContractByCreatorIndexKey(creatorAddr, contractAddr sdk.AccAddress) []byte {
+	return append(GetContractByCreatorIndexPrefix(creatorAddr), contractAddr...)
+}
+
+// GetContractByAdminIndexPrefix returns the prefix of the contract by admin index for the admin address
+func GetContractByAdminIndexPrefix(adminAddr sdk.AccAddress) []byte {
+	return append(ContractByAdminIndexPrefix, address.MustLengthPrefix(adminAddr)...)
+}
+
+// GetContractByAdminIndexKey returns the key of the contract by admin index for the admin and the contract address
+func GetContractByAdminIndexKey(adminAddr, contractAddr sdk.AccAddress) []byte {
+	return append(GetContractByAdminIndexPrefix(adminAddr), contractAddr...)
+}
+
+// GetContractCodeHistoryPrefix returns the prefix of the contract code history for the contract address
+func GetContractCodeHistoryPrefix(contractAddr sdk.AccAddress) []byte {
+	return append(ContractCodeHistoryPrefix, address.MustLengthPrefix(contractAddr)...)
+}
+
+// GetContractCodeHistoryKey returns the key of the contract code history entry for the contract address and the sequence
+func GetContractCodeHistoryKey(contractAddr sdk.AccAddress, sequence uint64) []byte {
+	return append(GetContractCodeHistoryPrefix(contractAddr), sdk.Uint64ToBigEndian(sequence)...)
+}
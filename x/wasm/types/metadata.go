@@ -0,0 +1,47 @@
+package types
+
+import (
+	"net/url"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Max sizes of the human-readable contract and code information
+const (
+	MaxLabelSize               = 128
+	MaxCodeMetadataSourceSize  = 256
+	MaxCodeMetadataBuilderSize = 128
+	MaxCodeMetadataSchemaSize  = 4 * 1024
+)
+
+// ValidateLabel checks the contract label is not too long; the label is optional
+func ValidateLabel(label string) error {
+	if len(label) > MaxLabelSize {
+		return sdkerrors.Wrapf(ErrInvalidLabel, "label cannot be longer than %d bytes", MaxLabelSize)
+	}
+
+	return nil
+}
+
+// ValidateBasic performs basic validation of the code metadata; all fields are optional
+func (m CodeMetadata) ValidateBasic() error {
+	if len(m.Source) > MaxCodeMetadataSourceSize {
+		return sdkerrors.Wrapf(ErrInvalidCodeMetadata, "source cannot be longer than %d bytes", MaxCodeMetadataSourceSize)
+	}
+
+	if len(m.Source) != 0 {
+		if _, err := url.ParseRequestURI(m.Source); err != nil {
+			return sdkerrors.Wrapf(ErrInvalidCodeMetadata, "source must be a valid url: %s", err)
+		}
+	}
+
+	if len(m.Builder) > MaxCodeMetadataBuilderSize {
+		return sdkerrors.Wrapf(ErrInvalidCodeMetadata, "builder cannot be longer than %d bytes", MaxCodeMetadataBuilderSize)
+	}
+
+	if len(m.Schema) > MaxCodeMetadataSchemaSize {
+		return sdkerrors.Wrapf(ErrInvalidCodeMetadata, "schema cannot be longer than %d bytes", MaxCodeMetadataSchemaSize)
+	}
+
+	return nil
+}
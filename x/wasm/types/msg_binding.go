@@ -30,6 +30,11 @@ type StargateWasmMsgParserInterface interface {
 	Parse(msg wasmvmtypes.CosmosMsg) (sdk.Msg, error)
 }
 
+// IBCWasmMsgParserInterface - ibc msg parsers
+type IBCWasmMsgParserInterface interface {
+	Parse(ctx sdk.Context, contractAddr sdk.AccAddress, msg wasmvmtypes.CosmosMsg) (sdk.Msg, error)
+}
+
 // WasmCustomMsg - wasm custom msg parser
 type WasmCustomMsg struct {
 	Route   string          `json:"route"`
@@ -40,6 +45,7 @@ type WasmCustomMsg struct {
 type MsgParser struct {
 	Parsers        map[string]WasmMsgParserInterface
 	StargateParser StargateWasmMsgParserInterface
+	IBCParser      IBCWasmMsgParserInterface
 }
 
 // NewWasmMsgParser returns wasm msg parser
@@ -53,10 +59,6 @@ func NewWasmMsgParser() MsgParser {
 func (p MsgParser) Parse(ctx sdk.Context, contractAddr sdk.AccAddress, msg wasmvmtypes.CosmosMsg) (sdk.Msg, error) {
 	switch {
 	case msg.Bank != nil:
-		if msg.Bank.Burn != nil {
-			return nil, sdkerrors.Wrap(ErrNoRegisteredParser, "Burn not supported")
-		}
-
 		if parser, ok := p.Parsers[WasmMsgParserRouteBank]; ok {
 			return parser.Parse(contractAddr, msg)
 		}
@@ -105,7 +107,11 @@ func (p MsgParser) Parse(ctx sdk.Context, contractAddr sdk.AccAddress, msg wasmv
 
 		return nil, sdkerrors.Wrap(ErrNoRegisteredParser, "stargate")
 	case msg.IBC != nil:
-		return nil, sdkerrors.Wrap(ErrNoRegisteredParser, "IBC not supported")
+		if p.IBCParser != nil {
+			return p.IBCParser.Parse(ctx, contractAddr, msg)
+		}
+
+		return nil, sdkerrors.Wrap(ErrNoRegisteredParser, "ibc")
 	}
 
 	return nil, sdkerrors.Wrap(ErrInvalidMsg, "failed to parse empty msg")
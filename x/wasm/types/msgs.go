@@ -12,21 +12,27 @@ var (
 	_ sdk.Msg = &MsgStoreCode{}
 	_ sdk.Msg = &MsgMigrateCode{}
 	_ sdk.Msg = &MsgInstantiateContract{}
+	_ sdk.Msg = &MsgInstantiateContract2{}
 	_ sdk.Msg = &MsgExecuteContract{}
 	_ sdk.Msg = &MsgMigrateContract{}
 	_ sdk.Msg = &MsgUpdateContractAdmin{}
 	_ sdk.Msg = &MsgClearContractAdmin{}
+	_ sdk.Msg = &MsgUpdateContractLabel{}
+	_ sdk.Msg = &MsgUpdateCodeMetadata{}
 )
 
 // wasm message types
 const (
-	TypeMsgStoreCode           = "store_code"
-	TypeMsgMigrateCode         = "migrate_code"
-	TypeMsgInstantiateContract = "instantiate_contract"
-	TypeMsgExecuteContract     = "execute_contract"
-	TypeMsgMigrateContract     = "migrate_contract"
-	TypeMsgUpdateContractAdmin = "update_contract_admin"
-	TypeMsgClearContractAdmin  = "clear_contract_admin"
+	TypeMsgStoreCode            = "store_code"
+	TypeMsgMigrateCode          = "migrate_code"
+	TypeMsgInstantiateContract  = "instantiate_contract"
+	TypeMsgInstantiateContract2 = "instantiate_contract2"
+	TypeMsgExecuteContract      = "execute_contract"
+	TypeMsgMigrateContract      = "migrate_contract"
+	TypeMsgUpdateContractAdmin  = "update_contract_admin"
+	TypeMsgClearContractAdmin   = "clear_contract_admin"
+	TypeMsgUpdateContractLabel  = "update_contract_label"
+	TypeMsgUpdateCodeMetadata   = "update_code_metadata"
 )
 
 // NewMsgStoreCode creates a MsgStoreCode instance
@@ -73,6 +79,16 @@ func (msg MsgStoreCode) ValidateBasic() error {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "wasm code too large")
 	}
 
+	if msg.InstantiatePermission != nil {
+		if err := msg.InstantiatePermission.ValidateBasic(); err != nil {
+			return sdkerrors.Wrap(err, "instantiate permission")
+		}
+	}
+
+	if err := msg.Metadata.ValidateBasic(); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -177,7 +193,7 @@ func (msg MsgInstantiateContract) ValidateBasic() error {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "wasm msg byte format is invalid json")
 	}
 
-	return nil
+	return ValidateLabel(msg.Label)
 }
 
 // GetSignBytes implements sdk.Msg
@@ -195,6 +211,77 @@ func (msg MsgInstantiateContract) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{sender}
 }
 
+// NewMsgInstantiateContract2 creates a MsgInstantiateContract2 instance
+func NewMsgInstantiateContract2(sender, admin sdk.AccAddress, codeID uint64, initMsg []byte, initCoins sdk.Coins, salt []byte) *MsgInstantiateContract2 {
+	var adminAddr string
+	if !admin.Empty() {
+		adminAddr = admin.String()
+	}
+
+	return &MsgInstantiateContract2{
+		Sender:    sender.String(),
+		Admin:     adminAddr,
+		CodeID:    codeID,
+		InitMsg:   initMsg,
+		InitCoins: initCoins,
+		Salt:      salt,
+	}
+}
+
+// Route implements sdk.Msg
+func (msg MsgInstantiateContract2) Route() string {
+	return RouterKey
+}
+
+// Type implements sdk.Msg
+func (msg MsgInstantiateContract2) Type() string {
+	return TypeMsgInstantiateContract2
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgInstantiateContract2) ValidateBasic() error {
+	if err := ValidateSalt(msg.Salt); err != nil {
+		return err
+	}
+
+	return MsgInstantiateContract{
+		Sender:    msg.Sender,
+		Admin:     msg.Admin,
+		CodeID:    msg.CodeID,
+		InitMsg:   msg.InitMsg,
+		InitCoins: msg.InitCoins,
+		Label:     msg.Label,
+	}.ValidateBasic()
+}
+
+// GetSignBytes implements sdk.Msg
+func (msg MsgInstantiateContract2) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgInstantiateContract2) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{sender}
+}
+
+// ValidateSalt checks the salt of the predictable contract address is not empty and not too long
+func ValidateSalt(salt []byte) error {
+	if len(salt) == 0 {
+		return sdkerrors.Wrap(ErrInvalidSalt, "salt cannot be empty")
+	}
+
+	if len(salt) > MaxSaltSize {
+		return sdkerrors.Wrapf(ErrInvalidSalt, "salt cannot be longer than %d bytes", MaxSaltSize)
+	}
+
+	return nil
+}
+
 // NewMsgExecuteContract creates a NewMsgExecuteContract instance
 func NewMsgExecuteContract(sender sdk.AccAddress, contract sdk.AccAddress, execMsg []byte, coins sdk.Coins) *MsgExecuteContract {
 	return &MsgExecuteContract{
@@ -418,3 +505,100 @@ func (msg MsgClearContractAdmin) GetSigners() []sdk.AccAddress {
 	}
 	return []sdk.AccAddress{owner}
 }
+
+// NewMsgUpdateContractLabel creates a MsgUpdateContractLabel instance
+func NewMsgUpdateContractLabel(sender, contract sdk.AccAddress, newLabel string) *MsgUpdateContractLabel {
+	return &MsgUpdateContractLabel{
+		Sender:   sender.String(),
+		Contract: contract.String(),
+		NewLabel: newLabel,
+	}
+}
+
+// Route implements sdk.Msg
+func (msg MsgUpdateContractLabel) Route() string {
+	return RouterKey
+}
+
+// Type implements sdk.Msg
+func (msg MsgUpdateContractLabel) Type() string {
+	return TypeMsgUpdateContractLabel
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgUpdateContractLabel) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	_, err = sdk.AccAddressFromBech32(msg.Contract)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid contract address (%s)", err)
+	}
+
+	return ValidateLabel(msg.NewLabel)
+}
+
+// GetSignBytes implements sdk.Msg
+func (msg MsgUpdateContractLabel) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgUpdateContractLabel) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{sender}
+}
+
+// NewMsgUpdateCodeMetadata creates a MsgUpdateCodeMetadata instance
+func NewMsgUpdateCodeMetadata(sender sdk.AccAddress, codeID uint64, metadata CodeMetadata) *MsgUpdateCodeMetadata {
+	return &MsgUpdateCodeMetadata{
+		Sender:   sender.String(),
+		CodeID:   codeID,
+		Metadata: metadata,
+	}
+}
+
+// Route implements sdk.Msg
+func (msg MsgUpdateCodeMetadata) Route() string {
+	return RouterKey
+}
+
+// Type implements sdk.Msg
+func (msg MsgUpdateCodeMetadata) Type() string {
+	return TypeMsgUpdateCodeMetadata
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgUpdateCodeMetadata) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	if msg.CodeID == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "missing code_id")
+	}
+
+	return msg.Metadata.ValidateBasic()
+}
+
+// GetSignBytes implements sdk.Msg
+func (msg MsgUpdateCodeMetadata) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgUpdateCodeMetadata) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{sender}
+}
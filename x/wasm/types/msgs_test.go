@@ -33,6 +33,13 @@ func TestMsgStoreCode(t *testing.T) {
 			require.NotNil(t, msg.ValidateBasic(), "test: %v", i)
 		}
 	}
+
+	msg := NewMsgStoreCode(addrs[0], []byte{1, 2, 3})
+	msg.InstantiatePermission = &AccessConfig{Permission: AccessTypeAllowlist, Addresses: []string{addrs[0].String()}}
+	require.NoError(t, msg.ValidateBasic())
+
+	msg.InstantiatePermission = &AccessConfig{Permission: AccessTypeAllowlist}
+	require.Error(t, msg.ValidateBasic())
 }
 
 func TestMsgMigrateCode(t *testing.T) {
@@ -92,6 +99,35 @@ func TestMsgInstantiateCode(t *testing.T) {
 	}
 }
 
+func TestMsgInstantiateContract2(t *testing.T) {
+	addrs := []sdk.AccAddress{
+		sdk.AccAddress([]byte("addr1_______________")),
+	}
+
+	tests := []struct {
+		creator    sdk.AccAddress
+		initMsg    []byte
+		salt       []byte
+		expectPass bool
+	}{
+		{sdk.AccAddress{}, []byte("{}"), []byte("salt"), false},
+		{addrs[0], []byte("{invalid json}"), []byte("salt"), false},
+		{addrs[0], []byte("{}"), nil, false},
+		{addrs[0], []byte("{}"), make([]byte, MaxSaltSize+1), false},
+		{addrs[0], []byte("{}"), make([]byte, MaxSaltSize), true},
+		{addrs[0], []byte("{}"), []byte("salt"), true},
+	}
+
+	for i, tc := range tests {
+		msg := NewMsgInstantiateContract2(tc.creator, sdk.AccAddress{}, 0, tc.initMsg, sdk.Coins{}, tc.salt)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
+
 func TestMsgExecuteContract(t *testing.T) {
 	addrs := []sdk.AccAddress{
 		sdk.AccAddress([]byte("addr1_______________")),
@@ -209,3 +245,62 @@ func TestMsgClearContractMigratable(t *testing.T) {
 		}
 	}
 }
+
+func TestMsgUpdateContractLabel(t *testing.T) {
+	addrs := []sdk.AccAddress{
+		sdk.AccAddress([]byte("addr1_______________")),
+		sdk.AccAddress([]byte("addr2_______________")),
+	}
+
+	tests := []struct {
+		sender     sdk.AccAddress
+		contract   sdk.AccAddress
+		newLabel   string
+		expectPass bool
+	}{
+		{sdk.AccAddress{}, addrs[1], "label", false},
+		{addrs[0], sdk.AccAddress{}, "label", false},
+		{addrs[0], addrs[1], string(make([]byte, MaxLabelSize+1)), false},
+		{addrs[0], addrs[1], "", true},
+		{addrs[0], addrs[1], "label", true},
+	}
+
+	for i, tc := range tests {
+		msg := NewMsgUpdateContractLabel(tc.sender, tc.contract, tc.newLabel)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
+
+func TestMsgUpdateCodeMetadata(t *testing.T) {
+	addrs := []sdk.AccAddress{
+		sdk.AccAddress([]byte("addr1_______________")),
+	}
+
+	tests := []struct {
+		sender     sdk.AccAddress
+		codeID     uint64
+		metadata   CodeMetadata
+		expectPass bool
+	}{
+		{sdk.AccAddress{}, 1, CodeMetadata{}, false},
+		{addrs[0], 0, CodeMetadata{}, false},
+		{addrs[0], 1, CodeMetadata{Source: "not a url"}, false},
+		{addrs[0], 1, CodeMetadata{Builder: string(make([]byte, MaxCodeMetadataBuilderSize+1))}, false},
+		{addrs[0], 1, CodeMetadata{Schema: string(make([]byte, MaxCodeMetadataSchemaSize+1))}, false},
+		{addrs[0], 1, CodeMetadata{}, true},
+		{addrs[0], 1, CodeMetadata{Source: "https://github.com/CosmWasm/cosmwasm", Builder: "cosmwasm/rust-optimizer:0.12.4", Schema: "{}"}, true},
+	}
+
+	for i, tc := range tests {
+		msg := NewMsgUpdateCodeMetadata(tc.sender, tc.codeID, tc.metadata)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
@@ -2,7 +2,9 @@ package types
 
 import (
 	"fmt"
+	"strings"
 
+	"github.com/gogo/protobuf/proto"
 	"gopkg.in/yaml.v2"
 
 	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
@@ -20,6 +22,11 @@ var (
 	KeyMaxContractSize    = []byte("MaxContractSize")
 	KeyMaxContractGas     = []byte("MaxContractGas")
 	KeyMaxContractMsgSize = []byte("MaxContractMsgSize")
+
+	KeyCodeUploadAccess             = []byte("CodeUploadAccess")
+	KeyInstantiateDefaultPermission = []byte("InstantiateDefaultPermission")
+
+	KeyStargateQueryAllowlist = []byte("StargateQueryAllowlist")
 )
 
 // Default parameter values
@@ -28,11 +35,30 @@ const (
 	DefaultMaxContractGas     = uint64(20_000_000) // 20,000,000
 	DefaultMaxContractMsgSize = uint64(4 * 1024)   // 4KB
 
+	DefaultInstantiateDefaultPermission = AccessTypeEverybody
+
 	// ContractMemoryLimit is the memory limit of each contract execution (in MiB)
 	// constant value so all nodes run with the same limit.
 	ContractMemoryLimit = uint32(32)
 )
 
+// DefaultStargateQueryAllowlist is the set of stargate queries whose responses
+// are deterministic across nodes and allowed from the contracts by default
+var DefaultStargateQueryAllowlist = []StargateQuery{
+	{Path: "/cosmos.auth.v1beta1.Query/Account", ResponseType: "cosmos.auth.v1beta1.QueryAccountResponse"},
+	{Path: "/cosmos.bank.v1beta1.Query/Balance", ResponseType: "cosmos.bank.v1beta1.QueryBalanceResponse"},
+	{Path: "/cosmos.bank.v1beta1.Query/AllBalances", ResponseType: "cosmos.bank.v1beta1.QueryAllBalancesResponse"},
+	{Path: "/cosmos.bank.v1beta1.Query/SupplyOf", ResponseType: "cosmos.bank.v1beta1.QuerySupplyOfResponse"},
+	{Path: "/cosmos.distribution.v1beta1.Query/DelegationRewards", ResponseType: "cosmos.distribution.v1beta1.QueryDelegationRewardsResponse"},
+	{Path: "/cosmos.staking.v1beta1.Query/Validator", ResponseType: "cosmos.staking.v1beta1.QueryValidatorResponse"},
+	{Path: "/cosmos.staking.v1beta1.Query/Delegation", ResponseType: "cosmos.staking.v1beta1.QueryDelegationResponse"},
+	{Path: "/terra.market.v1beta1.Query/Swap", ResponseType: "terra.market.v1beta1.QuerySwapResponse"},
+	{Path: "/terra.oracle.v1beta1.Query/ExchangeRate", ResponseType: "terra.oracle.v1beta1.QueryExchangeRateResponse"},
+	{Path: "/terra.treasury.v1beta1.Query/TaxRate", ResponseType: "terra.treasury.v1beta1.QueryTaxRateResponse"},
+	{Path: "/terra.treasury.v1beta1.Query/TaxCap", ResponseType: "terra.treasury.v1beta1.QueryTaxCapResponse"},
+	{Path: "/terra.wasm.v1beta1.Query/ContractInfo", ResponseType: "terra.wasm.v1beta1.QueryContractInfoResponse"},
+}
+
 var _ paramstypes.ParamSet = &Params{}
 
 // DefaultParams creates default treasury module parameters
@@ -41,6 +67,11 @@ func DefaultParams() Params {
 		MaxContractSize:    DefaultMaxContractSize,
 		MaxContractGas:     DefaultMaxContractGas,
 		MaxContractMsgSize: DefaultMaxContractMsgSize,
+
+		CodeUploadAccess:             AllowEverybody,
+		InstantiateDefaultPermission: DefaultInstantiateDefaultPermission,
+
+		StargateQueryAllowlist: DefaultStargateQueryAllowlist,
 	}
 }
 
@@ -52,6 +83,9 @@ func (p *Params) ParamSetPairs() paramstypes.ParamSetPairs {
 		paramstypes.NewParamSetPair(KeyMaxContractSize, &p.MaxContractSize, validateMaxContractSize),
 		paramstypes.NewParamSetPair(KeyMaxContractGas, &p.MaxContractGas, validateMaxContractGas),
 		paramstypes.NewParamSetPair(KeyMaxContractMsgSize, &p.MaxContractMsgSize, validateMaxContractMsgSize),
+		paramstypes.NewParamSetPair(KeyCodeUploadAccess, &p.CodeUploadAccess, validateCodeUploadAccess),
+		paramstypes.NewParamSetPair(KeyInstantiateDefaultPermission, &p.InstantiateDefaultPermission, validateInstantiateDefaultPermission),
+		paramstypes.NewParamSetPair(KeyStargateQueryAllowlist, &p.StargateQueryAllowlist, validateStargateQueryAllowlist),
 	}
 }
 
@@ -80,6 +114,18 @@ func (p Params) Validate() error {
 		return fmt.Errorf("max contract msg byte size %d must be equal or smaller than %d", p.MaxContractMsgSize, EnforcedMaxContractMsgSize)
 	}
 
+	if err := p.CodeUploadAccess.ValidateBasic(); err != nil {
+		return fmt.Errorf("invalid code upload access: %s", err)
+	}
+
+	if err := validateAccessType(p.InstantiateDefaultPermission); err != nil {
+		return fmt.Errorf("invalid instantiate default permission: %s", err)
+	}
+
+	if err := validateStargateQueries(p.StargateQueryAllowlist); err != nil {
+		return fmt.Errorf("invalid stargate query allowlist: %s", err)
+	}
+
 	return nil
 }
 
@@ -121,3 +167,55 @@ func validateMaxContractMsgSize(i interface{}) error {
 
 	return nil
 }
+
+func validateCodeUploadAccess(i interface{}) error {
+	v, ok := i.(AccessConfig)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return v.ValidateBasic()
+}
+
+func validateInstantiateDefaultPermission(i interface{}) error {
+	v, ok := i.(AccessType)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return validateAccessType(v)
+}
+
+func validateStargateQueryAllowlist(i interface{}) error {
+	v, ok := i.([]StargateQuery)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return validateStargateQueries(v)
+}
+
+func validateStargateQueries(queries []StargateQuery) error {
+	paths := make(map[string]bool, len(queries))
+	for _, query := range queries {
+		if !strings.HasPrefix(query.Path, "/") {
+			return fmt.Errorf("path %q must be a fully qualified gRPC method", query.Path)
+		}
+
+		if query.ResponseType == "" {
+			return fmt.Errorf("missing response type for %s", query.Path)
+		}
+
+		if proto.MessageType(query.ResponseType) == nil {
+			return fmt.Errorf("unknown response type %s for %s", query.ResponseType, query.Path)
+		}
+
+		if paths[query.Path] {
+			return fmt.Errorf("duplicate path %s", query.Path)
+		}
+
+		paths[query.Path] = true
+	}
+
+	return nil
+}
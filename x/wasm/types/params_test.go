@@ -4,6 +4,16 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+
+	// register the response types of the default stargate query allowlist
+	_ "github.com/cosmos/cosmos-sdk/x/auth/types"
+	_ "github.com/cosmos/cosmos-sdk/x/bank/types"
+	_ "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	_ "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	_ "github.com/terra-money/core/x/market/types"
+	_ "github.com/terra-money/core/x/oracle/types"
+	_ "github.com/terra-money/core/x/treasury/types"
 )
 
 func TestParams(t *testing.T) {
@@ -22,3 +32,48 @@ func TestParams(t *testing.T) {
 	params.MaxContractSize = EnforcedMaxContractSize + 1
 	require.Error(t, params.Validate())
 }
+
+func TestAccessConfigParams(t *testing.T) {
+	params := DefaultParams()
+	params.CodeUploadAccess = AllowNobody
+	params.InstantiateDefaultPermission = AccessTypeAllowlist
+	require.NoError(t, params.Validate())
+
+	params = DefaultParams()
+	params.CodeUploadAccess = AccessConfig{}
+	require.Error(t, params.Validate())
+
+	params = DefaultParams()
+	params.CodeUploadAccess = AccessConfig{Permission: AccessTypeAllowlist}
+	require.Error(t, params.Validate())
+
+	params = DefaultParams()
+	params.InstantiateDefaultPermission = AccessTypeUnspecified
+	require.Error(t, params.Validate())
+}
+
+func TestStargateQueryAllowlistParams(t *testing.T) {
+	params := DefaultParams()
+	params.StargateQueryAllowlist = nil
+	require.NoError(t, params.Validate())
+
+	params = DefaultParams()
+	params.StargateQueryAllowlist = append(params.StargateQueryAllowlist, StargateQuery{Path: "cosmos.bank.v1beta1.Query/Params", ResponseType: "cosmos.bank.v1beta1.QueryParamsResponse"})
+	require.Error(t, params.Validate())
+
+	params = DefaultParams()
+	params.StargateQueryAllowlist = append(params.StargateQueryAllowlist, StargateQuery{Path: "/cosmos.bank.v1beta1.Query/Params"})
+	require.Error(t, params.Validate())
+
+	params = DefaultParams()
+	params.StargateQueryAllowlist = append(params.StargateQueryAllowlist, params.StargateQueryAllowlist[0])
+	require.Error(t, params.Validate())
+
+	params = DefaultParams()
+	params.StargateQueryAllowlist = append(params.StargateQueryAllowlist, StargateQuery{Path: "/cosmos.bank.v1beta1.Query/Params", ResponseType: "cosmos.bank.v1beta1.QueryUnknownResponse"})
+	require.Error(t, params.Validate())
+
+	params = DefaultParams()
+	params.StargateQueryAllowlist = append(params.StargateQueryAllowlist, StargateQuery{Path: "/cosmos.bank.v1beta1.Query/Params", ResponseType: "cosmos.bank.v1beta1.QueryParamsResponse"})
+	require.NoError(t, params.Validate())
+}
@@ -0,0 +1,373 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	// ProposalTypePinCodes defines the type for a PinCodesProposal
+	ProposalTypePinCodes = "PinCodes"
+
+	// ProposalTypeUnpinCodes defines the type for a UnpinCodesProposal
+	ProposalTypeUnpinCodes = "UnpinCodes"
+
+	// ProposalTypeStoreCode defines the type for a StoreCodeProposal
+	ProposalTypeStoreCode = "StoreCode"
+
+	// ProposalTypeInstantiateContract defines the type for a InstantiateContractProposal
+	ProposalTypeInstantiateContract = "InstantiateContract"
+
+	// ProposalTypeMigrateContract defines the type for a MigrateContractProposal
+	ProposalTypeMigrateContract = "MigrateContract"
+
+	// ProposalTypeSudoContract defines the type for a SudoContractProposal
+	ProposalTypeSudoContract = "SudoContract"
+)
+
+// Assert proposals implement govtypes.Content at compile-time
+var (
+	_ govtypes.Content = &PinCodesProposal{}
+	_ govtypes.Content = &UnpinCodesProposal{}
+	_ govtypes.Content = &StoreCodeProposal{}
+	_ govtypes.Content = &InstantiateContractProposal{}
+	_ govtypes.Content = &MigrateContractProposal{}
+	_ govtypes.Content = &SudoContractProposal{}
+)
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypePinCodes)
+	govtypes.RegisterProposalType(ProposalTypeUnpinCodes)
+	govtypes.RegisterProposalType(ProposalTypeStoreCode)
+	govtypes.RegisterProposalType(ProposalTypeInstantiateContract)
+	govtypes.RegisterProposalType(ProposalTypeMigrateContract)
+	govtypes.RegisterProposalType(ProposalTypeSudoContract)
+}
+
+// NewPinCodesProposal creates a new pin codes proposal.
+func NewPinCodesProposal(title, description string, codeIDs []uint64) *PinCodesProposal {
+	return &PinCodesProposal{title, description, codeIDs}
+}
+
+// GetTitle returns the title of a pin codes proposal.
+func (p *PinCodesProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of a pin codes proposal.
+func (p *PinCodesProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of a pin codes proposal.
+func (p *PinCodesProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a pin codes proposal.
+func (p *PinCodesProposal) ProposalType() string { return ProposalTypePinCodes }
+
+// ValidateBasic runs basic stateless validity checks
+func (p *PinCodesProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+
+	return validateCodeIDs(p.CodeIDs)
+}
+
+// String implements the Stringer interface.
+func (p PinCodesProposal) String() string {
+	return fmt.Sprintf(`Pin Codes Proposal:
+  Title:       %s
+  Description: %s
+  Code IDs:    %v
+`, p.Title, p.Description, p.CodeIDs)
+}
+
+// NewUnpinCodesProposal creates a new unpin codes proposal.
+func NewUnpinCodesProposal(title, description string, codeIDs []uint64) *UnpinCodesProposal {
+	return &UnpinCodesProposal{title, description, codeIDs}
+}
+
+// GetTitle returns the title of an unpin codes proposal.
+func (p *UnpinCodesProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of an unpin codes proposal.
+func (p *UnpinCodesProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of an unpin codes proposal.
+func (p *UnpinCodesProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of an unpin codes proposal.
+func (p *UnpinCodesProposal) ProposalType() string { return ProposalTypeUnpinCodes }
+
+// ValidateBasic runs basic stateless validity checks
+func (p *UnpinCodesProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+
+	return validateCodeIDs(p.CodeIDs)
+}
+
+// String implements the Stringer interface.
+func (p UnpinCodesProposal) String() string {
+	return fmt.Sprintf(`Unpin Codes Proposal:
+  Title:       %s
+  Description: %s
+  Code IDs:    %v
+`, p.Title, p.Description, p.CodeIDs)
+}
+
+// NewStoreCodeProposal creates a new store code proposal.
+func NewStoreCodeProposal(
+	title, description string,
+	runAs sdk.AccAddress,
+	wasmByteCode []byte,
+	instantiatePermission *AccessConfig,
+	metadata CodeMetadata,
+) *StoreCodeProposal {
+	return &StoreCodeProposal{title, description, runAs.String(), wasmByteCode, instantiatePermission, metadata}
+}
+
+// GetTitle returns the title of a store code proposal.
+func (p *StoreCodeProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of a store code proposal.
+func (p *StoreCodeProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of a store code proposal.
+func (p *StoreCodeProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a store code proposal.
+func (p *StoreCodeProposal) ProposalType() string { return ProposalTypeStoreCode }
+
+// ValidateBasic runs basic stateless validity checks
+func (p *StoreCodeProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+
+	msg := MsgStoreCode{
+		Sender:                p.RunAs,
+		WASMByteCode:          p.WASMByteCode,
+		InstantiatePermission: p.InstantiatePermission,
+		Metadata:              p.Metadata,
+	}
+
+	return msg.ValidateBasic()
+}
+
+// String implements the Stringer interface.
+func (p StoreCodeProposal) String() string {
+	return fmt.Sprintf(`Store Code Proposal:
+  Title:                  %s
+  Description:            %s
+  Run As:                 %s
+  WASM Byte Code:         %X
+  Instantiate Permission: %v
+  Metadata:               %v
+`, p.Title, p.Description, p.RunAs, p.WASMByteCode, p.InstantiatePermission, p.Metadata)
+}
+
+// NewInstantiateContractProposal creates a new instantiate contract proposal.
+func NewInstantiateContractProposal(
+	title, description string,
+	runAs, admin sdk.AccAddress,
+	codeID uint64,
+	initMsg []byte,
+	initCoins sdk.Coins,
+	label string,
+) *InstantiateContractProposal {
+	var adminAddr string
+	if !admin.Empty() {
+		adminAddr = admin.String()
+	}
+
+	return &InstantiateContractProposal{title, description, runAs.String(), adminAddr, codeID, initMsg, initCoins, label}
+}
+
+// GetTitle returns the title of an instantiate contract proposal.
+func (p *InstantiateContractProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of an instantiate contract proposal.
+func (p *InstantiateContractProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of an instantiate contract proposal.
+func (p *InstantiateContractProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of an instantiate contract proposal.
+func (p *InstantiateContractProposal) ProposalType() string { return ProposalTypeInstantiateContract }
+
+// ValidateBasic runs basic stateless validity checks
+func (p *InstantiateContractProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+
+	if p.CodeID == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "missing code_id")
+	}
+
+	msg := MsgInstantiateContract{
+		Sender:    p.RunAs,
+		Admin:     p.Admin,
+		CodeID:    p.CodeID,
+		InitMsg:   p.InitMsg,
+		InitCoins: p.InitCoins,
+		Label:     p.Label,
+	}
+
+	return msg.ValidateBasic()
+}
+
+// String implements the Stringer interface.
+func (p InstantiateContractProposal) String() string {
+	return fmt.Sprintf(`Instantiate Contract Proposal:
+  Title:       %s
+  Description: %s
+  Run As:      %s
+  Admin:       %s
+  Code ID:     %d
+  Init Msg:    %q
+  Init Coins:  %s
+  Label:       %s
+`, p.Title, p.Description, p.RunAs, p.Admin, p.CodeID, p.InitMsg, p.InitCoins, p.Label)
+}
+
+// NewMigrateContractProposal creates a new migrate contract proposal.
+func NewMigrateContractProposal(
+	title, description string,
+	contract sdk.AccAddress,
+	newCodeID uint64,
+	migrateMsg []byte,
+) *MigrateContractProposal {
+	return &MigrateContractProposal{title, description, contract.String(), newCodeID, migrateMsg}
+}
+
+// GetTitle returns the title of a migrate contract proposal.
+func (p *MigrateContractProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of a migrate contract proposal.
+func (p *MigrateContractProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of a migrate contract proposal.
+func (p *MigrateContractProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a migrate contract proposal.
+func (p *MigrateContractProposal) ProposalType() string { return ProposalTypeMigrateContract }
+
+// ValidateBasic runs basic stateless validity checks
+func (p *MigrateContractProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+
+	if p.NewCodeID == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "missing new_code_id")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(p.Contract); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid contract address (%s)", err)
+	}
+
+	if uint64(len(p.MigrateMsg)) > EnforcedMaxContractMsgSize {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "wasm msg byte size is too huge")
+	}
+
+	if !json.Valid(p.MigrateMsg) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "wasm msg byte format is invalid json")
+	}
+
+	return nil
+}
+
+// String implements the Stringer interface.
+func (p MigrateContractProposal) String() string {
+	return fmt.Sprintf(`Migrate Contract Proposal:
+  Title:       %s
+  Description: %s
+  Contract:    %s
+  New Code ID: %d
+  Migrate Msg: %q
+`, p.Title, p.Description, p.Contract, p.NewCodeID, p.MigrateMsg)
+}
+
+// NewSudoContractProposal creates a new sudo contract proposal.
+func NewSudoContractProposal(
+	title, description string,
+	contract sdk.AccAddress,
+	msg []byte,
+) *SudoContractProposal {
+	return &SudoContractProposal{title, description, contract.String(), msg}
+}
+
+// GetTitle returns the title of a sudo contract proposal.
+func (p *SudoContractProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of a sudo contract proposal.
+func (p *SudoContractProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of a sudo contract proposal.
+func (p *SudoContractProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a sudo contract proposal.
+func (p *SudoContractProposal) ProposalType() string { return ProposalTypeSudoContract }
+
+// ValidateBasic runs basic stateless validity checks
+func (p *SudoContractProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+
+	if _, err := sdk.AccAddressFromBech32(p.Contract); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid contract address (%s)", err)
+	}
+
+	if uint64(len(p.Msg)) > EnforcedMaxContractMsgSize {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "wasm msg byte size is too huge")
+	}
+
+	if !json.Valid(p.Msg) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "wasm msg byte format is invalid json")
+	}
+
+	return nil
+}
+
+// String implements the Stringer interface.
+func (p SudoContractProposal) String() string {
+	return fmt.Sprintf(`Sudo Contract Proposal:
+  Title:       %s
+  Description: %s
+  Contract:    %s
+  Msg:         %q
+`, p.Title, p.Description, p.Contract, p.Msg)
+}
+
+func validateCodeIDs(codeIDs []uint64) error {
+	if len(codeIDs) == 0 {
+		return ErrEmptyCodeIDs
+	}
+
+	seen := make(map[uint64]bool, len(codeIDs))
+	for _, codeID := range codeIDs {
+		if codeID == 0 {
+			return sdkerrors.Wrap(ErrInvalidMsg, "code id cannot be zero")
+		}
+
+		if seen[codeID] {
+			return sdkerrors.Wrapf(ErrDuplicateCodeID, "%d", codeID)
+		}
+
+		seen[codeID] = true
+	}
+
+	return nil
+}
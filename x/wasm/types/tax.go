@@ -0,0 +1,32 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	customauthtypes "github.com/terra-money/core/custom/auth/types"
+)
+
+// RegisterTaxableMsgs registers the taxable msgs of the module
+func RegisterTaxableMsgs(registry customauthtypes.TaxableMsgRegistry) {
+	// the contract address does not exist before instantiation,
+	// so init coins are always taxed
+	registry.RegisterTaxableMsg(&MsgInstantiateContract{}, func(msg sdk.Msg) ([]customauthtypes.TaxablePrincipal, error) {
+		return []customauthtypes.TaxablePrincipal{{
+			Amount: msg.(*MsgInstantiateContract).InitCoins,
+		}}, nil
+	})
+
+	registry.RegisterTaxableMsg(&MsgInstantiateContract2{}, func(msg sdk.Msg) ([]customauthtypes.TaxablePrincipal, error) {
+		return []customauthtypes.TaxablePrincipal{{
+			Amount: msg.(*MsgInstantiateContract2).InitCoins,
+		}}, nil
+	})
+
+	registry.RegisterTaxableMsg(&MsgExecuteContract{}, func(msg sdk.Msg) ([]customauthtypes.TaxablePrincipal, error) {
+		msgExecuteContract := msg.(*MsgExecuteContract)
+		return []customauthtypes.TaxablePrincipal{{
+			Amount:  msgExecuteContract.Coins,
+			Parties: []string{msgExecuteContract.Sender, msgExecuteContract.Contract},
+		}}, nil
+	})
+}
@@ -38,6 +38,10 @@ type MsgStoreCode struct {
 	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
 	// WASMByteCode can be raw or gzip compressed
 	WASMByteCode []byte `protobuf:"bytes,2,opt,name=wasm_byte_code,json=wasmByteCode,proto3" json:"wasm_byte_code,omitempty" yaml:"wasm_byte_code"`
+	// InstantiatePermission to apply on the code; the default permission of the params is used when empty
+	InstantiatePermission *AccessConfig `protobuf:"bytes,3,opt,name=instantiate_permission,json=instantiatePermission,proto3" json:"instantiate_permission,omitempty" yaml:"instantiate_permission"`
+	// Metadata is the optional human-readable information of the code
+	Metadata CodeMetadata `protobuf:"bytes,4,opt,name=metadata,proto3" json:"metadata" yaml:"metadata"`
 }
 
 func (m *MsgStoreCode) Reset()         { *m = MsgStoreCode{} }
@@ -214,6 +218,8 @@ type MsgInstantiateContract struct {
 	InitMsg encoding_json.RawMessage `protobuf:"bytes,4,opt,name=init_msg,json=initMsg,proto3,casttype=encoding/json.RawMessage" json:"init_msg,omitempty" yaml:"init_msg"`
 	// InitCoins that are transferred to the contract on execution
 	InitCoins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=init_coins,json=initCoins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"init_coins" yaml:"init_coins"`
+	// Label is the human-readable name of the contract
+	Label string `protobuf:"bytes,6,opt,name=label,proto3" json:"label,omitempty" yaml:"label"`
 }
 
 func (m *MsgInstantiateContract) Reset()         { *m = MsgInstantiateContract{} }
@@ -304,6 +310,114 @@ func (m *MsgInstantiateContractResponse) GetData() []byte {
 	return nil
 }
 
+// MsgInstantiateContract2 represents a message to create
+// a new smart contract instance for the given code id
+// at the address derived from the code hash, the sender and the salt.
+type MsgInstantiateContract2 struct {
+	// Sender is an sender address
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	// Admin is an optional admin address who can migrate the contract
+	Admin string `protobuf:"bytes,2,opt,name=admin,proto3" json:"admin,omitempty" yaml:"admin"`
+	// CodeID is the reference to the stored WASM code
+	CodeID uint64 `protobuf:"varint,3,opt,name=code_id,json=codeId,proto3" json:"code_id,omitempty" yaml:"code_id"`
+	// InitMsg json encoded message to be passed to the contract on instantiation
+	InitMsg encoding_json.RawMessage `protobuf:"bytes,4,opt,name=init_msg,json=initMsg,proto3,casttype=encoding/json.RawMessage" json:"init_msg,omitempty" yaml:"init_msg"`
+	// InitCoins that are transferred to the contract on execution
+	InitCoins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=init_coins,json=initCoins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"init_coins" yaml:"init_coins"`
+	// Salt is an arbitrary value chosen by the sender to derive the contract address
+	Salt []byte `protobuf:"bytes,6,opt,name=salt,proto3" json:"salt,omitempty" yaml:"salt"`
+	// Label is the human-readable name of the contract
+	Label string `protobuf:"bytes,7,opt,name=label,proto3" json:"label,omitempty" yaml:"label"`
+}
+
+func (m *MsgInstantiateContract2) Reset()         { *m = MsgInstantiateContract2{} }
+func (m *MsgInstantiateContract2) String() string { return proto.CompactTextString(m) }
+func (*MsgInstantiateContract2) ProtoMessage()    {}
+func (*MsgInstantiateContract2) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5834e4e1a84cce82, []int{6}
+}
+func (m *MsgInstantiateContract2) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgInstantiateContract2) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgInstantiateContract2.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgInstantiateContract2) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgInstantiateContract2.Merge(m, src)
+}
+func (m *MsgInstantiateContract2) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgInstantiateContract2) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgInstantiateContract2.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgInstantiateContract2 proto.InternalMessageInfo
+
+// MsgInstantiateContract2Response defines the Msg/InstantiateContract2 response type.
+type MsgInstantiateContract2Response struct {
+	// ContractAddress is the bech32 address of the new contract instance.
+	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty" yaml:"contract_address"`
+	// Data contains base64-encoded bytes to returned from the contract
+	Data []byte `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty" yaml:"data"`
+}
+
+func (m *MsgInstantiateContract2Response) Reset()         { *m = MsgInstantiateContract2Response{} }
+func (m *MsgInstantiateContract2Response) String() string { return proto.CompactTextString(m) }
+func (*MsgInstantiateContract2Response) ProtoMessage()    {}
+func (*MsgInstantiateContract2Response) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5834e4e1a84cce82, []int{7}
+}
+func (m *MsgInstantiateContract2Response) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgInstantiateContract2Response) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgInstantiateContract2Response.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgInstantiateContract2Response) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgInstantiateContract2Response.Merge(m, src)
+}
+func (m *MsgInstantiateContract2Response) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgInstantiateContract2Response) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgInstantiateContract2Response.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgInstantiateContract2Response proto.InternalMessageInfo
+
+func (m *MsgInstantiateContract2Response) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+func (m *MsgInstantiateContract2Response) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
 // MsgExecuteContract represents a message to
 // submits the given message data to a smart contract.
 type MsgExecuteContract struct {
@@ -321,7 +435,7 @@ func (m *MsgExecuteContract) Reset()         { *m = MsgExecuteContract{} }
 func (m *MsgExecuteContract) String() string { return proto.CompactTextString(m) }
 func (*MsgExecuteContract) ProtoMessage()    {}
 func (*MsgExecuteContract) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5834e4e1a84cce82, []int{6}
+	return fileDescriptor_5834e4e1a84cce82, []int{8}
 }
 func (m *MsgExecuteContract) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -360,7 +474,7 @@ func (m *MsgExecuteContractResponse) Reset()         { *m = MsgExecuteContractRe
 func (m *MsgExecuteContractResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgExecuteContractResponse) ProtoMessage()    {}
 func (*MsgExecuteContractResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5834e4e1a84cce82, []int{7}
+	return fileDescriptor_5834e4e1a84cce82, []int{9}
 }
 func (m *MsgExecuteContractResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -413,7 +527,7 @@ func (m *MsgMigrateContract) Reset()         { *m = MsgMigrateContract{} }
 func (m *MsgMigrateContract) String() string { return proto.CompactTextString(m) }
 func (*MsgMigrateContract) ProtoMessage()    {}
 func (*MsgMigrateContract) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5834e4e1a84cce82, []int{8}
+	return fileDescriptor_5834e4e1a84cce82, []int{10}
 }
 func (m *MsgMigrateContract) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -452,7 +566,7 @@ func (m *MsgMigrateContractResponse) Reset()         { *m = MsgMigrateContractRe
 func (m *MsgMigrateContractResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgMigrateContractResponse) ProtoMessage()    {}
 func (*MsgMigrateContractResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5834e4e1a84cce82, []int{9}
+	return fileDescriptor_5834e4e1a84cce82, []int{11}
 }
 func (m *MsgMigrateContractResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -503,7 +617,7 @@ func (m *MsgUpdateContractAdmin) Reset()         { *m = MsgUpdateContractAdmin{}
 func (m *MsgUpdateContractAdmin) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateContractAdmin) ProtoMessage()    {}
 func (*MsgUpdateContractAdmin) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5834e4e1a84cce82, []int{10}
+	return fileDescriptor_5834e4e1a84cce82, []int{12}
 }
 func (m *MsgUpdateContractAdmin) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -540,7 +654,7 @@ func (m *MsgUpdateContractAdminResponse) Reset()         { *m = MsgUpdateContrac
 func (m *MsgUpdateContractAdminResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateContractAdminResponse) ProtoMessage()    {}
 func (*MsgUpdateContractAdminResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5834e4e1a84cce82, []int{11}
+	return fileDescriptor_5834e4e1a84cce82, []int{13}
 }
 func (m *MsgUpdateContractAdminResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -582,7 +696,7 @@ func (m *MsgClearContractAdmin) Reset()         { *m = MsgClearContractAdmin{} }
 func (m *MsgClearContractAdmin) String() string { return proto.CompactTextString(m) }
 func (*MsgClearContractAdmin) ProtoMessage()    {}
 func (*MsgClearContractAdmin) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5834e4e1a84cce82, []int{12}
+	return fileDescriptor_5834e4e1a84cce82, []int{14}
 }
 func (m *MsgClearContractAdmin) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -619,7 +733,7 @@ func (m *MsgClearContractAdminResponse) Reset()         { *m = MsgClearContractA
 func (m *MsgClearContractAdminResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgClearContractAdminResponse) ProtoMessage()    {}
 func (*MsgClearContractAdminResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5834e4e1a84cce82, []int{13}
+	return fileDescriptor_5834e4e1a84cce82, []int{15}
 }
 func (m *MsgClearContractAdminResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -648,6 +762,168 @@ func (m *MsgClearContractAdminResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgClearContractAdminResponse proto.InternalMessageInfo
 
+// MsgUpdateContractLabel represents a message to
+// set a new label for a smart contract
+type MsgUpdateContractLabel struct {
+	// Sender is the contract admin, or the contract creator when the contract has no admin
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	// Contract is the address of the smart contract
+	Contract string `protobuf:"bytes,2,opt,name=contract,proto3" json:"contract,omitempty" yaml:"contract"`
+	// NewLabel is the new human-readable name of the contract
+	NewLabel string `protobuf:"bytes,3,opt,name=new_label,json=newLabel,proto3" json:"new_label,omitempty" yaml:"new_label"`
+}
+
+func (m *MsgUpdateContractLabel) Reset()         { *m = MsgUpdateContractLabel{} }
+func (m *MsgUpdateContractLabel) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateContractLabel) ProtoMessage()    {}
+func (*MsgUpdateContractLabel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5834e4e1a84cce82, []int{16}
+}
+func (m *MsgUpdateContractLabel) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateContractLabel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateContractLabel.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateContractLabel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateContractLabel.Merge(m, src)
+}
+func (m *MsgUpdateContractLabel) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateContractLabel) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateContractLabel.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateContractLabel proto.InternalMessageInfo
+
+// MsgUpdateContractLabelResponse defines the Msg/UpdateContractLabel response type.
+type MsgUpdateContractLabelResponse struct {
+}
+
+func (m *MsgUpdateContractLabelResponse) Reset()         { *m = MsgUpdateContractLabelResponse{} }
+func (m *MsgUpdateContractLabelResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateContractLabelResponse) ProtoMessage()    {}
+func (*MsgUpdateContractLabelResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5834e4e1a84cce82, []int{17}
+}
+func (m *MsgUpdateContractLabelResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateContractLabelResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateContractLabelResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateContractLabelResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateContractLabelResponse.Merge(m, src)
+}
+func (m *MsgUpdateContractLabelResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateContractLabelResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateContractLabelResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateContractLabelResponse proto.InternalMessageInfo
+
+// MsgUpdateCodeMetadata represents a message to
+// set new metadata for a stored code
+type MsgUpdateCodeMetadata struct {
+	// Sender is the code creator
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	// CodeID is the reference to the stored WASM code
+	CodeID uint64 `protobuf:"varint,2,opt,name=code_id,json=codeId,proto3" json:"code_id,omitempty" yaml:"code_id"`
+	// Metadata is the new human-readable information of the code
+	Metadata CodeMetadata `protobuf:"bytes,3,opt,name=metadata,proto3" json:"metadata" yaml:"metadata"`
+}
+
+func (m *MsgUpdateCodeMetadata) Reset()         { *m = MsgUpdateCodeMetadata{} }
+func (m *MsgUpdateCodeMetadata) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateCodeMetadata) ProtoMessage()    {}
+func (*MsgUpdateCodeMetadata) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5834e4e1a84cce82, []int{18}
+}
+func (m *MsgUpdateCodeMetadata) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateCodeMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateCodeMetadata.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateCodeMetadata) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateCodeMetadata.Merge(m, src)
+}
+func (m *MsgUpdateCodeMetadata) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateCodeMetadata) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateCodeMetadata.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateCodeMetadata proto.InternalMessageInfo
+
+// MsgUpdateCodeMetadataResponse defines the Msg/UpdateCodeMetadata response type.
+type MsgUpdateCodeMetadataResponse struct {
+}
+
+func (m *MsgUpdateCodeMetadataResponse) Reset()         { *m = MsgUpdateCodeMetadataResponse{} }
+func (m *MsgUpdateCodeMetadataResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateCodeMetadataResponse) ProtoMessage()    {}
+func (*MsgUpdateCodeMetadataResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5834e4e1a84cce82, []int{19}
+}
+func (m *MsgUpdateCodeMetadataResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateCodeMetadataResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateCodeMetadataResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateCodeMetadataResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateCodeMetadataResponse.Merge(m, src)
+}
+func (m *MsgUpdateCodeMetadataResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateCodeMetadataResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateCodeMetadataResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateCodeMetadataResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgStoreCode)(nil), "terra.wasm.v1beta1.MsgStoreCode")
 	proto.RegisterType((*MsgStoreCodeResponse)(nil), "terra.wasm.v1beta1.MsgStoreCodeResponse")
@@ -655,6 +931,8 @@ func init() {
 	proto.RegisterType((*MsgMigrateCodeResponse)(nil), "terra.wasm.v1beta1.MsgMigrateCodeResponse")
 	proto.RegisterType((*MsgInstantiateContract)(nil), "terra.wasm.v1beta1.MsgInstantiateContract")
 	proto.RegisterType((*MsgInstantiateContractResponse)(nil), "terra.wasm.v1beta1.MsgInstantiateContractResponse")
+	proto.RegisterType((*MsgInstantiateContract2)(nil), "terra.wasm.v1beta1.MsgInstantiateContract2")
+	proto.RegisterType((*MsgInstantiateContract2Response)(nil), "terra.wasm.v1beta1.MsgInstantiateContract2Response")
 	proto.RegisterType((*MsgExecuteContract)(nil), "terra.wasm.v1beta1.MsgExecuteContract")
 	proto.RegisterType((*MsgExecuteContractResponse)(nil), "terra.wasm.v1beta1.MsgExecuteContractResponse")
 	proto.RegisterType((*MsgMigrateContract)(nil), "terra.wasm.v1beta1.MsgMigrateContract")
@@ -663,71 +941,91 @@ func init() {
 	proto.RegisterType((*MsgUpdateContractAdminResponse)(nil), "terra.wasm.v1beta1.MsgUpdateContractAdminResponse")
 	proto.RegisterType((*MsgClearContractAdmin)(nil), "terra.wasm.v1beta1.MsgClearContractAdmin")
 	proto.RegisterType((*MsgClearContractAdminResponse)(nil), "terra.wasm.v1beta1.MsgClearContractAdminResponse")
+	proto.RegisterType((*MsgUpdateContractLabel)(nil), "terra.wasm.v1beta1.MsgUpdateContractLabel")
+	proto.RegisterType((*MsgUpdateContractLabelResponse)(nil), "terra.wasm.v1beta1.MsgUpdateContractLabelResponse")
+	proto.RegisterType((*MsgUpdateCodeMetadata)(nil), "terra.wasm.v1beta1.MsgUpdateCodeMetadata")
+	proto.RegisterType((*MsgUpdateCodeMetadataResponse)(nil), "terra.wasm.v1beta1.MsgUpdateCodeMetadataResponse")
 }
 
 func init() { proto.RegisterFile("terra/wasm/v1beta1/tx.proto", fileDescriptor_5834e4e1a84cce82) }
 
 var fileDescriptor_5834e4e1a84cce82 = []byte{
-	// 944 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xcf, 0x6f, 0xe3, 0x44,
-	0x14, 0x8e, 0x9b, 0xb6, 0xdb, 0xbc, 0x84, 0xb6, 0xeb, 0x76, 0x21, 0x64, 0x21, 0x13, 0xcd, 0x4a,
-	0xab, 0x14, 0x69, 0x6d, 0xb5, 0x88, 0xcb, 0x9e, 0x48, 0xca, 0x22, 0x15, 0xc9, 0x20, 0xb9, 0x42,
-	0x2b, 0x21, 0xa1, 0xc8, 0xb1, 0x47, 0xc6, 0xb0, 0xf1, 0x14, 0x8f, 0x4b, 0x1a, 0x2e, 0x5c, 0xb9,
-	0x20, 0x81, 0xc4, 0x1f, 0xb0, 0x67, 0x0e, 0xfc, 0x11, 0x9c, 0xf6, 0x82, 0xb4, 0x47, 0x4e, 0x06,
-	0xa5, 0x17, 0xce, 0x96, 0x38, 0xc0, 0x09, 0x79, 0x66, 0xec, 0x4e, 0x9b, 0xdf, 0xd5, 0x9e, 0x6c,
-	0xcd, 0xfb, 0xde, 0x8f, 0xf9, 0xbe, 0xf7, 0x66, 0x06, 0xee, 0xc7, 0x24, 0x8a, 0x1c, 0x73, 0xe8,
-	0xb0, 0x81, 0xf9, 0xcd, 0x61, 0x9f, 0xc4, 0xce, 0xa1, 0x19, 0x5f, 0x18, 0x67, 0x11, 0x8d, 0xa9,
-	0xae, 0x73, 0xa3, 0x91, 0x19, 0x0d, 0x69, 0x6c, 0xec, 0xfb, 0xd4, 0xa7, 0xdc, 0x6c, 0x66, 0x7f,
-	0x02, 0xd9, 0x68, 0xba, 0x94, 0x0d, 0x28, 0x33, 0xfb, 0x0e, 0x23, 0x45, 0x1c, 0x97, 0x06, 0xa1,
-	0xb0, 0xe3, 0x9f, 0x35, 0xa8, 0x59, 0xcc, 0x3f, 0x8d, 0x69, 0x44, 0x8e, 0xa9, 0x47, 0xf4, 0x03,
-	0xd8, 0x64, 0x24, 0xf4, 0x48, 0x54, 0xd7, 0x5a, 0x5a, 0xbb, 0xd2, 0xbd, 0x9b, 0x26, 0xe8, 0xb5,
-	0x91, 0x33, 0x78, 0xf6, 0x18, 0x8b, 0x75, 0x6c, 0x4b, 0x80, 0xfe, 0x09, 0x6c, 0x67, 0x15, 0xf4,
-	0xfa, 0xa3, 0x98, 0xf4, 0x5c, 0xea, 0x91, 0xfa, 0x5a, 0x4b, 0x6b, 0xd7, 0xba, 0x07, 0xe3, 0x04,
-	0xd5, 0x9e, 0x76, 0x4e, 0xad, 0xee, 0x28, 0xe6, 0x41, 0xd3, 0x04, 0xdd, 0x13, 0x21, 0xae, 0xe3,
-	0xb1, 0x5d, 0xcb, 0x16, 0x72, 0xd8, 0xe3, 0xad, 0xef, 0x9f, 0xa3, 0xd2, 0xdf, 0xcf, 0x51, 0x09,
-	0x5b, 0xb0, 0xaf, 0x56, 0x65, 0x13, 0x76, 0x46, 0x43, 0x46, 0xf4, 0xf7, 0xe0, 0x4e, 0xe6, 0xd8,
-	0x0b, 0x3c, 0x5e, 0xde, 0x7a, 0xf7, 0xad, 0x71, 0x82, 0x36, 0x33, 0xc8, 0xc9, 0x07, 0x69, 0x82,
-	0xb6, 0x45, 0x16, 0x09, 0xc1, 0xf6, 0x66, 0xf6, 0x77, 0xe2, 0xe1, 0xdf, 0x35, 0xd8, 0xb6, 0x98,
-	0x6f, 0x05, 0x7e, 0xe4, 0x88, 0x5c, 0xb7, 0x8c, 0xa4, 0xd0, 0xb3, 0xb6, 0x3a, 0x3d, 0xe5, 0x57,
-	0x45, 0x4f, 0x1d, 0x5e, 0xbf, 0xbe, 0x9d, 0x9c, 0x20, 0xfc, 0xef, 0x1a, 0x37, 0x9d, 0x84, 0x2c,
-	0x76, 0xc2, 0x38, 0xe0, 0xe6, 0x30, 0x8e, 0x1c, 0x37, 0x5e, 0x45, 0xd9, 0x87, 0xb0, 0xe1, 0x78,
-	0x83, 0x20, 0x94, 0x9b, 0xdc, 0x4d, 0x13, 0x54, 0x13, 0x48, 0xbe, 0x8c, 0x6d, 0x61, 0x56, 0x49,
-	0x2c, 0xaf, 0x40, 0xe2, 0x47, 0xb0, 0x15, 0x84, 0x41, 0xdc, 0x1b, 0x30, 0xbf, 0xbe, 0xce, 0x39,
-	0x31, 0xd3, 0x04, 0xed, 0x08, 0x74, 0x6e, 0xc1, 0xff, 0x25, 0xa8, 0x4e, 0x42, 0x97, 0x7a, 0x41,
-	0xe8, 0x9b, 0x5f, 0x32, 0x1a, 0x1a, 0xb6, 0x33, 0xb4, 0x08, 0x63, 0x8e, 0x4f, 0xec, 0x3b, 0x19,
-	0xcc, 0x62, 0xbe, 0xfe, 0x1d, 0x00, 0xf7, 0xc8, 0x7a, 0x9a, 0xd5, 0x37, 0x5a, 0xe5, 0x76, 0xf5,
-	0xe8, 0x4d, 0x43, 0x74, 0xbd, 0x91, 0x75, 0x7d, 0x3e, 0x20, 0xc6, 0x31, 0x0d, 0xc2, 0xee, 0x93,
-	0x17, 0x09, 0x2a, 0xa5, 0x09, 0xba, 0xab, 0x24, 0xe3, 0xae, 0xf8, 0x97, 0x3f, 0x51, 0xdb, 0x0f,
-	0xe2, 0x2f, 0xce, 0xfb, 0x86, 0x4b, 0x07, 0xa6, 0x9c, 0x1b, 0xf1, 0x79, 0xc4, 0xbc, 0xaf, 0xcc,
-	0x78, 0x74, 0x46, 0x18, 0x8f, 0xc2, 0xec, 0x4a, 0xe6, 0xc8, 0x7f, 0x15, 0x55, 0x7e, 0xd0, 0xa0,
-	0x39, 0x9d, 0xfb, 0xa2, 0x7f, 0x3f, 0x84, 0x5d, 0x57, 0xae, 0xf5, 0x1c, 0xcf, 0x8b, 0x08, 0x63,
-	0x52, 0x8d, 0xfb, 0x69, 0x82, 0xde, 0xc8, 0xf9, 0xba, 0x8e, 0xc0, 0xf6, 0x4e, 0xbe, 0xd4, 0x11,
-	0x2b, 0xfa, 0x03, 0x58, 0xf7, 0x9c, 0xd8, 0x91, 0x03, 0xb7, 0x93, 0x26, 0xa8, 0x2a, 0x7c, 0xb3,
-	0x55, 0x6c, 0x73, 0x23, 0xfe, 0x6d, 0x0d, 0x74, 0x8b, 0xf9, 0x4f, 0x2e, 0x88, 0x7b, 0x7e, 0xbb,
-	0x3e, 0x30, 0x61, 0x2b, 0xcf, 0x2c, 0x5b, 0x61, 0xef, 0x4a, 0xa8, 0xdc, 0x82, 0xed, 0x02, 0xa4,
-	0x9f, 0x42, 0x95, 0x88, 0x74, 0x5c, 0x5c, 0xd1, 0xf0, 0x47, 0x69, 0x82, 0x74, 0xe1, 0xa3, 0x18,
-	0xe7, 0xeb, 0x0b, 0x12, 0x99, 0x49, 0xfc, 0x35, 0x6c, 0x2c, 0xa9, 0xee, 0xfb, 0x52, 0xdd, 0x5a,
-	0x5e, 0xe1, 0xca, 0xc2, 0x8a, 0x4c, 0x8a, 0xa8, 0x1d, 0x68, 0x4c, 0x72, 0x58, 0xe8, 0x99, 0xeb,
-	0xa0, 0xcd, 0xd3, 0xe1, 0x27, 0xa1, 0x43, 0x31, 0xae, 0x92, 0xab, 0x62, 0xc8, 0xb4, 0xf9, 0x43,
-	0xb6, 0xb2, 0x08, 0xc7, 0x50, 0x0d, 0xc9, 0xb0, 0x77, 0x7d, 0x32, 0x1f, 0x8c, 0x13, 0x54, 0xf9,
-	0x98, 0x0c, 0x8b, 0xe1, 0x94, 0x8a, 0x28, 0x48, 0x6c, 0x57, 0x42, 0x09, 0xf0, 0x32, 0x25, 0x07,
-	0xa2, 0x60, 0x65, 0x4c, 0x15, 0x25, 0x15, 0xe3, 0x02, 0x25, 0x25, 0xd2, 0x62, 0xfe, 0x04, 0xad,
-	0x37, 0x28, 0x59, 0x8d, 0xd6, 0x5f, 0x35, 0x7e, 0xd4, 0x7d, 0x7a, 0xe6, 0x29, 0x21, 0x3a, 0x9c,
-	0xb2, 0x65, 0xa9, 0x3d, 0x84, 0x6c, 0xc7, 0x3d, 0xf5, 0xac, 0xdb, 0x4f, 0x13, 0xb4, 0x7b, 0x45,
-	0x8d, 0xc4, 0x6f, 0x85, 0x64, 0xd8, 0x99, 0x50, 0xa3, 0xbc, 0x84, 0x1a, 0xca, 0x9e, 0x5b, 0xfc,
-	0x78, 0x98, 0x52, 0x6f, 0x71, 0x7a, 0x7f, 0x0b, 0xf7, 0x2c, 0xe6, 0x1f, 0x3f, 0x23, 0x4e, 0x74,
-	0xbb, 0x0d, 0xad, 0xda, 0x2b, 0x4a, 0x75, 0x08, 0xde, 0x9e, 0x9a, 0x3b, 0x2f, 0xee, 0xe8, 0x9f,
-	0x0d, 0x28, 0x67, 0xe3, 0xf8, 0x14, 0x2a, 0x57, 0xcf, 0x85, 0x96, 0x31, 0xf9, 0x14, 0x31, 0xd4,
-	0xab, 0xbb, 0xd1, 0x5e, 0x84, 0x28, 0x54, 0xff, 0x1c, 0xaa, 0xea, 0x0d, 0x8d, 0x67, 0x38, 0x2a,
-	0x98, 0xc6, 0x3b, 0x8b, 0x31, 0x45, 0xf8, 0x73, 0xd8, 0x9b, 0x76, 0x2d, 0xce, 0x0a, 0x31, 0x05,
-	0xdb, 0x38, 0x5a, 0x1e, 0x5b, 0xa4, 0x0d, 0x60, 0xe7, 0xe6, 0x09, 0xfc, 0x70, 0x46, 0x98, 0x1b,
-	0xb8, 0x86, 0xb1, 0x1c, 0x4e, 0x4d, 0x35, 0x71, 0xc8, 0x2c, 0x22, 0x68, 0x41, 0xaa, 0x59, 0x13,
-	0x7a, 0x0e, 0x7b, 0xd3, 0x06, 0x6f, 0x16, 0x99, 0x53, 0xb0, 0x33, 0xc9, 0x9c, 0x33, 0x20, 0x7a,
-	0x04, 0xfa, 0x94, 0xe9, 0x38, 0x98, 0x11, 0x69, 0x12, 0xda, 0x38, 0x5c, 0x1a, 0x9a, 0xe7, 0xec,
-	0x76, 0x5f, 0x8c, 0x9b, 0xda, 0xcb, 0x71, 0x53, 0xfb, 0x6b, 0xdc, 0xd4, 0x7e, 0xbc, 0x6c, 0x96,
-	0x5e, 0x5e, 0x36, 0x4b, 0x7f, 0x5c, 0x36, 0x4b, 0x9f, 0xa9, 0xd7, 0x0a, 0x0f, 0xfb, 0x68, 0x40,
-	0x43, 0x32, 0x32, 0x5d, 0x1a, 0x11, 0xf3, 0x42, 0xbc, 0xdd, 0xf9, 0xe5, 0xd2, 0xdf, 0xe4, 0xaf,
-	0xed, 0x77, 0xff, 0x0f, 0x00, 0x00, 0xff, 0xff, 0xfa, 0xdf, 0x2a, 0xed, 0xd6, 0x0b, 0x00, 0x00,
+	// 1196 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x58, 0xc1, 0x6f, 0xe3, 0xc4,
+	0x17, 0x8e, 0x9b, 0x36, 0x4d, 0x5f, 0xf2, 0x6b, 0xbb, 0x6e, 0xbb, 0xcd, 0xcf, 0x4b, 0xe3, 0xe0,
+	0x4a, 0xab, 0x14, 0xb4, 0xb1, 0x9a, 0x15, 0x97, 0x3d, 0x91, 0x94, 0x45, 0x2a, 0x5a, 0x03, 0x72,
+	0xb5, 0x5a, 0x09, 0x09, 0x45, 0x8e, 0x3d, 0x18, 0x43, 0x63, 0x17, 0x8f, 0x4b, 0x9b, 0xbd, 0x70,
+	0xe5, 0x02, 0x82, 0xff, 0x60, 0xb9, 0x72, 0x40, 0xe2, 0x5f, 0xe0, 0xc2, 0x5e, 0x90, 0xf6, 0x88,
+	0xf6, 0x60, 0x50, 0x7a, 0x80, 0x73, 0x8e, 0x9c, 0x90, 0x67, 0xc6, 0xce, 0x34, 0x71, 0x1a, 0xa7,
+	0xa8, 0xe2, 0xc2, 0xa9, 0xd6, 0xbc, 0x6f, 0xde, 0x9b, 0xf7, 0x7d, 0xef, 0xbd, 0x99, 0x06, 0xee,
+	0x04, 0xc8, 0xf7, 0x0d, 0xf5, 0xcc, 0xc0, 0x3d, 0xf5, 0xf3, 0xfd, 0x2e, 0x0a, 0x8c, 0x7d, 0x35,
+	0x38, 0x6f, 0x9c, 0xf8, 0x5e, 0xe0, 0x89, 0x22, 0x31, 0x36, 0x22, 0x63, 0x83, 0x19, 0xa5, 0x4d,
+	0xdb, 0xb3, 0x3d, 0x62, 0x56, 0xa3, 0x2f, 0x8a, 0x94, 0xaa, 0xa6, 0x87, 0x7b, 0x1e, 0x56, 0xbb,
+	0x06, 0x46, 0x89, 0x1f, 0xd3, 0x73, 0x5c, 0x66, 0xdf, 0x49, 0x09, 0x43, 0xdc, 0x12, 0xb3, 0xf2,
+	0xc7, 0x02, 0x94, 0x35, 0x6c, 0x1f, 0x05, 0x9e, 0x8f, 0x0e, 0x3c, 0x0b, 0x89, 0x7b, 0x50, 0xc0,
+	0xc8, 0xb5, 0x90, 0x5f, 0x11, 0x6a, 0x42, 0x7d, 0xa5, 0x7d, 0x6b, 0x18, 0xca, 0xff, 0xeb, 0x1b,
+	0xbd, 0xe3, 0x07, 0x0a, 0x5d, 0x57, 0x74, 0x06, 0x10, 0xdf, 0x83, 0xd5, 0xc8, 0x53, 0xa7, 0xdb,
+	0x0f, 0x50, 0xc7, 0xf4, 0x2c, 0x54, 0x59, 0xa8, 0x09, 0xf5, 0x72, 0x7b, 0x6f, 0x10, 0xca, 0xe5,
+	0x27, 0xad, 0x23, 0xad, 0xdd, 0x0f, 0x88, 0xd3, 0x61, 0x28, 0x6f, 0x51, 0x17, 0x97, 0xf1, 0x8a,
+	0x5e, 0x8e, 0x16, 0x62, 0x98, 0xf8, 0x14, 0x6e, 0x3b, 0x2e, 0x0e, 0x0c, 0x37, 0x70, 0x8c, 0x00,
+	0x75, 0x4e, 0x90, 0xdf, 0x73, 0x30, 0x76, 0x3c, 0xb7, 0x92, 0xaf, 0x09, 0xf5, 0x52, 0xb3, 0xd6,
+	0x98, 0xa4, 0xa5, 0xd1, 0x32, 0x4d, 0x84, 0xf1, 0x81, 0xe7, 0x7e, 0xe4, 0xd8, 0xed, 0x57, 0x87,
+	0xa1, 0xbc, 0x43, 0x43, 0xa5, 0x7b, 0x52, 0xf4, 0x2d, 0xce, 0xf0, 0x7e, 0xb2, 0x2e, 0x3e, 0x86,
+	0x62, 0x0f, 0x05, 0x86, 0x65, 0x04, 0x46, 0x65, 0x71, 0x7a, 0xb4, 0xe8, 0x9c, 0x1a, 0xc3, 0xb5,
+	0xb7, 0x9f, 0x87, 0x72, 0x6e, 0x18, 0xca, 0x6b, 0x34, 0x62, 0xbc, 0x5f, 0xd1, 0x13, 0x57, 0x0f,
+	0x8a, 0x5f, 0x3e, 0x93, 0x73, 0x7f, 0x3e, 0x93, 0x73, 0x8a, 0x06, 0x9b, 0x3c, 0xd1, 0x3a, 0xc2,
+	0x27, 0x9e, 0x8b, 0x91, 0xf8, 0x06, 0x2c, 0x47, 0x5c, 0x74, 0x1c, 0x8b, 0x30, 0xbe, 0xd8, 0x7e,
+	0x65, 0x10, 0xca, 0x85, 0x08, 0x72, 0xf8, 0xd6, 0x30, 0x94, 0x57, 0xa9, 0x6f, 0x06, 0x51, 0xf4,
+	0x42, 0xf4, 0x75, 0x68, 0x29, 0xbf, 0x08, 0xb0, 0xaa, 0x61, 0x5b, 0x73, 0x6c, 0xdf, 0x60, 0xf4,
+	0x5d, 0xcf, 0x13, 0xa7, 0xf8, 0xc2, 0xfc, 0x8a, 0xe7, 0xff, 0x91, 0xe2, 0x1c, 0x3d, 0x15, 0xb8,
+	0x7d, 0x39, 0x9d, 0x98, 0x20, 0xe5, 0xbb, 0x3c, 0x31, 0x1d, 0x8e, 0x64, 0x3b, 0xf0, 0xdc, 0xc0,
+	0x37, 0xcc, 0x60, 0x9e, 0x62, 0xbd, 0x0b, 0x4b, 0x86, 0xd5, 0x73, 0x5c, 0x96, 0xe4, 0xfa, 0x30,
+	0x94, 0xcb, 0x14, 0x49, 0x96, 0x15, 0x9d, 0x9a, 0x79, 0x12, 0xf3, 0x73, 0x90, 0xf8, 0x0e, 0x14,
+	0x1d, 0xd7, 0x09, 0x3a, 0x3d, 0x6c, 0x93, 0xf2, 0x29, 0xb7, 0xd5, 0x51, 0x61, 0xc4, 0x16, 0xe5,
+	0xaf, 0x50, 0xae, 0x20, 0xd7, 0xf4, 0x2c, 0xc7, 0xb5, 0xd5, 0x4f, 0xb0, 0xe7, 0x36, 0x74, 0xe3,
+	0x4c, 0x43, 0x18, 0x1b, 0x36, 0xd2, 0x97, 0x23, 0x98, 0x86, 0x6d, 0xf1, 0x0b, 0x00, 0xb2, 0x23,
+	0xea, 0x62, 0x5c, 0x59, 0xaa, 0xe5, 0xeb, 0xa5, 0xe6, 0xff, 0x1b, 0xb4, 0xcf, 0x1b, 0x51, 0x9f,
+	0x73, 0xd5, 0xe8, 0xb8, 0xed, 0x87, 0xac, 0x0a, 0x6f, 0x71, 0xc1, 0xc8, 0x56, 0xe5, 0xfb, 0xdf,
+	0xe4, 0xba, 0xed, 0x04, 0x1f, 0x9f, 0x76, 0x1b, 0xa6, 0xd7, 0x53, 0xd9, 0xa4, 0xa0, 0x7f, 0xee,
+	0x61, 0xeb, 0x53, 0x35, 0xe8, 0x9f, 0x20, 0x4c, 0xbc, 0x60, 0x7d, 0x25, 0xda, 0x48, 0x3e, 0x23,
+	0xae, 0x8e, 0x8d, 0x2e, 0x3a, 0xae, 0x14, 0xc6, 0xb9, 0x22, 0xcb, 0x8a, 0x4e, 0xcd, 0x9c, 0x7a,
+	0x5f, 0x09, 0x50, 0x4d, 0xd7, 0x28, 0xa9, 0xf3, 0xb7, 0x61, 0xdd, 0x64, 0x6b, 0x1d, 0xc3, 0xb2,
+	0x7c, 0x84, 0x31, 0x53, 0xed, 0xce, 0x30, 0x94, 0xb7, 0x63, 0x5e, 0x2f, 0x23, 0x14, 0x7d, 0x2d,
+	0x5e, 0x6a, 0xd1, 0x15, 0x71, 0x17, 0x16, 0x49, 0x93, 0xd2, 0x59, 0xb3, 0x36, 0x0c, 0xe5, 0x12,
+	0xdd, 0x4b, 0x5b, 0x8f, 0x18, 0x95, 0x9f, 0xf3, 0xb0, 0x9d, 0x7e, 0x9e, 0xe6, 0x7f, 0x45, 0x73,
+	0x33, 0x45, 0xb3, 0x0b, 0x8b, 0xd8, 0x38, 0x0e, 0x2a, 0x85, 0x71, 0x5d, 0xa2, 0x55, 0x45, 0x27,
+	0xc6, 0x51, 0x65, 0x2d, 0x67, 0xad, 0xac, 0xaf, 0x05, 0x90, 0xa7, 0x28, 0xf9, 0xef, 0x94, 0xd6,
+	0x4f, 0x0b, 0x20, 0x6a, 0xd8, 0x7e, 0x78, 0x8e, 0xcc, 0xd3, 0xeb, 0x8d, 0x22, 0x15, 0x8a, 0x71,
+	0x64, 0x56, 0x58, 0x1b, 0x23, 0xd9, 0x63, 0x8b, 0xa2, 0x27, 0x20, 0xf1, 0x08, 0x4a, 0x88, 0x86,
+	0x23, 0xa5, 0x42, 0x67, 0x6e, 0x73, 0x18, 0xca, 0x22, 0xdd, 0xc3, 0x19, 0xaf, 0xae, 0x16, 0x60,
+	0xc8, 0xa8, 0x60, 0x3e, 0x83, 0xa5, 0x8c, 0xb5, 0xf2, 0x26, 0xab, 0x95, 0x72, 0x7c, 0xc2, 0xb9,
+	0xcb, 0x84, 0x46, 0xe2, 0x54, 0x6d, 0x81, 0x34, 0xc9, 0x61, 0xa2, 0x67, 0xac, 0x83, 0x70, 0x95,
+	0x0e, 0xdf, 0x52, 0x1d, 0x92, 0x1b, 0x83, 0x71, 0x95, 0xb4, 0xac, 0x70, 0x75, 0xcb, 0xce, 0x2d,
+	0xc2, 0x01, 0x94, 0x5c, 0x74, 0xd6, 0xb9, 0xdc, 0xe7, 0xbb, 0x83, 0x50, 0x5e, 0x79, 0x17, 0x9d,
+	0x25, 0xad, 0xce, 0x14, 0xe1, 0x90, 0x8a, 0xbe, 0xe2, 0x32, 0x80, 0x15, 0x29, 0xd9, 0xa3, 0x07,
+	0xe6, 0x9a, 0x9e, 0x53, 0x92, 0x33, 0xce, 0x50, 0x92, 0x21, 0x35, 0x6c, 0x4f, 0xd0, 0x3a, 0x46,
+	0xc9, 0x7c, 0xb4, 0xfe, 0x20, 0x90, 0xdb, 0xf6, 0xf1, 0x89, 0xc5, 0xb9, 0x68, 0x11, 0xca, 0xb2,
+	0x52, 0xbb, 0x0f, 0x51, 0xc6, 0x1d, 0x7e, 0x72, 0x6e, 0x0e, 0x43, 0x79, 0x7d, 0x44, 0x0d, 0xc3,
+	0x17, 0x5d, 0x74, 0xd6, 0x9a, 0x50, 0x23, 0x9f, 0x41, 0x0d, 0x2e, 0xe7, 0x1a, 0x54, 0xd3, 0xcf,
+	0x9b, 0x3c, 0x20, 0x9e, 0xc2, 0x96, 0x86, 0xed, 0x83, 0x63, 0x64, 0xf8, 0xd7, 0x4b, 0x68, 0xde,
+	0x5a, 0xe1, 0x4e, 0x27, 0xc3, 0x4e, 0x6a, 0xec, 0xe4, 0x70, 0x3f, 0xa6, 0xf1, 0xfd, 0x28, 0x1a,
+	0x82, 0x37, 0x3a, 0x52, 0x98, 0x46, 0x74, 0x18, 0xe7, 0xd3, 0x34, 0x62, 0x03, 0x39, 0xd2, 0xe8,
+	0xd1, 0xd8, 0x4c, 0x4e, 0xa3, 0x9c, 0x60, 0x92, 0xac, 0x5e, 0x0a, 0xb0, 0xc5, 0x41, 0x46, 0x6f,
+	0xe6, 0x79, 0x92, 0xe2, 0x6e, 0xd5, 0x85, 0x39, 0x6e, 0x55, 0xfe, 0x25, 0x9f, 0xbf, 0x89, 0x97,
+	0x3c, 0xd5, 0x74, 0x32, 0xb7, 0x38, 0xfb, 0xe6, 0xcb, 0x22, 0xe4, 0xa3, 0x11, 0xfb, 0x04, 0x56,
+	0x46, 0xff, 0x58, 0xa5, 0x1e, 0x82, 0xff, 0x8f, 0x40, 0xaa, 0xcf, 0x42, 0x24, 0x9d, 0xfc, 0x21,
+	0x94, 0xf8, 0x87, 0xbf, 0x32, 0x65, 0x23, 0x87, 0x91, 0x5e, 0x9b, 0x8d, 0x49, 0xdc, 0x9f, 0xc2,
+	0x46, 0xda, 0x6b, 0x7b, 0x9a, 0x8b, 0x14, 0xac, 0xd4, 0xcc, 0x8e, 0x4d, 0xc2, 0x9e, 0xc3, 0x66,
+	0xea, 0x83, 0xed, 0xf5, 0xec, 0xbe, 0x9a, 0xd2, 0xfd, 0x39, 0xc0, 0x49, 0x64, 0x07, 0xd6, 0xc6,
+	0xef, 0xf3, 0xbb, 0x53, 0xfc, 0x8c, 0xe1, 0xa4, 0x46, 0x36, 0x1c, 0x1f, 0x6a, 0xe2, 0xca, 0x9a,
+	0x25, 0xcd, 0x8c, 0x50, 0xd3, 0xe6, 0xfd, 0x29, 0x6c, 0xa4, 0x8d, 0xf1, 0x69, 0x32, 0xa6, 0x60,
+	0xa5, 0x66, 0x76, 0x6c, 0x12, 0xd6, 0x07, 0x31, 0x65, 0xd6, 0xee, 0x4d, 0xf1, 0x34, 0x09, 0x95,
+	0xf6, 0x33, 0x43, 0xa7, 0xa7, 0x4a, 0x27, 0x68, 0xb6, 0x54, 0x09, 0x56, 0x6a, 0x66, 0xc7, 0xf2,
+	0xa9, 0xa6, 0x8d, 0xb8, 0x19, 0x9e, 0x46, 0x50, 0x69, 0x3f, 0x33, 0x34, 0x8e, 0xd9, 0x6e, 0x3f,
+	0x1f, 0x54, 0x85, 0x17, 0x83, 0xaa, 0xf0, 0xfb, 0xa0, 0x2a, 0x7c, 0x73, 0x51, 0xcd, 0xbd, 0xb8,
+	0xa8, 0xe6, 0x7e, 0xbd, 0xa8, 0xe6, 0x3e, 0xe0, 0xdf, 0x63, 0xc4, 0xed, 0xbd, 0x9e, 0xe7, 0xa2,
+	0xbe, 0x6a, 0x7a, 0x3e, 0x52, 0xcf, 0xe9, 0x4f, 0x40, 0xe4, 0x55, 0xd6, 0x2d, 0x90, 0x1f, 0x7f,
+	0xee, 0xff, 0x3d, 0x00, 0x31, 0x65, 0x9b, 0x5b, 0x84, 0x12, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -748,6 +1046,9 @@ type MsgClient interface {
 	MigrateCode(ctx context.Context, in *MsgMigrateCode, opts ...grpc.CallOption) (*MsgMigrateCodeResponse, error)
 	//  Instantiate creates a new smart contract instance for the given code id.
 	InstantiateContract(ctx context.Context, in *MsgInstantiateContract, opts ...grpc.CallOption) (*MsgInstantiateContractResponse, error)
+	// InstantiateContract2 creates a new smart contract instance for the given code id
+	// with the predictable address derived from the salt
+	InstantiateContract2(ctx context.Context, in *MsgInstantiateContract2, opts ...grpc.CallOption) (*MsgInstantiateContract2Response, error)
 	// Execute submits the given message data to a smart contract
 	ExecuteContract(ctx context.Context, in *MsgExecuteContract, opts ...grpc.CallOption) (*MsgExecuteContractResponse, error)
 	// Migrate runs a code upgrade/ downgrade for a smart contract
@@ -756,6 +1057,10 @@ type MsgClient interface {
 	UpdateContractAdmin(ctx context.Context, in *MsgUpdateContractAdmin, opts ...grpc.CallOption) (*MsgUpdateContractAdminResponse, error)
 	// ClearContractAdmin remove admin flag from a smart contract
 	ClearContractAdmin(ctx context.Context, in *MsgClearContractAdmin, opts ...grpc.CallOption) (*MsgClearContractAdminResponse, error)
+	// UpdateContractLabel sets a new label for a smart contract
+	UpdateContractLabel(ctx context.Context, in *MsgUpdateContractLabel, opts ...grpc.CallOption) (*MsgUpdateContractLabelResponse, error)
+	// UpdateCodeMetadata sets new metadata for a stored code
+	UpdateCodeMetadata(ctx context.Context, in *MsgUpdateCodeMetadata, opts ...grpc.CallOption) (*MsgUpdateCodeMetadataResponse, error)
 }
 
 type msgClient struct {
@@ -793,6 +1098,15 @@ func (c *msgClient) InstantiateContract(ctx context.Context, in *MsgInstantiateC
 	return out, nil
 }
 
+func (c *msgClient) InstantiateContract2(ctx context.Context, in *MsgInstantiateContract2, opts ...grpc.CallOption) (*MsgInstantiateContract2Response, error) {
+	out := new(MsgInstantiateContract2Response)
+	err := c.cc.Invoke(ctx, "/terra.wasm.v1beta1.Msg/InstantiateContract2", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) ExecuteContract(ctx context.Context, in *MsgExecuteContract, opts ...grpc.CallOption) (*MsgExecuteContractResponse, error) {
 	out := new(MsgExecuteContractResponse)
 	err := c.cc.Invoke(ctx, "/terra.wasm.v1beta1.Msg/ExecuteContract", in, out, opts...)
@@ -829,6 +1143,24 @@ func (c *msgClient) ClearContractAdmin(ctx context.Context, in *MsgClearContract
 	return out, nil
 }
 
+func (c *msgClient) UpdateContractLabel(ctx context.Context, in *MsgUpdateContractLabel, opts ...grpc.CallOption) (*MsgUpdateContractLabelResponse, error) {
+	out := new(MsgUpdateContractLabelResponse)
+	err := c.cc.Invoke(ctx, "/terra.wasm.v1beta1.Msg/UpdateContractLabel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateCodeMetadata(ctx context.Context, in *MsgUpdateCodeMetadata, opts ...grpc.CallOption) (*MsgUpdateCodeMetadataResponse, error) {
+	out := new(MsgUpdateCodeMetadataResponse)
+	err := c.cc.Invoke(ctx, "/terra.wasm.v1beta1.Msg/UpdateCodeMetadata", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// StoreCode to submit Wasm code to the system
@@ -837,6 +1169,9 @@ type MsgServer interface {
 	MigrateCode(context.Context, *MsgMigrateCode) (*MsgMigrateCodeResponse, error)
 	//  Instantiate creates a new smart contract instance for the given code id.
 	InstantiateContract(context.Context, *MsgInstantiateContract) (*MsgInstantiateContractResponse, error)
+	// InstantiateContract2 creates a new smart contract instance for the given code id
+	// with the predictable address derived from the salt
+	InstantiateContract2(context.Context, *MsgInstantiateContract2) (*MsgInstantiateContract2Response, error)
 	// Execute submits the given message data to a smart contract
 	ExecuteContract(context.Context, *MsgExecuteContract) (*MsgExecuteContractResponse, error)
 	// Migrate runs a code upgrade/ downgrade for a smart contract
@@ -845,6 +1180,10 @@ type MsgServer interface {
 	UpdateContractAdmin(context.Context, *MsgUpdateContractAdmin) (*MsgUpdateContractAdminResponse, error)
 	// ClearContractAdmin remove admin flag from a smart contract
 	ClearContractAdmin(context.Context, *MsgClearContractAdmin) (*MsgClearContractAdminResponse, error)
+	// UpdateContractLabel sets a new label for a smart contract
+	UpdateContractLabel(context.Context, *MsgUpdateContractLabel) (*MsgUpdateContractLabelResponse, error)
+	// UpdateCodeMetadata sets new metadata for a stored code
+	UpdateCodeMetadata(context.Context, *MsgUpdateCodeMetadata) (*MsgUpdateCodeMetadataResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -860,6 +1199,9 @@ func (*UnimplementedMsgServer) MigrateCode(ctx context.Context, req *MsgMigrateC
 func (*UnimplementedMsgServer) InstantiateContract(ctx context.Context, req *MsgInstantiateContract) (*MsgInstantiateContractResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method InstantiateContract not implemented")
 }
+func (*UnimplementedMsgServer) InstantiateContract2(ctx context.Context, req *MsgInstantiateContract2) (*MsgInstantiateContract2Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InstantiateContract2 not implemented")
+}
 func (*UnimplementedMsgServer) ExecuteContract(ctx context.Context, req *MsgExecuteContract) (*MsgExecuteContractResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ExecuteContract not implemented")
 }
@@ -872,6 +1214,12 @@ func (*UnimplementedMsgServer) UpdateContractAdmin(ctx context.Context, req *Msg
 func (*UnimplementedMsgServer) ClearContractAdmin(ctx context.Context, req *MsgClearContractAdmin) (*MsgClearContractAdminResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ClearContractAdmin not implemented")
 }
+func (*UnimplementedMsgServer) UpdateContractLabel(ctx context.Context, req *MsgUpdateContractLabel) (*MsgUpdateContractLabelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateContractLabel not implemented")
+}
+func (*UnimplementedMsgServer) UpdateCodeMetadata(ctx context.Context, req *MsgUpdateCodeMetadata) (*MsgUpdateCodeMetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCodeMetadata not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -931,6 +1279,24 @@ func _Msg_InstantiateContract_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_InstantiateContract2_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgInstantiateContract2)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).InstantiateContract2(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/terra.wasm.v1beta1.Msg/InstantiateContract2",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).InstantiateContract2(ctx, req.(*MsgInstantiateContract2))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_ExecuteContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgExecuteContract)
 	if err := dec(in); err != nil {
@@ -1003,6 +1369,42 @@ func _Msg_ClearContractAdmin_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateContractLabel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateContractLabel)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateContractLabel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/terra.wasm.v1beta1.Msg/UpdateContractLabel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateContractLabel(ctx, req.(*MsgUpdateContractLabel))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateCodeMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateCodeMetadata)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateCodeMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/terra.wasm.v1beta1.Msg/UpdateCodeMetadata",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateCodeMetadata(ctx, req.(*MsgUpdateCodeMetadata))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "terra.wasm.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -1019,6 +1421,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "InstantiateContract",
 			Handler:    _Msg_InstantiateContract_Handler,
 		},
+		{
+			MethodName: "InstantiateContract2",
+			Handler:    _Msg_InstantiateContract2_Handler,
+		},
 		{
 			MethodName: "ExecuteContract",
 			Handler:    _Msg_ExecuteContract_Handler,
@@ -1035,6 +1441,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ClearContractAdmin",
 			Handler:    _Msg_ClearContractAdmin_Handler,
 		},
+		{
+			MethodName: "UpdateContractLabel",
+			Handler:    _Msg_UpdateContractLabel_Handler,
+		},
+		{
+			MethodName: "UpdateCodeMetadata",
+			Handler:    _Msg_UpdateCodeMetadata_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "terra/wasm/v1beta1/tx.proto",
@@ -1060,6 +1474,28 @@ func (m *MsgStoreCode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.InstantiatePermission != nil {
+		{
+			size, err := m.InstantiatePermission.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.WASMByteCode) > 0 {
 		i -= len(m.WASMByteCode)
 		copy(dAtA[i:], m.WASMByteCode)
@@ -1190,6 +1626,13 @@ func (m *MsgInstantiateContract) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if len(m.Label) > 0 {
+		i -= len(m.Label)
+		copy(dAtA[i:], m.Label)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Label)))
+		i--
+		dAtA[i] = 0x32
+	}
 	if len(m.InitCoins) > 0 {
 		for iNdEx := len(m.InitCoins) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -1270,7 +1713,7 @@ func (m *MsgInstantiateContractResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgExecuteContract) Marshal() (dAtA []byte, err error) {
+func (m *MsgInstantiateContract2) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1280,18 +1723,132 @@ func (m *MsgExecuteContract) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgExecuteContract) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgInstantiateContract2) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgExecuteContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgInstantiateContract2) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Coins) > 0 {
-		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Label) > 0 {
+		i -= len(m.Label)
+		copy(dAtA[i:], m.Label)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Label)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Salt) > 0 {
+		i -= len(m.Salt)
+		copy(dAtA[i:], m.Salt)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Salt)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.InitCoins) > 0 {
+		for iNdEx := len(m.InitCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.InitCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.InitMsg) > 0 {
+		i -= len(m.InitMsg)
+		copy(dAtA[i:], m.InitMsg)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.InitMsg)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.CodeID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CodeID))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgInstantiateContract2Response) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgInstantiateContract2Response) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgInstantiateContract2Response) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgExecuteContract) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgExecuteContract) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgExecuteContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Coins) > 0 {
+		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
 			{
 				size, err := m.Coins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
@@ -1564,6 +2121,141 @@ func (m *MsgClearContractAdminResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgUpdateContractLabel) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateContractLabel) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateContractLabel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NewLabel) > 0 {
+		i -= len(m.NewLabel)
+		copy(dAtA[i:], m.NewLabel)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewLabel)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateContractLabelResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateContractLabelResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateContractLabelResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateCodeMetadata) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateCodeMetadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateCodeMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.CodeID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CodeID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateCodeMetadataResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateCodeMetadataResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateCodeMetadataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -1589,6 +2281,12 @@ func (m *MsgStoreCode) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	if m.InstantiatePermission != nil {
+		l = m.InstantiatePermission.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Metadata.Size()
+	n += 1 + l + sovTx(uint64(l))
 	return n
 }
 
@@ -1660,6 +2358,10 @@ func (m *MsgInstantiateContract) Size() (n int) {
 			n += 1 + l + sovTx(uint64(l))
 		}
 	}
+	l = len(m.Label)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -1680,7 +2382,7 @@ func (m *MsgInstantiateContractResponse) Size() (n int) {
 	return n
 }
 
-func (m *MsgExecuteContract) Size() (n int) {
+func (m *MsgInstantiateContract2) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -1690,29 +2392,44 @@ func (m *MsgExecuteContract) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	l = len(m.Contract)
+	l = len(m.Admin)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	l = len(m.ExecuteMsg)
+	if m.CodeID != 0 {
+		n += 1 + sovTx(uint64(m.CodeID))
+	}
+	l = len(m.InitMsg)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	if len(m.Coins) > 0 {
-		for _, e := range m.Coins {
+	if len(m.InitCoins) > 0 {
+		for _, e := range m.InitCoins {
 			l = e.Size()
 			n += 1 + l + sovTx(uint64(l))
 		}
 	}
+	l = len(m.Salt)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Label)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
-func (m *MsgExecuteContractResponse) Size() (n int) {
+func (m *MsgInstantiateContract2Response) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
 	l = len(m.Data)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
@@ -1720,13 +2437,13 @@ func (m *MsgExecuteContractResponse) Size() (n int) {
 	return n
 }
 
-func (m *MsgMigrateContract) Size() (n int) {
+func (m *MsgExecuteContract) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Admin)
+	l = len(m.Sender)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
@@ -1734,18 +2451,58 @@ func (m *MsgMigrateContract) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	if m.NewCodeID != 0 {
-		n += 1 + sovTx(uint64(m.NewCodeID))
-	}
-	l = len(m.MigrateMsg)
+	l = len(m.ExecuteMsg)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	return n
-}
-
-func (m *MsgMigrateContractResponse) Size() (n int) {
-	if m == nil {
+	if len(m.Coins) > 0 {
+		for _, e := range m.Coins {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgExecuteContractResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgMigrateContract) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.NewCodeID != 0 {
+		n += 1 + sovTx(uint64(m.NewCodeID))
+	}
+	l = len(m.MigrateMsg)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgMigrateContractResponse) Size() (n int) {
+	if m == nil {
 		return 0
 	}
 	var l int
@@ -1813,6 +2570,63 @@ func (m *MsgClearContractAdminResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgUpdateContractLabel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NewLabel)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateContractLabelResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateCodeMetadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.CodeID != 0 {
+		n += 1 + sovTx(uint64(m.CodeID))
+	}
+	l = m.Metadata.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateCodeMetadataResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1914,6 +2728,75 @@ func (m *MsgStoreCode) Unmarshal(dAtA []byte) error {
 				m.WASMByteCode = []byte{}
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InstantiatePermission", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.InstantiatePermission == nil {
+				m.InstantiatePermission = &AccessConfig{}
+			}
+			if err := m.InstantiatePermission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1954,21 +2837,923 @@ func (m *MsgStoreCodeResponse) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgStoreCodeResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgStoreCodeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CodeID", wireType)
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgStoreCodeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgStoreCodeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeID", wireType)
+			}
+			m.CodeID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CodeID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgMigrateCode) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMigrateCode: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMigrateCode: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeID", wireType)
+			}
+			m.CodeID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CodeID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WASMByteCode", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WASMByteCode = append(m.WASMByteCode[:0], dAtA[iNdEx:postIndex]...)
+			if m.WASMByteCode == nil {
+				m.WASMByteCode = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgMigrateCodeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMigrateCodeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMigrateCodeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgInstantiateContract) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgInstantiateContract: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgInstantiateContract: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeID", wireType)
+			}
+			m.CodeID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CodeID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitMsg", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InitMsg = append(m.InitMsg[:0], dAtA[iNdEx:postIndex]...)
+			if m.InitMsg == nil {
+				m.InitMsg = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitCoins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InitCoins = append(m.InitCoins, types.Coin{})
+			if err := m.InitCoins[len(m.InitCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Label", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Label = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgInstantiateContractResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgInstantiateContractResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgInstantiateContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgInstantiateContract2) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgInstantiateContract2: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgInstantiateContract2: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeID", wireType)
+			}
+			m.CodeID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CodeID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitMsg", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InitMsg = append(m.InitMsg[:0], dAtA[iNdEx:postIndex]...)
+			if m.InitMsg == nil {
+				m.InitMsg = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitCoins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InitCoins = append(m.InitCoins, types.Coin{})
+			if err := m.InitCoins[len(m.InitCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Salt", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Salt = append(m.Salt[:0], dAtA[iNdEx:postIndex]...)
+			if m.Salt == nil {
+				m.Salt = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Label", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Label = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgInstantiateContract2Response) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgInstantiateContract2Response: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgInstantiateContract2Response: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
-			m.CodeID = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1978,11 +3763,26 @@ func (m *MsgStoreCodeResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CodeID |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2004,7 +3804,7 @@ func (m *MsgStoreCodeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgMigrateCode) Unmarshal(dAtA []byte) error {
+func (m *MsgExecuteContract) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2027,17 +3827,17 @@ func (m *MsgMigrateCode) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgMigrateCode: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgExecuteContract: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgMigrateCode: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgExecuteContract: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CodeID", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
-			m.CodeID = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2047,14 +3847,27 @@ func (m *MsgMigrateCode) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CodeID |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2082,11 +3895,11 @@ func (m *MsgMigrateCode) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.Contract = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WASMByteCode", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecuteMsg", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -2113,9 +3926,43 @@ func (m *MsgMigrateCode) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WASMByteCode = append(m.WASMByteCode[:0], dAtA[iNdEx:postIndex]...)
-			if m.WASMByteCode == nil {
-				m.WASMByteCode = []byte{}
+			m.ExecuteMsg = append(m.ExecuteMsg[:0], dAtA[iNdEx:postIndex]...)
+			if m.ExecuteMsg == nil {
+				m.ExecuteMsg = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Coins = append(m.Coins, types.Coin{})
+			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -2139,7 +3986,7 @@ func (m *MsgMigrateCode) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgMigrateCodeResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgExecuteContractResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2162,12 +4009,46 @@ func (m *MsgMigrateCodeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgMigrateCodeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgExecuteContractResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgMigrateCodeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgExecuteContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2189,7 +4070,7 @@ func (m *MsgMigrateCodeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgInstantiateContract) Unmarshal(dAtA []byte) error {
+func (m *MsgMigrateContract) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2212,15 +4093,15 @@ func (m *MsgInstantiateContract) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgInstantiateContract: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgMigrateContract: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgInstantiateContract: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgMigrateContract: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2248,11 +4129,11 @@ func (m *MsgInstantiateContract) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.Admin = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2280,13 +4161,13 @@ func (m *MsgInstantiateContract) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Admin = string(dAtA[iNdEx:postIndex])
+			m.Contract = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CodeID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewCodeID", wireType)
 			}
-			m.CodeID = 0
+			m.NewCodeID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2296,14 +4177,14 @@ func (m *MsgInstantiateContract) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CodeID |= uint64(b&0x7F) << shift
+				m.NewCodeID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InitMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MigrateMsg", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -2330,43 +4211,9 @@ func (m *MsgInstantiateContract) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.InitMsg = append(m.InitMsg[:0], dAtA[iNdEx:postIndex]...)
-			if m.InitMsg == nil {
-				m.InitMsg = []byte{}
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InitCoins", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.InitCoins = append(m.InitCoins, types.Coin{})
-			if err := m.InitCoins[len(m.InitCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.MigrateMsg = append(m.MigrateMsg[:0], dAtA[iNdEx:postIndex]...)
+			if m.MigrateMsg == nil {
+				m.MigrateMsg = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -2390,7 +4237,7 @@ func (m *MsgInstantiateContract) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgInstantiateContractResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgMigrateContractResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2413,45 +4260,13 @@ func (m *MsgInstantiateContractResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgInstantiateContractResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgMigrateContractResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgInstantiateContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgMigrateContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ContractAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
@@ -2506,7 +4321,7 @@ func (m *MsgInstantiateContractResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgExecuteContract) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateContractAdmin) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2529,15 +4344,15 @@ func (m *MsgExecuteContract) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgExecuteContract: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateContractAdmin: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgExecuteContract: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateContractAdmin: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2565,11 +4380,11 @@ func (m *MsgExecuteContract) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.Admin = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewAdmin", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2597,47 +4412,13 @@ func (m *MsgExecuteContract) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Contract = string(dAtA[iNdEx:postIndex])
+			m.NewAdmin = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExecuteMsg", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ExecuteMsg = append(m.ExecuteMsg[:0], dAtA[iNdEx:postIndex]...)
-			if m.ExecuteMsg == nil {
-				m.ExecuteMsg = []byte{}
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2647,25 +4428,23 @@ func (m *MsgExecuteContract) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Coins = append(m.Coins, types.Coin{})
-			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2688,7 +4467,7 @@ func (m *MsgExecuteContract) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgExecuteContractResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateContractAdminResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2711,46 +4490,12 @@ func (m *MsgExecuteContractResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgExecuteContractResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateContractAdminResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgExecuteContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateContractAdminResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
-			if m.Data == nil {
-				m.Data = []byte{}
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2772,7 +4517,7 @@ func (m *MsgExecuteContractResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgMigrateContract) Unmarshal(dAtA []byte) error {
+func (m *MsgClearContractAdmin) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2795,10 +4540,10 @@ func (m *MsgMigrateContract) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgMigrateContract: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgClearContractAdmin: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgMigrateContract: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgClearContractAdmin: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2865,59 +4610,6 @@ func (m *MsgMigrateContract) Unmarshal(dAtA []byte) error {
 			}
 			m.Contract = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewCodeID", wireType)
-			}
-			m.NewCodeID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.NewCodeID |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MigrateMsg", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.MigrateMsg = append(m.MigrateMsg[:0], dAtA[iNdEx:postIndex]...)
-			if m.MigrateMsg == nil {
-				m.MigrateMsg = []byte{}
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2939,7 +4631,7 @@ func (m *MsgMigrateContract) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgMigrateContractResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgClearContractAdminResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2962,46 +4654,12 @@ func (m *MsgMigrateContractResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgMigrateContractResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgClearContractAdminResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgMigrateContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgClearContractAdminResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
-			if m.Data == nil {
-				m.Data = []byte{}
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3023,7 +4681,7 @@ func (m *MsgMigrateContractResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateContractAdmin) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateContractLabel) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3046,15 +4704,15 @@ func (m *MsgUpdateContractAdmin) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateContractAdmin: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateContractLabel: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateContractAdmin: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateContractLabel: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3082,11 +4740,11 @@ func (m *MsgUpdateContractAdmin) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Admin = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewAdmin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3114,11 +4772,11 @@ func (m *MsgUpdateContractAdmin) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NewAdmin = string(dAtA[iNdEx:postIndex])
+			m.Contract = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewLabel", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3146,7 +4804,7 @@ func (m *MsgUpdateContractAdmin) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Contract = string(dAtA[iNdEx:postIndex])
+			m.NewLabel = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3169,7 +4827,7 @@ func (m *MsgUpdateContractAdmin) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateContractAdminResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateContractLabelResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3192,10 +4850,10 @@ func (m *MsgUpdateContractAdminResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateContractAdminResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateContractLabelResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateContractAdminResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateContractLabelResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3219,7 +4877,7 @@ func (m *MsgUpdateContractAdminResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgClearContractAdmin) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateCodeMetadata) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3242,15 +4900,15 @@ func (m *MsgClearContractAdmin) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgClearContractAdmin: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateCodeMetadata: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgClearContractAdmin: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateCodeMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3278,13 +4936,32 @@ func (m *MsgClearContractAdmin) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Admin = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeID", wireType)
+			}
+			m.CodeID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CodeID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3294,23 +4971,24 @@ func (m *MsgClearContractAdmin) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Contract = string(dAtA[iNdEx:postIndex])
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3333,7 +5011,7 @@ func (m *MsgClearContractAdmin) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgClearContractAdminResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateCodeMetadataResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3356,10 +5034,10 @@ func (m *MsgClearContractAdminResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgClearContractAdminResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateCodeMetadataResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgClearContractAdminResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateCodeMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -26,11 +26,94 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// AccessType defines the types of permissions for the wasm code actions
+type AccessType int32
+
+const (
+	// ACCESS_TYPE_UNSPECIFIED is a placeholder for an empty value
+	AccessTypeUnspecified AccessType = 0
+	// ACCESS_TYPE_NOBODY forbids the action for all accounts
+	AccessTypeNobody AccessType = 1
+	// ACCESS_TYPE_ALLOWLIST allows the action only for the listed addresses
+	AccessTypeAllowlist AccessType = 2
+	// ACCESS_TYPE_EVERYBODY allows the action for all accounts
+	AccessTypeEverybody AccessType = 3
+)
+
+var AccessType_name = map[int32]string{
+	0: "ACCESS_TYPE_UNSPECIFIED",
+	1: "ACCESS_TYPE_NOBODY",
+	2: "ACCESS_TYPE_ALLOWLIST",
+	3: "ACCESS_TYPE_EVERYBODY",
+}
+
+var AccessType_value = map[string]int32{
+	"ACCESS_TYPE_UNSPECIFIED": 0,
+	"ACCESS_TYPE_NOBODY":      1,
+	"ACCESS_TYPE_ALLOWLIST":   2,
+	"ACCESS_TYPE_EVERYBODY":   3,
+}
+
+func (x AccessType) String() string {
+	return proto.EnumName(AccessType_name, int32(x))
+}
+
+func (AccessType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_2bd5d0123068c880, []int{0}
+}
+
+// ContractCodeHistoryOperationType defines the operation recorded in the contract code history
+type ContractCodeHistoryOperationType int32
+
+const (
+	// CONTRACT_CODE_HISTORY_OPERATION_TYPE_UNSPECIFIED is a placeholder for an empty value
+	ContractCodeHistoryOperationTypeUnspecified ContractCodeHistoryOperationType = 0
+	// CONTRACT_CODE_HISTORY_OPERATION_TYPE_INIT records the contract instantiation
+	ContractCodeHistoryOperationTypeInit ContractCodeHistoryOperationType = 1
+	// CONTRACT_CODE_HISTORY_OPERATION_TYPE_MIGRATE records a code migration
+	ContractCodeHistoryOperationTypeMigrate ContractCodeHistoryOperationType = 2
+	// CONTRACT_CODE_HISTORY_OPERATION_TYPE_UPDATE_ADMIN records an admin update
+	ContractCodeHistoryOperationTypeUpdateAdmin ContractCodeHistoryOperationType = 3
+	// CONTRACT_CODE_HISTORY_OPERATION_TYPE_CLEAR_ADMIN records an admin clear
+	ContractCodeHistoryOperationTypeClearAdmin ContractCodeHistoryOperationType = 4
+)
+
+var ContractCodeHistoryOperationType_name = map[int32]string{
+	0: "CONTRACT_CODE_HISTORY_OPERATION_TYPE_UNSPECIFIED",
+	1: "CONTRACT_CODE_HISTORY_OPERATION_TYPE_INIT",
+	2: "CONTRACT_CODE_HISTORY_OPERATION_TYPE_MIGRATE",
+	3: "CONTRACT_CODE_HISTORY_OPERATION_TYPE_UPDATE_ADMIN",
+	4: "CONTRACT_CODE_HISTORY_OPERATION_TYPE_CLEAR_ADMIN",
+}
+
+var ContractCodeHistoryOperationType_value = map[string]int32{
+	"CONTRACT_CODE_HISTORY_OPERATION_TYPE_UNSPECIFIED":  0,
+	"CONTRACT_CODE_HISTORY_OPERATION_TYPE_INIT":         1,
+	"CONTRACT_CODE_HISTORY_OPERATION_TYPE_MIGRATE":      2,
+	"CONTRACT_CODE_HISTORY_OPERATION_TYPE_UPDATE_ADMIN": 3,
+	"CONTRACT_CODE_HISTORY_OPERATION_TYPE_CLEAR_ADMIN":  4,
+}
+
+func (x ContractCodeHistoryOperationType) String() string {
+	return proto.EnumName(ContractCodeHistoryOperationType_name, int32(x))
+}
+
+func (ContractCodeHistoryOperationType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_2bd5d0123068c880, []int{1}
+}
+
 // Params defines the parameters for the wasm module.
 type Params struct {
 	MaxContractSize    uint64 `protobuf:"varint,1,opt,name=max_contract_size,json=maxContractSize,proto3" json:"max_contract_size,omitempty" yaml:"max_contract_size"`
 	MaxContractGas     uint64 `protobuf:"varint,2,opt,name=max_contract_gas,json=maxContractGas,proto3" json:"max_contract_gas,omitempty" yaml:"max_contract_gas"`
 	MaxContractMsgSize uint64 `protobuf:"varint,3,opt,name=max_contract_msg_size,json=maxContractMsgSize,proto3" json:"max_contract_msg_size,omitempty" yaml:"max_contract_msg_size"`
+	// CodeUploadAccess defines who can upload a new code
+	CodeUploadAccess AccessConfig `protobuf:"bytes,4,opt,name=code_upload_access,json=codeUploadAccess,proto3" json:"code_upload_access" yaml:"code_upload_access"`
+	// InstantiateDefaultPermission is the instantiate permission given to a new code
+	// when the uploader does not specify one
+	InstantiateDefaultPermission AccessType `protobuf:"varint,5,opt,name=instantiate_default_permission,json=instantiateDefaultPermission,proto3,enum=terra.wasm.v1beta1.AccessType" json:"instantiate_default_permission,omitempty" yaml:"instantiate_default_permission"`
+	// StargateQueryAllowlist defines the stargate queries the contracts are allowed to call
+	StargateQueryAllowlist []StargateQuery `protobuf:"bytes,6,rep,name=stargate_query_allowlist,json=stargateQueryAllowlist,proto3" json:"stargate_query_allowlist" yaml:"stargate_query_allowlist"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -86,6 +169,137 @@ func (m *Params) GetMaxContractMsgSize() uint64 {
 	return 0
 }
 
+func (m *Params) GetCodeUploadAccess() AccessConfig {
+	if m != nil {
+		return m.CodeUploadAccess
+	}
+	return AccessConfig{}
+}
+
+func (m *Params) GetInstantiateDefaultPermission() AccessType {
+	if m != nil {
+		return m.InstantiateDefaultPermission
+	}
+	return AccessTypeUnspecified
+}
+
+func (m *Params) GetStargateQueryAllowlist() []StargateQuery {
+	if m != nil {
+		return m.StargateQueryAllowlist
+	}
+	return nil
+}
+
+// StargateQuery defines a stargate query path allowed from the contracts and
+// the proto type its response is re-marshalled into
+type StargateQuery struct {
+	// Path is the fully qualified gRPC method, e.g. /cosmos.bank.v1beta1.Query/Balance
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty" yaml:"path"`
+	// ResponseType is the full proto name of the response, e.g. cosmos.bank.v1beta1.QueryBalanceResponse
+	ResponseType string `protobuf:"bytes,2,opt,name=response_type,json=responseType,proto3" json:"response_type,omitempty" yaml:"response_type"`
+}
+
+func (m *StargateQuery) Reset()         { *m = StargateQuery{} }
+func (m *StargateQuery) String() string { return proto.CompactTextString(m) }
+func (*StargateQuery) ProtoMessage()    {}
+func (*StargateQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2bd5d0123068c880, []int{1}
+}
+func (m *StargateQuery) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StargateQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StargateQuery.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StargateQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StargateQuery.Merge(m, src)
+}
+func (m *StargateQuery) XXX_Size() int {
+	return m.Size()
+}
+func (m *StargateQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_StargateQuery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StargateQuery proto.InternalMessageInfo
+
+func (m *StargateQuery) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *StargateQuery) GetResponseType() string {
+	if m != nil {
+		return m.ResponseType
+	}
+	return ""
+}
+
+// AccessConfig defines who is allowed to execute a wasm code action
+type AccessConfig struct {
+	Permission AccessType `protobuf:"varint,1,opt,name=permission,proto3,enum=terra.wasm.v1beta1.AccessType" json:"permission,omitempty" yaml:"permission"`
+	// Addresses is the list of allowed addresses for ACCESS_TYPE_ALLOWLIST
+	Addresses []string `protobuf:"bytes,2,rep,name=addresses,proto3" json:"addresses,omitempty" yaml:"addresses"`
+}
+
+func (m *AccessConfig) Reset()         { *m = AccessConfig{} }
+func (m *AccessConfig) String() string { return proto.CompactTextString(m) }
+func (*AccessConfig) ProtoMessage()    {}
+func (*AccessConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2bd5d0123068c880, []int{2}
+}
+func (m *AccessConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccessConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AccessConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AccessConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccessConfig.Merge(m, src)
+}
+func (m *AccessConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccessConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccessConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccessConfig proto.InternalMessageInfo
+
+func (m *AccessConfig) GetPermission() AccessType {
+	if m != nil {
+		return m.Permission
+	}
+	return AccessTypeUnspecified
+}
+
+func (m *AccessConfig) GetAddresses() []string {
+	if m != nil {
+		return m.Addresses
+	}
+	return nil
+}
+
 // CodeInfo is data for the uploaded contract WASM code
 type CodeInfo struct {
 	// CodeID is the sequentially increasing unique identifier
@@ -94,13 +308,17 @@ type CodeInfo struct {
 	CodeHash []byte `protobuf:"bytes,2,opt,name=code_hash,json=codeHash,proto3" json:"code_hash,omitempty" yaml:"code_hash"`
 	// Creator address who initially stored the code
 	Creator string `protobuf:"bytes,3,opt,name=creator,proto3" json:"creator,omitempty" yaml:"creator"`
+	// InstantiatePermission defines who can instantiate the code or migrate contracts to it
+	InstantiatePermission AccessConfig `protobuf:"bytes,4,opt,name=instantiate_permission,json=instantiatePermission,proto3" json:"instantiate_permission" yaml:"instantiate_permission"`
+	// Metadata is the optional human-readable information of the code
+	Metadata CodeMetadata `protobuf:"bytes,5,opt,name=metadata,proto3" json:"metadata" yaml:"metadata"`
 }
 
 func (m *CodeInfo) Reset()         { *m = CodeInfo{} }
 func (m *CodeInfo) String() string { return proto.CompactTextString(m) }
 func (*CodeInfo) ProtoMessage()    {}
 func (*CodeInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2bd5d0123068c880, []int{1}
+	return fileDescriptor_2bd5d0123068c880, []int{3}
 }
 func (m *CodeInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -150,6 +368,84 @@ func (m *CodeInfo) GetCreator() string {
 	return ""
 }
 
+func (m *CodeInfo) GetInstantiatePermission() AccessConfig {
+	if m != nil {
+		return m.InstantiatePermission
+	}
+	return AccessConfig{}
+}
+
+func (m *CodeInfo) GetMetadata() CodeMetadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return CodeMetadata{}
+}
+
+// CodeMetadata is the optional human-readable information of a stored code
+type CodeMetadata struct {
+	// Source is the URL of the source code
+	Source string `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty" yaml:"source"`
+	// Builder is the docker image used to build the code reproducibly
+	Builder string `protobuf:"bytes,2,opt,name=builder,proto3" json:"builder,omitempty" yaml:"builder"`
+	// Schema is the URL or the content of the JSON schema of the contract messages
+	Schema string `protobuf:"bytes,3,opt,name=schema,proto3" json:"schema,omitempty" yaml:"schema"`
+}
+
+func (m *CodeMetadata) Reset()         { *m = CodeMetadata{} }
+func (m *CodeMetadata) String() string { return proto.CompactTextString(m) }
+func (*CodeMetadata) ProtoMessage()    {}
+func (*CodeMetadata) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2bd5d0123068c880, []int{4}
+}
+func (m *CodeMetadata) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CodeMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CodeMetadata.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CodeMetadata) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CodeMetadata.Merge(m, src)
+}
+func (m *CodeMetadata) XXX_Size() int {
+	return m.Size()
+}
+func (m *CodeMetadata) XXX_DiscardUnknown() {
+	xxx_messageInfo_CodeMetadata.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CodeMetadata proto.InternalMessageInfo
+
+func (m *CodeMetadata) GetSource() string {
+	if m != nil {
+		return m.Source
+	}
+	return ""
+}
+
+func (m *CodeMetadata) GetBuilder() string {
+	if m != nil {
+		return m.Builder
+	}
+	return ""
+}
+
+func (m *CodeMetadata) GetSchema() string {
+	if m != nil {
+		return m.Schema
+	}
+	return ""
+}
+
 // ContractInfo stores a WASM contract instance
 type ContractInfo struct {
 	// Address is the address of the contract
@@ -162,13 +458,17 @@ type ContractInfo struct {
 	CodeID uint64 `protobuf:"varint,4,opt,name=code_id,json=codeId,proto3" json:"code_id,omitempty" yaml:"code_id"`
 	// InitMsg is the raw message used when instantiating a contract
 	InitMsg encoding_json.RawMessage `protobuf:"bytes,5,opt,name=init_msg,json=initMsg,proto3,casttype=encoding/json.RawMessage" json:"init_msg,omitempty" yaml:"init_msg"`
+	// IBCPortID is the port bound to the contract; empty when the contract has no IBC entry points
+	IBCPortID string `protobuf:"bytes,6,opt,name=ibc_port_id,json=ibcPortId,proto3" json:"ibc_port_id,omitempty" yaml:"ibc_port_id"`
+	// Label is the human-readable name of the contract
+	Label string `protobuf:"bytes,7,opt,name=label,proto3" json:"label,omitempty" yaml:"label"`
 }
 
 func (m *ContractInfo) Reset()         { *m = ContractInfo{} }
 func (m *ContractInfo) String() string { return proto.CompactTextString(m) }
 func (*ContractInfo) ProtoMessage()    {}
 func (*ContractInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2bd5d0123068c880, []int{2}
+	return fileDescriptor_2bd5d0123068c880, []int{5}
 }
 func (m *ContractInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -232,48 +532,202 @@ func (m *ContractInfo) GetInitMsg() encoding_json.RawMessage {
 	return nil
 }
 
+func (m *ContractInfo) GetIBCPortID() string {
+	if m != nil {
+		return m.IBCPortID
+	}
+	return ""
+}
+
+func (m *ContractInfo) GetLabel() string {
+	if m != nil {
+		return m.Label
+	}
+	return ""
+}
+
+// ContractCodeHistoryEntry is an entry of the contract code history
+type ContractCodeHistoryEntry struct {
+	Operation ContractCodeHistoryOperationType `protobuf:"varint,1,opt,name=operation,proto3,enum=terra.wasm.v1beta1.ContractCodeHistoryOperationType" json:"operation,omitempty" yaml:"operation"`
+	// CodeID is the code of the contract after the operation
+	CodeID uint64 `protobuf:"varint,2,opt,name=code_id,json=codeId,proto3" json:"code_id,omitempty" yaml:"code_id"`
+	// Height is the block height of the operation
+	Height int64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty" yaml:"height"`
+	// Sender is the address executed the operation; empty for governance
+	Sender string `protobuf:"bytes,4,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	// Msg is the raw init or migrate message; empty for admin operations
+	Msg encoding_json.RawMessage `protobuf:"bytes,5,opt,name=msg,proto3,casttype=encoding/json.RawMessage" json:"msg,omitempty" yaml:"msg"`
+}
+
+func (m *ContractCodeHistoryEntry) Reset()         { *m = ContractCodeHistoryEntry{} }
+func (m *ContractCodeHistoryEntry) String() string { return proto.CompactTextString(m) }
+func (*ContractCodeHistoryEntry) ProtoMessage()    {}
+func (*ContractCodeHistoryEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2bd5d0123068c880, []int{6}
+}
+func (m *ContractCodeHistoryEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContractCodeHistoryEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContractCodeHistoryEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ContractCodeHistoryEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractCodeHistoryEntry.Merge(m, src)
+}
+func (m *ContractCodeHistoryEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContractCodeHistoryEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractCodeHistoryEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContractCodeHistoryEntry proto.InternalMessageInfo
+
+func (m *ContractCodeHistoryEntry) GetOperation() ContractCodeHistoryOperationType {
+	if m != nil {
+		return m.Operation
+	}
+	return ContractCodeHistoryOperationTypeUnspecified
+}
+
+func (m *ContractCodeHistoryEntry) GetCodeID() uint64 {
+	if m != nil {
+		return m.CodeID
+	}
+	return 0
+}
+
+func (m *ContractCodeHistoryEntry) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *ContractCodeHistoryEntry) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *ContractCodeHistoryEntry) GetMsg() encoding_json.RawMessage {
+	if m != nil {
+		return m.Msg
+	}
+	return nil
+}
+
 func init() {
+	proto.RegisterEnum("terra.wasm.v1beta1.AccessType", AccessType_name, AccessType_value)
+	proto.RegisterEnum("terra.wasm.v1beta1.ContractCodeHistoryOperationType", ContractCodeHistoryOperationType_name, ContractCodeHistoryOperationType_value)
 	proto.RegisterType((*Params)(nil), "terra.wasm.v1beta1.Params")
+	proto.RegisterType((*StargateQuery)(nil), "terra.wasm.v1beta1.StargateQuery")
+	proto.RegisterType((*AccessConfig)(nil), "terra.wasm.v1beta1.AccessConfig")
 	proto.RegisterType((*CodeInfo)(nil), "terra.wasm.v1beta1.CodeInfo")
+	proto.RegisterType((*CodeMetadata)(nil), "terra.wasm.v1beta1.CodeMetadata")
 	proto.RegisterType((*ContractInfo)(nil), "terra.wasm.v1beta1.ContractInfo")
+	proto.RegisterType((*ContractCodeHistoryEntry)(nil), "terra.wasm.v1beta1.ContractCodeHistoryEntry")
 }
 
 func init() { proto.RegisterFile("terra/wasm/v1beta1/wasm.proto", fileDescriptor_2bd5d0123068c880) }
 
 var fileDescriptor_2bd5d0123068c880 = []byte{
-	// 507 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0xbf, 0x6f, 0xd3, 0x40,
-	0x14, 0xc7, 0xe3, 0x90, 0x26, 0xe9, 0x29, 0x6a, 0xc3, 0xa9, 0x88, 0xa8, 0x14, 0x5f, 0x75, 0x03,
-	0xea, 0x50, 0x62, 0x45, 0x88, 0x25, 0x63, 0x0a, 0xa2, 0x45, 0x8a, 0x84, 0xdc, 0x8d, 0x25, 0x7a,
-	0xb1, 0x0f, 0xe7, 0x10, 0x77, 0x57, 0xf9, 0x19, 0x9a, 0xf4, 0xaf, 0x60, 0x44, 0x62, 0xa9, 0xf8,
-	0x6b, 0x18, 0x3b, 0x32, 0x59, 0x28, 0x59, 0x98, 0x3d, 0x21, 0x26, 0xe4, 0x73, 0x8c, 0x1c, 0x51,
-	0x09, 0xb1, 0x3d, 0xbf, 0xef, 0xc7, 0xdf, 0xf7, 0xe3, 0xf4, 0xc8, 0xc3, 0x44, 0xc4, 0x31, 0x78,
-	0x97, 0x80, 0xca, 0xfb, 0x30, 0x98, 0x8a, 0x04, 0x06, 0xf6, 0xa3, 0x7f, 0x11, 0x9b, 0xc4, 0x50,
-	0x6a, 0xe5, 0xbe, 0xcd, 0xac, 0xe5, 0xfd, 0xbd, 0xc8, 0x44, 0xc6, 0xca, 0x5e, 0x1e, 0x15, 0xe4,
-	0xbe, 0x1b, 0x18, 0x54, 0x06, 0xbd, 0x29, 0xa0, 0xf8, 0xe3, 0x14, 0x18, 0xa9, 0x0b, 0x9d, 0xff,
-	0x74, 0x48, 0xf3, 0x15, 0xc4, 0xa0, 0x90, 0x9e, 0x92, 0xbb, 0x0a, 0xe6, 0x93, 0xc0, 0xe8, 0x24,
-	0x86, 0x20, 0x99, 0xa0, 0xbc, 0x12, 0x3d, 0xe7, 0xd0, 0x39, 0x6a, 0x8c, 0x0e, 0xb2, 0x94, 0xf5,
-	0x16, 0xa0, 0xde, 0x0d, 0xf9, 0x5f, 0x08, 0xf7, 0x77, 0x15, 0xcc, 0x4f, 0xd6, 0xa9, 0x73, 0x79,
-	0x25, 0xe8, 0x73, 0xd2, 0xdd, 0xc0, 0x22, 0xc0, 0x5e, 0xdd, 0x1a, 0x3d, 0xc8, 0x52, 0x76, 0xff,
-	0x16, 0xa3, 0x08, 0x90, 0xfb, 0x3b, 0x15, 0x9f, 0x17, 0x80, 0xf4, 0x9c, 0xdc, 0xdb, 0x80, 0x14,
-	0x46, 0x45, 0x53, 0x77, 0xac, 0xd7, 0x61, 0x96, 0xb2, 0x83, 0x5b, 0xbc, 0x4a, 0x8c, 0xfb, 0xb4,
-	0x62, 0x38, 0xc6, 0x28, 0xef, 0x6d, 0xd8, 0xfe, 0x74, 0xcd, 0x6a, 0x3f, 0xae, 0x99, 0xc3, 0xbf,
-	0x38, 0xa4, 0x7d, 0x62, 0x42, 0x71, 0xa6, 0xdf, 0x18, 0xfa, 0x94, 0xb4, 0x02, 0x13, 0x8a, 0x89,
-	0x0c, 0xcb, 0x91, 0x97, 0x29, 0x6b, 0x5a, 0xf9, 0x59, 0x96, 0xb2, 0x9d, 0xa2, 0xce, 0x1a, 0xe1,
-	0x7e, 0x33, 0x8f, 0xce, 0x42, 0x3a, 0x20, 0xdb, 0x36, 0x37, 0x03, 0x9c, 0xd9, 0x11, 0x3b, 0xa3,
-	0xbd, 0x2c, 0x65, 0xdd, 0x0a, 0x9e, 0x4b, 0xdc, 0x6f, 0xe7, 0xf1, 0x29, 0xe0, 0x8c, 0x1e, 0x93,
-	0x56, 0x10, 0x0b, 0x48, 0x4c, 0x6c, 0xe7, 0xd8, 0x1e, 0xd1, 0x8a, 0x7f, 0x21, 0x70, 0xbf, 0x44,
-	0xf8, 0xe7, 0x3a, 0xe9, 0x94, 0x23, 0xd8, 0x46, 0x8f, 0x49, 0x0b, 0xc2, 0x30, 0x16, 0x88, 0xb6,
-	0xd1, 0x8d, 0xdf, 0xd7, 0x02, 0xf7, 0x4b, 0xa4, 0x5a, 0xac, 0xfe, 0xcf, 0x62, 0xf4, 0x11, 0xd9,
-	0x82, 0x50, 0x49, 0xbd, 0x6e, 0xac, 0x9b, 0xa5, 0xac, 0x53, 0x3a, 0x2b, 0xa9, 0xb9, 0x5f, 0xc8,
-	0xd5, 0x65, 0x35, 0xfe, 0x63, 0x59, 0x2f, 0x49, 0x5b, 0x6a, 0x69, 0x1f, 0xa8, 0xb7, 0x65, 0x77,
-	0xe5, 0x65, 0x29, 0xdb, 0x2d, 0xe8, 0x52, 0xe1, 0xbf, 0x52, 0xd6, 0x13, 0x3a, 0x30, 0xa1, 0xd4,
-	0x91, 0xf7, 0x16, 0x8d, 0xee, 0xfb, 0x70, 0x39, 0x16, 0x88, 0x10, 0x09, 0xbf, 0x95, 0x63, 0x63,
-	0x8c, 0x86, 0x8d, 0xfc, 0x09, 0x47, 0xa3, 0xaf, 0x4b, 0xd7, 0xb9, 0x59, 0xba, 0xce, 0xf7, 0xa5,
-	0xeb, 0x7c, 0x5c, 0xb9, 0xb5, 0x9b, 0x95, 0x5b, 0xfb, 0xb6, 0x72, 0x6b, 0xaf, 0x8f, 0x22, 0x99,
-	0xcc, 0xde, 0x4f, 0xfb, 0x81, 0x51, 0x9e, 0x3d, 0x96, 0xc7, 0xca, 0x68, 0xb1, 0xf0, 0x02, 0x13,
-	0x0b, 0x6f, 0x5e, 0x1c, 0x56, 0xb2, 0xb8, 0x10, 0x38, 0x6d, 0xda, 0x43, 0x78, 0xf2, 0x3b, 0x00,
-	0x00, 0xff, 0xff, 0xc1, 0x86, 0x77, 0xe4, 0x73, 0x03, 0x00, 0x00,
+	// 1348 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x57, 0xcf, 0x53, 0xdb, 0x46,
+	0x1b, 0x46, 0xb6, 0x31, 0x78, 0x21, 0x89, 0xb3, 0x1f, 0x04, 0xc5, 0x5f, 0x62, 0x29, 0xca, 0x97,
+	0x2f, 0x40, 0x28, 0x2e, 0xf4, 0xc7, 0x21, 0xd3, 0x1e, 0x2c, 0xa3, 0x06, 0x77, 0x00, 0xd3, 0xb5,
+	0x69, 0x86, 0xce, 0x74, 0x34, 0x6b, 0x69, 0x91, 0xd5, 0xb1, 0xb4, 0x8e, 0x56, 0x24, 0x71, 0x0e,
+	0x3d, 0xe5, 0x90, 0xe1, 0xd2, 0x5e, 0x3a, 0x93, 0x0b, 0xd3, 0xcc, 0xf4, 0x9f, 0xc9, 0x31, 0x97,
+	0xce, 0xf4, 0xa4, 0xc9, 0x90, 0x4b, 0xcf, 0xbe, 0xb5, 0xa7, 0x8e, 0x56, 0x32, 0x16, 0x98, 0xd4,
+	0xce, 0x6d, 0xfd, 0x3e, 0xcf, 0xfb, 0xbc, 0xaf, 0xdf, 0xf7, 0xd9, 0xc5, 0x80, 0x9b, 0x3e, 0xf1,
+	0x3c, 0x5c, 0x7a, 0x82, 0x99, 0x53, 0x7a, 0xbc, 0xd6, 0x24, 0x3e, 0x5e, 0xe3, 0x1f, 0x56, 0x3b,
+	0x1e, 0xf5, 0x29, 0x84, 0x1c, 0x5e, 0xe5, 0x91, 0x18, 0x2e, 0xcc, 0x59, 0xd4, 0xa2, 0x1c, 0x2e,
+	0x85, 0xa7, 0x88, 0x59, 0x28, 0x1a, 0x94, 0x39, 0x94, 0x95, 0x9a, 0x98, 0x91, 0x53, 0x25, 0x83,
+	0xda, 0x6e, 0x84, 0x2b, 0xcf, 0x27, 0x41, 0x76, 0x17, 0x7b, 0xd8, 0x61, 0x70, 0x13, 0x5c, 0x75,
+	0xf0, 0x53, 0xdd, 0xa0, 0xae, 0xef, 0x61, 0xc3, 0xd7, 0x99, 0xfd, 0x8c, 0x88, 0x82, 0x2c, 0x2c,
+	0x66, 0xd4, 0x1b, 0xbd, 0x40, 0x12, 0xbb, 0xd8, 0x69, 0xdf, 0x57, 0x86, 0x28, 0x0a, 0xba, 0xe2,
+	0xe0, 0xa7, 0x95, 0x38, 0x54, 0xb7, 0x9f, 0x11, 0xa8, 0x81, 0xfc, 0x19, 0x9a, 0x85, 0x99, 0x98,
+	0xe2, 0x42, 0xff, 0xed, 0x05, 0xd2, 0xc2, 0x05, 0x42, 0x16, 0x66, 0x0a, 0xba, 0x9c, 0xd0, 0x79,
+	0x80, 0x19, 0xac, 0x83, 0xf9, 0x33, 0x24, 0x87, 0x59, 0x51, 0x53, 0x69, 0xae, 0x25, 0xf7, 0x02,
+	0xe9, 0xc6, 0x05, 0x5a, 0x7d, 0x9a, 0x82, 0x60, 0x42, 0x70, 0x9b, 0x59, 0xbc, 0xb7, 0x47, 0x00,
+	0x1a, 0xd4, 0x24, 0xfa, 0x61, 0xa7, 0x4d, 0xb1, 0xa9, 0x63, 0xc3, 0x20, 0x8c, 0x89, 0x19, 0x59,
+	0x58, 0x9c, 0x59, 0x97, 0x57, 0x87, 0xe7, 0xba, 0x5a, 0xe6, 0x8c, 0x0a, 0x75, 0x0f, 0x6c, 0x4b,
+	0xbd, 0xf5, 0x3a, 0x90, 0x26, 0x7a, 0x81, 0x74, 0x3d, 0xaa, 0x3b, 0xac, 0xa4, 0xa0, 0x7c, 0x18,
+	0xdc, 0xe3, 0xb1, 0x28, 0x15, 0xfe, 0x24, 0x80, 0xa2, 0xed, 0x32, 0x1f, 0xbb, 0xbe, 0x8d, 0x7d,
+	0xa2, 0x9b, 0xe4, 0x00, 0x1f, 0xb6, 0x7d, 0xbd, 0x43, 0x3c, 0xc7, 0x66, 0xcc, 0xa6, 0xae, 0x38,
+	0x29, 0x0b, 0x8b, 0x97, 0xd7, 0x8b, 0xef, 0xaf, 0xdf, 0xe8, 0x76, 0x88, 0xba, 0xd4, 0x0b, 0xa4,
+	0x3b, 0x51, 0xe5, 0x7f, 0xd7, 0x53, 0xd0, 0x8d, 0x04, 0x61, 0x23, 0xc2, 0x77, 0x4f, 0x61, 0xf8,
+	0x5c, 0x00, 0x22, 0xf3, 0xb1, 0x67, 0x85, 0xe9, 0x8f, 0x0e, 0x89, 0xd7, 0xd5, 0x71, 0xbb, 0x4d,
+	0x9f, 0xb4, 0x6d, 0xe6, 0x8b, 0x59, 0x39, 0xbd, 0x38, 0xb3, 0x7e, 0xeb, 0xa2, 0x5e, 0xea, 0x71,
+	0xce, 0x37, 0x61, 0x8a, 0x7a, 0x37, 0x1e, 0x86, 0x14, 0xb5, 0xf4, 0x3e, 0x41, 0x05, 0x5d, 0x63,
+	0xc9, 0xbc, 0x72, 0x1f, 0xb8, 0x3f, 0xfd, 0xf2, 0x95, 0x34, 0xf1, 0xe7, 0x2b, 0x49, 0x50, 0xba,
+	0xe0, 0xd2, 0x19, 0x6d, 0x78, 0x1b, 0x64, 0x3a, 0xd8, 0x6f, 0x71, 0xff, 0xe5, 0xd4, 0x2b, 0xbd,
+	0x40, 0x9a, 0x89, 0xaa, 0x84, 0x51, 0x05, 0x71, 0x10, 0x7e, 0x09, 0x2e, 0x79, 0x84, 0x75, 0xa8,
+	0xcb, 0x88, 0xee, 0x77, 0x3b, 0x84, 0x9b, 0x2c, 0xa7, 0x8a, 0xbd, 0x40, 0x9a, 0x8b, 0xd8, 0x67,
+	0x60, 0x05, 0xcd, 0xf6, 0x3f, 0x87, 0xe3, 0xbc, 0x9f, 0xe1, 0xa5, 0x7f, 0x15, 0xc0, 0x6c, 0x72,
+	0xc7, 0x70, 0x0f, 0x80, 0xc4, 0x66, 0x84, 0xb1, 0x36, 0x33, 0xdf, 0x0b, 0xa4, 0xab, 0x71, 0x83,
+	0x89, 0x2d, 0x24, 0x84, 0xe0, 0x3a, 0xc8, 0x61, 0xd3, 0xf4, 0x08, 0x63, 0x24, 0xbc, 0x0d, 0xe9,
+	0xc5, 0x9c, 0x3a, 0xd7, 0x0b, 0xa4, 0x7c, 0x94, 0x75, 0x0a, 0x29, 0x68, 0x40, 0x8b, 0x3b, 0xfc,
+	0x2b, 0x05, 0xa6, 0x2b, 0xd4, 0x24, 0x55, 0xf7, 0x80, 0xc2, 0xcf, 0xc0, 0x14, 0x77, 0x9d, 0x6d,
+	0xf6, 0xef, 0xe6, 0x49, 0x20, 0x65, 0x39, 0xbc, 0xd1, 0x0b, 0xa4, 0xcb, 0x09, 0x63, 0xda, 0xa6,
+	0x82, 0xb2, 0xe1, 0xa9, 0x6a, 0xc2, 0x35, 0x90, 0xe3, 0xb1, 0x16, 0x66, 0x2d, 0x3e, 0xa6, 0xd9,
+	0x64, 0xf5, 0x53, 0x48, 0x41, 0xd3, 0xe1, 0x79, 0x13, 0xb3, 0x16, 0x5c, 0x01, 0x53, 0x86, 0x47,
+	0xb0, 0x4f, 0x3d, 0x7e, 0xe1, 0x72, 0x2a, 0x4c, 0xe8, 0x47, 0x80, 0x82, 0xfa, 0x14, 0xf8, 0x23,
+	0xb8, 0x96, 0xf4, 0x64, 0x62, 0x82, 0xe3, 0xde, 0xad, 0x3b, 0xb1, 0x9d, 0x6e, 0x0e, 0x3b, 0x3c,
+	0x39, 0xd3, 0xf9, 0x04, 0x90, 0xb0, 0xf4, 0x1e, 0x98, 0x76, 0x88, 0x8f, 0x4d, 0xec, 0x63, 0x71,
+	0xf2, 0xfd, 0x15, 0xc3, 0x41, 0x6d, 0xc7, 0x3c, 0x75, 0x21, 0xae, 0x78, 0x25, 0x7e, 0x45, 0xe2,
+	0xb8, 0x82, 0x4e, 0xa5, 0x94, 0x97, 0x02, 0x98, 0x4d, 0xe6, 0xc0, 0x25, 0x90, 0x65, 0xf4, 0xd0,
+	0x33, 0x48, 0x6c, 0xcd, 0xab, 0xbd, 0x40, 0xba, 0x14, 0x5f, 0x00, 0x1e, 0x57, 0x50, 0x4c, 0x08,
+	0x07, 0xd8, 0x3c, 0xb4, 0xdb, 0x26, 0xf1, 0xc4, 0xd4, 0xf9, 0x01, 0xc6, 0x80, 0x82, 0xfa, 0x14,
+	0x2e, 0x6c, 0xb4, 0x88, 0x83, 0xc5, 0xf4, 0x90, 0x30, 0x8f, 0x87, 0xc2, 0xfc, 0x10, 0xdb, 0xe2,
+	0x45, 0x1a, 0xcc, 0xf6, 0x5f, 0x37, 0x6e, 0x8d, 0x15, 0x30, 0x15, 0x5b, 0x47, 0x14, 0xce, 0xd7,
+	0x8b, 0x01, 0x05, 0xf5, 0x29, 0xc9, 0xf5, 0xa6, 0x46, 0xaf, 0xf7, 0xff, 0x60, 0x12, 0x9b, 0x8e,
+	0xed, 0xc6, 0xcd, 0xe5, 0x7b, 0x81, 0x34, 0xdb, 0x57, 0x76, 0x6c, 0x57, 0x41, 0x11, 0x9c, 0xb4,
+	0x67, 0xe6, 0x03, 0xec, 0xf9, 0x35, 0x98, 0xb6, 0x5d, 0x9b, 0xbf, 0xdd, 0x7c, 0x7b, 0xb3, 0x6a,
+	0x69, 0xb0, 0x97, 0x3e, 0xa2, 0xfc, 0x1d, 0x48, 0x22, 0x71, 0x0d, 0x6a, 0xda, 0xae, 0x55, 0xfa,
+	0x81, 0x51, 0x77, 0x15, 0xe1, 0x27, 0xdb, 0x84, 0x31, 0x6c, 0x11, 0x34, 0x15, 0xd2, 0xb6, 0x99,
+	0x05, 0x2b, 0x60, 0xc6, 0x6e, 0x1a, 0x7a, 0x87, 0x7a, 0x7e, 0xd8, 0x46, 0x96, 0x37, 0x7c, 0xfb,
+	0x24, 0x90, 0x72, 0x55, 0xb5, 0xb2, 0x4b, 0x3d, 0x9f, 0x77, 0x02, 0x63, 0xed, 0x01, 0x53, 0x41,
+	0x39, 0xbb, 0x69, 0x70, 0x82, 0x19, 0x7e, 0xdf, 0x36, 0x6e, 0x92, 0xb6, 0x38, 0x75, 0xfe, 0xfb,
+	0xf2, 0xb0, 0x82, 0x22, 0x38, 0x5e, 0xc5, 0xef, 0x29, 0x20, 0xf6, 0x57, 0x11, 0x7e, 0xd7, 0x4d,
+	0x9b, 0xf9, 0xd4, 0xeb, 0x6a, 0xae, 0xef, 0x75, 0x61, 0x0b, 0xe4, 0x68, 0x87, 0x78, 0xd8, 0x1f,
+	0x3c, 0x27, 0x9f, 0x5e, 0x6c, 0xcd, 0x21, 0x81, 0x5a, 0x3f, 0x8f, 0x3f, 0x32, 0x89, 0x0b, 0x7b,
+	0x2a, 0xa8, 0xa0, 0x81, 0x78, 0x72, 0xf8, 0xa9, 0x0f, 0x18, 0xfe, 0x12, 0xc8, 0xb6, 0x88, 0x6d,
+	0xb5, 0x7c, 0xbe, 0xdc, 0x74, 0xd2, 0x79, 0x51, 0x5c, 0x41, 0x31, 0x81, 0x9b, 0x94, 0xb8, 0xa1,
+	0xa3, 0x33, 0x43, 0x26, 0xe5, 0xf1, 0xd0, 0xa4, 0xfc, 0x00, 0xbf, 0x00, 0xe9, 0xc1, 0x36, 0x97,
+	0x7b, 0x81, 0x04, 0xe2, 0x5b, 0x36, 0x6a, 0x91, 0x61, 0x5a, 0x34, 0xd7, 0xe5, 0xb7, 0x02, 0x00,
+	0x83, 0x57, 0x16, 0x7e, 0x0e, 0x16, 0xca, 0x95, 0x8a, 0x56, 0xaf, 0xeb, 0x8d, 0xfd, 0x5d, 0x4d,
+	0xdf, 0xdb, 0xa9, 0xef, 0x6a, 0x95, 0xea, 0x57, 0x55, 0x6d, 0x23, 0x3f, 0x51, 0xb8, 0x7e, 0x74,
+	0x2c, 0xcf, 0x0f, 0xc8, 0x7b, 0x2e, 0xeb, 0x10, 0xc3, 0x3e, 0xb0, 0x89, 0x09, 0x57, 0x00, 0x4c,
+	0xe6, 0xed, 0xd4, 0xd4, 0xda, 0xc6, 0x7e, 0x5e, 0x28, 0xcc, 0x1d, 0x1d, 0xcb, 0xf9, 0x41, 0xca,
+	0x0e, 0x6d, 0x52, 0xb3, 0x0b, 0xd7, 0xc1, 0x7c, 0x92, 0x5d, 0xde, 0xda, 0xaa, 0x3d, 0xdc, 0xaa,
+	0xd6, 0x1b, 0xf9, 0x54, 0x61, 0xe1, 0xe8, 0x58, 0xfe, 0xcf, 0x20, 0xe1, 0xf4, 0x2f, 0xd9, 0xf9,
+	0x1c, 0xed, 0x5b, 0x0d, 0xed, 0xf3, 0x22, 0xe9, 0xf3, 0x39, 0xda, 0x63, 0xe2, 0x75, 0xc3, 0x3a,
+	0x85, 0xcc, 0x8b, 0xdf, 0x8a, 0x13, 0xcb, 0xbf, 0x64, 0x80, 0x3c, 0x6a, 0xf3, 0x90, 0x80, 0x8f,
+	0x2b, 0xb5, 0x9d, 0x06, 0x2a, 0x57, 0x1a, 0x7a, 0xa5, 0xb6, 0xa1, 0xe9, 0x9b, 0xd5, 0x7a, 0xa3,
+	0x86, 0xf6, 0xf5, 0xda, 0xae, 0x86, 0xca, 0x8d, 0x6a, 0x6d, 0xe7, 0xa2, 0x89, 0x94, 0x8e, 0x8e,
+	0xe5, 0x7b, 0xa3, 0xb4, 0x93, 0x73, 0x7a, 0x08, 0x96, 0xc6, 0x2a, 0x53, 0xdd, 0xa9, 0x36, 0xf2,
+	0x42, 0x61, 0xf1, 0xe8, 0x58, 0xfe, 0xdf, 0x28, 0xfd, 0xaa, 0x6b, 0xfb, 0xf0, 0x7b, 0xb0, 0x32,
+	0x96, 0xf0, 0x76, 0xf5, 0x01, 0x2a, 0x37, 0xb4, 0x7c, 0xaa, 0x70, 0xef, 0xe8, 0x58, 0xbe, 0x3b,
+	0x4a, 0x7b, 0xdb, 0xb6, 0x3c, 0xec, 0x13, 0x78, 0x00, 0xd6, 0xc6, 0x1b, 0xcf, 0xee, 0x46, 0xb9,
+	0xa1, 0xe9, 0xe5, 0x8d, 0xed, 0xea, 0x4e, 0x3e, 0x3d, 0xe6, 0x7c, 0x3a, 0x26, 0xf6, 0x49, 0x99,
+	0x3f, 0x6e, 0xe6, 0x98, 0x6b, 0xa8, 0x6c, 0x69, 0x65, 0x14, 0x97, 0xc9, 0x14, 0x56, 0x8f, 0x8e,
+	0xe5, 0xe5, 0x51, 0x65, 0x2a, 0x6d, 0x82, 0x3d, 0x5e, 0x25, 0xf2, 0x85, 0xaa, 0xbe, 0x3e, 0x29,
+	0x0a, 0x6f, 0x4e, 0x8a, 0xc2, 0xdb, 0x93, 0xa2, 0xf0, 0xf3, 0xbb, 0xe2, 0xc4, 0x9b, 0x77, 0xc5,
+	0x89, 0x3f, 0xde, 0x15, 0x27, 0xbe, 0x5b, 0xb4, 0x6c, 0xbf, 0x75, 0xd8, 0x5c, 0x35, 0xa8, 0x53,
+	0xe2, 0xcf, 0xc8, 0x47, 0x0e, 0x75, 0x49, 0xb7, 0x64, 0x50, 0x8f, 0x94, 0x9e, 0x46, 0xff, 0x33,
+	0x84, 0x3f, 0x77, 0x58, 0x33, 0xcb, 0x7f, 0xe3, 0x7f, 0xf2, 0xcf, 0x00, 0xa3, 0xb2, 0x3f, 0xa1,
+	0x4e, 0x0c, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -304,16 +758,30 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.MaxContractMsgSize != that1.MaxContractMsgSize {
 		return false
 	}
+	if !this.CodeUploadAccess.Equal(&that1.CodeUploadAccess) {
+		return false
+	}
+	if this.InstantiateDefaultPermission != that1.InstantiateDefaultPermission {
+		return false
+	}
+	if len(this.StargateQueryAllowlist) != len(that1.StargateQueryAllowlist) {
+		return false
+	}
+	for i := range this.StargateQueryAllowlist {
+		if !this.StargateQueryAllowlist[i].Equal(&that1.StargateQueryAllowlist[i]) {
+			return false
+		}
+	}
 	return true
 }
-func (this *ContractInfo) Equal(that interface{}) bool {
+func (this *StargateQuery) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*ContractInfo)
+	that1, ok := that.(*StargateQuery)
 	if !ok {
-		that2, ok := that.(ContractInfo)
+		that2, ok := that.(StargateQuery)
 		if ok {
 			that1 = &that2
 		} else {
@@ -325,43 +793,203 @@ func (this *ContractInfo) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.Address != that1.Address {
+	if this.Path != that1.Path {
 		return false
 	}
-	if this.Creator != that1.Creator {
+	if this.ResponseType != that1.ResponseType {
 		return false
 	}
-	if this.Admin != that1.Admin {
+	return true
+}
+func (this *AccessConfig) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AccessConfig)
+	if !ok {
+		that2, ok := that.(AccessConfig)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
 		return false
 	}
-	if this.CodeID != that1.CodeID {
+	if this.Permission != that1.Permission {
 		return false
 	}
-	if !bytes.Equal(this.InitMsg, that1.InitMsg) {
+	if len(this.Addresses) != len(that1.Addresses) {
 		return false
 	}
+	for i := range this.Addresses {
+		if this.Addresses[i] != that1.Addresses[i] {
+			return false
+		}
+	}
 	return true
 }
-func (m *Params) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *CodeMetadata) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
 
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
+	that1, ok := that.(*CodeMetadata)
+	if !ok {
+		that2, ok := that.(CodeMetadata)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Source != that1.Source {
+		return false
+	}
+	if this.Builder != that1.Builder {
+		return false
+	}
+	if this.Schema != that1.Schema {
+		return false
+	}
+	return true
+}
+func (this *ContractInfo) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ContractInfo)
+	if !ok {
+		that2, ok := that.(ContractInfo)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Address != that1.Address {
+		return false
+	}
+	if this.Creator != that1.Creator {
+		return false
+	}
+	if this.Admin != that1.Admin {
+		return false
+	}
+	if this.CodeID != that1.CodeID {
+		return false
+	}
+	if !bytes.Equal(this.InitMsg, that1.InitMsg) {
+		return false
+	}
+	if this.IBCPortID != that1.IBCPortID {
+		return false
+	}
+	if this.Label != that1.Label {
+		return false
+	}
+	return true
+}
+func (this *ContractCodeHistoryEntry) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ContractCodeHistoryEntry)
+	if !ok {
+		that2, ok := that.(ContractCodeHistoryEntry)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Operation != that1.Operation {
+		return false
+	}
+	if this.CodeID != that1.CodeID {
+		return false
+	}
+	if this.Height != that1.Height {
+		return false
+	}
+	if this.Sender != that1.Sender {
+		return false
+	}
+	if !bytes.Equal(this.Msg, that1.Msg) {
+		return false
+	}
+	return true
+}
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
+	if len(m.StargateQueryAllowlist) > 0 {
+		for iNdEx := len(m.StargateQueryAllowlist) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StargateQueryAllowlist[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintWasm(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.InstantiateDefaultPermission != 0 {
+		i = encodeVarintWasm(dAtA, i, uint64(m.InstantiateDefaultPermission))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size, err := m.CodeUploadAccess.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintWasm(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
 	if m.MaxContractMsgSize != 0 {
 		i = encodeVarintWasm(dAtA, i, uint64(m.MaxContractMsgSize))
 		i--
@@ -380,6 +1008,80 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *StargateQuery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StargateQuery) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StargateQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ResponseType) > 0 {
+		i -= len(m.ResponseType)
+		copy(dAtA[i:], m.ResponseType)
+		i = encodeVarintWasm(dAtA, i, uint64(len(m.ResponseType)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Path) > 0 {
+		i -= len(m.Path)
+		copy(dAtA[i:], m.Path)
+		i = encodeVarintWasm(dAtA, i, uint64(len(m.Path)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AccessConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccessConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccessConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Addresses) > 0 {
+		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Addresses[iNdEx])
+			copy(dAtA[i:], m.Addresses[iNdEx])
+			i = encodeVarintWasm(dAtA, i, uint64(len(m.Addresses[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Permission != 0 {
+		i = encodeVarintWasm(dAtA, i, uint64(m.Permission))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *CodeInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -400,6 +1102,26 @@ func (m *CodeInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintWasm(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.InstantiatePermission.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintWasm(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
 	if len(m.Creator) > 0 {
 		i -= len(m.Creator)
 		copy(dAtA[i:], m.Creator)
@@ -422,6 +1144,50 @@ func (m *CodeInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *CodeMetadata) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CodeMetadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CodeMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Schema) > 0 {
+		i -= len(m.Schema)
+		copy(dAtA[i:], m.Schema)
+		i = encodeVarintWasm(dAtA, i, uint64(len(m.Schema)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Builder) > 0 {
+		i -= len(m.Builder)
+		copy(dAtA[i:], m.Builder)
+		i = encodeVarintWasm(dAtA, i, uint64(len(m.Builder)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintWasm(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ContractInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -442,6 +1208,20 @@ func (m *ContractInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Label) > 0 {
+		i -= len(m.Label)
+		copy(dAtA[i:], m.Label)
+		i = encodeVarintWasm(dAtA, i, uint64(len(m.Label)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.IBCPortID) > 0 {
+		i -= len(m.IBCPortID)
+		copy(dAtA[i:], m.IBCPortID)
+		i = encodeVarintWasm(dAtA, i, uint64(len(m.IBCPortID)))
+		i--
+		dAtA[i] = 0x32
+	}
 	if len(m.InitMsg) > 0 {
 		i -= len(m.InitMsg)
 		copy(dAtA[i:], m.InitMsg)
@@ -478,6 +1258,58 @@ func (m *ContractInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ContractCodeHistoryEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractCodeHistoryEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractCodeHistoryEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Msg) > 0 {
+		i -= len(m.Msg)
+		copy(dAtA[i:], m.Msg)
+		i = encodeVarintWasm(dAtA, i, uint64(len(m.Msg)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintWasm(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Height != 0 {
+		i = encodeVarintWasm(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.CodeID != 0 {
+		i = encodeVarintWasm(dAtA, i, uint64(m.CodeID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Operation != 0 {
+		i = encodeVarintWasm(dAtA, i, uint64(m.Operation))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintWasm(dAtA []byte, offset int, v uint64) int {
 	offset -= sovWasm(v)
 	base := offset
@@ -504,40 +1336,111 @@ func (m *Params) Size() (n int) {
 	if m.MaxContractMsgSize != 0 {
 		n += 1 + sovWasm(uint64(m.MaxContractMsgSize))
 	}
+	l = m.CodeUploadAccess.Size()
+	n += 1 + l + sovWasm(uint64(l))
+	if m.InstantiateDefaultPermission != 0 {
+		n += 1 + sovWasm(uint64(m.InstantiateDefaultPermission))
+	}
+	if len(m.StargateQueryAllowlist) > 0 {
+		for _, e := range m.StargateQueryAllowlist {
+			l = e.Size()
+			n += 1 + l + sovWasm(uint64(l))
+		}
+	}
 	return n
 }
 
-func (m *CodeInfo) Size() (n int) {
+func (m *StargateQuery) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.CodeID != 0 {
-		n += 1 + sovWasm(uint64(m.CodeID))
-	}
-	l = len(m.CodeHash)
+	l = len(m.Path)
 	if l > 0 {
 		n += 1 + l + sovWasm(uint64(l))
 	}
-	l = len(m.Creator)
+	l = len(m.ResponseType)
 	if l > 0 {
 		n += 1 + l + sovWasm(uint64(l))
 	}
 	return n
 }
 
-func (m *ContractInfo) Size() (n int) {
+func (m *AccessConfig) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovWasm(uint64(l))
+	if m.Permission != 0 {
+		n += 1 + sovWasm(uint64(m.Permission))
 	}
-	l = len(m.Creator)
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			l = len(s)
+			n += 1 + l + sovWasm(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CodeInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CodeID != 0 {
+		n += 1 + sovWasm(uint64(m.CodeID))
+	}
+	l = len(m.CodeHash)
+	if l > 0 {
+		n += 1 + l + sovWasm(uint64(l))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovWasm(uint64(l))
+	}
+	l = m.InstantiatePermission.Size()
+	n += 1 + l + sovWasm(uint64(l))
+	l = m.Metadata.Size()
+	n += 1 + l + sovWasm(uint64(l))
+	return n
+}
+
+func (m *CodeMetadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovWasm(uint64(l))
+	}
+	l = len(m.Builder)
+	if l > 0 {
+		n += 1 + l + sovWasm(uint64(l))
+	}
+	l = len(m.Schema)
+	if l > 0 {
+		n += 1 + l + sovWasm(uint64(l))
+	}
+	return n
+}
+
+func (m *ContractInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovWasm(uint64(l))
+	}
+	l = len(m.Creator)
 	if l > 0 {
 		n += 1 + l + sovWasm(uint64(l))
 	}
@@ -552,6 +1455,40 @@ func (m *ContractInfo) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovWasm(uint64(l))
 	}
+	l = len(m.IBCPortID)
+	if l > 0 {
+		n += 1 + l + sovWasm(uint64(l))
+	}
+	l = len(m.Label)
+	if l > 0 {
+		n += 1 + l + sovWasm(uint64(l))
+	}
+	return n
+}
+
+func (m *ContractCodeHistoryEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Operation != 0 {
+		n += 1 + sovWasm(uint64(m.Operation))
+	}
+	if m.CodeID != 0 {
+		n += 1 + sovWasm(uint64(m.CodeID))
+	}
+	if m.Height != 0 {
+		n += 1 + sovWasm(uint64(m.Height))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovWasm(uint64(l))
+	}
+	l = len(m.Msg)
+	if l > 0 {
+		n += 1 + l + sovWasm(uint64(l))
+	}
 	return n
 }
 
@@ -647,6 +1584,508 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeUploadAccess", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWasm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthWasm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthWasm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CodeUploadAccess.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InstantiateDefaultPermission", wireType)
+			}
+			m.InstantiateDefaultPermission = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWasm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InstantiateDefaultPermission |= AccessType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StargateQueryAllowlist", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWasm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthWasm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthWasm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StargateQueryAllowlist = append(m.StargateQueryAllowlist, StargateQuery{})
+			if err := m.StargateQueryAllowlist[len(m.StargateQueryAllowlist)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWasm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWasm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StargateQuery) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWasm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StargateQuery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StargateQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWasm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWasm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWasm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResponseType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWasm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWasm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWasm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ResponseType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWasm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWasm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AccessConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWasm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccessConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccessConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Permission", wireType)
+			}
+			m.Permission = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWasm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Permission |= AccessType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWasm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWasm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWasm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWasm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWasm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CodeInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWasm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CodeInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CodeInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeID", wireType)
+			}
+			m.CodeID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWasm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CodeID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWasm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthWasm
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWasm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CodeHash = append(m.CodeHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.CodeHash == nil {
+				m.CodeHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWasm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWasm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWasm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InstantiatePermission", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWasm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthWasm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthWasm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InstantiatePermission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWasm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthWasm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthWasm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipWasm(dAtA[iNdEx:])
@@ -668,7 +2107,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CodeInfo) Unmarshal(dAtA []byte) error {
+func (m *CodeMetadata) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -691,17 +2130,17 @@ func (m *CodeInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CodeInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: CodeMetadata: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CodeInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CodeMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CodeID", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
 			}
-			m.CodeID = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowWasm
@@ -711,16 +2150,29 @@ func (m *CodeInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CodeID |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWasm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWasm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Source = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CodeHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Builder", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowWasm
@@ -730,29 +2182,27 @@ func (m *CodeInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthWasm
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthWasm
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CodeHash = append(m.CodeHash[:0], dAtA[iNdEx:postIndex]...)
-			if m.CodeHash == nil {
-				m.CodeHash = []byte{}
-			}
+			m.Builder = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Schema", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -780,7 +2230,7 @@ func (m *CodeInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.Schema = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -981,6 +2431,243 @@ func (m *ContractInfo) Unmarshal(dAtA []byte) error {
 				m.InitMsg = []byte{}
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IBCPortID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWasm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWasm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWasm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IBCPortID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Label", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWasm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWasm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWasm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Label = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWasm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWasm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ContractCodeHistoryEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWasm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContractCodeHistoryEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContractCodeHistoryEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operation", wireType)
+			}
+			m.Operation = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWasm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Operation |= ContractCodeHistoryOperationType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeID", wireType)
+			}
+			m.CodeID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWasm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CodeID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWasm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWasm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWasm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWasm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWasm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthWasm
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWasm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Msg = append(m.Msg[:0], dAtA[iNdEx:postIndex]...)
+			if m.Msg == nil {
+				m.Msg = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipWasm(dAtA[iNdEx:])